@@ -18,10 +18,12 @@
 package assistantv1
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/watson-developer-cloud/go-sdk/common"
+	"net/http"
 )
 
 // AssistantV1 : The IBM Watson&trade; Assistant service combines machine learning, natural language understanding, and
@@ -44,10 +46,13 @@ const DefaultServiceName = "conversation"
 
 // AssistantV1Options : Service options
 type AssistantV1Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewAssistantV1 : constructs an instance of AssistantV1 with passed in options.
@@ -85,6 +90,16 @@ func NewAssistantV1(options *AssistantV1Options) (service *AssistantV1, err erro
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &AssistantV1{
 		Service: baseService,
 		Version: options.Version,
@@ -100,7 +115,27 @@ func (assistant *AssistantV1) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (assistant *AssistantV1) DisableSSLVerification() {
-	assistant.Service.DisableSSLVerification()
+	common.DisableSSLVerification(assistant.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (assistant *AssistantV1) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(assistant.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (assistant *AssistantV1) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(assistant.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (assistant *AssistantV1) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(assistant.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (assistant *AssistantV1) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(assistant.Service, interceptor)
 }
 
 // Message : Get response to user input
@@ -110,6 +145,11 @@ func (assistant *AssistantV1) DisableSSLVerification() {
 // including ease of deployment, automatic state management, versioning, and search capabilities. For more information,
 // see the [documentation](https://cloud.ibm.com/docs/assistant?topic=assistant-api-overview).
 func (assistant *AssistantV1) Message(messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
+	return assistant.MessageWithContext(context.Background(), messageOptions)
+}
+
+// MessageWithContext is an alternate form of the Message method which supports a Context parameter
+func (assistant *AssistantV1) MessageWithContext(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(messageOptions, "messageOptions cannot be nil")
 	if err != nil {
 		return
@@ -174,7 +214,10 @@ func (assistant *AssistantV1) Message(messageOptions *MessageOptions) (result *M
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(MessageResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MessageResponse)
@@ -189,6 +232,11 @@ func (assistant *AssistantV1) Message(messageOptions *MessageOptions) (result *M
 // ListWorkspaces : List workspaces
 // List the workspaces associated with a Watson Assistant service instance.
 func (assistant *AssistantV1) ListWorkspaces(listWorkspacesOptions *ListWorkspacesOptions) (result *WorkspaceCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListWorkspacesWithContext(context.Background(), listWorkspacesOptions)
+}
+
+// ListWorkspacesWithContext is an alternate form of the ListWorkspaces method which supports a Context parameter
+func (assistant *AssistantV1) ListWorkspacesWithContext(ctx context.Context, listWorkspacesOptions *ListWorkspacesOptions) (result *WorkspaceCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listWorkspacesOptions, "listWorkspacesOptions")
 	if err != nil {
 		return
@@ -233,7 +281,10 @@ func (assistant *AssistantV1) ListWorkspaces(listWorkspacesOptions *ListWorkspac
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(WorkspaceCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*WorkspaceCollection)
@@ -249,6 +300,11 @@ func (assistant *AssistantV1) ListWorkspaces(listWorkspacesOptions *ListWorkspac
 // Create a workspace based on component objects. You must provide workspace components defining the content of the new
 // workspace.
 func (assistant *AssistantV1) CreateWorkspace(createWorkspaceOptions *CreateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	return assistant.CreateWorkspaceWithContext(context.Background(), createWorkspaceOptions)
+}
+
+// CreateWorkspaceWithContext is an alternate form of the CreateWorkspace method which supports a Context parameter
+func (assistant *AssistantV1) CreateWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(createWorkspaceOptions, "createWorkspaceOptions")
 	if err != nil {
 		return
@@ -324,7 +380,10 @@ func (assistant *AssistantV1) CreateWorkspace(createWorkspaceOptions *CreateWork
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Workspace))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Workspace)
@@ -339,6 +398,11 @@ func (assistant *AssistantV1) CreateWorkspace(createWorkspaceOptions *CreateWork
 // GetWorkspace : Get information about a workspace
 // Get information about a workspace, optionally including all workspace content.
 func (assistant *AssistantV1) GetWorkspace(getWorkspaceOptions *GetWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	return assistant.GetWorkspaceWithContext(context.Background(), getWorkspaceOptions)
+}
+
+// GetWorkspaceWithContext is an alternate form of the GetWorkspace method which supports a Context parameter
+func (assistant *AssistantV1) GetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getWorkspaceOptions, "getWorkspaceOptions cannot be nil")
 	if err != nil {
 		return
@@ -384,7 +448,10 @@ func (assistant *AssistantV1) GetWorkspace(getWorkspaceOptions *GetWorkspaceOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Workspace))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Workspace)
@@ -400,6 +467,11 @@ func (assistant *AssistantV1) GetWorkspace(getWorkspaceOptions *GetWorkspaceOpti
 // Update an existing workspace with new or modified data. You must provide component objects defining the content of
 // the updated workspace.
 func (assistant *AssistantV1) UpdateWorkspace(updateWorkspaceOptions *UpdateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	return assistant.UpdateWorkspaceWithContext(context.Background(), updateWorkspaceOptions)
+}
+
+// UpdateWorkspaceWithContext is an alternate form of the UpdateWorkspace method which supports a Context parameter
+func (assistant *AssistantV1) UpdateWorkspaceWithContext(ctx context.Context, updateWorkspaceOptions *UpdateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateWorkspaceOptions, "updateWorkspaceOptions cannot be nil")
 	if err != nil {
 		return
@@ -482,7 +554,10 @@ func (assistant *AssistantV1) UpdateWorkspace(updateWorkspaceOptions *UpdateWork
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Workspace))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Workspace)
@@ -497,6 +572,11 @@ func (assistant *AssistantV1) UpdateWorkspace(updateWorkspaceOptions *UpdateWork
 // DeleteWorkspace : Delete workspace
 // Delete a workspace from the service instance.
 func (assistant *AssistantV1) DeleteWorkspace(deleteWorkspaceOptions *DeleteWorkspaceOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteWorkspaceWithContext(context.Background(), deleteWorkspaceOptions)
+}
+
+// DeleteWorkspaceWithContext is an alternate form of the DeleteWorkspace method which supports a Context parameter
+func (assistant *AssistantV1) DeleteWorkspaceWithContext(ctx context.Context, deleteWorkspaceOptions *DeleteWorkspaceOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteWorkspaceOptions, "deleteWorkspaceOptions cannot be nil")
 	if err != nil {
 		return
@@ -532,7 +612,10 @@ func (assistant *AssistantV1) DeleteWorkspace(deleteWorkspaceOptions *DeleteWork
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -540,6 +623,11 @@ func (assistant *AssistantV1) DeleteWorkspace(deleteWorkspaceOptions *DeleteWork
 // ListIntents : List intents
 // List the intents for a workspace.
 func (assistant *AssistantV1) ListIntents(listIntentsOptions *ListIntentsOptions) (result *IntentCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListIntentsWithContext(context.Background(), listIntentsOptions)
+}
+
+// ListIntentsWithContext is an alternate form of the ListIntents method which supports a Context parameter
+func (assistant *AssistantV1) ListIntentsWithContext(ctx context.Context, listIntentsOptions *ListIntentsOptions) (result *IntentCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listIntentsOptions, "listIntentsOptions cannot be nil")
 	if err != nil {
 		return
@@ -591,7 +679,10 @@ func (assistant *AssistantV1) ListIntents(listIntentsOptions *ListIntentsOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(IntentCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*IntentCollection)
@@ -609,6 +700,11 @@ func (assistant *AssistantV1) ListIntents(listIntentsOptions *ListIntentsOptions
 // If you want to create multiple intents with a single API call, consider using the **[Update
 // workspace](#update-workspace)** method instead.
 func (assistant *AssistantV1) CreateIntent(createIntentOptions *CreateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	return assistant.CreateIntentWithContext(context.Background(), createIntentOptions)
+}
+
+// CreateIntentWithContext is an alternate form of the CreateIntent method which supports a Context parameter
+func (assistant *AssistantV1) CreateIntentWithContext(ctx context.Context, createIntentOptions *CreateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createIntentOptions, "createIntentOptions cannot be nil")
 	if err != nil {
 		return
@@ -664,7 +760,10 @@ func (assistant *AssistantV1) CreateIntent(createIntentOptions *CreateIntentOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Intent))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Intent)
@@ -679,6 +778,11 @@ func (assistant *AssistantV1) CreateIntent(createIntentOptions *CreateIntentOpti
 // GetIntent : Get intent
 // Get information about an intent, optionally including all intent content.
 func (assistant *AssistantV1) GetIntent(getIntentOptions *GetIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	return assistant.GetIntentWithContext(context.Background(), getIntentOptions)
+}
+
+// GetIntentWithContext is an alternate form of the GetIntent method which supports a Context parameter
+func (assistant *AssistantV1) GetIntentWithContext(ctx context.Context, getIntentOptions *GetIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getIntentOptions, "getIntentOptions cannot be nil")
 	if err != nil {
 		return
@@ -721,7 +825,10 @@ func (assistant *AssistantV1) GetIntent(getIntentOptions *GetIntentOptions) (res
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Intent))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Intent)
@@ -740,6 +847,11 @@ func (assistant *AssistantV1) GetIntent(getIntentOptions *GetIntentOptions) (res
 // If you want to update multiple intents with a single API call, consider using the **[Update
 // workspace](#update-workspace)** method instead.
 func (assistant *AssistantV1) UpdateIntent(updateIntentOptions *UpdateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	return assistant.UpdateIntentWithContext(context.Background(), updateIntentOptions)
+}
+
+// UpdateIntentWithContext is an alternate form of the UpdateIntent method which supports a Context parameter
+func (assistant *AssistantV1) UpdateIntentWithContext(ctx context.Context, updateIntentOptions *UpdateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateIntentOptions, "updateIntentOptions cannot be nil")
 	if err != nil {
 		return
@@ -798,7 +910,10 @@ func (assistant *AssistantV1) UpdateIntent(updateIntentOptions *UpdateIntentOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Intent))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Intent)
@@ -813,6 +928,11 @@ func (assistant *AssistantV1) UpdateIntent(updateIntentOptions *UpdateIntentOpti
 // DeleteIntent : Delete intent
 // Delete an intent from a workspace.
 func (assistant *AssistantV1) DeleteIntent(deleteIntentOptions *DeleteIntentOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteIntentWithContext(context.Background(), deleteIntentOptions)
+}
+
+// DeleteIntentWithContext is an alternate form of the DeleteIntent method which supports a Context parameter
+func (assistant *AssistantV1) DeleteIntentWithContext(ctx context.Context, deleteIntentOptions *DeleteIntentOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteIntentOptions, "deleteIntentOptions cannot be nil")
 	if err != nil {
 		return
@@ -848,7 +968,10 @@ func (assistant *AssistantV1) DeleteIntent(deleteIntentOptions *DeleteIntentOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -856,6 +979,11 @@ func (assistant *AssistantV1) DeleteIntent(deleteIntentOptions *DeleteIntentOpti
 // ListExamples : List user input examples
 // List the user input examples for an intent, optionally including contextual entity mentions.
 func (assistant *AssistantV1) ListExamples(listExamplesOptions *ListExamplesOptions) (result *ExampleCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListExamplesWithContext(context.Background(), listExamplesOptions)
+}
+
+// ListExamplesWithContext is an alternate form of the ListExamples method which supports a Context parameter
+func (assistant *AssistantV1) ListExamplesWithContext(ctx context.Context, listExamplesOptions *ListExamplesOptions) (result *ExampleCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listExamplesOptions, "listExamplesOptions cannot be nil")
 	if err != nil {
 		return
@@ -904,7 +1032,10 @@ func (assistant *AssistantV1) ListExamples(listExamplesOptions *ListExamplesOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(ExampleCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ExampleCollection)
@@ -922,6 +1053,11 @@ func (assistant *AssistantV1) ListExamples(listExamplesOptions *ListExamplesOpti
 // If you want to add multiple examples with a single API call, consider using the **[Update intent](#update-intent)**
 // method instead.
 func (assistant *AssistantV1) CreateExample(createExampleOptions *CreateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	return assistant.CreateExampleWithContext(context.Background(), createExampleOptions)
+}
+
+// CreateExampleWithContext is an alternate form of the CreateExample method which supports a Context parameter
+func (assistant *AssistantV1) CreateExampleWithContext(ctx context.Context, createExampleOptions *CreateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createExampleOptions, "createExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -974,7 +1110,10 @@ func (assistant *AssistantV1) CreateExample(createExampleOptions *CreateExampleO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Example))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Example)
@@ -989,6 +1128,11 @@ func (assistant *AssistantV1) CreateExample(createExampleOptions *CreateExampleO
 // GetExample : Get user input example
 // Get information about a user input example.
 func (assistant *AssistantV1) GetExample(getExampleOptions *GetExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	return assistant.GetExampleWithContext(context.Background(), getExampleOptions)
+}
+
+// GetExampleWithContext is an alternate form of the GetExample method which supports a Context parameter
+func (assistant *AssistantV1) GetExampleWithContext(ctx context.Context, getExampleOptions *GetExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getExampleOptions, "getExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -1028,7 +1172,10 @@ func (assistant *AssistantV1) GetExample(getExampleOptions *GetExampleOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Example))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Example)
@@ -1046,6 +1193,11 @@ func (assistant *AssistantV1) GetExample(getExampleOptions *GetExampleOptions) (
 // If you want to update multiple examples with a single API call, consider using the **[Update
 // intent](#update-intent)** method instead.
 func (assistant *AssistantV1) UpdateExample(updateExampleOptions *UpdateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	return assistant.UpdateExampleWithContext(context.Background(), updateExampleOptions)
+}
+
+// UpdateExampleWithContext is an alternate form of the UpdateExample method which supports a Context parameter
+func (assistant *AssistantV1) UpdateExampleWithContext(ctx context.Context, updateExampleOptions *UpdateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateExampleOptions, "updateExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -1098,7 +1250,10 @@ func (assistant *AssistantV1) UpdateExample(updateExampleOptions *UpdateExampleO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Example))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Example)
@@ -1113,6 +1268,11 @@ func (assistant *AssistantV1) UpdateExample(updateExampleOptions *UpdateExampleO
 // DeleteExample : Delete user input example
 // Delete a user input example from an intent.
 func (assistant *AssistantV1) DeleteExample(deleteExampleOptions *DeleteExampleOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteExampleWithContext(context.Background(), deleteExampleOptions)
+}
+
+// DeleteExampleWithContext is an alternate form of the DeleteExample method which supports a Context parameter
+func (assistant *AssistantV1) DeleteExampleWithContext(ctx context.Context, deleteExampleOptions *DeleteExampleOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteExampleOptions, "deleteExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -1148,7 +1308,10 @@ func (assistant *AssistantV1) DeleteExample(deleteExampleOptions *DeleteExampleO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1156,6 +1319,11 @@ func (assistant *AssistantV1) DeleteExample(deleteExampleOptions *DeleteExampleO
 // ListCounterexamples : List counterexamples
 // List the counterexamples for a workspace. Counterexamples are examples that have been marked as irrelevant input.
 func (assistant *AssistantV1) ListCounterexamples(listCounterexamplesOptions *ListCounterexamplesOptions) (result *CounterexampleCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListCounterexamplesWithContext(context.Background(), listCounterexamplesOptions)
+}
+
+// ListCounterexamplesWithContext is an alternate form of the ListCounterexamples method which supports a Context parameter
+func (assistant *AssistantV1) ListCounterexamplesWithContext(ctx context.Context, listCounterexamplesOptions *ListCounterexamplesOptions) (result *CounterexampleCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listCounterexamplesOptions, "listCounterexamplesOptions cannot be nil")
 	if err != nil {
 		return
@@ -1204,7 +1372,10 @@ func (assistant *AssistantV1) ListCounterexamples(listCounterexamplesOptions *Li
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(CounterexampleCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CounterexampleCollection)
@@ -1222,6 +1393,11 @@ func (assistant *AssistantV1) ListCounterexamples(listCounterexamplesOptions *Li
 // If you want to add multiple counterexamples with a single API call, consider using the **[Update
 // workspace](#update-workspace)** method instead.
 func (assistant *AssistantV1) CreateCounterexample(createCounterexampleOptions *CreateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	return assistant.CreateCounterexampleWithContext(context.Background(), createCounterexampleOptions)
+}
+
+// CreateCounterexampleWithContext is an alternate form of the CreateCounterexample method which supports a Context parameter
+func (assistant *AssistantV1) CreateCounterexampleWithContext(ctx context.Context, createCounterexampleOptions *CreateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createCounterexampleOptions, "createCounterexampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -1271,7 +1447,10 @@ func (assistant *AssistantV1) CreateCounterexample(createCounterexampleOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Counterexample))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Counterexample)
@@ -1286,6 +1465,11 @@ func (assistant *AssistantV1) CreateCounterexample(createCounterexampleOptions *
 // GetCounterexample : Get counterexample
 // Get information about a counterexample. Counterexamples are examples that have been marked as irrelevant input.
 func (assistant *AssistantV1) GetCounterexample(getCounterexampleOptions *GetCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	return assistant.GetCounterexampleWithContext(context.Background(), getCounterexampleOptions)
+}
+
+// GetCounterexampleWithContext is an alternate form of the GetCounterexample method which supports a Context parameter
+func (assistant *AssistantV1) GetCounterexampleWithContext(ctx context.Context, getCounterexampleOptions *GetCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCounterexampleOptions, "getCounterexampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -1325,7 +1509,10 @@ func (assistant *AssistantV1) GetCounterexample(getCounterexampleOptions *GetCou
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Counterexample))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Counterexample)
@@ -1340,6 +1527,11 @@ func (assistant *AssistantV1) GetCounterexample(getCounterexampleOptions *GetCou
 // UpdateCounterexample : Update counterexample
 // Update the text of a counterexample. Counterexamples are examples that have been marked as irrelevant input.
 func (assistant *AssistantV1) UpdateCounterexample(updateCounterexampleOptions *UpdateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	return assistant.UpdateCounterexampleWithContext(context.Background(), updateCounterexampleOptions)
+}
+
+// UpdateCounterexampleWithContext is an alternate form of the UpdateCounterexample method which supports a Context parameter
+func (assistant *AssistantV1) UpdateCounterexampleWithContext(ctx context.Context, updateCounterexampleOptions *UpdateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateCounterexampleOptions, "updateCounterexampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -1389,7 +1581,10 @@ func (assistant *AssistantV1) UpdateCounterexample(updateCounterexampleOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Counterexample))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Counterexample)
@@ -1404,6 +1599,11 @@ func (assistant *AssistantV1) UpdateCounterexample(updateCounterexampleOptions *
 // DeleteCounterexample : Delete counterexample
 // Delete a counterexample from a workspace. Counterexamples are examples that have been marked as irrelevant input.
 func (assistant *AssistantV1) DeleteCounterexample(deleteCounterexampleOptions *DeleteCounterexampleOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteCounterexampleWithContext(context.Background(), deleteCounterexampleOptions)
+}
+
+// DeleteCounterexampleWithContext is an alternate form of the DeleteCounterexample method which supports a Context parameter
+func (assistant *AssistantV1) DeleteCounterexampleWithContext(ctx context.Context, deleteCounterexampleOptions *DeleteCounterexampleOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteCounterexampleOptions, "deleteCounterexampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -1439,7 +1639,10 @@ func (assistant *AssistantV1) DeleteCounterexample(deleteCounterexampleOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1447,6 +1650,11 @@ func (assistant *AssistantV1) DeleteCounterexample(deleteCounterexampleOptions *
 // ListEntities : List entities
 // List the entities for a workspace.
 func (assistant *AssistantV1) ListEntities(listEntitiesOptions *ListEntitiesOptions) (result *EntityCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListEntitiesWithContext(context.Background(), listEntitiesOptions)
+}
+
+// ListEntitiesWithContext is an alternate form of the ListEntities method which supports a Context parameter
+func (assistant *AssistantV1) ListEntitiesWithContext(ctx context.Context, listEntitiesOptions *ListEntitiesOptions) (result *EntityCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listEntitiesOptions, "listEntitiesOptions cannot be nil")
 	if err != nil {
 		return
@@ -1498,7 +1706,10 @@ func (assistant *AssistantV1) ListEntities(listEntitiesOptions *ListEntitiesOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(EntityCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*EntityCollection)
@@ -1516,6 +1727,11 @@ func (assistant *AssistantV1) ListEntities(listEntitiesOptions *ListEntitiesOpti
 // If you want to create multiple entities with a single API call, consider using the **[Update
 // workspace](#update-workspace)** method instead.
 func (assistant *AssistantV1) CreateEntity(createEntityOptions *CreateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	return assistant.CreateEntityWithContext(context.Background(), createEntityOptions)
+}
+
+// CreateEntityWithContext is an alternate form of the CreateEntity method which supports a Context parameter
+func (assistant *AssistantV1) CreateEntityWithContext(ctx context.Context, createEntityOptions *CreateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createEntityOptions, "createEntityOptions cannot be nil")
 	if err != nil {
 		return
@@ -1577,7 +1793,10 @@ func (assistant *AssistantV1) CreateEntity(createEntityOptions *CreateEntityOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Entity))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Entity)
@@ -1592,6 +1811,11 @@ func (assistant *AssistantV1) CreateEntity(createEntityOptions *CreateEntityOpti
 // GetEntity : Get entity
 // Get information about an entity, optionally including all entity content.
 func (assistant *AssistantV1) GetEntity(getEntityOptions *GetEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	return assistant.GetEntityWithContext(context.Background(), getEntityOptions)
+}
+
+// GetEntityWithContext is an alternate form of the GetEntity method which supports a Context parameter
+func (assistant *AssistantV1) GetEntityWithContext(ctx context.Context, getEntityOptions *GetEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getEntityOptions, "getEntityOptions cannot be nil")
 	if err != nil {
 		return
@@ -1634,7 +1858,10 @@ func (assistant *AssistantV1) GetEntity(getEntityOptions *GetEntityOptions) (res
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Entity))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Entity)
@@ -1653,6 +1880,11 @@ func (assistant *AssistantV1) GetEntity(getEntityOptions *GetEntityOptions) (res
 // If you want to update multiple entities with a single API call, consider using the **[Update
 // workspace](#update-workspace)** method instead.
 func (assistant *AssistantV1) UpdateEntity(updateEntityOptions *UpdateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	return assistant.UpdateEntityWithContext(context.Background(), updateEntityOptions)
+}
+
+// UpdateEntityWithContext is an alternate form of the UpdateEntity method which supports a Context parameter
+func (assistant *AssistantV1) UpdateEntityWithContext(ctx context.Context, updateEntityOptions *UpdateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateEntityOptions, "updateEntityOptions cannot be nil")
 	if err != nil {
 		return
@@ -1717,7 +1949,10 @@ func (assistant *AssistantV1) UpdateEntity(updateEntityOptions *UpdateEntityOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Entity))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Entity)
@@ -1732,6 +1967,11 @@ func (assistant *AssistantV1) UpdateEntity(updateEntityOptions *UpdateEntityOpti
 // DeleteEntity : Delete entity
 // Delete an entity from a workspace, or disable a system entity.
 func (assistant *AssistantV1) DeleteEntity(deleteEntityOptions *DeleteEntityOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteEntityWithContext(context.Background(), deleteEntityOptions)
+}
+
+// DeleteEntityWithContext is an alternate form of the DeleteEntity method which supports a Context parameter
+func (assistant *AssistantV1) DeleteEntityWithContext(ctx context.Context, deleteEntityOptions *DeleteEntityOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteEntityOptions, "deleteEntityOptions cannot be nil")
 	if err != nil {
 		return
@@ -1767,7 +2007,10 @@ func (assistant *AssistantV1) DeleteEntity(deleteEntityOptions *DeleteEntityOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1776,6 +2019,11 @@ func (assistant *AssistantV1) DeleteEntity(deleteEntityOptions *DeleteEntityOpti
 // List mentions for a contextual entity. An entity mention is an occurrence of a contextual entity in the context of an
 // intent user input example.
 func (assistant *AssistantV1) ListMentions(listMentionsOptions *ListMentionsOptions) (result *EntityMentionCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListMentionsWithContext(context.Background(), listMentionsOptions)
+}
+
+// ListMentionsWithContext is an alternate form of the ListMentions method which supports a Context parameter
+func (assistant *AssistantV1) ListMentionsWithContext(ctx context.Context, listMentionsOptions *ListMentionsOptions) (result *EntityMentionCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listMentionsOptions, "listMentionsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1818,7 +2066,10 @@ func (assistant *AssistantV1) ListMentions(listMentionsOptions *ListMentionsOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(EntityMentionCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*EntityMentionCollection)
@@ -1833,6 +2084,11 @@ func (assistant *AssistantV1) ListMentions(listMentionsOptions *ListMentionsOpti
 // ListValues : List entity values
 // List the values for an entity.
 func (assistant *AssistantV1) ListValues(listValuesOptions *ListValuesOptions) (result *ValueCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListValuesWithContext(context.Background(), listValuesOptions)
+}
+
+// ListValuesWithContext is an alternate form of the ListValues method which supports a Context parameter
+func (assistant *AssistantV1) ListValuesWithContext(ctx context.Context, listValuesOptions *ListValuesOptions) (result *ValueCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listValuesOptions, "listValuesOptions cannot be nil")
 	if err != nil {
 		return
@@ -1884,7 +2140,10 @@ func (assistant *AssistantV1) ListValues(listValuesOptions *ListValuesOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(ValueCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ValueCollection)
@@ -1902,6 +2161,11 @@ func (assistant *AssistantV1) ListValues(listValuesOptions *ListValuesOptions) (
 // If you want to create multiple entity values with a single API call, consider using the **[Update
 // entity](#update-entity)** method instead.
 func (assistant *AssistantV1) CreateValue(createValueOptions *CreateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	return assistant.CreateValueWithContext(context.Background(), createValueOptions)
+}
+
+// CreateValueWithContext is an alternate form of the CreateValue method which supports a Context parameter
+func (assistant *AssistantV1) CreateValueWithContext(ctx context.Context, createValueOptions *CreateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createValueOptions, "createValueOptions cannot be nil")
 	if err != nil {
 		return
@@ -1963,7 +2227,10 @@ func (assistant *AssistantV1) CreateValue(createValueOptions *CreateValueOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Value))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Value)
@@ -1978,6 +2245,11 @@ func (assistant *AssistantV1) CreateValue(createValueOptions *CreateValueOptions
 // GetValue : Get entity value
 // Get information about an entity value.
 func (assistant *AssistantV1) GetValue(getValueOptions *GetValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	return assistant.GetValueWithContext(context.Background(), getValueOptions)
+}
+
+// GetValueWithContext is an alternate form of the GetValue method which supports a Context parameter
+func (assistant *AssistantV1) GetValueWithContext(ctx context.Context, getValueOptions *GetValueOptions) (result *Value, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getValueOptions, "getValueOptions cannot be nil")
 	if err != nil {
 		return
@@ -2020,7 +2292,10 @@ func (assistant *AssistantV1) GetValue(getValueOptions *GetValueOptions) (result
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Value))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Value)
@@ -2039,6 +2314,11 @@ func (assistant *AssistantV1) GetValue(getValueOptions *GetValueOptions) (result
 // If you want to update multiple entity values with a single API call, consider using the **[Update
 // entity](#update-entity)** method instead.
 func (assistant *AssistantV1) UpdateValue(updateValueOptions *UpdateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	return assistant.UpdateValueWithContext(context.Background(), updateValueOptions)
+}
+
+// UpdateValueWithContext is an alternate form of the UpdateValue method which supports a Context parameter
+func (assistant *AssistantV1) UpdateValueWithContext(ctx context.Context, updateValueOptions *UpdateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateValueOptions, "updateValueOptions cannot be nil")
 	if err != nil {
 		return
@@ -2103,7 +2383,10 @@ func (assistant *AssistantV1) UpdateValue(updateValueOptions *UpdateValueOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Value))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Value)
@@ -2118,6 +2401,11 @@ func (assistant *AssistantV1) UpdateValue(updateValueOptions *UpdateValueOptions
 // DeleteValue : Delete entity value
 // Delete a value from an entity.
 func (assistant *AssistantV1) DeleteValue(deleteValueOptions *DeleteValueOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteValueWithContext(context.Background(), deleteValueOptions)
+}
+
+// DeleteValueWithContext is an alternate form of the DeleteValue method which supports a Context parameter
+func (assistant *AssistantV1) DeleteValueWithContext(ctx context.Context, deleteValueOptions *DeleteValueOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteValueOptions, "deleteValueOptions cannot be nil")
 	if err != nil {
 		return
@@ -2153,7 +2441,10 @@ func (assistant *AssistantV1) DeleteValue(deleteValueOptions *DeleteValueOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2161,6 +2452,11 @@ func (assistant *AssistantV1) DeleteValue(deleteValueOptions *DeleteValueOptions
 // ListSynonyms : List entity value synonyms
 // List the synonyms for an entity value.
 func (assistant *AssistantV1) ListSynonyms(listSynonymsOptions *ListSynonymsOptions) (result *SynonymCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListSynonymsWithContext(context.Background(), listSynonymsOptions)
+}
+
+// ListSynonymsWithContext is an alternate form of the ListSynonyms method which supports a Context parameter
+func (assistant *AssistantV1) ListSynonymsWithContext(ctx context.Context, listSynonymsOptions *ListSynonymsOptions) (result *SynonymCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listSynonymsOptions, "listSynonymsOptions cannot be nil")
 	if err != nil {
 		return
@@ -2209,7 +2505,10 @@ func (assistant *AssistantV1) ListSynonyms(listSynonymsOptions *ListSynonymsOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(SynonymCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SynonymCollection)
@@ -2227,6 +2526,11 @@ func (assistant *AssistantV1) ListSynonyms(listSynonymsOptions *ListSynonymsOpti
 // If you want to create multiple synonyms with a single API call, consider using the **[Update
 // entity](#update-entity)** or **[Update entity value](#update-entity-value)** method instead.
 func (assistant *AssistantV1) CreateSynonym(createSynonymOptions *CreateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	return assistant.CreateSynonymWithContext(context.Background(), createSynonymOptions)
+}
+
+// CreateSynonymWithContext is an alternate form of the CreateSynonym method which supports a Context parameter
+func (assistant *AssistantV1) CreateSynonymWithContext(ctx context.Context, createSynonymOptions *CreateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createSynonymOptions, "createSynonymOptions cannot be nil")
 	if err != nil {
 		return
@@ -2276,7 +2580,10 @@ func (assistant *AssistantV1) CreateSynonym(createSynonymOptions *CreateSynonymO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Synonym))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Synonym)
@@ -2291,6 +2598,11 @@ func (assistant *AssistantV1) CreateSynonym(createSynonymOptions *CreateSynonymO
 // GetSynonym : Get entity value synonym
 // Get information about a synonym of an entity value.
 func (assistant *AssistantV1) GetSynonym(getSynonymOptions *GetSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	return assistant.GetSynonymWithContext(context.Background(), getSynonymOptions)
+}
+
+// GetSynonymWithContext is an alternate form of the GetSynonym method which supports a Context parameter
+func (assistant *AssistantV1) GetSynonymWithContext(ctx context.Context, getSynonymOptions *GetSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getSynonymOptions, "getSynonymOptions cannot be nil")
 	if err != nil {
 		return
@@ -2330,7 +2642,10 @@ func (assistant *AssistantV1) GetSynonym(getSynonymOptions *GetSynonymOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Synonym))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Synonym)
@@ -2348,6 +2663,11 @@ func (assistant *AssistantV1) GetSynonym(getSynonymOptions *GetSynonymOptions) (
 // If you want to update multiple synonyms with a single API call, consider using the **[Update
 // entity](#update-entity)** or **[Update entity value](#update-entity-value)** method instead.
 func (assistant *AssistantV1) UpdateSynonym(updateSynonymOptions *UpdateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	return assistant.UpdateSynonymWithContext(context.Background(), updateSynonymOptions)
+}
+
+// UpdateSynonymWithContext is an alternate form of the UpdateSynonym method which supports a Context parameter
+func (assistant *AssistantV1) UpdateSynonymWithContext(ctx context.Context, updateSynonymOptions *UpdateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateSynonymOptions, "updateSynonymOptions cannot be nil")
 	if err != nil {
 		return
@@ -2397,7 +2717,10 @@ func (assistant *AssistantV1) UpdateSynonym(updateSynonymOptions *UpdateSynonymO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(Synonym))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Synonym)
@@ -2412,6 +2735,11 @@ func (assistant *AssistantV1) UpdateSynonym(updateSynonymOptions *UpdateSynonymO
 // DeleteSynonym : Delete entity value synonym
 // Delete a synonym from an entity value.
 func (assistant *AssistantV1) DeleteSynonym(deleteSynonymOptions *DeleteSynonymOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteSynonymWithContext(context.Background(), deleteSynonymOptions)
+}
+
+// DeleteSynonymWithContext is an alternate form of the DeleteSynonym method which supports a Context parameter
+func (assistant *AssistantV1) DeleteSynonymWithContext(ctx context.Context, deleteSynonymOptions *DeleteSynonymOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteSynonymOptions, "deleteSynonymOptions cannot be nil")
 	if err != nil {
 		return
@@ -2447,7 +2775,10 @@ func (assistant *AssistantV1) DeleteSynonym(deleteSynonymOptions *DeleteSynonymO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2455,6 +2786,11 @@ func (assistant *AssistantV1) DeleteSynonym(deleteSynonymOptions *DeleteSynonymO
 // ListDialogNodes : List dialog nodes
 // List the dialog nodes for a workspace.
 func (assistant *AssistantV1) ListDialogNodes(listDialogNodesOptions *ListDialogNodesOptions) (result *DialogNodeCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListDialogNodesWithContext(context.Background(), listDialogNodesOptions)
+}
+
+// ListDialogNodesWithContext is an alternate form of the ListDialogNodes method which supports a Context parameter
+func (assistant *AssistantV1) ListDialogNodesWithContext(ctx context.Context, listDialogNodesOptions *ListDialogNodesOptions) (result *DialogNodeCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listDialogNodesOptions, "listDialogNodesOptions cannot be nil")
 	if err != nil {
 		return
@@ -2503,7 +2839,10 @@ func (assistant *AssistantV1) ListDialogNodes(listDialogNodesOptions *ListDialog
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(DialogNodeCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNodeCollection)
@@ -2521,6 +2860,11 @@ func (assistant *AssistantV1) ListDialogNodes(listDialogNodesOptions *ListDialog
 // If you want to create multiple dialog nodes with a single API call, consider using the **[Update
 // workspace](#update-workspace)** method instead.
 func (assistant *AssistantV1) CreateDialogNode(createDialogNodeOptions *CreateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	return assistant.CreateDialogNodeWithContext(context.Background(), createDialogNodeOptions)
+}
+
+// CreateDialogNodeWithContext is an alternate form of the CreateDialogNode method which supports a Context parameter
+func (assistant *AssistantV1) CreateDialogNodeWithContext(ctx context.Context, createDialogNodeOptions *CreateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createDialogNodeOptions, "createDialogNodeOptions cannot be nil")
 	if err != nil {
 		return
@@ -2624,7 +2968,10 @@ func (assistant *AssistantV1) CreateDialogNode(createDialogNodeOptions *CreateDi
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(DialogNode))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNode)
@@ -2639,6 +2986,11 @@ func (assistant *AssistantV1) CreateDialogNode(createDialogNodeOptions *CreateDi
 // GetDialogNode : Get dialog node
 // Get information about a dialog node.
 func (assistant *AssistantV1) GetDialogNode(getDialogNodeOptions *GetDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	return assistant.GetDialogNodeWithContext(context.Background(), getDialogNodeOptions)
+}
+
+// GetDialogNodeWithContext is an alternate form of the GetDialogNode method which supports a Context parameter
+func (assistant *AssistantV1) GetDialogNodeWithContext(ctx context.Context, getDialogNodeOptions *GetDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getDialogNodeOptions, "getDialogNodeOptions cannot be nil")
 	if err != nil {
 		return
@@ -2678,7 +3030,10 @@ func (assistant *AssistantV1) GetDialogNode(getDialogNodeOptions *GetDialogNodeO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(DialogNode))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNode)
@@ -2696,6 +3051,11 @@ func (assistant *AssistantV1) GetDialogNode(getDialogNodeOptions *GetDialogNodeO
 // If you want to update multiple dialog nodes with a single API call, consider using the **[Update
 // workspace](#update-workspace)** method instead.
 func (assistant *AssistantV1) UpdateDialogNode(updateDialogNodeOptions *UpdateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	return assistant.UpdateDialogNodeWithContext(context.Background(), updateDialogNodeOptions)
+}
+
+// UpdateDialogNodeWithContext is an alternate form of the UpdateDialogNode method which supports a Context parameter
+func (assistant *AssistantV1) UpdateDialogNodeWithContext(ctx context.Context, updateDialogNodeOptions *UpdateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateDialogNodeOptions, "updateDialogNodeOptions cannot be nil")
 	if err != nil {
 		return
@@ -2799,7 +3159,10 @@ func (assistant *AssistantV1) UpdateDialogNode(updateDialogNodeOptions *UpdateDi
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(DialogNode))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DialogNode)
@@ -2814,6 +3177,11 @@ func (assistant *AssistantV1) UpdateDialogNode(updateDialogNodeOptions *UpdateDi
 // DeleteDialogNode : Delete dialog node
 // Delete a dialog node from a workspace.
 func (assistant *AssistantV1) DeleteDialogNode(deleteDialogNodeOptions *DeleteDialogNodeOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteDialogNodeWithContext(context.Background(), deleteDialogNodeOptions)
+}
+
+// DeleteDialogNodeWithContext is an alternate form of the DeleteDialogNode method which supports a Context parameter
+func (assistant *AssistantV1) DeleteDialogNodeWithContext(ctx context.Context, deleteDialogNodeOptions *DeleteDialogNodeOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteDialogNodeOptions, "deleteDialogNodeOptions cannot be nil")
 	if err != nil {
 		return
@@ -2849,7 +3217,10 @@ func (assistant *AssistantV1) DeleteDialogNode(deleteDialogNodeOptions *DeleteDi
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2857,6 +3228,11 @@ func (assistant *AssistantV1) DeleteDialogNode(deleteDialogNodeOptions *DeleteDi
 // ListLogs : List log events in a workspace
 // List the events from the log of a specific workspace.
 func (assistant *AssistantV1) ListLogs(listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListLogsWithContext(context.Background(), listLogsOptions)
+}
+
+// ListLogsWithContext is an alternate form of the ListLogs method which supports a Context parameter
+func (assistant *AssistantV1) ListLogsWithContext(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listLogsOptions, "listLogsOptions cannot be nil")
 	if err != nil {
 		return
@@ -2905,7 +3281,10 @@ func (assistant *AssistantV1) ListLogs(listLogsOptions *ListLogsOptions) (result
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(LogCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LogCollection)
@@ -2920,6 +3299,11 @@ func (assistant *AssistantV1) ListLogs(listLogsOptions *ListLogsOptions) (result
 // ListAllLogs : List log events in all workspaces
 // List the events from the logs of all workspaces in the service instance.
 func (assistant *AssistantV1) ListAllLogs(listAllLogsOptions *ListAllLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListAllLogsWithContext(context.Background(), listAllLogsOptions)
+}
+
+// ListAllLogsWithContext is an alternate form of the ListAllLogs method which supports a Context parameter
+func (assistant *AssistantV1) ListAllLogsWithContext(ctx context.Context, listAllLogsOptions *ListAllLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listAllLogsOptions, "listAllLogsOptions cannot be nil")
 	if err != nil {
 		return
@@ -2966,7 +3350,10 @@ func (assistant *AssistantV1) ListAllLogs(listAllLogsOptions *ListAllLogsOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(LogCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LogCollection)
@@ -2986,6 +3373,11 @@ func (assistant *AssistantV1) ListAllLogs(listAllLogsOptions *ListAllLogsOptions
 // more information about personal data and customer IDs, see [Information
 // security](https://cloud.ibm.com/docs/assistant?topic=assistant-information-security#information-security).
 func (assistant *AssistantV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (assistant *AssistantV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -3023,7 +3415,10 @@ func (assistant *AssistantV1) DeleteUserData(deleteUserDataOptions *DeleteUserDa
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// AssistantV1API : The operations of the AssistantV1 service.
+// It is implemented by *AssistantV1, and by *MockAssistantV1 for unit tests.
+type AssistantV1API interface {
+	Message(messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error)
+	MessageWithContext(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error)
+	ListWorkspaces(listWorkspacesOptions *ListWorkspacesOptions) (result *WorkspaceCollection, response *core.DetailedResponse, err error)
+	ListWorkspacesWithContext(ctx context.Context, listWorkspacesOptions *ListWorkspacesOptions) (result *WorkspaceCollection, response *core.DetailedResponse, err error)
+	CreateWorkspace(createWorkspaceOptions *CreateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	CreateWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	GetWorkspace(getWorkspaceOptions *GetWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	GetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	UpdateWorkspace(updateWorkspaceOptions *UpdateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	UpdateWorkspaceWithContext(ctx context.Context, updateWorkspaceOptions *UpdateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	DeleteWorkspace(deleteWorkspaceOptions *DeleteWorkspaceOptions) (response *core.DetailedResponse, err error)
+	DeleteWorkspaceWithContext(ctx context.Context, deleteWorkspaceOptions *DeleteWorkspaceOptions) (response *core.DetailedResponse, err error)
+	ListIntents(listIntentsOptions *ListIntentsOptions) (result *IntentCollection, response *core.DetailedResponse, err error)
+	ListIntentsWithContext(ctx context.Context, listIntentsOptions *ListIntentsOptions) (result *IntentCollection, response *core.DetailedResponse, err error)
+	CreateIntent(createIntentOptions *CreateIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	CreateIntentWithContext(ctx context.Context, createIntentOptions *CreateIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	GetIntent(getIntentOptions *GetIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	GetIntentWithContext(ctx context.Context, getIntentOptions *GetIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	UpdateIntent(updateIntentOptions *UpdateIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	UpdateIntentWithContext(ctx context.Context, updateIntentOptions *UpdateIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	DeleteIntent(deleteIntentOptions *DeleteIntentOptions) (response *core.DetailedResponse, err error)
+	DeleteIntentWithContext(ctx context.Context, deleteIntentOptions *DeleteIntentOptions) (response *core.DetailedResponse, err error)
+	ListExamples(listExamplesOptions *ListExamplesOptions) (result *ExampleCollection, response *core.DetailedResponse, err error)
+	ListExamplesWithContext(ctx context.Context, listExamplesOptions *ListExamplesOptions) (result *ExampleCollection, response *core.DetailedResponse, err error)
+	CreateExample(createExampleOptions *CreateExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	CreateExampleWithContext(ctx context.Context, createExampleOptions *CreateExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	GetExample(getExampleOptions *GetExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	GetExampleWithContext(ctx context.Context, getExampleOptions *GetExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	UpdateExample(updateExampleOptions *UpdateExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	UpdateExampleWithContext(ctx context.Context, updateExampleOptions *UpdateExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	DeleteExample(deleteExampleOptions *DeleteExampleOptions) (response *core.DetailedResponse, err error)
+	DeleteExampleWithContext(ctx context.Context, deleteExampleOptions *DeleteExampleOptions) (response *core.DetailedResponse, err error)
+	ListCounterexamples(listCounterexamplesOptions *ListCounterexamplesOptions) (result *CounterexampleCollection, response *core.DetailedResponse, err error)
+	ListCounterexamplesWithContext(ctx context.Context, listCounterexamplesOptions *ListCounterexamplesOptions) (result *CounterexampleCollection, response *core.DetailedResponse, err error)
+	CreateCounterexample(createCounterexampleOptions *CreateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	CreateCounterexampleWithContext(ctx context.Context, createCounterexampleOptions *CreateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	GetCounterexample(getCounterexampleOptions *GetCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	GetCounterexampleWithContext(ctx context.Context, getCounterexampleOptions *GetCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	UpdateCounterexample(updateCounterexampleOptions *UpdateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	UpdateCounterexampleWithContext(ctx context.Context, updateCounterexampleOptions *UpdateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	DeleteCounterexample(deleteCounterexampleOptions *DeleteCounterexampleOptions) (response *core.DetailedResponse, err error)
+	DeleteCounterexampleWithContext(ctx context.Context, deleteCounterexampleOptions *DeleteCounterexampleOptions) (response *core.DetailedResponse, err error)
+	ListEntities(listEntitiesOptions *ListEntitiesOptions) (result *EntityCollection, response *core.DetailedResponse, err error)
+	ListEntitiesWithContext(ctx context.Context, listEntitiesOptions *ListEntitiesOptions) (result *EntityCollection, response *core.DetailedResponse, err error)
+	CreateEntity(createEntityOptions *CreateEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	CreateEntityWithContext(ctx context.Context, createEntityOptions *CreateEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	GetEntity(getEntityOptions *GetEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	GetEntityWithContext(ctx context.Context, getEntityOptions *GetEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	UpdateEntity(updateEntityOptions *UpdateEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	UpdateEntityWithContext(ctx context.Context, updateEntityOptions *UpdateEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	DeleteEntity(deleteEntityOptions *DeleteEntityOptions) (response *core.DetailedResponse, err error)
+	DeleteEntityWithContext(ctx context.Context, deleteEntityOptions *DeleteEntityOptions) (response *core.DetailedResponse, err error)
+	ListMentions(listMentionsOptions *ListMentionsOptions) (result *EntityMentionCollection, response *core.DetailedResponse, err error)
+	ListMentionsWithContext(ctx context.Context, listMentionsOptions *ListMentionsOptions) (result *EntityMentionCollection, response *core.DetailedResponse, err error)
+	ListValues(listValuesOptions *ListValuesOptions) (result *ValueCollection, response *core.DetailedResponse, err error)
+	ListValuesWithContext(ctx context.Context, listValuesOptions *ListValuesOptions) (result *ValueCollection, response *core.DetailedResponse, err error)
+	CreateValue(createValueOptions *CreateValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	CreateValueWithContext(ctx context.Context, createValueOptions *CreateValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	GetValue(getValueOptions *GetValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	GetValueWithContext(ctx context.Context, getValueOptions *GetValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	UpdateValue(updateValueOptions *UpdateValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	UpdateValueWithContext(ctx context.Context, updateValueOptions *UpdateValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	DeleteValue(deleteValueOptions *DeleteValueOptions) (response *core.DetailedResponse, err error)
+	DeleteValueWithContext(ctx context.Context, deleteValueOptions *DeleteValueOptions) (response *core.DetailedResponse, err error)
+	ListSynonyms(listSynonymsOptions *ListSynonymsOptions) (result *SynonymCollection, response *core.DetailedResponse, err error)
+	ListSynonymsWithContext(ctx context.Context, listSynonymsOptions *ListSynonymsOptions) (result *SynonymCollection, response *core.DetailedResponse, err error)
+	CreateSynonym(createSynonymOptions *CreateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	CreateSynonymWithContext(ctx context.Context, createSynonymOptions *CreateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	GetSynonym(getSynonymOptions *GetSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	GetSynonymWithContext(ctx context.Context, getSynonymOptions *GetSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	UpdateSynonym(updateSynonymOptions *UpdateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	UpdateSynonymWithContext(ctx context.Context, updateSynonymOptions *UpdateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	DeleteSynonym(deleteSynonymOptions *DeleteSynonymOptions) (response *core.DetailedResponse, err error)
+	DeleteSynonymWithContext(ctx context.Context, deleteSynonymOptions *DeleteSynonymOptions) (response *core.DetailedResponse, err error)
+	ListDialogNodes(listDialogNodesOptions *ListDialogNodesOptions) (result *DialogNodeCollection, response *core.DetailedResponse, err error)
+	ListDialogNodesWithContext(ctx context.Context, listDialogNodesOptions *ListDialogNodesOptions) (result *DialogNodeCollection, response *core.DetailedResponse, err error)
+	CreateDialogNode(createDialogNodeOptions *CreateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	CreateDialogNodeWithContext(ctx context.Context, createDialogNodeOptions *CreateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	GetDialogNode(getDialogNodeOptions *GetDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	GetDialogNodeWithContext(ctx context.Context, getDialogNodeOptions *GetDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	UpdateDialogNode(updateDialogNodeOptions *UpdateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	UpdateDialogNodeWithContext(ctx context.Context, updateDialogNodeOptions *UpdateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	DeleteDialogNode(deleteDialogNodeOptions *DeleteDialogNodeOptions) (response *core.DetailedResponse, err error)
+	DeleteDialogNodeWithContext(ctx context.Context, deleteDialogNodeOptions *DeleteDialogNodeOptions) (response *core.DetailedResponse, err error)
+	ListLogs(listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	ListLogsWithContext(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	ListAllLogs(listAllLogsOptions *ListAllLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	ListAllLogsWithContext(ctx context.Context, listAllLogsOptions *ListAllLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	NewCaptureGroup(group string) (model *CaptureGroup, err error)
+	NewCounterexample(text string) (model *Counterexample, err error)
+	NewCreateCounterexampleOptions(workspaceID string, text string) *CreateCounterexampleOptions
+	NewCreateDialogNodeOptions(workspaceID string, dialogNode string) *CreateDialogNodeOptions
+	NewCreateEntity(entity string) (model *CreateEntity, err error)
+	NewCreateEntityOptions(workspaceID string, entity string) *CreateEntityOptions
+	NewCreateExampleOptions(workspaceID string, intent string, text string) *CreateExampleOptions
+	NewCreateIntent(intent string) (model *CreateIntent, err error)
+	NewCreateIntentOptions(workspaceID string, intent string) *CreateIntentOptions
+	NewCreateSynonymOptions(workspaceID string, entity string, value string, synonym string) *CreateSynonymOptions
+	NewCreateValue(value string) (model *CreateValue, err error)
+	NewCreateValueOptions(workspaceID string, entity string, value string) *CreateValueOptions
+	NewCreateWorkspaceOptions() *CreateWorkspaceOptions
+	NewDeleteCounterexampleOptions(workspaceID string, text string) *DeleteCounterexampleOptions
+	NewDeleteDialogNodeOptions(workspaceID string, dialogNode string) *DeleteDialogNodeOptions
+	NewDeleteEntityOptions(workspaceID string, entity string) *DeleteEntityOptions
+	NewDeleteExampleOptions(workspaceID string, intent string, text string) *DeleteExampleOptions
+	NewDeleteIntentOptions(workspaceID string, intent string) *DeleteIntentOptions
+	NewDeleteSynonymOptions(workspaceID string, entity string, value string, synonym string) *DeleteSynonymOptions
+	NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions
+	NewDeleteValueOptions(workspaceID string, entity string, value string) *DeleteValueOptions
+	NewDeleteWorkspaceOptions(workspaceID string) *DeleteWorkspaceOptions
+	NewDialogNode(dialogNode string) (model *DialogNode, err error)
+	NewDialogNodeAction(name string, resultVariable string) (model *DialogNodeAction, err error)
+	NewDialogNodeNextStep(behavior string) (model *DialogNodeNextStep, err error)
+	NewDialogNodeOutputGeneric(responseType string) (model *DialogNodeOutputGeneric, err error)
+	NewDialogNodeOutputOptionsElement(label string, value *DialogNodeOutputOptionsElementValue) (model *DialogNodeOutputOptionsElement, err error)
+	NewDialogSuggestion(label string, value *DialogSuggestionValue) (model *DialogSuggestion, err error)
+	NewDialogSuggestionResponseGeneric(responseType string) (model *DialogSuggestionResponseGeneric, err error)
+	NewExample(text string) (model *Example, err error)
+	NewGetCounterexampleOptions(workspaceID string, text string) *GetCounterexampleOptions
+	NewGetDialogNodeOptions(workspaceID string, dialogNode string) *GetDialogNodeOptions
+	NewGetEntityOptions(workspaceID string, entity string) *GetEntityOptions
+	NewGetExampleOptions(workspaceID string, intent string, text string) *GetExampleOptions
+	NewGetIntentOptions(workspaceID string, intent string) *GetIntentOptions
+	NewGetSynonymOptions(workspaceID string, entity string, value string, synonym string) *GetSynonymOptions
+	NewGetValueOptions(workspaceID string, entity string, value string) *GetValueOptions
+	NewGetWorkspaceOptions(workspaceID string) *GetWorkspaceOptions
+	NewListAllLogsOptions(filter string) *ListAllLogsOptions
+	NewListCounterexamplesOptions(workspaceID string) *ListCounterexamplesOptions
+	NewListDialogNodesOptions(workspaceID string) *ListDialogNodesOptions
+	NewListEntitiesOptions(workspaceID string) *ListEntitiesOptions
+	NewListExamplesOptions(workspaceID string, intent string) *ListExamplesOptions
+	NewListIntentsOptions(workspaceID string) *ListIntentsOptions
+	NewListLogsOptions(workspaceID string) *ListLogsOptions
+	NewListMentionsOptions(workspaceID string, entity string) *ListMentionsOptions
+	NewListSynonymsOptions(workspaceID string, entity string, value string) *ListSynonymsOptions
+	NewListValuesOptions(workspaceID string, entity string) *ListValuesOptions
+	NewListWorkspacesOptions() *ListWorkspacesOptions
+	NewLogMessage(level string, msg string) (model *LogMessage, err error)
+	NewMention(entity string, location []int64) (model *Mention, err error)
+	NewMessageOptions(workspaceID string) *MessageOptions
+	NewRuntimeEntity(entity string, location []int64, value string) (model *RuntimeEntity, err error)
+	NewRuntimeIntent(intent string, confidence float64) (model *RuntimeIntent, err error)
+	NewRuntimeResponseGeneric(responseType string) (model *RuntimeResponseGeneric, err error)
+	NewSynonym(synonym string) (model *Synonym, err error)
+	NewUpdateCounterexampleOptions(workspaceID string, text string) *UpdateCounterexampleOptions
+	NewUpdateDialogNodeOptions(workspaceID string, dialogNode string) *UpdateDialogNodeOptions
+	NewUpdateEntityOptions(workspaceID string, entity string) *UpdateEntityOptions
+	NewUpdateExampleOptions(workspaceID string, intent string, text string) *UpdateExampleOptions
+	NewUpdateIntentOptions(workspaceID string, intent string) *UpdateIntentOptions
+	NewUpdateSynonymOptions(workspaceID string, entity string, value string, synonym string) *UpdateSynonymOptions
+	NewUpdateValueOptions(workspaceID string, entity string, value string) *UpdateValueOptions
+	NewUpdateWorkspaceOptions(workspaceID string) *UpdateWorkspaceOptions
+	NewWebhook(URL string, name string) (model *Webhook, err error)
+	NewWebhookHeader(name string, value string) (model *WebhookHeader, err error)
+	NewWorkspacePager(listWorkspacesOptions *ListWorkspacesOptions) (*WorkspacePager, error)
+	NewIntentPager(listIntentsOptions *ListIntentsOptions) (*IntentPager, error)
+	NewExamplePager(listExamplesOptions *ListExamplesOptions) (*ExamplePager, error)
+	NewCounterexamplePager(listCounterexamplesOptions *ListCounterexamplesOptions) (*CounterexamplePager, error)
+	NewEntityPager(listEntitiesOptions *ListEntitiesOptions) (*EntityPager, error)
+	NewValuePager(listValuesOptions *ListValuesOptions) (*ValuePager, error)
+	NewSynonymPager(listSynonymsOptions *ListSynonymsOptions) (*SynonymPager, error)
+	NewDialogNodePager(listDialogNodesOptions *ListDialogNodesOptions) (*DialogNodePager, error)
+	NewLogPager(listLogsOptions *ListLogsOptions) (*LogPager, error)
+}
+
+var _ AssistantV1API = (*AssistantV1)(nil)
@@ -0,0 +1,1129 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockAssistantV1 : A mock implementation of AssistantV1API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *AssistantV1.
+type MockAssistantV1 struct {
+	common.MockRecorder
+
+	MessageFunc              func(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error)
+	ListWorkspacesFunc       func(ctx context.Context, listWorkspacesOptions *ListWorkspacesOptions) (result *WorkspaceCollection, response *core.DetailedResponse, err error)
+	CreateWorkspaceFunc      func(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	GetWorkspaceFunc         func(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	UpdateWorkspaceFunc      func(ctx context.Context, updateWorkspaceOptions *UpdateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error)
+	DeleteWorkspaceFunc      func(ctx context.Context, deleteWorkspaceOptions *DeleteWorkspaceOptions) (response *core.DetailedResponse, err error)
+	ListIntentsFunc          func(ctx context.Context, listIntentsOptions *ListIntentsOptions) (result *IntentCollection, response *core.DetailedResponse, err error)
+	CreateIntentFunc         func(ctx context.Context, createIntentOptions *CreateIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	GetIntentFunc            func(ctx context.Context, getIntentOptions *GetIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	UpdateIntentFunc         func(ctx context.Context, updateIntentOptions *UpdateIntentOptions) (result *Intent, response *core.DetailedResponse, err error)
+	DeleteIntentFunc         func(ctx context.Context, deleteIntentOptions *DeleteIntentOptions) (response *core.DetailedResponse, err error)
+	ListExamplesFunc         func(ctx context.Context, listExamplesOptions *ListExamplesOptions) (result *ExampleCollection, response *core.DetailedResponse, err error)
+	CreateExampleFunc        func(ctx context.Context, createExampleOptions *CreateExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	GetExampleFunc           func(ctx context.Context, getExampleOptions *GetExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	UpdateExampleFunc        func(ctx context.Context, updateExampleOptions *UpdateExampleOptions) (result *Example, response *core.DetailedResponse, err error)
+	DeleteExampleFunc        func(ctx context.Context, deleteExampleOptions *DeleteExampleOptions) (response *core.DetailedResponse, err error)
+	ListCounterexamplesFunc  func(ctx context.Context, listCounterexamplesOptions *ListCounterexamplesOptions) (result *CounterexampleCollection, response *core.DetailedResponse, err error)
+	CreateCounterexampleFunc func(ctx context.Context, createCounterexampleOptions *CreateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	GetCounterexampleFunc    func(ctx context.Context, getCounterexampleOptions *GetCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	UpdateCounterexampleFunc func(ctx context.Context, updateCounterexampleOptions *UpdateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error)
+	DeleteCounterexampleFunc func(ctx context.Context, deleteCounterexampleOptions *DeleteCounterexampleOptions) (response *core.DetailedResponse, err error)
+	ListEntitiesFunc         func(ctx context.Context, listEntitiesOptions *ListEntitiesOptions) (result *EntityCollection, response *core.DetailedResponse, err error)
+	CreateEntityFunc         func(ctx context.Context, createEntityOptions *CreateEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	GetEntityFunc            func(ctx context.Context, getEntityOptions *GetEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	UpdateEntityFunc         func(ctx context.Context, updateEntityOptions *UpdateEntityOptions) (result *Entity, response *core.DetailedResponse, err error)
+	DeleteEntityFunc         func(ctx context.Context, deleteEntityOptions *DeleteEntityOptions) (response *core.DetailedResponse, err error)
+	ListMentionsFunc         func(ctx context.Context, listMentionsOptions *ListMentionsOptions) (result *EntityMentionCollection, response *core.DetailedResponse, err error)
+	ListValuesFunc           func(ctx context.Context, listValuesOptions *ListValuesOptions) (result *ValueCollection, response *core.DetailedResponse, err error)
+	CreateValueFunc          func(ctx context.Context, createValueOptions *CreateValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	GetValueFunc             func(ctx context.Context, getValueOptions *GetValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	UpdateValueFunc          func(ctx context.Context, updateValueOptions *UpdateValueOptions) (result *Value, response *core.DetailedResponse, err error)
+	DeleteValueFunc          func(ctx context.Context, deleteValueOptions *DeleteValueOptions) (response *core.DetailedResponse, err error)
+	ListSynonymsFunc         func(ctx context.Context, listSynonymsOptions *ListSynonymsOptions) (result *SynonymCollection, response *core.DetailedResponse, err error)
+	CreateSynonymFunc        func(ctx context.Context, createSynonymOptions *CreateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	GetSynonymFunc           func(ctx context.Context, getSynonymOptions *GetSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	UpdateSynonymFunc        func(ctx context.Context, updateSynonymOptions *UpdateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error)
+	DeleteSynonymFunc        func(ctx context.Context, deleteSynonymOptions *DeleteSynonymOptions) (response *core.DetailedResponse, err error)
+	ListDialogNodesFunc      func(ctx context.Context, listDialogNodesOptions *ListDialogNodesOptions) (result *DialogNodeCollection, response *core.DetailedResponse, err error)
+	CreateDialogNodeFunc     func(ctx context.Context, createDialogNodeOptions *CreateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	GetDialogNodeFunc        func(ctx context.Context, getDialogNodeOptions *GetDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	UpdateDialogNodeFunc     func(ctx context.Context, updateDialogNodeOptions *UpdateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error)
+	DeleteDialogNodeFunc     func(ctx context.Context, deleteDialogNodeOptions *DeleteDialogNodeOptions) (response *core.DetailedResponse, err error)
+	ListLogsFunc             func(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	ListAllLogsFunc          func(ctx context.Context, listAllLogsOptions *ListAllLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	DeleteUserDataFunc       func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ AssistantV1API = (*MockAssistantV1)(nil)
+
+// Message records the call and invokes MessageFunc
+func (mock *MockAssistantV1) Message(messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
+	return mock.MessageWithContext(context.Background(), messageOptions)
+}
+
+// MessageWithContext records the call and invokes MessageFunc
+func (mock *MockAssistantV1) MessageWithContext(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Message", messageOptions)
+	if mock.MessageFunc != nil {
+		return mock.MessageFunc(ctx, messageOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "Message")
+	return
+}
+
+// ListWorkspaces records the call and invokes ListWorkspacesFunc
+func (mock *MockAssistantV1) ListWorkspaces(listWorkspacesOptions *ListWorkspacesOptions) (result *WorkspaceCollection, response *core.DetailedResponse, err error) {
+	return mock.ListWorkspacesWithContext(context.Background(), listWorkspacesOptions)
+}
+
+// ListWorkspacesWithContext records the call and invokes ListWorkspacesFunc
+func (mock *MockAssistantV1) ListWorkspacesWithContext(ctx context.Context, listWorkspacesOptions *ListWorkspacesOptions) (result *WorkspaceCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListWorkspaces", listWorkspacesOptions)
+	if mock.ListWorkspacesFunc != nil {
+		return mock.ListWorkspacesFunc(ctx, listWorkspacesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListWorkspaces")
+	return
+}
+
+// CreateWorkspace records the call and invokes CreateWorkspaceFunc
+func (mock *MockAssistantV1) CreateWorkspace(createWorkspaceOptions *CreateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	return mock.CreateWorkspaceWithContext(context.Background(), createWorkspaceOptions)
+}
+
+// CreateWorkspaceWithContext records the call and invokes CreateWorkspaceFunc
+func (mock *MockAssistantV1) CreateWorkspaceWithContext(ctx context.Context, createWorkspaceOptions *CreateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateWorkspace", createWorkspaceOptions)
+	if mock.CreateWorkspaceFunc != nil {
+		return mock.CreateWorkspaceFunc(ctx, createWorkspaceOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateWorkspace")
+	return
+}
+
+// GetWorkspace records the call and invokes GetWorkspaceFunc
+func (mock *MockAssistantV1) GetWorkspace(getWorkspaceOptions *GetWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	return mock.GetWorkspaceWithContext(context.Background(), getWorkspaceOptions)
+}
+
+// GetWorkspaceWithContext records the call and invokes GetWorkspaceFunc
+func (mock *MockAssistantV1) GetWorkspaceWithContext(ctx context.Context, getWorkspaceOptions *GetWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetWorkspace", getWorkspaceOptions)
+	if mock.GetWorkspaceFunc != nil {
+		return mock.GetWorkspaceFunc(ctx, getWorkspaceOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetWorkspace")
+	return
+}
+
+// UpdateWorkspace records the call and invokes UpdateWorkspaceFunc
+func (mock *MockAssistantV1) UpdateWorkspace(updateWorkspaceOptions *UpdateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	return mock.UpdateWorkspaceWithContext(context.Background(), updateWorkspaceOptions)
+}
+
+// UpdateWorkspaceWithContext records the call and invokes UpdateWorkspaceFunc
+func (mock *MockAssistantV1) UpdateWorkspaceWithContext(ctx context.Context, updateWorkspaceOptions *UpdateWorkspaceOptions) (result *Workspace, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateWorkspace", updateWorkspaceOptions)
+	if mock.UpdateWorkspaceFunc != nil {
+		return mock.UpdateWorkspaceFunc(ctx, updateWorkspaceOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateWorkspace")
+	return
+}
+
+// DeleteWorkspace records the call and invokes DeleteWorkspaceFunc
+func (mock *MockAssistantV1) DeleteWorkspace(deleteWorkspaceOptions *DeleteWorkspaceOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteWorkspaceWithContext(context.Background(), deleteWorkspaceOptions)
+}
+
+// DeleteWorkspaceWithContext records the call and invokes DeleteWorkspaceFunc
+func (mock *MockAssistantV1) DeleteWorkspaceWithContext(ctx context.Context, deleteWorkspaceOptions *DeleteWorkspaceOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteWorkspace", deleteWorkspaceOptions)
+	if mock.DeleteWorkspaceFunc != nil {
+		return mock.DeleteWorkspaceFunc(ctx, deleteWorkspaceOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteWorkspace")
+	return
+}
+
+// ListIntents records the call and invokes ListIntentsFunc
+func (mock *MockAssistantV1) ListIntents(listIntentsOptions *ListIntentsOptions) (result *IntentCollection, response *core.DetailedResponse, err error) {
+	return mock.ListIntentsWithContext(context.Background(), listIntentsOptions)
+}
+
+// ListIntentsWithContext records the call and invokes ListIntentsFunc
+func (mock *MockAssistantV1) ListIntentsWithContext(ctx context.Context, listIntentsOptions *ListIntentsOptions) (result *IntentCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListIntents", listIntentsOptions)
+	if mock.ListIntentsFunc != nil {
+		return mock.ListIntentsFunc(ctx, listIntentsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListIntents")
+	return
+}
+
+// CreateIntent records the call and invokes CreateIntentFunc
+func (mock *MockAssistantV1) CreateIntent(createIntentOptions *CreateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	return mock.CreateIntentWithContext(context.Background(), createIntentOptions)
+}
+
+// CreateIntentWithContext records the call and invokes CreateIntentFunc
+func (mock *MockAssistantV1) CreateIntentWithContext(ctx context.Context, createIntentOptions *CreateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateIntent", createIntentOptions)
+	if mock.CreateIntentFunc != nil {
+		return mock.CreateIntentFunc(ctx, createIntentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateIntent")
+	return
+}
+
+// GetIntent records the call and invokes GetIntentFunc
+func (mock *MockAssistantV1) GetIntent(getIntentOptions *GetIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	return mock.GetIntentWithContext(context.Background(), getIntentOptions)
+}
+
+// GetIntentWithContext records the call and invokes GetIntentFunc
+func (mock *MockAssistantV1) GetIntentWithContext(ctx context.Context, getIntentOptions *GetIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetIntent", getIntentOptions)
+	if mock.GetIntentFunc != nil {
+		return mock.GetIntentFunc(ctx, getIntentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetIntent")
+	return
+}
+
+// UpdateIntent records the call and invokes UpdateIntentFunc
+func (mock *MockAssistantV1) UpdateIntent(updateIntentOptions *UpdateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	return mock.UpdateIntentWithContext(context.Background(), updateIntentOptions)
+}
+
+// UpdateIntentWithContext records the call and invokes UpdateIntentFunc
+func (mock *MockAssistantV1) UpdateIntentWithContext(ctx context.Context, updateIntentOptions *UpdateIntentOptions) (result *Intent, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateIntent", updateIntentOptions)
+	if mock.UpdateIntentFunc != nil {
+		return mock.UpdateIntentFunc(ctx, updateIntentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateIntent")
+	return
+}
+
+// DeleteIntent records the call and invokes DeleteIntentFunc
+func (mock *MockAssistantV1) DeleteIntent(deleteIntentOptions *DeleteIntentOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteIntentWithContext(context.Background(), deleteIntentOptions)
+}
+
+// DeleteIntentWithContext records the call and invokes DeleteIntentFunc
+func (mock *MockAssistantV1) DeleteIntentWithContext(ctx context.Context, deleteIntentOptions *DeleteIntentOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteIntent", deleteIntentOptions)
+	if mock.DeleteIntentFunc != nil {
+		return mock.DeleteIntentFunc(ctx, deleteIntentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteIntent")
+	return
+}
+
+// ListExamples records the call and invokes ListExamplesFunc
+func (mock *MockAssistantV1) ListExamples(listExamplesOptions *ListExamplesOptions) (result *ExampleCollection, response *core.DetailedResponse, err error) {
+	return mock.ListExamplesWithContext(context.Background(), listExamplesOptions)
+}
+
+// ListExamplesWithContext records the call and invokes ListExamplesFunc
+func (mock *MockAssistantV1) ListExamplesWithContext(ctx context.Context, listExamplesOptions *ListExamplesOptions) (result *ExampleCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListExamples", listExamplesOptions)
+	if mock.ListExamplesFunc != nil {
+		return mock.ListExamplesFunc(ctx, listExamplesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListExamples")
+	return
+}
+
+// CreateExample records the call and invokes CreateExampleFunc
+func (mock *MockAssistantV1) CreateExample(createExampleOptions *CreateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	return mock.CreateExampleWithContext(context.Background(), createExampleOptions)
+}
+
+// CreateExampleWithContext records the call and invokes CreateExampleFunc
+func (mock *MockAssistantV1) CreateExampleWithContext(ctx context.Context, createExampleOptions *CreateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateExample", createExampleOptions)
+	if mock.CreateExampleFunc != nil {
+		return mock.CreateExampleFunc(ctx, createExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateExample")
+	return
+}
+
+// GetExample records the call and invokes GetExampleFunc
+func (mock *MockAssistantV1) GetExample(getExampleOptions *GetExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	return mock.GetExampleWithContext(context.Background(), getExampleOptions)
+}
+
+// GetExampleWithContext records the call and invokes GetExampleFunc
+func (mock *MockAssistantV1) GetExampleWithContext(ctx context.Context, getExampleOptions *GetExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetExample", getExampleOptions)
+	if mock.GetExampleFunc != nil {
+		return mock.GetExampleFunc(ctx, getExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetExample")
+	return
+}
+
+// UpdateExample records the call and invokes UpdateExampleFunc
+func (mock *MockAssistantV1) UpdateExample(updateExampleOptions *UpdateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	return mock.UpdateExampleWithContext(context.Background(), updateExampleOptions)
+}
+
+// UpdateExampleWithContext records the call and invokes UpdateExampleFunc
+func (mock *MockAssistantV1) UpdateExampleWithContext(ctx context.Context, updateExampleOptions *UpdateExampleOptions) (result *Example, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateExample", updateExampleOptions)
+	if mock.UpdateExampleFunc != nil {
+		return mock.UpdateExampleFunc(ctx, updateExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateExample")
+	return
+}
+
+// DeleteExample records the call and invokes DeleteExampleFunc
+func (mock *MockAssistantV1) DeleteExample(deleteExampleOptions *DeleteExampleOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteExampleWithContext(context.Background(), deleteExampleOptions)
+}
+
+// DeleteExampleWithContext records the call and invokes DeleteExampleFunc
+func (mock *MockAssistantV1) DeleteExampleWithContext(ctx context.Context, deleteExampleOptions *DeleteExampleOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteExample", deleteExampleOptions)
+	if mock.DeleteExampleFunc != nil {
+		return mock.DeleteExampleFunc(ctx, deleteExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteExample")
+	return
+}
+
+// ListCounterexamples records the call and invokes ListCounterexamplesFunc
+func (mock *MockAssistantV1) ListCounterexamples(listCounterexamplesOptions *ListCounterexamplesOptions) (result *CounterexampleCollection, response *core.DetailedResponse, err error) {
+	return mock.ListCounterexamplesWithContext(context.Background(), listCounterexamplesOptions)
+}
+
+// ListCounterexamplesWithContext records the call and invokes ListCounterexamplesFunc
+func (mock *MockAssistantV1) ListCounterexamplesWithContext(ctx context.Context, listCounterexamplesOptions *ListCounterexamplesOptions) (result *CounterexampleCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListCounterexamples", listCounterexamplesOptions)
+	if mock.ListCounterexamplesFunc != nil {
+		return mock.ListCounterexamplesFunc(ctx, listCounterexamplesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListCounterexamples")
+	return
+}
+
+// CreateCounterexample records the call and invokes CreateCounterexampleFunc
+func (mock *MockAssistantV1) CreateCounterexample(createCounterexampleOptions *CreateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	return mock.CreateCounterexampleWithContext(context.Background(), createCounterexampleOptions)
+}
+
+// CreateCounterexampleWithContext records the call and invokes CreateCounterexampleFunc
+func (mock *MockAssistantV1) CreateCounterexampleWithContext(ctx context.Context, createCounterexampleOptions *CreateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateCounterexample", createCounterexampleOptions)
+	if mock.CreateCounterexampleFunc != nil {
+		return mock.CreateCounterexampleFunc(ctx, createCounterexampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateCounterexample")
+	return
+}
+
+// GetCounterexample records the call and invokes GetCounterexampleFunc
+func (mock *MockAssistantV1) GetCounterexample(getCounterexampleOptions *GetCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	return mock.GetCounterexampleWithContext(context.Background(), getCounterexampleOptions)
+}
+
+// GetCounterexampleWithContext records the call and invokes GetCounterexampleFunc
+func (mock *MockAssistantV1) GetCounterexampleWithContext(ctx context.Context, getCounterexampleOptions *GetCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetCounterexample", getCounterexampleOptions)
+	if mock.GetCounterexampleFunc != nil {
+		return mock.GetCounterexampleFunc(ctx, getCounterexampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetCounterexample")
+	return
+}
+
+// UpdateCounterexample records the call and invokes UpdateCounterexampleFunc
+func (mock *MockAssistantV1) UpdateCounterexample(updateCounterexampleOptions *UpdateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	return mock.UpdateCounterexampleWithContext(context.Background(), updateCounterexampleOptions)
+}
+
+// UpdateCounterexampleWithContext records the call and invokes UpdateCounterexampleFunc
+func (mock *MockAssistantV1) UpdateCounterexampleWithContext(ctx context.Context, updateCounterexampleOptions *UpdateCounterexampleOptions) (result *Counterexample, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateCounterexample", updateCounterexampleOptions)
+	if mock.UpdateCounterexampleFunc != nil {
+		return mock.UpdateCounterexampleFunc(ctx, updateCounterexampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateCounterexample")
+	return
+}
+
+// DeleteCounterexample records the call and invokes DeleteCounterexampleFunc
+func (mock *MockAssistantV1) DeleteCounterexample(deleteCounterexampleOptions *DeleteCounterexampleOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteCounterexampleWithContext(context.Background(), deleteCounterexampleOptions)
+}
+
+// DeleteCounterexampleWithContext records the call and invokes DeleteCounterexampleFunc
+func (mock *MockAssistantV1) DeleteCounterexampleWithContext(ctx context.Context, deleteCounterexampleOptions *DeleteCounterexampleOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteCounterexample", deleteCounterexampleOptions)
+	if mock.DeleteCounterexampleFunc != nil {
+		return mock.DeleteCounterexampleFunc(ctx, deleteCounterexampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteCounterexample")
+	return
+}
+
+// ListEntities records the call and invokes ListEntitiesFunc
+func (mock *MockAssistantV1) ListEntities(listEntitiesOptions *ListEntitiesOptions) (result *EntityCollection, response *core.DetailedResponse, err error) {
+	return mock.ListEntitiesWithContext(context.Background(), listEntitiesOptions)
+}
+
+// ListEntitiesWithContext records the call and invokes ListEntitiesFunc
+func (mock *MockAssistantV1) ListEntitiesWithContext(ctx context.Context, listEntitiesOptions *ListEntitiesOptions) (result *EntityCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListEntities", listEntitiesOptions)
+	if mock.ListEntitiesFunc != nil {
+		return mock.ListEntitiesFunc(ctx, listEntitiesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListEntities")
+	return
+}
+
+// CreateEntity records the call and invokes CreateEntityFunc
+func (mock *MockAssistantV1) CreateEntity(createEntityOptions *CreateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	return mock.CreateEntityWithContext(context.Background(), createEntityOptions)
+}
+
+// CreateEntityWithContext records the call and invokes CreateEntityFunc
+func (mock *MockAssistantV1) CreateEntityWithContext(ctx context.Context, createEntityOptions *CreateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateEntity", createEntityOptions)
+	if mock.CreateEntityFunc != nil {
+		return mock.CreateEntityFunc(ctx, createEntityOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateEntity")
+	return
+}
+
+// GetEntity records the call and invokes GetEntityFunc
+func (mock *MockAssistantV1) GetEntity(getEntityOptions *GetEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	return mock.GetEntityWithContext(context.Background(), getEntityOptions)
+}
+
+// GetEntityWithContext records the call and invokes GetEntityFunc
+func (mock *MockAssistantV1) GetEntityWithContext(ctx context.Context, getEntityOptions *GetEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetEntity", getEntityOptions)
+	if mock.GetEntityFunc != nil {
+		return mock.GetEntityFunc(ctx, getEntityOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetEntity")
+	return
+}
+
+// UpdateEntity records the call and invokes UpdateEntityFunc
+func (mock *MockAssistantV1) UpdateEntity(updateEntityOptions *UpdateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	return mock.UpdateEntityWithContext(context.Background(), updateEntityOptions)
+}
+
+// UpdateEntityWithContext records the call and invokes UpdateEntityFunc
+func (mock *MockAssistantV1) UpdateEntityWithContext(ctx context.Context, updateEntityOptions *UpdateEntityOptions) (result *Entity, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateEntity", updateEntityOptions)
+	if mock.UpdateEntityFunc != nil {
+		return mock.UpdateEntityFunc(ctx, updateEntityOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateEntity")
+	return
+}
+
+// DeleteEntity records the call and invokes DeleteEntityFunc
+func (mock *MockAssistantV1) DeleteEntity(deleteEntityOptions *DeleteEntityOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteEntityWithContext(context.Background(), deleteEntityOptions)
+}
+
+// DeleteEntityWithContext records the call and invokes DeleteEntityFunc
+func (mock *MockAssistantV1) DeleteEntityWithContext(ctx context.Context, deleteEntityOptions *DeleteEntityOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteEntity", deleteEntityOptions)
+	if mock.DeleteEntityFunc != nil {
+		return mock.DeleteEntityFunc(ctx, deleteEntityOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteEntity")
+	return
+}
+
+// ListMentions records the call and invokes ListMentionsFunc
+func (mock *MockAssistantV1) ListMentions(listMentionsOptions *ListMentionsOptions) (result *EntityMentionCollection, response *core.DetailedResponse, err error) {
+	return mock.ListMentionsWithContext(context.Background(), listMentionsOptions)
+}
+
+// ListMentionsWithContext records the call and invokes ListMentionsFunc
+func (mock *MockAssistantV1) ListMentionsWithContext(ctx context.Context, listMentionsOptions *ListMentionsOptions) (result *EntityMentionCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListMentions", listMentionsOptions)
+	if mock.ListMentionsFunc != nil {
+		return mock.ListMentionsFunc(ctx, listMentionsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListMentions")
+	return
+}
+
+// ListValues records the call and invokes ListValuesFunc
+func (mock *MockAssistantV1) ListValues(listValuesOptions *ListValuesOptions) (result *ValueCollection, response *core.DetailedResponse, err error) {
+	return mock.ListValuesWithContext(context.Background(), listValuesOptions)
+}
+
+// ListValuesWithContext records the call and invokes ListValuesFunc
+func (mock *MockAssistantV1) ListValuesWithContext(ctx context.Context, listValuesOptions *ListValuesOptions) (result *ValueCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListValues", listValuesOptions)
+	if mock.ListValuesFunc != nil {
+		return mock.ListValuesFunc(ctx, listValuesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListValues")
+	return
+}
+
+// CreateValue records the call and invokes CreateValueFunc
+func (mock *MockAssistantV1) CreateValue(createValueOptions *CreateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	return mock.CreateValueWithContext(context.Background(), createValueOptions)
+}
+
+// CreateValueWithContext records the call and invokes CreateValueFunc
+func (mock *MockAssistantV1) CreateValueWithContext(ctx context.Context, createValueOptions *CreateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateValue", createValueOptions)
+	if mock.CreateValueFunc != nil {
+		return mock.CreateValueFunc(ctx, createValueOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateValue")
+	return
+}
+
+// GetValue records the call and invokes GetValueFunc
+func (mock *MockAssistantV1) GetValue(getValueOptions *GetValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	return mock.GetValueWithContext(context.Background(), getValueOptions)
+}
+
+// GetValueWithContext records the call and invokes GetValueFunc
+func (mock *MockAssistantV1) GetValueWithContext(ctx context.Context, getValueOptions *GetValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetValue", getValueOptions)
+	if mock.GetValueFunc != nil {
+		return mock.GetValueFunc(ctx, getValueOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetValue")
+	return
+}
+
+// UpdateValue records the call and invokes UpdateValueFunc
+func (mock *MockAssistantV1) UpdateValue(updateValueOptions *UpdateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	return mock.UpdateValueWithContext(context.Background(), updateValueOptions)
+}
+
+// UpdateValueWithContext records the call and invokes UpdateValueFunc
+func (mock *MockAssistantV1) UpdateValueWithContext(ctx context.Context, updateValueOptions *UpdateValueOptions) (result *Value, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateValue", updateValueOptions)
+	if mock.UpdateValueFunc != nil {
+		return mock.UpdateValueFunc(ctx, updateValueOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateValue")
+	return
+}
+
+// DeleteValue records the call and invokes DeleteValueFunc
+func (mock *MockAssistantV1) DeleteValue(deleteValueOptions *DeleteValueOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteValueWithContext(context.Background(), deleteValueOptions)
+}
+
+// DeleteValueWithContext records the call and invokes DeleteValueFunc
+func (mock *MockAssistantV1) DeleteValueWithContext(ctx context.Context, deleteValueOptions *DeleteValueOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteValue", deleteValueOptions)
+	if mock.DeleteValueFunc != nil {
+		return mock.DeleteValueFunc(ctx, deleteValueOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteValue")
+	return
+}
+
+// ListSynonyms records the call and invokes ListSynonymsFunc
+func (mock *MockAssistantV1) ListSynonyms(listSynonymsOptions *ListSynonymsOptions) (result *SynonymCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSynonymsWithContext(context.Background(), listSynonymsOptions)
+}
+
+// ListSynonymsWithContext records the call and invokes ListSynonymsFunc
+func (mock *MockAssistantV1) ListSynonymsWithContext(ctx context.Context, listSynonymsOptions *ListSynonymsOptions) (result *SynonymCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListSynonyms", listSynonymsOptions)
+	if mock.ListSynonymsFunc != nil {
+		return mock.ListSynonymsFunc(ctx, listSynonymsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListSynonyms")
+	return
+}
+
+// CreateSynonym records the call and invokes CreateSynonymFunc
+func (mock *MockAssistantV1) CreateSynonym(createSynonymOptions *CreateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	return mock.CreateSynonymWithContext(context.Background(), createSynonymOptions)
+}
+
+// CreateSynonymWithContext records the call and invokes CreateSynonymFunc
+func (mock *MockAssistantV1) CreateSynonymWithContext(ctx context.Context, createSynonymOptions *CreateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateSynonym", createSynonymOptions)
+	if mock.CreateSynonymFunc != nil {
+		return mock.CreateSynonymFunc(ctx, createSynonymOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateSynonym")
+	return
+}
+
+// GetSynonym records the call and invokes GetSynonymFunc
+func (mock *MockAssistantV1) GetSynonym(getSynonymOptions *GetSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	return mock.GetSynonymWithContext(context.Background(), getSynonymOptions)
+}
+
+// GetSynonymWithContext records the call and invokes GetSynonymFunc
+func (mock *MockAssistantV1) GetSynonymWithContext(ctx context.Context, getSynonymOptions *GetSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetSynonym", getSynonymOptions)
+	if mock.GetSynonymFunc != nil {
+		return mock.GetSynonymFunc(ctx, getSynonymOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetSynonym")
+	return
+}
+
+// UpdateSynonym records the call and invokes UpdateSynonymFunc
+func (mock *MockAssistantV1) UpdateSynonym(updateSynonymOptions *UpdateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	return mock.UpdateSynonymWithContext(context.Background(), updateSynonymOptions)
+}
+
+// UpdateSynonymWithContext records the call and invokes UpdateSynonymFunc
+func (mock *MockAssistantV1) UpdateSynonymWithContext(ctx context.Context, updateSynonymOptions *UpdateSynonymOptions) (result *Synonym, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateSynonym", updateSynonymOptions)
+	if mock.UpdateSynonymFunc != nil {
+		return mock.UpdateSynonymFunc(ctx, updateSynonymOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateSynonym")
+	return
+}
+
+// DeleteSynonym records the call and invokes DeleteSynonymFunc
+func (mock *MockAssistantV1) DeleteSynonym(deleteSynonymOptions *DeleteSynonymOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteSynonymWithContext(context.Background(), deleteSynonymOptions)
+}
+
+// DeleteSynonymWithContext records the call and invokes DeleteSynonymFunc
+func (mock *MockAssistantV1) DeleteSynonymWithContext(ctx context.Context, deleteSynonymOptions *DeleteSynonymOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteSynonym", deleteSynonymOptions)
+	if mock.DeleteSynonymFunc != nil {
+		return mock.DeleteSynonymFunc(ctx, deleteSynonymOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteSynonym")
+	return
+}
+
+// ListDialogNodes records the call and invokes ListDialogNodesFunc
+func (mock *MockAssistantV1) ListDialogNodes(listDialogNodesOptions *ListDialogNodesOptions) (result *DialogNodeCollection, response *core.DetailedResponse, err error) {
+	return mock.ListDialogNodesWithContext(context.Background(), listDialogNodesOptions)
+}
+
+// ListDialogNodesWithContext records the call and invokes ListDialogNodesFunc
+func (mock *MockAssistantV1) ListDialogNodesWithContext(ctx context.Context, listDialogNodesOptions *ListDialogNodesOptions) (result *DialogNodeCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListDialogNodes", listDialogNodesOptions)
+	if mock.ListDialogNodesFunc != nil {
+		return mock.ListDialogNodesFunc(ctx, listDialogNodesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListDialogNodes")
+	return
+}
+
+// CreateDialogNode records the call and invokes CreateDialogNodeFunc
+func (mock *MockAssistantV1) CreateDialogNode(createDialogNodeOptions *CreateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	return mock.CreateDialogNodeWithContext(context.Background(), createDialogNodeOptions)
+}
+
+// CreateDialogNodeWithContext records the call and invokes CreateDialogNodeFunc
+func (mock *MockAssistantV1) CreateDialogNodeWithContext(ctx context.Context, createDialogNodeOptions *CreateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateDialogNode", createDialogNodeOptions)
+	if mock.CreateDialogNodeFunc != nil {
+		return mock.CreateDialogNodeFunc(ctx, createDialogNodeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "CreateDialogNode")
+	return
+}
+
+// GetDialogNode records the call and invokes GetDialogNodeFunc
+func (mock *MockAssistantV1) GetDialogNode(getDialogNodeOptions *GetDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	return mock.GetDialogNodeWithContext(context.Background(), getDialogNodeOptions)
+}
+
+// GetDialogNodeWithContext records the call and invokes GetDialogNodeFunc
+func (mock *MockAssistantV1) GetDialogNodeWithContext(ctx context.Context, getDialogNodeOptions *GetDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetDialogNode", getDialogNodeOptions)
+	if mock.GetDialogNodeFunc != nil {
+		return mock.GetDialogNodeFunc(ctx, getDialogNodeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "GetDialogNode")
+	return
+}
+
+// UpdateDialogNode records the call and invokes UpdateDialogNodeFunc
+func (mock *MockAssistantV1) UpdateDialogNode(updateDialogNodeOptions *UpdateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	return mock.UpdateDialogNodeWithContext(context.Background(), updateDialogNodeOptions)
+}
+
+// UpdateDialogNodeWithContext records the call and invokes UpdateDialogNodeFunc
+func (mock *MockAssistantV1) UpdateDialogNodeWithContext(ctx context.Context, updateDialogNodeOptions *UpdateDialogNodeOptions) (result *DialogNode, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateDialogNode", updateDialogNodeOptions)
+	if mock.UpdateDialogNodeFunc != nil {
+		return mock.UpdateDialogNodeFunc(ctx, updateDialogNodeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "UpdateDialogNode")
+	return
+}
+
+// DeleteDialogNode records the call and invokes DeleteDialogNodeFunc
+func (mock *MockAssistantV1) DeleteDialogNode(deleteDialogNodeOptions *DeleteDialogNodeOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteDialogNodeWithContext(context.Background(), deleteDialogNodeOptions)
+}
+
+// DeleteDialogNodeWithContext records the call and invokes DeleteDialogNodeFunc
+func (mock *MockAssistantV1) DeleteDialogNodeWithContext(ctx context.Context, deleteDialogNodeOptions *DeleteDialogNodeOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteDialogNode", deleteDialogNodeOptions)
+	if mock.DeleteDialogNodeFunc != nil {
+		return mock.DeleteDialogNodeFunc(ctx, deleteDialogNodeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteDialogNode")
+	return
+}
+
+// ListLogs records the call and invokes ListLogsFunc
+func (mock *MockAssistantV1) ListLogs(listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	return mock.ListLogsWithContext(context.Background(), listLogsOptions)
+}
+
+// ListLogsWithContext records the call and invokes ListLogsFunc
+func (mock *MockAssistantV1) ListLogsWithContext(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListLogs", listLogsOptions)
+	if mock.ListLogsFunc != nil {
+		return mock.ListLogsFunc(ctx, listLogsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListLogs")
+	return
+}
+
+// ListAllLogs records the call and invokes ListAllLogsFunc
+func (mock *MockAssistantV1) ListAllLogs(listAllLogsOptions *ListAllLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	return mock.ListAllLogsWithContext(context.Background(), listAllLogsOptions)
+}
+
+// ListAllLogsWithContext records the call and invokes ListAllLogsFunc
+func (mock *MockAssistantV1) ListAllLogsWithContext(ctx context.Context, listAllLogsOptions *ListAllLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListAllLogs", listAllLogsOptions)
+	if mock.ListAllLogsFunc != nil {
+		return mock.ListAllLogsFunc(ctx, listAllLogsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "ListAllLogs")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockAssistantV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockAssistantV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV1", "DeleteUserData")
+	return
+}
+
+// NewCaptureGroup delegates to AssistantV1.NewCaptureGroup
+func (mock *MockAssistantV1) NewCaptureGroup(group string) (model *CaptureGroup, err error) {
+	return new(AssistantV1).NewCaptureGroup(group)
+}
+
+// NewCounterexample delegates to AssistantV1.NewCounterexample
+func (mock *MockAssistantV1) NewCounterexample(text string) (model *Counterexample, err error) {
+	return new(AssistantV1).NewCounterexample(text)
+}
+
+// NewCreateCounterexampleOptions delegates to AssistantV1.NewCreateCounterexampleOptions
+func (mock *MockAssistantV1) NewCreateCounterexampleOptions(workspaceID string, text string) *CreateCounterexampleOptions {
+	return new(AssistantV1).NewCreateCounterexampleOptions(workspaceID, text)
+}
+
+// NewCreateDialogNodeOptions delegates to AssistantV1.NewCreateDialogNodeOptions
+func (mock *MockAssistantV1) NewCreateDialogNodeOptions(workspaceID string, dialogNode string) *CreateDialogNodeOptions {
+	return new(AssistantV1).NewCreateDialogNodeOptions(workspaceID, dialogNode)
+}
+
+// NewCreateEntity delegates to AssistantV1.NewCreateEntity
+func (mock *MockAssistantV1) NewCreateEntity(entity string) (model *CreateEntity, err error) {
+	return new(AssistantV1).NewCreateEntity(entity)
+}
+
+// NewCreateEntityOptions delegates to AssistantV1.NewCreateEntityOptions
+func (mock *MockAssistantV1) NewCreateEntityOptions(workspaceID string, entity string) *CreateEntityOptions {
+	return new(AssistantV1).NewCreateEntityOptions(workspaceID, entity)
+}
+
+// NewCreateExampleOptions delegates to AssistantV1.NewCreateExampleOptions
+func (mock *MockAssistantV1) NewCreateExampleOptions(workspaceID string, intent string, text string) *CreateExampleOptions {
+	return new(AssistantV1).NewCreateExampleOptions(workspaceID, intent, text)
+}
+
+// NewCreateIntent delegates to AssistantV1.NewCreateIntent
+func (mock *MockAssistantV1) NewCreateIntent(intent string) (model *CreateIntent, err error) {
+	return new(AssistantV1).NewCreateIntent(intent)
+}
+
+// NewCreateIntentOptions delegates to AssistantV1.NewCreateIntentOptions
+func (mock *MockAssistantV1) NewCreateIntentOptions(workspaceID string, intent string) *CreateIntentOptions {
+	return new(AssistantV1).NewCreateIntentOptions(workspaceID, intent)
+}
+
+// NewCreateSynonymOptions delegates to AssistantV1.NewCreateSynonymOptions
+func (mock *MockAssistantV1) NewCreateSynonymOptions(workspaceID string, entity string, value string, synonym string) *CreateSynonymOptions {
+	return new(AssistantV1).NewCreateSynonymOptions(workspaceID, entity, value, synonym)
+}
+
+// NewCreateValue delegates to AssistantV1.NewCreateValue
+func (mock *MockAssistantV1) NewCreateValue(value string) (model *CreateValue, err error) {
+	return new(AssistantV1).NewCreateValue(value)
+}
+
+// NewCreateValueOptions delegates to AssistantV1.NewCreateValueOptions
+func (mock *MockAssistantV1) NewCreateValueOptions(workspaceID string, entity string, value string) *CreateValueOptions {
+	return new(AssistantV1).NewCreateValueOptions(workspaceID, entity, value)
+}
+
+// NewCreateWorkspaceOptions delegates to AssistantV1.NewCreateWorkspaceOptions
+func (mock *MockAssistantV1) NewCreateWorkspaceOptions() *CreateWorkspaceOptions {
+	return new(AssistantV1).NewCreateWorkspaceOptions()
+}
+
+// NewDeleteCounterexampleOptions delegates to AssistantV1.NewDeleteCounterexampleOptions
+func (mock *MockAssistantV1) NewDeleteCounterexampleOptions(workspaceID string, text string) *DeleteCounterexampleOptions {
+	return new(AssistantV1).NewDeleteCounterexampleOptions(workspaceID, text)
+}
+
+// NewDeleteDialogNodeOptions delegates to AssistantV1.NewDeleteDialogNodeOptions
+func (mock *MockAssistantV1) NewDeleteDialogNodeOptions(workspaceID string, dialogNode string) *DeleteDialogNodeOptions {
+	return new(AssistantV1).NewDeleteDialogNodeOptions(workspaceID, dialogNode)
+}
+
+// NewDeleteEntityOptions delegates to AssistantV1.NewDeleteEntityOptions
+func (mock *MockAssistantV1) NewDeleteEntityOptions(workspaceID string, entity string) *DeleteEntityOptions {
+	return new(AssistantV1).NewDeleteEntityOptions(workspaceID, entity)
+}
+
+// NewDeleteExampleOptions delegates to AssistantV1.NewDeleteExampleOptions
+func (mock *MockAssistantV1) NewDeleteExampleOptions(workspaceID string, intent string, text string) *DeleteExampleOptions {
+	return new(AssistantV1).NewDeleteExampleOptions(workspaceID, intent, text)
+}
+
+// NewDeleteIntentOptions delegates to AssistantV1.NewDeleteIntentOptions
+func (mock *MockAssistantV1) NewDeleteIntentOptions(workspaceID string, intent string) *DeleteIntentOptions {
+	return new(AssistantV1).NewDeleteIntentOptions(workspaceID, intent)
+}
+
+// NewDeleteSynonymOptions delegates to AssistantV1.NewDeleteSynonymOptions
+func (mock *MockAssistantV1) NewDeleteSynonymOptions(workspaceID string, entity string, value string, synonym string) *DeleteSynonymOptions {
+	return new(AssistantV1).NewDeleteSynonymOptions(workspaceID, entity, value, synonym)
+}
+
+// NewDeleteUserDataOptions delegates to AssistantV1.NewDeleteUserDataOptions
+func (mock *MockAssistantV1) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(AssistantV1).NewDeleteUserDataOptions(customerID)
+}
+
+// NewDeleteValueOptions delegates to AssistantV1.NewDeleteValueOptions
+func (mock *MockAssistantV1) NewDeleteValueOptions(workspaceID string, entity string, value string) *DeleteValueOptions {
+	return new(AssistantV1).NewDeleteValueOptions(workspaceID, entity, value)
+}
+
+// NewDeleteWorkspaceOptions delegates to AssistantV1.NewDeleteWorkspaceOptions
+func (mock *MockAssistantV1) NewDeleteWorkspaceOptions(workspaceID string) *DeleteWorkspaceOptions {
+	return new(AssistantV1).NewDeleteWorkspaceOptions(workspaceID)
+}
+
+// NewDialogNode delegates to AssistantV1.NewDialogNode
+func (mock *MockAssistantV1) NewDialogNode(dialogNode string) (model *DialogNode, err error) {
+	return new(AssistantV1).NewDialogNode(dialogNode)
+}
+
+// NewDialogNodeAction delegates to AssistantV1.NewDialogNodeAction
+func (mock *MockAssistantV1) NewDialogNodeAction(name string, resultVariable string) (model *DialogNodeAction, err error) {
+	return new(AssistantV1).NewDialogNodeAction(name, resultVariable)
+}
+
+// NewDialogNodeNextStep delegates to AssistantV1.NewDialogNodeNextStep
+func (mock *MockAssistantV1) NewDialogNodeNextStep(behavior string) (model *DialogNodeNextStep, err error) {
+	return new(AssistantV1).NewDialogNodeNextStep(behavior)
+}
+
+// NewDialogNodeOutputGeneric delegates to AssistantV1.NewDialogNodeOutputGeneric
+func (mock *MockAssistantV1) NewDialogNodeOutputGeneric(responseType string) (model *DialogNodeOutputGeneric, err error) {
+	return new(AssistantV1).NewDialogNodeOutputGeneric(responseType)
+}
+
+// NewDialogNodeOutputOptionsElement delegates to AssistantV1.NewDialogNodeOutputOptionsElement
+func (mock *MockAssistantV1) NewDialogNodeOutputOptionsElement(label string, value *DialogNodeOutputOptionsElementValue) (model *DialogNodeOutputOptionsElement, err error) {
+	return new(AssistantV1).NewDialogNodeOutputOptionsElement(label, value)
+}
+
+// NewDialogSuggestion delegates to AssistantV1.NewDialogSuggestion
+func (mock *MockAssistantV1) NewDialogSuggestion(label string, value *DialogSuggestionValue) (model *DialogSuggestion, err error) {
+	return new(AssistantV1).NewDialogSuggestion(label, value)
+}
+
+// NewDialogSuggestionResponseGeneric delegates to AssistantV1.NewDialogSuggestionResponseGeneric
+func (mock *MockAssistantV1) NewDialogSuggestionResponseGeneric(responseType string) (model *DialogSuggestionResponseGeneric, err error) {
+	return new(AssistantV1).NewDialogSuggestionResponseGeneric(responseType)
+}
+
+// NewExample delegates to AssistantV1.NewExample
+func (mock *MockAssistantV1) NewExample(text string) (model *Example, err error) {
+	return new(AssistantV1).NewExample(text)
+}
+
+// NewGetCounterexampleOptions delegates to AssistantV1.NewGetCounterexampleOptions
+func (mock *MockAssistantV1) NewGetCounterexampleOptions(workspaceID string, text string) *GetCounterexampleOptions {
+	return new(AssistantV1).NewGetCounterexampleOptions(workspaceID, text)
+}
+
+// NewGetDialogNodeOptions delegates to AssistantV1.NewGetDialogNodeOptions
+func (mock *MockAssistantV1) NewGetDialogNodeOptions(workspaceID string, dialogNode string) *GetDialogNodeOptions {
+	return new(AssistantV1).NewGetDialogNodeOptions(workspaceID, dialogNode)
+}
+
+// NewGetEntityOptions delegates to AssistantV1.NewGetEntityOptions
+func (mock *MockAssistantV1) NewGetEntityOptions(workspaceID string, entity string) *GetEntityOptions {
+	return new(AssistantV1).NewGetEntityOptions(workspaceID, entity)
+}
+
+// NewGetExampleOptions delegates to AssistantV1.NewGetExampleOptions
+func (mock *MockAssistantV1) NewGetExampleOptions(workspaceID string, intent string, text string) *GetExampleOptions {
+	return new(AssistantV1).NewGetExampleOptions(workspaceID, intent, text)
+}
+
+// NewGetIntentOptions delegates to AssistantV1.NewGetIntentOptions
+func (mock *MockAssistantV1) NewGetIntentOptions(workspaceID string, intent string) *GetIntentOptions {
+	return new(AssistantV1).NewGetIntentOptions(workspaceID, intent)
+}
+
+// NewGetSynonymOptions delegates to AssistantV1.NewGetSynonymOptions
+func (mock *MockAssistantV1) NewGetSynonymOptions(workspaceID string, entity string, value string, synonym string) *GetSynonymOptions {
+	return new(AssistantV1).NewGetSynonymOptions(workspaceID, entity, value, synonym)
+}
+
+// NewGetValueOptions delegates to AssistantV1.NewGetValueOptions
+func (mock *MockAssistantV1) NewGetValueOptions(workspaceID string, entity string, value string) *GetValueOptions {
+	return new(AssistantV1).NewGetValueOptions(workspaceID, entity, value)
+}
+
+// NewGetWorkspaceOptions delegates to AssistantV1.NewGetWorkspaceOptions
+func (mock *MockAssistantV1) NewGetWorkspaceOptions(workspaceID string) *GetWorkspaceOptions {
+	return new(AssistantV1).NewGetWorkspaceOptions(workspaceID)
+}
+
+// NewListAllLogsOptions delegates to AssistantV1.NewListAllLogsOptions
+func (mock *MockAssistantV1) NewListAllLogsOptions(filter string) *ListAllLogsOptions {
+	return new(AssistantV1).NewListAllLogsOptions(filter)
+}
+
+// NewListCounterexamplesOptions delegates to AssistantV1.NewListCounterexamplesOptions
+func (mock *MockAssistantV1) NewListCounterexamplesOptions(workspaceID string) *ListCounterexamplesOptions {
+	return new(AssistantV1).NewListCounterexamplesOptions(workspaceID)
+}
+
+// NewListDialogNodesOptions delegates to AssistantV1.NewListDialogNodesOptions
+func (mock *MockAssistantV1) NewListDialogNodesOptions(workspaceID string) *ListDialogNodesOptions {
+	return new(AssistantV1).NewListDialogNodesOptions(workspaceID)
+}
+
+// NewListEntitiesOptions delegates to AssistantV1.NewListEntitiesOptions
+func (mock *MockAssistantV1) NewListEntitiesOptions(workspaceID string) *ListEntitiesOptions {
+	return new(AssistantV1).NewListEntitiesOptions(workspaceID)
+}
+
+// NewListExamplesOptions delegates to AssistantV1.NewListExamplesOptions
+func (mock *MockAssistantV1) NewListExamplesOptions(workspaceID string, intent string) *ListExamplesOptions {
+	return new(AssistantV1).NewListExamplesOptions(workspaceID, intent)
+}
+
+// NewListIntentsOptions delegates to AssistantV1.NewListIntentsOptions
+func (mock *MockAssistantV1) NewListIntentsOptions(workspaceID string) *ListIntentsOptions {
+	return new(AssistantV1).NewListIntentsOptions(workspaceID)
+}
+
+// NewListLogsOptions delegates to AssistantV1.NewListLogsOptions
+func (mock *MockAssistantV1) NewListLogsOptions(workspaceID string) *ListLogsOptions {
+	return new(AssistantV1).NewListLogsOptions(workspaceID)
+}
+
+// NewListMentionsOptions delegates to AssistantV1.NewListMentionsOptions
+func (mock *MockAssistantV1) NewListMentionsOptions(workspaceID string, entity string) *ListMentionsOptions {
+	return new(AssistantV1).NewListMentionsOptions(workspaceID, entity)
+}
+
+// NewListSynonymsOptions delegates to AssistantV1.NewListSynonymsOptions
+func (mock *MockAssistantV1) NewListSynonymsOptions(workspaceID string, entity string, value string) *ListSynonymsOptions {
+	return new(AssistantV1).NewListSynonymsOptions(workspaceID, entity, value)
+}
+
+// NewListValuesOptions delegates to AssistantV1.NewListValuesOptions
+func (mock *MockAssistantV1) NewListValuesOptions(workspaceID string, entity string) *ListValuesOptions {
+	return new(AssistantV1).NewListValuesOptions(workspaceID, entity)
+}
+
+// NewListWorkspacesOptions delegates to AssistantV1.NewListWorkspacesOptions
+func (mock *MockAssistantV1) NewListWorkspacesOptions() *ListWorkspacesOptions {
+	return new(AssistantV1).NewListWorkspacesOptions()
+}
+
+// NewLogMessage delegates to AssistantV1.NewLogMessage
+func (mock *MockAssistantV1) NewLogMessage(level string, msg string) (model *LogMessage, err error) {
+	return new(AssistantV1).NewLogMessage(level, msg)
+}
+
+// NewMention delegates to AssistantV1.NewMention
+func (mock *MockAssistantV1) NewMention(entity string, location []int64) (model *Mention, err error) {
+	return new(AssistantV1).NewMention(entity, location)
+}
+
+// NewMessageOptions delegates to AssistantV1.NewMessageOptions
+func (mock *MockAssistantV1) NewMessageOptions(workspaceID string) *MessageOptions {
+	return new(AssistantV1).NewMessageOptions(workspaceID)
+}
+
+// NewRuntimeEntity delegates to AssistantV1.NewRuntimeEntity
+func (mock *MockAssistantV1) NewRuntimeEntity(entity string, location []int64, value string) (model *RuntimeEntity, err error) {
+	return new(AssistantV1).NewRuntimeEntity(entity, location, value)
+}
+
+// NewRuntimeIntent delegates to AssistantV1.NewRuntimeIntent
+func (mock *MockAssistantV1) NewRuntimeIntent(intent string, confidence float64) (model *RuntimeIntent, err error) {
+	return new(AssistantV1).NewRuntimeIntent(intent, confidence)
+}
+
+// NewRuntimeResponseGeneric delegates to AssistantV1.NewRuntimeResponseGeneric
+func (mock *MockAssistantV1) NewRuntimeResponseGeneric(responseType string) (model *RuntimeResponseGeneric, err error) {
+	return new(AssistantV1).NewRuntimeResponseGeneric(responseType)
+}
+
+// NewSynonym delegates to AssistantV1.NewSynonym
+func (mock *MockAssistantV1) NewSynonym(synonym string) (model *Synonym, err error) {
+	return new(AssistantV1).NewSynonym(synonym)
+}
+
+// NewUpdateCounterexampleOptions delegates to AssistantV1.NewUpdateCounterexampleOptions
+func (mock *MockAssistantV1) NewUpdateCounterexampleOptions(workspaceID string, text string) *UpdateCounterexampleOptions {
+	return new(AssistantV1).NewUpdateCounterexampleOptions(workspaceID, text)
+}
+
+// NewUpdateDialogNodeOptions delegates to AssistantV1.NewUpdateDialogNodeOptions
+func (mock *MockAssistantV1) NewUpdateDialogNodeOptions(workspaceID string, dialogNode string) *UpdateDialogNodeOptions {
+	return new(AssistantV1).NewUpdateDialogNodeOptions(workspaceID, dialogNode)
+}
+
+// NewUpdateEntityOptions delegates to AssistantV1.NewUpdateEntityOptions
+func (mock *MockAssistantV1) NewUpdateEntityOptions(workspaceID string, entity string) *UpdateEntityOptions {
+	return new(AssistantV1).NewUpdateEntityOptions(workspaceID, entity)
+}
+
+// NewUpdateExampleOptions delegates to AssistantV1.NewUpdateExampleOptions
+func (mock *MockAssistantV1) NewUpdateExampleOptions(workspaceID string, intent string, text string) *UpdateExampleOptions {
+	return new(AssistantV1).NewUpdateExampleOptions(workspaceID, intent, text)
+}
+
+// NewUpdateIntentOptions delegates to AssistantV1.NewUpdateIntentOptions
+func (mock *MockAssistantV1) NewUpdateIntentOptions(workspaceID string, intent string) *UpdateIntentOptions {
+	return new(AssistantV1).NewUpdateIntentOptions(workspaceID, intent)
+}
+
+// NewUpdateSynonymOptions delegates to AssistantV1.NewUpdateSynonymOptions
+func (mock *MockAssistantV1) NewUpdateSynonymOptions(workspaceID string, entity string, value string, synonym string) *UpdateSynonymOptions {
+	return new(AssistantV1).NewUpdateSynonymOptions(workspaceID, entity, value, synonym)
+}
+
+// NewUpdateValueOptions delegates to AssistantV1.NewUpdateValueOptions
+func (mock *MockAssistantV1) NewUpdateValueOptions(workspaceID string, entity string, value string) *UpdateValueOptions {
+	return new(AssistantV1).NewUpdateValueOptions(workspaceID, entity, value)
+}
+
+// NewUpdateWorkspaceOptions delegates to AssistantV1.NewUpdateWorkspaceOptions
+func (mock *MockAssistantV1) NewUpdateWorkspaceOptions(workspaceID string) *UpdateWorkspaceOptions {
+	return new(AssistantV1).NewUpdateWorkspaceOptions(workspaceID)
+}
+
+// NewWebhook delegates to AssistantV1.NewWebhook
+func (mock *MockAssistantV1) NewWebhook(URL string, name string) (model *Webhook, err error) {
+	return new(AssistantV1).NewWebhook(URL, name)
+}
+
+// NewWebhookHeader delegates to AssistantV1.NewWebhookHeader
+func (mock *MockAssistantV1) NewWebhookHeader(name string, value string) (model *WebhookHeader, err error) {
+	return new(AssistantV1).NewWebhookHeader(name, value)
+}
+
+// NewWorkspacePager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewWorkspacePager(listWorkspacesOptions *ListWorkspacesOptions) (*WorkspacePager, error) {
+	return newWorkspacePager(mock, listWorkspacesOptions)
+}
+
+// NewIntentPager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewIntentPager(listIntentsOptions *ListIntentsOptions) (*IntentPager, error) {
+	return newIntentPager(mock, listIntentsOptions)
+}
+
+// NewExamplePager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewExamplePager(listExamplesOptions *ListExamplesOptions) (*ExamplePager, error) {
+	return newExamplePager(mock, listExamplesOptions)
+}
+
+// NewCounterexamplePager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewCounterexamplePager(listCounterexamplesOptions *ListCounterexamplesOptions) (*CounterexamplePager, error) {
+	return newCounterexamplePager(mock, listCounterexamplesOptions)
+}
+
+// NewEntityPager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewEntityPager(listEntitiesOptions *ListEntitiesOptions) (*EntityPager, error) {
+	return newEntityPager(mock, listEntitiesOptions)
+}
+
+// NewValuePager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewValuePager(listValuesOptions *ListValuesOptions) (*ValuePager, error) {
+	return newValuePager(mock, listValuesOptions)
+}
+
+// NewSynonymPager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewSynonymPager(listSynonymsOptions *ListSynonymsOptions) (*SynonymPager, error) {
+	return newSynonymPager(mock, listSynonymsOptions)
+}
+
+// NewDialogNodePager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewDialogNodePager(listDialogNodesOptions *ListDialogNodesOptions) (*DialogNodePager, error) {
+	return newDialogNodePager(mock, listDialogNodesOptions)
+}
+
+// NewLogPager returns a pager retrieving its pages from the mock
+func (mock *MockAssistantV1) NewLogPager(listLogsOptions *ListLogsOptions) (*LogPager, error) {
+	return newLogPager(mock, listLogsOptions)
+}
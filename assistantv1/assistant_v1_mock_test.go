@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1_test
+
+import (
+	"github.com/IBM/go-sdk-core/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/assistantv1"
+	"github.com/watson-developer-cloud/go-sdk/internal/mocktest"
+)
+
+var _ = Describe(`MockAssistantV1`, func() {
+	It(`Forwards each operation to its function and constructs the options like the service`, func() {
+		service, err := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+			Version:       "2020-04-01",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(mocktest.Check(new(assistantv1.MockAssistantV1), service)).To(BeEmpty())
+	})
+})
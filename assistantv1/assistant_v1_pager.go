@@ -0,0 +1,765 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// nextCursor returns the cursor of the next page, or nil on the last page
+func nextCursor(cursor *string) *string {
+	if cursor == nil || *cursor == "" {
+		return nil
+	}
+	return cursor
+}
+
+func errNoMoreResults() error {
+	return fmt.Errorf("No more results available")
+}
+
+// WorkspacePager : Iterates over the workspaces returned by ListWorkspaces, following the pagination cursors.
+// Each page holds at most PageLimit workspaces, as set in the options.
+type WorkspacePager struct {
+	client  AssistantV1API
+	options ListWorkspacesOptions
+	hasNext bool
+	err     error
+}
+
+// NewWorkspacePager : Instantiate a WorkspacePager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewWorkspacePager(listWorkspacesOptions *ListWorkspacesOptions) (*WorkspacePager, error) {
+	return newWorkspacePager(assistant, listWorkspacesOptions)
+}
+
+func newWorkspacePager(client AssistantV1API, listWorkspacesOptions *ListWorkspacesOptions) (*WorkspacePager, error) {
+	if err := core.ValidateNotNil(listWorkspacesOptions, "listWorkspacesOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listWorkspacesOptions, "listWorkspacesOptions"); err != nil {
+		return nil, err
+	}
+
+	return &WorkspacePager{
+		client:  client,
+		options: *listWorkspacesOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of workspaces can be retrieved
+func (pager *WorkspacePager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of workspaces
+func (pager *WorkspacePager) Next() ([]Workspace, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *WorkspacePager) NextWithContext(ctx context.Context) ([]Workspace, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListWorkspacesWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Workspaces, nil
+}
+
+// All retrieves the remaining pages of workspaces.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *WorkspacePager) All() ([]Workspace, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *WorkspacePager) AllWithContext(ctx context.Context) (all []Workspace, err error) {
+	for pager.HasNext() {
+		var page []Workspace
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// IntentPager : Iterates over the intents returned by ListIntents, following the pagination cursors.
+// Each page holds at most PageLimit intents, as set in the options.
+type IntentPager struct {
+	client  AssistantV1API
+	options ListIntentsOptions
+	hasNext bool
+	err     error
+}
+
+// NewIntentPager : Instantiate a IntentPager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewIntentPager(listIntentsOptions *ListIntentsOptions) (*IntentPager, error) {
+	return newIntentPager(assistant, listIntentsOptions)
+}
+
+func newIntentPager(client AssistantV1API, listIntentsOptions *ListIntentsOptions) (*IntentPager, error) {
+	if err := core.ValidateNotNil(listIntentsOptions, "listIntentsOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listIntentsOptions, "listIntentsOptions"); err != nil {
+		return nil, err
+	}
+
+	return &IntentPager{
+		client:  client,
+		options: *listIntentsOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of intents can be retrieved
+func (pager *IntentPager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of intents
+func (pager *IntentPager) Next() ([]Intent, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *IntentPager) NextWithContext(ctx context.Context) ([]Intent, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListIntentsWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Intents, nil
+}
+
+// All retrieves the remaining pages of intents.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *IntentPager) All() ([]Intent, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *IntentPager) AllWithContext(ctx context.Context) (all []Intent, err error) {
+	for pager.HasNext() {
+		var page []Intent
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// ExamplePager : Iterates over the user input examples returned by ListExamples, following the pagination cursors.
+// Each page holds at most PageLimit user input examples, as set in the options.
+type ExamplePager struct {
+	client  AssistantV1API
+	options ListExamplesOptions
+	hasNext bool
+	err     error
+}
+
+// NewExamplePager : Instantiate a ExamplePager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewExamplePager(listExamplesOptions *ListExamplesOptions) (*ExamplePager, error) {
+	return newExamplePager(assistant, listExamplesOptions)
+}
+
+func newExamplePager(client AssistantV1API, listExamplesOptions *ListExamplesOptions) (*ExamplePager, error) {
+	if err := core.ValidateNotNil(listExamplesOptions, "listExamplesOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listExamplesOptions, "listExamplesOptions"); err != nil {
+		return nil, err
+	}
+
+	return &ExamplePager{
+		client:  client,
+		options: *listExamplesOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of user input examples can be retrieved
+func (pager *ExamplePager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of user input examples
+func (pager *ExamplePager) Next() ([]Example, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *ExamplePager) NextWithContext(ctx context.Context) ([]Example, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListExamplesWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Examples, nil
+}
+
+// All retrieves the remaining pages of user input examples.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *ExamplePager) All() ([]Example, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *ExamplePager) AllWithContext(ctx context.Context) (all []Example, err error) {
+	for pager.HasNext() {
+		var page []Example
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// CounterexamplePager : Iterates over the counterexamples returned by ListCounterexamples, following the pagination cursors.
+// Each page holds at most PageLimit counterexamples, as set in the options.
+type CounterexamplePager struct {
+	client  AssistantV1API
+	options ListCounterexamplesOptions
+	hasNext bool
+	err     error
+}
+
+// NewCounterexamplePager : Instantiate a CounterexamplePager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewCounterexamplePager(listCounterexamplesOptions *ListCounterexamplesOptions) (*CounterexamplePager, error) {
+	return newCounterexamplePager(assistant, listCounterexamplesOptions)
+}
+
+func newCounterexamplePager(client AssistantV1API, listCounterexamplesOptions *ListCounterexamplesOptions) (*CounterexamplePager, error) {
+	if err := core.ValidateNotNil(listCounterexamplesOptions, "listCounterexamplesOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listCounterexamplesOptions, "listCounterexamplesOptions"); err != nil {
+		return nil, err
+	}
+
+	return &CounterexamplePager{
+		client:  client,
+		options: *listCounterexamplesOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of counterexamples can be retrieved
+func (pager *CounterexamplePager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of counterexamples
+func (pager *CounterexamplePager) Next() ([]Counterexample, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *CounterexamplePager) NextWithContext(ctx context.Context) ([]Counterexample, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListCounterexamplesWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Counterexamples, nil
+}
+
+// All retrieves the remaining pages of counterexamples.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *CounterexamplePager) All() ([]Counterexample, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *CounterexamplePager) AllWithContext(ctx context.Context) (all []Counterexample, err error) {
+	for pager.HasNext() {
+		var page []Counterexample
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// EntityPager : Iterates over the entities returned by ListEntities, following the pagination cursors.
+// Each page holds at most PageLimit entities, as set in the options.
+type EntityPager struct {
+	client  AssistantV1API
+	options ListEntitiesOptions
+	hasNext bool
+	err     error
+}
+
+// NewEntityPager : Instantiate a EntityPager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewEntityPager(listEntitiesOptions *ListEntitiesOptions) (*EntityPager, error) {
+	return newEntityPager(assistant, listEntitiesOptions)
+}
+
+func newEntityPager(client AssistantV1API, listEntitiesOptions *ListEntitiesOptions) (*EntityPager, error) {
+	if err := core.ValidateNotNil(listEntitiesOptions, "listEntitiesOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listEntitiesOptions, "listEntitiesOptions"); err != nil {
+		return nil, err
+	}
+
+	return &EntityPager{
+		client:  client,
+		options: *listEntitiesOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of entities can be retrieved
+func (pager *EntityPager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of entities
+func (pager *EntityPager) Next() ([]Entity, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *EntityPager) NextWithContext(ctx context.Context) ([]Entity, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListEntitiesWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Entities, nil
+}
+
+// All retrieves the remaining pages of entities.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *EntityPager) All() ([]Entity, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *EntityPager) AllWithContext(ctx context.Context) (all []Entity, err error) {
+	for pager.HasNext() {
+		var page []Entity
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// ValuePager : Iterates over the entity values returned by ListValues, following the pagination cursors.
+// Each page holds at most PageLimit entity values, as set in the options.
+type ValuePager struct {
+	client  AssistantV1API
+	options ListValuesOptions
+	hasNext bool
+	err     error
+}
+
+// NewValuePager : Instantiate a ValuePager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewValuePager(listValuesOptions *ListValuesOptions) (*ValuePager, error) {
+	return newValuePager(assistant, listValuesOptions)
+}
+
+func newValuePager(client AssistantV1API, listValuesOptions *ListValuesOptions) (*ValuePager, error) {
+	if err := core.ValidateNotNil(listValuesOptions, "listValuesOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listValuesOptions, "listValuesOptions"); err != nil {
+		return nil, err
+	}
+
+	return &ValuePager{
+		client:  client,
+		options: *listValuesOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of entity values can be retrieved
+func (pager *ValuePager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of entity values
+func (pager *ValuePager) Next() ([]Value, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *ValuePager) NextWithContext(ctx context.Context) ([]Value, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListValuesWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Values, nil
+}
+
+// All retrieves the remaining pages of entity values.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *ValuePager) All() ([]Value, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *ValuePager) AllWithContext(ctx context.Context) (all []Value, err error) {
+	for pager.HasNext() {
+		var page []Value
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// SynonymPager : Iterates over the synonyms returned by ListSynonyms, following the pagination cursors.
+// Each page holds at most PageLimit synonyms, as set in the options.
+type SynonymPager struct {
+	client  AssistantV1API
+	options ListSynonymsOptions
+	hasNext bool
+	err     error
+}
+
+// NewSynonymPager : Instantiate a SynonymPager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewSynonymPager(listSynonymsOptions *ListSynonymsOptions) (*SynonymPager, error) {
+	return newSynonymPager(assistant, listSynonymsOptions)
+}
+
+func newSynonymPager(client AssistantV1API, listSynonymsOptions *ListSynonymsOptions) (*SynonymPager, error) {
+	if err := core.ValidateNotNil(listSynonymsOptions, "listSynonymsOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listSynonymsOptions, "listSynonymsOptions"); err != nil {
+		return nil, err
+	}
+
+	return &SynonymPager{
+		client:  client,
+		options: *listSynonymsOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of synonyms can be retrieved
+func (pager *SynonymPager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of synonyms
+func (pager *SynonymPager) Next() ([]Synonym, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *SynonymPager) NextWithContext(ctx context.Context) ([]Synonym, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListSynonymsWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Synonyms, nil
+}
+
+// All retrieves the remaining pages of synonyms.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *SynonymPager) All() ([]Synonym, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *SynonymPager) AllWithContext(ctx context.Context) (all []Synonym, err error) {
+	for pager.HasNext() {
+		var page []Synonym
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// DialogNodePager : Iterates over the dialog nodes returned by ListDialogNodes, following the pagination cursors.
+// Each page holds at most PageLimit dialog nodes, as set in the options.
+type DialogNodePager struct {
+	client  AssistantV1API
+	options ListDialogNodesOptions
+	hasNext bool
+	err     error
+}
+
+// NewDialogNodePager : Instantiate a DialogNodePager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewDialogNodePager(listDialogNodesOptions *ListDialogNodesOptions) (*DialogNodePager, error) {
+	return newDialogNodePager(assistant, listDialogNodesOptions)
+}
+
+func newDialogNodePager(client AssistantV1API, listDialogNodesOptions *ListDialogNodesOptions) (*DialogNodePager, error) {
+	if err := core.ValidateNotNil(listDialogNodesOptions, "listDialogNodesOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listDialogNodesOptions, "listDialogNodesOptions"); err != nil {
+		return nil, err
+	}
+
+	return &DialogNodePager{
+		client:  client,
+		options: *listDialogNodesOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of dialog nodes can be retrieved
+func (pager *DialogNodePager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of dialog nodes
+func (pager *DialogNodePager) Next() ([]DialogNode, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *DialogNodePager) NextWithContext(ctx context.Context) ([]DialogNode, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListDialogNodesWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.DialogNodes, nil
+}
+
+// All retrieves the remaining pages of dialog nodes.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *DialogNodePager) All() ([]DialogNode, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *DialogNodePager) AllWithContext(ctx context.Context) (all []DialogNode, err error) {
+	for pager.HasNext() {
+		var page []DialogNode
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
+
+// LogPager : Iterates over the log events returned by ListLogs, following the pagination cursors.
+// Each page holds at most PageLimit log events, as set in the options.
+type LogPager struct {
+	client  AssistantV1API
+	options ListLogsOptions
+	hasNext bool
+	err     error
+}
+
+// NewLogPager : Instantiate a LogPager starting at the page identified by the Cursor of the options, if any
+func (assistant *AssistantV1) NewLogPager(listLogsOptions *ListLogsOptions) (*LogPager, error) {
+	return newLogPager(assistant, listLogsOptions)
+}
+
+func newLogPager(client AssistantV1API, listLogsOptions *ListLogsOptions) (*LogPager, error) {
+	if err := core.ValidateNotNil(listLogsOptions, "listLogsOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(listLogsOptions, "listLogsOptions"); err != nil {
+		return nil, err
+	}
+
+	return &LogPager{
+		client:  client,
+		options: *listLogsOptions,
+		hasNext: true,
+	}, nil
+}
+
+// HasNext reports whether another page of log events can be retrieved
+func (pager *LogPager) HasNext() bool {
+	return pager.hasNext && pager.err == nil
+}
+
+// Next retrieves the next page of log events
+func (pager *LogPager) Next() ([]Log, error) {
+	return pager.NextWithContext(context.Background())
+}
+
+// NextWithContext is an alternate form of the Next method which supports a Context parameter
+func (pager *LogPager) NextWithContext(ctx context.Context) ([]Log, error) {
+	if pager.err != nil {
+		return nil, pager.err
+	}
+	if !pager.hasNext {
+		return nil, errNoMoreResults()
+	}
+
+	result, _, err := pager.client.ListLogsWithContext(ctx, &pager.options)
+	if err != nil {
+		pager.err = err
+		return nil, err
+	}
+
+	pager.options.Cursor = nil
+	if result.Pagination != nil {
+		pager.options.Cursor = nextCursor(result.Pagination.NextCursor)
+	}
+	pager.hasNext = pager.options.Cursor != nil
+	return result.Logs, nil
+}
+
+// All retrieves the remaining pages of log events.
+// On an error, the items retrieved before it are returned with the error.
+func (pager *LogPager) All() ([]Log, error) {
+	return pager.AllWithContext(context.Background())
+}
+
+// AllWithContext is an alternate form of the All method which supports a Context parameter
+func (pager *LogPager) AllWithContext(ctx context.Context) (all []Log, err error) {
+	for pager.HasNext() {
+		var page []Log
+		page, err = pager.NextWithContext(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, pager.err
+}
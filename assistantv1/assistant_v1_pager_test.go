@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/assistantv1"
+)
+
+var _ = Describe(`AssistantV1 pagers`, func() {
+	version := "exampleString"
+	bearerToken := "0ui9876453"
+	workspaceID := "exampleString"
+
+	newTestService := func(url string) *assistantv1.AssistantV1 {
+		testService, testServiceErr := assistantv1.NewAssistantV1(&assistantv1.AssistantV1Options{
+			URL:     url,
+			Version: version,
+			Authenticator: &core.BearerTokenAuthenticator{
+				BearerToken: bearerToken,
+			},
+		})
+		Expect(testServiceErr).To(BeNil())
+		Expect(testService).ToNot(BeNil())
+		return testService
+	}
+
+	Describe(`NewIntentPager(listIntentsOptions *ListIntentsOptions)`, func() {
+		listIntentsPath := strings.Replace("/v1/workspaces/{workspace_id}/intents", "{workspace_id}", workspaceID, 1)
+		Context(`Successfully - List all intents`, func() {
+			var cursors []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(listIntentsPath))
+				Expect(req.URL.Query()["page_limit"]).To(Equal([]string{"2"}))
+				cursor := req.URL.Query().Get("cursor")
+				cursors = append(cursors, cursor)
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				if cursor == "" {
+					fmt.Fprintf(res, `{"intents": [{"intent": "a"}, {"intent": "b"}], "pagination": {"refresh_url": "x", "next_cursor": "page2"}}`)
+				} else {
+					fmt.Fprintf(res, `{"intents": [{"intent": "c"}], "pagination": {"refresh_url": "x"}}`)
+				}
+			}))
+			It(`Succeed to follow the cursors`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				// Pass empty options
+				pager, err := testService.NewIntentPager(nil)
+				Expect(err).NotTo(BeNil())
+				Expect(pager).To(BeNil())
+
+				listIntentsOptions := testService.NewListIntentsOptions(workspaceID).SetPageLimit(2)
+				pager, err = testService.NewIntentPager(listIntentsOptions)
+				Expect(err).To(BeNil())
+				Expect(pager.HasNext()).To(BeTrue())
+
+				intents, err := pager.All()
+				Expect(err).To(BeNil())
+				Expect(intents).To(HaveLen(3))
+				Expect(*intents[2].Intent).To(Equal("c"))
+				Expect(cursors).To(Equal([]string{"", "page2"}))
+				Expect(listIntentsOptions.Cursor).To(BeNil())
+
+				Expect(pager.HasNext()).To(BeFalse())
+				page, err := pager.Next()
+				Expect(err).NotTo(BeNil())
+				Expect(page).To(BeNil())
+			})
+		})
+	})
+	Describe(`NewLogPager(listLogsOptions *ListLogsOptions)`, func() {
+		listLogsPath := strings.Replace("/v1/workspaces/{workspace_id}/logs", "{workspace_id}", workspaceID, 1)
+		Context(`Unsuccessfully - List logs`, func() {
+			calls := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(listLogsPath))
+				calls++
+				res.Header().Set("Content-type", "application/json")
+				if calls == 1 {
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"logs": [{"log_id": "1"}], "pagination": {"next_cursor": "page2"}}`)
+				} else {
+					res.WriteHeader(500)
+					fmt.Fprintf(res, `{"error": "Internal error", "code": 500}`)
+				}
+			}))
+			It(`Stop on the first error`, func() {
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				pager, err := testService.NewLogPager(testService.NewListLogsOptions(workspaceID))
+				Expect(err).To(BeNil())
+
+				logs, err := pager.Next()
+				Expect(err).To(BeNil())
+				Expect(logs).To(HaveLen(1))
+				Expect(pager.HasNext()).To(BeTrue())
+
+				logs, err = pager.All()
+				Expect(err).NotTo(BeNil())
+				Expect(logs).To(BeNil())
+				Expect(pager.HasNext()).To(BeFalse())
+				Expect(calls).To(Equal(2))
+			})
+		})
+		Context(`Partially - List logs`, func() {
+			It(`Return the pages retrieved before the error`, func() {
+				calls := 0
+				testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					calls++
+					res.Header().Set("Content-type", "application/json")
+					if calls < 3 {
+						res.WriteHeader(200)
+						fmt.Fprintf(res, `{"logs": [{"log_id": "%d"}], "pagination": {"next_cursor": "page%d"}}`, calls, calls+1)
+					} else {
+						res.WriteHeader(500)
+						fmt.Fprintf(res, `{"error": "Internal error", "code": 500}`)
+					}
+				}))
+				defer testServer.Close()
+				testService := newTestService(testServer.URL)
+
+				pager, err := testService.NewLogPager(testService.NewListLogsOptions(workspaceID))
+				Expect(err).To(BeNil())
+
+				logs, err := pager.All()
+				Expect(err).NotTo(BeNil())
+				Expect(logs).To(HaveLen(2))
+				Expect(*logs[0].LogID).To(Equal("1"))
+				Expect(*logs[1].LogID).To(Equal("2"))
+				Expect(calls).To(Equal(3))
+			})
+		})
+	})
+	Describe(`NewEntityPager(listEntitiesOptions *ListEntitiesOptions) with a mock`, func() {
+		It(`Succeed to page through the mock`, func() {
+			mock := new(assistantv1.MockAssistantV1)
+			mock.ListEntitiesFunc = func(ctx context.Context, listEntitiesOptions *assistantv1.ListEntitiesOptions) (*assistantv1.EntityCollection, *core.DetailedResponse, error) {
+				if listEntitiesOptions.Cursor == nil {
+					return &assistantv1.EntityCollection{
+						Entities:   []assistantv1.Entity{{Entity: core.StringPtr("a")}},
+						Pagination: &assistantv1.Pagination{NextCursor: core.StringPtr("next")},
+					}, nil, nil
+				}
+				return &assistantv1.EntityCollection{
+					Entities:   []assistantv1.Entity{{Entity: core.StringPtr("b")}},
+					Pagination: &assistantv1.Pagination{},
+				}, nil, nil
+			}
+
+			pager, err := mock.NewEntityPager(mock.NewListEntitiesOptions(workspaceID))
+			Expect(err).To(BeNil())
+			entities, err := pager.All()
+			Expect(err).To(BeNil())
+			Expect(entities).To(HaveLen(2))
+			Expect(mock.CallsTo("ListEntities")).To(HaveLen(2))
+		})
+	})
+})
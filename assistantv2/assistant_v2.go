@@ -18,7 +18,9 @@
 package assistantv2
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/IBM/go-sdk-core/core"
 	common "github.com/watson-developer-cloud/go-sdk/common"
@@ -45,10 +47,13 @@ const DefaultServiceName = "conversation"
 
 // AssistantV2Options : Service options
 type AssistantV2Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewAssistantV2 : constructs an instance of AssistantV2 with passed in options.
@@ -86,6 +91,16 @@ func NewAssistantV2(options *AssistantV2Options) (service *AssistantV2, err erro
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &AssistantV2{
 		Service: baseService,
 		Version: options.Version,
@@ -101,7 +116,27 @@ func (assistant *AssistantV2) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (assistant *AssistantV2) DisableSSLVerification() {
-	assistant.Service.DisableSSLVerification()
+	common.DisableSSLVerification(assistant.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (assistant *AssistantV2) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(assistant.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (assistant *AssistantV2) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(assistant.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (assistant *AssistantV2) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(assistant.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (assistant *AssistantV2) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(assistant.Service, interceptor)
 }
 
 // CreateSession : Create a session
@@ -109,6 +144,11 @@ func (assistant *AssistantV2) DisableSSLVerification() {
 // state of the conversation. A session persists until it is deleted, or until it times out because of inactivity. (For
 // more information, see the [documentation](https://cloud.ibm.com/docs/assistant?topic=assistant-assistant-settings).
 func (assistant *AssistantV2) CreateSession(createSessionOptions *CreateSessionOptions) (result *SessionResponse, response *core.DetailedResponse, err error) {
+	return assistant.CreateSessionWithContext(context.Background(), createSessionOptions)
+}
+
+// CreateSessionWithContext is an alternate form of the CreateSession method which supports a Context parameter
+func (assistant *AssistantV2) CreateSessionWithContext(ctx context.Context, createSessionOptions *CreateSessionOptions) (result *SessionResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createSessionOptions, "createSessionOptions cannot be nil")
 	if err != nil {
 		return
@@ -144,7 +184,10 @@ func (assistant *AssistantV2) CreateSession(createSessionOptions *CreateSessionO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(SessionResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SessionResponse)
@@ -160,6 +203,11 @@ func (assistant *AssistantV2) CreateSession(createSessionOptions *CreateSessionO
 // Deletes a session explicitly before it times out. (For more information about the session inactivity timeout, see the
 // [documentation](https://cloud.ibm.com/docs/assistant?topic=assistant-assistant-settings)).
 func (assistant *AssistantV2) DeleteSession(deleteSessionOptions *DeleteSessionOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteSessionWithContext(context.Background(), deleteSessionOptions)
+}
+
+// DeleteSessionWithContext is an alternate form of the DeleteSession method which supports a Context parameter
+func (assistant *AssistantV2) DeleteSessionWithContext(ctx context.Context, deleteSessionOptions *DeleteSessionOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteSessionOptions, "deleteSessionOptions cannot be nil")
 	if err != nil {
 		return
@@ -195,7 +243,10 @@ func (assistant *AssistantV2) DeleteSession(deleteSessionOptions *DeleteSessionO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -204,6 +255,11 @@ func (assistant *AssistantV2) DeleteSession(deleteSessionOptions *DeleteSessionO
 // Send user input to an assistant and receive a response, with conversation state (including context data) stored by
 // Watson Assistant for the duration of the session.
 func (assistant *AssistantV2) Message(messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
+	return assistant.MessageWithContext(context.Background(), messageOptions)
+}
+
+// MessageWithContext is an alternate form of the Message method which supports a Context parameter
+func (assistant *AssistantV2) MessageWithContext(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(messageOptions, "messageOptions cannot be nil")
 	if err != nil {
 		return
@@ -252,7 +308,10 @@ func (assistant *AssistantV2) Message(messageOptions *MessageOptions) (result *M
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(MessageResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MessageResponse)
@@ -268,6 +327,11 @@ func (assistant *AssistantV2) Message(messageOptions *MessageOptions) (result *M
 // Send user input to an assistant and receive a response, with conversation state (including context data) managed by
 // your application.
 func (assistant *AssistantV2) MessageStateless(messageStatelessOptions *MessageStatelessOptions) (result *MessageResponseStateless, response *core.DetailedResponse, err error) {
+	return assistant.MessageStatelessWithContext(context.Background(), messageStatelessOptions)
+}
+
+// MessageStatelessWithContext is an alternate form of the MessageStateless method which supports a Context parameter
+func (assistant *AssistantV2) MessageStatelessWithContext(ctx context.Context, messageStatelessOptions *MessageStatelessOptions) (result *MessageResponseStateless, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(messageStatelessOptions, "messageStatelessOptions cannot be nil")
 	if err != nil {
 		return
@@ -316,7 +380,10 @@ func (assistant *AssistantV2) MessageStateless(messageStatelessOptions *MessageS
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(MessageResponseStateless))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MessageResponseStateless)
@@ -333,6 +400,11 @@ func (assistant *AssistantV2) MessageStateless(messageStatelessOptions *MessageS
 //
 // This method is available only with Premium plans.
 func (assistant *AssistantV2) ListLogs(listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	return assistant.ListLogsWithContext(context.Background(), listLogsOptions)
+}
+
+// ListLogsWithContext is an alternate form of the ListLogs method which supports a Context parameter
+func (assistant *AssistantV2) ListLogsWithContext(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listLogsOptions, "listLogsOptions cannot be nil")
 	if err != nil {
 		return
@@ -381,7 +453,10 @@ func (assistant *AssistantV2) ListLogs(listLogsOptions *ListLogsOptions) (result
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, new(LogCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LogCollection)
@@ -403,6 +478,11 @@ func (assistant *AssistantV2) ListLogs(listLogsOptions *ListLogsOptions) (result
 //
 // This operation is limited to 4 requests per minute. For more information, see **Rate limiting**.
 func (assistant *AssistantV2) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return assistant.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (assistant *AssistantV2) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -440,7 +520,10 @@ func (assistant *AssistantV2) DeleteUserData(deleteUserDataOptions *DeleteUserDa
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = assistant.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
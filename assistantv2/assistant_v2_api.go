@@ -0,0 +1,51 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// AssistantV2API : The operations of the AssistantV2 service.
+// It is implemented by *AssistantV2, and by *MockAssistantV2 for unit tests.
+type AssistantV2API interface {
+	CreateSession(createSessionOptions *CreateSessionOptions) (result *SessionResponse, response *core.DetailedResponse, err error)
+	CreateSessionWithContext(ctx context.Context, createSessionOptions *CreateSessionOptions) (result *SessionResponse, response *core.DetailedResponse, err error)
+	DeleteSession(deleteSessionOptions *DeleteSessionOptions) (response *core.DetailedResponse, err error)
+	DeleteSessionWithContext(ctx context.Context, deleteSessionOptions *DeleteSessionOptions) (response *core.DetailedResponse, err error)
+	Message(messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error)
+	MessageWithContext(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error)
+	MessageStateless(messageStatelessOptions *MessageStatelessOptions) (result *MessageResponseStateless, response *core.DetailedResponse, err error)
+	MessageStatelessWithContext(ctx context.Context, messageStatelessOptions *MessageStatelessOptions) (result *MessageResponseStateless, response *core.DetailedResponse, err error)
+	ListLogs(listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	ListLogsWithContext(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	NewCaptureGroup(group string) (model *CaptureGroup, err error)
+	NewCreateSessionOptions(assistantID string) *CreateSessionOptions
+	NewDeleteSessionOptions(assistantID string, sessionID string) *DeleteSessionOptions
+	NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions
+	NewListLogsOptions(assistantID string) *ListLogsOptions
+	NewMessageOptions(assistantID string, sessionID string) *MessageOptions
+	NewMessageStatelessOptions(assistantID string) *MessageStatelessOptions
+	NewRuntimeEntity(entity string, location []int64, value string) (model *RuntimeEntity, err error)
+	NewRuntimeIntent(intent string, confidence float64) (model *RuntimeIntent, err error)
+}
+
+var _ AssistantV2API = (*AssistantV2)(nil)
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockAssistantV2 : A mock implementation of AssistantV2API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *AssistantV2.
+type MockAssistantV2 struct {
+	common.MockRecorder
+
+	CreateSessionFunc    func(ctx context.Context, createSessionOptions *CreateSessionOptions) (result *SessionResponse, response *core.DetailedResponse, err error)
+	DeleteSessionFunc    func(ctx context.Context, deleteSessionOptions *DeleteSessionOptions) (response *core.DetailedResponse, err error)
+	MessageFunc          func(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error)
+	MessageStatelessFunc func(ctx context.Context, messageStatelessOptions *MessageStatelessOptions) (result *MessageResponseStateless, response *core.DetailedResponse, err error)
+	ListLogsFunc         func(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error)
+	DeleteUserDataFunc   func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ AssistantV2API = (*MockAssistantV2)(nil)
+
+// CreateSession records the call and invokes CreateSessionFunc
+func (mock *MockAssistantV2) CreateSession(createSessionOptions *CreateSessionOptions) (result *SessionResponse, response *core.DetailedResponse, err error) {
+	return mock.CreateSessionWithContext(context.Background(), createSessionOptions)
+}
+
+// CreateSessionWithContext records the call and invokes CreateSessionFunc
+func (mock *MockAssistantV2) CreateSessionWithContext(ctx context.Context, createSessionOptions *CreateSessionOptions) (result *SessionResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateSession", createSessionOptions)
+	if mock.CreateSessionFunc != nil {
+		return mock.CreateSessionFunc(ctx, createSessionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV2", "CreateSession")
+	return
+}
+
+// DeleteSession records the call and invokes DeleteSessionFunc
+func (mock *MockAssistantV2) DeleteSession(deleteSessionOptions *DeleteSessionOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteSessionWithContext(context.Background(), deleteSessionOptions)
+}
+
+// DeleteSessionWithContext records the call and invokes DeleteSessionFunc
+func (mock *MockAssistantV2) DeleteSessionWithContext(ctx context.Context, deleteSessionOptions *DeleteSessionOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteSession", deleteSessionOptions)
+	if mock.DeleteSessionFunc != nil {
+		return mock.DeleteSessionFunc(ctx, deleteSessionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV2", "DeleteSession")
+	return
+}
+
+// Message records the call and invokes MessageFunc
+func (mock *MockAssistantV2) Message(messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
+	return mock.MessageWithContext(context.Background(), messageOptions)
+}
+
+// MessageWithContext records the call and invokes MessageFunc
+func (mock *MockAssistantV2) MessageWithContext(ctx context.Context, messageOptions *MessageOptions) (result *MessageResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Message", messageOptions)
+	if mock.MessageFunc != nil {
+		return mock.MessageFunc(ctx, messageOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV2", "Message")
+	return
+}
+
+// MessageStateless records the call and invokes MessageStatelessFunc
+func (mock *MockAssistantV2) MessageStateless(messageStatelessOptions *MessageStatelessOptions) (result *MessageResponseStateless, response *core.DetailedResponse, err error) {
+	return mock.MessageStatelessWithContext(context.Background(), messageStatelessOptions)
+}
+
+// MessageStatelessWithContext records the call and invokes MessageStatelessFunc
+func (mock *MockAssistantV2) MessageStatelessWithContext(ctx context.Context, messageStatelessOptions *MessageStatelessOptions) (result *MessageResponseStateless, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "MessageStateless", messageStatelessOptions)
+	if mock.MessageStatelessFunc != nil {
+		return mock.MessageStatelessFunc(ctx, messageStatelessOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV2", "MessageStateless")
+	return
+}
+
+// ListLogs records the call and invokes ListLogsFunc
+func (mock *MockAssistantV2) ListLogs(listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	return mock.ListLogsWithContext(context.Background(), listLogsOptions)
+}
+
+// ListLogsWithContext records the call and invokes ListLogsFunc
+func (mock *MockAssistantV2) ListLogsWithContext(ctx context.Context, listLogsOptions *ListLogsOptions) (result *LogCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListLogs", listLogsOptions)
+	if mock.ListLogsFunc != nil {
+		return mock.ListLogsFunc(ctx, listLogsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV2", "ListLogs")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockAssistantV2) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockAssistantV2) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockAssistantV2", "DeleteUserData")
+	return
+}
+
+// NewCaptureGroup delegates to AssistantV2.NewCaptureGroup
+func (mock *MockAssistantV2) NewCaptureGroup(group string) (model *CaptureGroup, err error) {
+	return new(AssistantV2).NewCaptureGroup(group)
+}
+
+// NewCreateSessionOptions delegates to AssistantV2.NewCreateSessionOptions
+func (mock *MockAssistantV2) NewCreateSessionOptions(assistantID string) *CreateSessionOptions {
+	return new(AssistantV2).NewCreateSessionOptions(assistantID)
+}
+
+// NewDeleteSessionOptions delegates to AssistantV2.NewDeleteSessionOptions
+func (mock *MockAssistantV2) NewDeleteSessionOptions(assistantID string, sessionID string) *DeleteSessionOptions {
+	return new(AssistantV2).NewDeleteSessionOptions(assistantID, sessionID)
+}
+
+// NewDeleteUserDataOptions delegates to AssistantV2.NewDeleteUserDataOptions
+func (mock *MockAssistantV2) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(AssistantV2).NewDeleteUserDataOptions(customerID)
+}
+
+// NewListLogsOptions delegates to AssistantV2.NewListLogsOptions
+func (mock *MockAssistantV2) NewListLogsOptions(assistantID string) *ListLogsOptions {
+	return new(AssistantV2).NewListLogsOptions(assistantID)
+}
+
+// NewMessageOptions delegates to AssistantV2.NewMessageOptions
+func (mock *MockAssistantV2) NewMessageOptions(assistantID string, sessionID string) *MessageOptions {
+	return new(AssistantV2).NewMessageOptions(assistantID, sessionID)
+}
+
+// NewMessageStatelessOptions delegates to AssistantV2.NewMessageStatelessOptions
+func (mock *MockAssistantV2) NewMessageStatelessOptions(assistantID string) *MessageStatelessOptions {
+	return new(AssistantV2).NewMessageStatelessOptions(assistantID)
+}
+
+// NewRuntimeEntity delegates to AssistantV2.NewRuntimeEntity
+func (mock *MockAssistantV2) NewRuntimeEntity(entity string, location []int64, value string) (model *RuntimeEntity, err error) {
+	return new(AssistantV2).NewRuntimeEntity(entity, location, value)
+}
+
+// NewRuntimeIntent delegates to AssistantV2.NewRuntimeIntent
+func (mock *MockAssistantV2) NewRuntimeIntent(intent string, confidence float64) (model *RuntimeIntent, err error) {
+	return new(AssistantV2).NewRuntimeIntent(intent, confidence)
+}
@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assistantv2_test
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/assistantv2"
+	"github.com/watson-developer-cloud/go-sdk/internal/mocktest"
+)
+
+// greet depends on the interface only, so that it can be tested with the mock
+func greet(assistant assistantv2.AssistantV2API, assistantID string, sessionID string) (string, error) {
+	messageOptions := assistant.NewMessageOptions(assistantID, sessionID).
+		SetInput(&assistantv2.MessageInput{Text: core.StringPtr("Hello")})
+	result, _, err := assistant.Message(messageOptions)
+	if err != nil {
+		return "", err
+	}
+	return *result.Output.Generic[0].Text, nil
+}
+
+var _ = Describe(`MockAssistantV2`, func() {
+	It(`Forwards each operation to its function and constructs the options like the service`, func() {
+		service, err := assistantv2.NewAssistantV2(&assistantv2.AssistantV2Options{
+			Version:       "2020-04-01",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(mocktest.Check(new(assistantv2.MockAssistantV2), service)).To(BeEmpty())
+	})
+	Describe(`Message(messageOptions *MessageOptions)`, func() {
+		It(`Returns the programmed response and records the call`, func() {
+			mock := new(assistantv2.MockAssistantV2)
+			mock.MessageFunc = func(ctx context.Context, messageOptions *assistantv2.MessageOptions) (*assistantv2.MessageResponse, *core.DetailedResponse, error) {
+				Expect(*messageOptions.Input.Text).To(Equal("Hello"))
+				return &assistantv2.MessageResponse{
+					Output: &assistantv2.MessageOutput{
+						Generic: []assistantv2.RuntimeResponseGeneric{{Text: core.StringPtr("Hi there")}},
+					},
+				}, &core.DetailedResponse{StatusCode: 200}, nil
+			}
+
+			text, err := greet(mock, "assistantID", "sessionID")
+			Expect(err).To(BeNil())
+			Expect(text).To(Equal("Hi there"))
+
+			calls := mock.CallsTo("Message")
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].Context).To(Equal(context.Background()))
+			Expect(*calls[0].Args[0].(*assistantv2.MessageOptions).SessionID).To(Equal("sessionID"))
+		})
+		It(`Fails when no behavior was programmed`, func() {
+			mock := new(assistantv2.MockAssistantV2)
+			result, response, err := mock.CreateSession(mock.NewCreateSessionOptions("assistantID"))
+			Expect(err).NotTo(BeNil())
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(mock.Calls()).To(HaveLen(1))
+		})
+	})
+})
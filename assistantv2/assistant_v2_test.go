@@ -17,6 +17,8 @@
 package assistantv2_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -26,6 +28,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/watson-developer-cloud/go-sdk/assistantv2"
+	"github.com/watson-developer-cloud/go-sdk/common"
 )
 
 var _ = Describe(`AssistantV2`, func() {
@@ -75,6 +78,99 @@ var _ = Describe(`AssistantV2`, func() {
 				Expect(result).ToNot(BeNil())
 			})
 		})
+		Context(`Unsuccessfully - Create a session for a missing assistant`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(createSessionPath))
+				Expect(req.Method).To(Equal("POST"))
+				res.Header().Set("Content-type", "application/json")
+				res.Header().Set("X-Global-Transaction-Id", "fake_TransactionID")
+				res.WriteHeader(404)
+				fmt.Fprintf(res, `{"error": "Resource not found", "code": 404}`)
+			}))
+			It(`Fail to call CreateSession with a typed error`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv2.NewAssistantV2(&assistantv2.AssistantV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				createSessionOptions := testService.NewCreateSessionOptions(assistantID)
+				result, response, operationErr := testService.CreateSession(createSessionOptions)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(operationErr.Error()).To(Equal("Resource not found"))
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(BeNil())
+
+				var serviceError *common.ServiceError
+				Expect(errors.As(operationErr, &serviceError)).To(BeTrue())
+				Expect(serviceError.StatusCode).To(Equal(404))
+				Expect(serviceError.TransactionID).To(Equal("fake_TransactionID"))
+				Expect(common.IsNotFound(operationErr)).To(BeTrue())
+			})
+		})
+		Context(`Using a context - Create a session`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(createSessionPath))
+				Expect(req.Method).To(Equal("POST"))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"session_id": "fake_SessionID"}`)
+			}))
+			It(`Succeed to call CreateSessionWithContext`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv2.NewAssistantV2(&assistantv2.AssistantV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				createSessionOptions := testService.NewCreateSessionOptions(assistantID)
+				result, response, operationErr := testService.CreateSessionWithContext(context.Background(), createSessionOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+			})
+			It(`Fail to call CreateSessionWithContext with a cancelled context`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := assistantv2.NewAssistantV2(&assistantv2.AssistantV2Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				createSessionOptions := testService.NewCreateSessionOptions(assistantID)
+				result, response, operationErr := testService.CreateSessionWithContext(ctx, createSessionOptions)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring(context.Canceled.Error()))
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+			})
+		})
 	})
 	Describe(`DeleteSession(deleteSessionOptions *DeleteSessionOptions)`, func() {
 		deleteSessionPath := "/v2/assistants/{assistant_id}/sessions/{session_id}"
@@ -0,0 +1,179 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+const (
+	HEADER_TRANSACTION_ID     = "X-Global-Transaction-Id"
+	HEADER_DP_TRANSACTION_ID  = "X-DP-Watson-Tran-ID"
+	HEADER_WATSON_TRANSACTION = "X-Watson-Transaction-Id"
+)
+
+// ServiceError : An error response returned by a Watson service. Every service operation returns a *ServiceError when
+// the service answered with an unsuccessful status code; use errors.As to retrieve it.
+type ServiceError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The error code reported by the service, if any. Depending on the service this is a symbolic code such as
+	// `not_found`, the description of the status code, or the status code itself.
+	Code string
+
+	// The description of the error, as reported by the service.
+	Description string
+
+	// The transaction ID assigned to the request, useful when troubleshooting with IBM support.
+	TransactionID string
+
+	// The raw response body, for a response that is not JSON. The body of a JSON response is decoded by the service
+	// core, which does not keep its bytes; it is in Result.
+	Body []byte
+
+	// The decoded body of a JSON response.
+	Result map[string]interface{}
+
+	// The full response, including headers.
+	Response *core.DetailedResponse
+
+	err error
+}
+
+// Error returns the description of the error, as reported by the service
+func (e *ServiceError) Error() string {
+	if e.Description == "" {
+		return http.StatusText(e.StatusCode)
+	}
+	return e.Description
+}
+
+// Unwrap returns the error reported by the underlying service core
+func (e *ServiceError) Unwrap() error {
+	return e.err
+}
+
+// NewServiceError : Builds a ServiceError from the response of a failed operation
+func NewServiceError(response *core.DetailedResponse, err error) *ServiceError {
+	serviceError := &ServiceError{
+		StatusCode:    response.StatusCode,
+		TransactionID: transactionID(response.Headers),
+		Response:      response,
+		err:           err,
+	}
+	if err != nil {
+		serviceError.Description = err.Error()
+	}
+
+	if responseMap, ok := response.Result.(map[string]interface{}); ok {
+		serviceError.Result = responseMap
+		serviceError.Code = errorCode(responseMap)
+	} else if response.RawResult != nil {
+		serviceError.Body = response.RawResult
+	}
+
+	return serviceError
+}
+
+// WrapServiceError : Converts the error of a failed operation into a *ServiceError. The error is returned unchanged
+// when it is nil, when no response was received from the service, for example if the request was cancelled, or when
+// the service answered with a successful status code, for example if the body of the response could not be decoded.
+func WrapServiceError(response *core.DetailedResponse, err error) error {
+	if err == nil || response == nil || response.StatusCode == 0 {
+		return err
+	}
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return err
+	}
+	return NewServiceError(response, err)
+}
+
+func transactionID(headers http.Header) string {
+	for _, name := range []string{HEADER_TRANSACTION_ID, HEADER_DP_TRANSACTION_ID, HEADER_WATSON_TRANSACTION} {
+		if value := headers.Get(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// errorCode looks for the error code in the various error body formats used by the services
+func errorCode(responseMap map[string]interface{}) string {
+	if errorList, ok := responseMap["errors"].([]interface{}); ok && len(errorList) > 0 {
+		if first, ok := errorList[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				return code
+			}
+		}
+	}
+	for _, key := range []string{"error_code", "code_description", "code"} {
+		switch value := responseMap[key].(type) {
+		case string:
+			return value
+		case float64:
+			return fmt.Sprint(value)
+		}
+	}
+	return ""
+}
+
+// GetServiceError : Returns the *ServiceError wrapped in err, if any
+func GetServiceError(err error) (*ServiceError, bool) {
+	var serviceError *ServiceError
+	if errors.As(err, &serviceError) {
+		return serviceError, true
+	}
+	return nil, false
+}
+
+func hasStatusCode(err error, statusCodes ...int) bool {
+	serviceError, ok := GetServiceError(err)
+	if !ok {
+		return false
+	}
+	for _, statusCode := range statusCodes {
+		if serviceError.StatusCode == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBadRequest : Reports whether the service rejected the request as invalid (400)
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized : Reports whether the request was not authenticated (401)
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden : Reports whether the credentials are not allowed to perform the operation (403)
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsNotFound : Reports whether the requested resource, such as a workspace or a session, does not exist (404)
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict : Reports whether the request conflicts with the current state of the resource (409)
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRateLimited : Reports whether the request was rejected because a rate limit or quota was exceeded (429)
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsServerError : Reports whether the service failed to process the request (5xx)
+func IsServerError(err error) bool {
+	serviceError, ok := GetServiceError(err)
+	return ok && serviceError.StatusCode >= 500
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapServiceErrorFromJSONBody(t *testing.T) {
+	headers := http.Header{}
+	headers.Set(HEADER_TRANSACTION_ID, "abc-123")
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusNotFound,
+		Headers:    headers,
+		Result: map[string]interface{}{
+			"error":            "Resource not found",
+			"code":             float64(404),
+			"code_description": "Not Found",
+		},
+	}
+
+	err := WrapServiceError(response, fmt.Errorf("Resource not found"))
+	assert.Equal(t, "Resource not found", err.Error())
+
+	var serviceError *ServiceError
+	assert.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &serviceError))
+	assert.Equal(t, http.StatusNotFound, serviceError.StatusCode)
+	assert.Equal(t, "Not Found", serviceError.Code)
+	assert.Equal(t, "Resource not found", serviceError.Description)
+	assert.Equal(t, "abc-123", serviceError.TransactionID)
+	assert.Equal(t, "Resource not found", serviceError.Result["error"])
+	assert.Nil(t, serviceError.Body)
+
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsRateLimited(err))
+	assert.False(t, IsServerError(err))
+}
+
+func TestWrapServiceErrorFromErrorsArray(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusTooManyRequests,
+		Headers:    http.Header{},
+		Result: map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{"code": "too_many_requests", "message": "Rate limit exceeded"},
+			},
+		},
+	}
+
+	err := WrapServiceError(response, fmt.Errorf("Rate limit exceeded"))
+	serviceError, ok := GetServiceError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "too_many_requests", serviceError.Code)
+	assert.True(t, IsRateLimited(err))
+}
+
+func TestWrapServiceErrorFromRawBody(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusUnauthorized,
+		Headers:    http.Header{},
+		RawResult:  []byte("Unauthorized"),
+	}
+
+	err := WrapServiceError(response, fmt.Errorf(http.StatusText(http.StatusUnauthorized)))
+	serviceError, ok := GetServiceError(err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("Unauthorized"), serviceError.Body)
+	assert.Nil(t, serviceError.Result)
+	assert.True(t, IsUnauthorized(err))
+}
+
+func TestWrapServiceErrorWithoutResponse(t *testing.T) {
+	assert.Nil(t, WrapServiceError(nil, nil))
+	assert.Nil(t, WrapServiceError(&core.DetailedResponse{StatusCode: http.StatusOK}, nil))
+
+	original := fmt.Errorf("connection refused")
+	assert.Equal(t, original, WrapServiceError(nil, original))
+	assert.False(t, IsNotFound(original))
+}
+
+func TestWrapServiceErrorWithSuccessfulResponse(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: http.StatusOK,
+		Headers:    http.Header{},
+		RawResult:  []byte("{not json"),
+	}
+
+	original := fmt.Errorf("An error occurred while unmarshalling the response body")
+	err := WrapServiceError(response, original)
+	assert.Equal(t, original, err)
+	_, ok := GetServiceError(err)
+	assert.False(t, ok)
+	assert.False(t, IsServerError(err))
+}
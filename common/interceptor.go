@@ -0,0 +1,127 @@
+package common
+
+import (
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// Interceptor : Hooks invoked around every request sent by a service, including the handshake of the websocket
+// connections
+type Interceptor interface {
+	// OnRequest is invoked before the request is sent, once the authentication headers have been added. The request
+	// can be modified, for example to add headers. Returning an error aborts the request.
+	OnRequest(req *http.Request) error
+
+	// OnResponse is invoked once the response has been received, or with the error that prevented it. The response
+	// body must not be consumed.
+	OnResponse(req *http.Request, resp *http.Response, err error)
+}
+
+// InterceptorFuncs : An Interceptor built from functions. Either function can be left nil.
+type InterceptorFuncs struct {
+	Request  func(req *http.Request) error
+	Response func(req *http.Request, resp *http.Response, err error)
+}
+
+// OnRequest invokes the Request function, if any
+func (funcs InterceptorFuncs) OnRequest(req *http.Request) error {
+	if funcs.Request == nil {
+		return nil
+	}
+	return funcs.Request(req)
+}
+
+// OnResponse invokes the Response function, if any
+func (funcs InterceptorFuncs) OnResponse(req *http.Request, resp *http.Response, err error) {
+	if funcs.Response != nil {
+		funcs.Response(req, resp, err)
+	}
+}
+
+// InterceptorTransport : An http.RoundTripper that invokes a chain of interceptors around each request. The
+// OnRequest hooks are invoked in the order of registration, the OnResponse hooks in the reverse order.
+type InterceptorTransport struct {
+	// The transport used to send the requests. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	Interceptors []Interceptor
+}
+
+// Unwrap returns the transport wrapped by the InterceptorTransport
+func (transport *InterceptorTransport) Unwrap() http.RoundTripper {
+	if transport.Base == nil {
+		return http.DefaultTransport
+	}
+	return transport.Base
+}
+
+func (transport *InterceptorTransport) setBase(base http.RoundTripper) {
+	transport.Base = base
+}
+
+// RoundTrip sends the request through the interceptors
+func (transport *InterceptorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it was given.
+	req = req.Clone(req.Context())
+
+	invoked, err := interceptRequest(transport.Interceptors, req)
+	if err != nil {
+		interceptResponse(transport.Interceptors[:invoked], req, nil, err)
+		return nil, err
+	}
+
+	resp, err := transport.Unwrap().RoundTrip(req)
+	interceptResponse(transport.Interceptors, req, resp, err)
+	return resp, err
+}
+
+// interceptRequest invokes the OnRequest hooks and returns the number of interceptors that accepted the request
+func interceptRequest(interceptors []Interceptor, req *http.Request) (int, error) {
+	for i, interceptor := range interceptors {
+		if err := interceptor.OnRequest(req); err != nil {
+			return i, err
+		}
+	}
+	return len(interceptors), nil
+}
+
+func interceptResponse(interceptors []Interceptor, req *http.Request, resp *http.Response, err error) {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptors[i].OnResponse(req, resp, err)
+	}
+}
+
+func isInterceptorTransport(transport http.RoundTripper) bool {
+	_, ok := transport.(*InterceptorTransport)
+	return ok
+}
+
+// AddInterceptor : Registers an interceptor invoked around every request sent by the service. When retries are
+// enabled, the interceptors are invoked for each attempt.
+func AddInterceptor(service *core.BaseService, interceptor Interceptor) {
+	if interceptorTransport, ok := findTransport(service, isInterceptorTransport).(*InterceptorTransport); ok {
+		interceptorTransport.Interceptors = append(interceptorTransport.Interceptors, interceptor)
+		return
+	}
+
+	// Interceptors sit closest to the network so that they observe every attempt of a retried request.
+	current, parent := innermostTransport(service)
+	interceptorTransport := &InterceptorTransport{
+		Base:         current,
+		Interceptors: []Interceptor{interceptor},
+	}
+	if parent == nil {
+		service.Client.Transport = interceptorTransport
+	} else {
+		parent.setBase(interceptorTransport)
+	}
+}
+
+// GetInterceptors : Returns the interceptors registered on the service
+func GetInterceptors(service *core.BaseService) []Interceptor {
+	if interceptorTransport, ok := findTransport(service, isInterceptorTransport).(*InterceptorTransport); ok {
+		return interceptorTransport.Interceptors
+	}
+	return nil
+}
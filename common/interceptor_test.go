@@ -0,0 +1,138 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBaseService(t *testing.T, url string) *core.BaseService {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return service
+}
+
+func TestInterceptorsAreInvokedInOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "first,second", req.Header.Get("X-Trace"))
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var events []string
+	service := newTestBaseService(t, server.URL)
+	for _, name := range []string{"first", "second"} {
+		name := name
+		AddInterceptor(service, InterceptorFuncs{
+			Request: func(req *http.Request) error {
+				events = append(events, "request "+name)
+				req.Header.Add("X-Trace", name)
+				req.Header.Set("X-Trace", strings.Join(req.Header["X-Trace"], ","))
+				return nil
+			},
+			Response: func(req *http.Request, resp *http.Response, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				events = append(events, "response "+name)
+			},
+		})
+	}
+	assert.Len(t, GetInterceptors(service), 2)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := service.Request(req, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"request first", "request second", "response second", "response first"}, events)
+	assert.Empty(t, req.Header.Get("X-Trace"))
+}
+
+func TestInterceptorCanAbortRequest(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	var responseErr error
+	service := newTestBaseService(t, server.URL)
+	AddInterceptor(service, InterceptorFuncs{
+		Response: func(req *http.Request, resp *http.Response, err error) {
+			responseErr = err
+		},
+	})
+	AddInterceptor(service, InterceptorFuncs{
+		Request: func(req *http.Request) error {
+			return fmt.Errorf("blocked")
+		},
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := service.Request(req, nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "blocked")
+	assert.Contains(t, responseErr.Error(), "blocked")
+	assert.Equal(t, 0, calls)
+}
+
+func TestInterceptorsAndRetriesShareTheChain(t *testing.T) {
+	service := newTestBaseService(t, "https://example.com")
+	AddInterceptor(service, InterceptorFuncs{})
+	EnableRetries(service, NewRetryPolicy(2))
+	EnableRetries(service, NewRetryPolicy(4))
+	AddInterceptor(service, InterceptorFuncs{})
+	DisableSSLVerification(service)
+
+	retryTransport, ok := service.Client.Transport.(*RetryTransport)
+	assert.True(t, ok)
+	assert.Equal(t, 4, retryTransport.Policy.MaxAttempts)
+	interceptorTransport, ok := retryTransport.Base.(*InterceptorTransport)
+	assert.True(t, ok)
+	assert.Len(t, interceptorTransport.Interceptors, 2)
+	httpTransport, ok := interceptorTransport.Base.(*http.Transport)
+	assert.True(t, ok)
+	assert.True(t, httpTransport.TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestDialWebsocketUsesInterceptors(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "intercepted", req.Header.Get("X-Custom"))
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		conn, err := upgrader.Upgrade(res, req, nil)
+		assert.Nil(t, err)
+		conn.Close()
+	}))
+	defer server.Close()
+
+	var status int
+	service := newTestBaseService(t, server.URL)
+	AddInterceptor(service, InterceptorFuncs{
+		Request: func(req *http.Request) error {
+			req.Header.Set("X-Custom", "intercepted")
+			return nil
+		},
+		Response: func(req *http.Request, resp *http.Response, err error) {
+			assert.Nil(t, err)
+			status = resp.StatusCode
+		},
+	})
+
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer token")
+	wsURL := strings.Replace(server.URL, "http", "ws", 1)
+	conn, _, err := DialWebsocket(context.Background(), service, wsURL, headers)
+	assert.Nil(t, err)
+	assert.NotNil(t, conn)
+	conn.Close()
+	assert.Equal(t, http.StatusSwitchingProtocols, status)
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MockCall : A call recorded by one of the mock services
+type MockCall struct {
+	// The name of the method, without the WithContext suffix.
+	Method string
+
+	// The context the method was called with, or context.Background() for the variants without a context.
+	Context context.Context
+
+	// The arguments of the call, other than the context.
+	Args []interface{}
+}
+
+// MockRecorder : Records the calls received by a mock service. It is safe for concurrent use.
+type MockRecorder struct {
+	lock  sync.Mutex
+	calls []MockCall
+}
+
+// Record adds a call to the recorder
+func (recorder *MockRecorder) Record(ctx context.Context, method string, args ...interface{}) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.calls = append(recorder.calls, MockCall{Method: method, Context: ctx, Args: args})
+}
+
+// Calls returns the calls recorded so far, in order
+func (recorder *MockRecorder) Calls() []MockCall {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return append([]MockCall(nil), recorder.calls...)
+}
+
+// CallsTo returns the calls recorded so far for the given method, in order
+func (recorder *MockRecorder) CallsTo(method string) []MockCall {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	var calls []MockCall
+	for _, call := range recorder.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls
+func (recorder *MockRecorder) Reset() {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.calls = nil
+}
+
+// ErrMockNotImplemented : The error returned by a mock service method whose behavior was not programmed
+func ErrMockNotImplemented(mock string, method string) error {
+	return fmt.Errorf("%s: no behavior was programmed for %s", mock, method)
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockRecorder(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	recorder := new(MockRecorder)
+	recorder.Record(ctx, "GetModel", "model")
+	recorder.Record(context.Background(), "ListModels")
+	recorder.Record(context.Background(), "GetModel", "other")
+
+	assert.Len(t, recorder.Calls(), 3)
+	calls := recorder.CallsTo("GetModel")
+	assert.Len(t, calls, 2)
+	assert.Equal(t, "value", calls[0].Context.Value(key{}))
+	assert.Equal(t, []interface{}{"other"}, calls[1].Args)
+
+	recorder.Reset()
+	assert.Empty(t, recorder.Calls())
+	assert.EqualError(t, ErrMockNotImplemented("MockSpeechToTextV1", "GetModel"), "MockSpeechToTextV1: no behavior was programmed for GetModel")
+}
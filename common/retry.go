@@ -0,0 +1,278 @@
+package common
+
+import (
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+const (
+	HEADER_RETRY_AFTER = "Retry-After"
+
+	DEFAULT_MAX_ATTEMPTS    = 3
+	DEFAULT_INITIAL_BACKOFF = 500 * time.Millisecond
+	DEFAULT_MAX_BACKOFF     = 30 * time.Second
+)
+
+// RetryPolicy : Configures the automatic retry of failed requests.
+//
+// A request is retried when the service answers with one of the RetryStatusCodes or when the request could not be
+// sent at all. Requests rejected with 429 (Too Many Requests) were never processed by the service and are always
+// eligible for a retry. Any other failure is only retried for idempotent HTTP methods (GET, HEAD, OPTIONS, PUT,
+// DELETE), unless RetryNonIdempotent is set. Requests whose body cannot be replayed are never retried.
+type RetryPolicy struct {
+	// The maximum number of attempts, including the first one. A value of 0 selects DEFAULT_MAX_ATTEMPTS, a value of 1
+	// disables retries.
+	MaxAttempts int
+
+	// The delay before the first retry. Each following retry doubles the delay, up to MaxBackoff. A value of 0 selects
+	// DEFAULT_INITIAL_BACKOFF.
+	InitialBackoff time.Duration
+
+	// The upper bound for the delay between two attempts. A value of 0 selects DEFAULT_MAX_BACKOFF. A delay requested
+	// by the service through the Retry-After header is honored even when it is larger.
+	MaxBackoff time.Duration
+
+	// The HTTP status codes that trigger a retry. When empty, 429, 502, 503 and 504 are retried.
+	RetryStatusCodes []int
+
+	// If true, requests with non-idempotent methods such as POST and PATCH are retried on every retryable failure, not
+	// only on 429 responses.
+	RetryNonIdempotent bool
+}
+
+// NewRetryPolicy : Instantiate a RetryPolicy with the default settings and the given maximum number of attempts
+func NewRetryPolicy(maxAttempts int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: maxAttempts,
+	}
+}
+
+// SetInitialBackoff : Allow user to set InitialBackoff
+func (policy *RetryPolicy) SetInitialBackoff(initialBackoff time.Duration) *RetryPolicy {
+	policy.InitialBackoff = initialBackoff
+	return policy
+}
+
+// SetMaxBackoff : Allow user to set MaxBackoff
+func (policy *RetryPolicy) SetMaxBackoff(maxBackoff time.Duration) *RetryPolicy {
+	policy.MaxBackoff = maxBackoff
+	return policy
+}
+
+// SetRetryStatusCodes : Allow user to set RetryStatusCodes
+func (policy *RetryPolicy) SetRetryStatusCodes(retryStatusCodes []int) *RetryPolicy {
+	policy.RetryStatusCodes = retryStatusCodes
+	return policy
+}
+
+// SetRetryNonIdempotent : Allow user to set RetryNonIdempotent
+func (policy *RetryPolicy) SetRetryNonIdempotent(retryNonIdempotent bool) *RetryPolicy {
+	policy.RetryNonIdempotent = retryNonIdempotent
+	return policy
+}
+
+func (policy *RetryPolicy) maxAttempts() int {
+	if policy.MaxAttempts <= 0 {
+		return DEFAULT_MAX_ATTEMPTS
+	}
+	return policy.MaxAttempts
+}
+
+func (policy *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	if len(policy.RetryStatusCodes) == 0 {
+		switch statusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	for _, code := range policy.RetryStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (policy *RetryPolicy) allowsMethod(method string) bool {
+	if policy.RetryNonIdempotent {
+		return true
+	}
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (1 for the first retry), with up to half of it randomized
+func (policy *RetryPolicy) backoff(retry int) time.Duration {
+	initial := policy.InitialBackoff
+	if initial <= 0 {
+		initial = DEFAULT_INITIAL_BACKOFF
+	}
+	max := policy.MaxBackoff
+	if max <= 0 {
+		max = DEFAULT_MAX_BACKOFF
+	}
+
+	delay := float64(initial) * math.Pow(2, float64(retry-1))
+	if delay > float64(max) {
+		delay = float64(max)
+	}
+	half := delay / 2
+	return time.Duration(half + jitter.Float64()*half)
+}
+
+// lockedSource makes the jitter generator safe for concurrent use
+type lockedSource struct {
+	lock   sync.Mutex
+	source rand.Source
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.source.Int63()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.source.Seed(seed)
+}
+
+var jitter = rand.New(&lockedSource{source: rand.NewSource(time.Now().UnixNano())})
+
+// parseRetryAfter reads the Retry-After header, given either in seconds or as an HTTP date
+func parseRetryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get(HEADER_RETRY_AFTER)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// RetryTransport : An http.RoundTripper that retries failed requests according to a RetryPolicy
+type RetryTransport struct {
+	// The transport used to send each attempt. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	Policy *RetryPolicy
+}
+
+// NewRetryTransport : Instantiate a RetryTransport sending its requests through base
+func NewRetryTransport(base http.RoundTripper, policy *RetryPolicy) *RetryTransport {
+	return &RetryTransport{
+		Base:   base,
+		Policy: policy,
+	}
+}
+
+// Unwrap returns the transport wrapped by the RetryTransport
+func (transport *RetryTransport) Unwrap() http.RoundTripper {
+	return transport.base()
+}
+
+func (transport *RetryTransport) base() http.RoundTripper {
+	if transport.Base == nil {
+		return http.DefaultTransport
+	}
+	return transport.Base
+}
+
+// RoundTrip sends the request, retrying it as long as the policy allows
+func (transport *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	policy := transport.Policy
+	if policy == nil {
+		policy = &RetryPolicy{}
+	}
+	maxAttempts := policy.maxAttempts()
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := transport.base().RoundTrip(attemptReq)
+		if attempt >= maxAttempts || !replayable || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		var delay time.Duration
+		if err != nil {
+			if !policy.allowsMethod(req.Method) {
+				return resp, err
+			}
+			delay = policy.backoff(attempt)
+		} else {
+			if !policy.isRetryableStatus(resp.StatusCode) {
+				return resp, err
+			}
+			if resp.StatusCode != http.StatusTooManyRequests && !policy.allowsMethod(req.Method) {
+				return resp, err
+			}
+			retryAfter, ok := parseRetryAfter(resp.Header)
+			if ok {
+				delay = retryAfter
+			} else {
+				delay = policy.backoff(attempt)
+			}
+
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (transport *RetryTransport) setBase(base http.RoundTripper) {
+	transport.Base = base
+}
+
+// EnableRetries : Wraps the HTTP client of the service so that every request is retried according to policy
+func EnableRetries(service *core.BaseService, policy *RetryPolicy) {
+	if retryTransport, ok := findTransport(service, isRetryTransport).(*RetryTransport); ok {
+		retryTransport.Policy = policy
+		return
+	}
+	service.Client.Transport = NewRetryTransport(service.Client.Transport, policy)
+}
+
+func isRetryTransport(transport http.RoundTripper) bool {
+	_, ok := transport.(*RetryTransport)
+	return ok
+}
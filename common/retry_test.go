@@ -0,0 +1,151 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFlakyServer(failures int32, status int, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(calls, 1) <= failures {
+			res.Header().Set(HEADER_RETRY_AFTER, "0")
+			res.WriteHeader(status)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+}
+
+func newRetryClient(policy *RetryPolicy) *http.Client {
+	return &http.Client{Transport: NewRetryTransport(nil, policy)}
+}
+
+func TestRetryTransportRetriesIdempotentRequests(t *testing.T) {
+	var calls int32
+	server := newFlakyServer(2, http.StatusServiceUnavailable, &calls)
+	defer server.Close()
+
+	resp, err := newRetryClient(NewRetryPolicy(3)).Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestRetryTransportStopsAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	server := newFlakyServer(5, http.StatusBadGateway, &calls)
+	defer server.Close()
+
+	resp, err := newRetryClient(NewRetryPolicy(2)).Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestRetryTransportSkipsNonIdempotentRequests(t *testing.T) {
+	var calls int32
+	server := newFlakyServer(1, http.StatusServiceUnavailable, &calls)
+	defer server.Close()
+
+	resp, err := newRetryClient(NewRetryPolicy(3)).Post(server.URL, "application/json", bytes.NewBufferString(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), calls)
+
+	atomic.StoreInt32(&calls, 0)
+	policy := NewRetryPolicy(3).SetRetryNonIdempotent(true)
+	resp, err = newRetryClient(policy).Post(server.URL, "application/json", bytes.NewBufferString(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestRetryTransportRetriesRateLimitedPost(t *testing.T) {
+	var calls int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		buf := new(bytes.Buffer)
+		_, _ = buf.ReadFrom(req.Body)
+		bodies = append(bodies, buf.String())
+		if atomic.AddInt32(&calls, 1) == 1 {
+			res.Header().Set(HEADER_RETRY_AFTER, "0")
+			res.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	resp, err := newRetryClient(NewRetryPolicy(3)).Post(server.URL, "application/json", bytes.NewBufferString(`{"text":"hello"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{`{"text":"hello"}`, `{"text":"hello"}`}, bodies)
+}
+
+func TestRetryTransportHonorsContext(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		res.Header().Set(HEADER_RETRY_AFTER, "60")
+		res.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := newRetryClient(NewRetryPolicy(3)).Do(req.WithContext(ctx))
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := NewRetryPolicy(5).SetInitialBackoff(100 * time.Millisecond).SetMaxBackoff(300 * time.Millisecond)
+	for i := 0; i < 20; i++ {
+		first := policy.backoff(1)
+		assert.True(t, first >= 50*time.Millisecond && first <= 100*time.Millisecond)
+		capped := policy.backoff(4)
+		assert.True(t, capped >= 150*time.Millisecond && capped <= 300*time.Millisecond)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	header := http.Header{}
+	_, ok := parseRetryAfter(header)
+	assert.False(t, ok)
+
+	header.Set(HEADER_RETRY_AFTER, "2")
+	delay, ok := parseRetryAfter(header)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, delay)
+
+	header.Set(HEADER_RETRY_AFTER, time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	delay, ok = parseRetryAfter(header)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+}
+
+func TestDisableSSLVerificationKeepsRetries(t *testing.T) {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           "https://example.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	EnableRetries(service, NewRetryPolicy(3))
+	DisableSSLVerification(service)
+
+	retryTransport, ok := service.Client.Transport.(*RetryTransport)
+	assert.True(t, ok)
+	httpTransport, ok := retryTransport.Base.(*http.Transport)
+	assert.True(t, ok)
+	assert.True(t, httpTransport.TLSClientConfig.InsecureSkipVerify)
+}
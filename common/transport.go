@@ -0,0 +1,235 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// wrappingTransport is implemented by the transports, such as RetryTransport, that the SDK installs around the
+// transport of a service's HTTP client
+type wrappingTransport interface {
+	http.RoundTripper
+	Unwrap() http.RoundTripper
+	setBase(base http.RoundTripper)
+}
+
+// findTransport walks the chain of transports of the service and returns the first one matching
+func findTransport(service *core.BaseService, match func(http.RoundTripper) bool) http.RoundTripper {
+	current := service.Client.Transport
+	for current != nil {
+		if match(current) {
+			return current
+		}
+		wrapper, ok := current.(wrappingTransport)
+		if !ok {
+			return nil
+		}
+		current = wrapper.Unwrap()
+	}
+	return nil
+}
+
+// innermostTransport returns the transport that actually sends the requests of the service, along with the last
+// wrapper installed around it, if any
+func innermostTransport(service *core.BaseService) (http.RoundTripper, wrappingTransport) {
+	var parent wrappingTransport
+	current := service.Client.Transport
+	for {
+		wrapper, ok := current.(wrappingTransport)
+		if !ok {
+			return current, parent
+		}
+		parent = wrapper
+		current = wrapper.Unwrap()
+	}
+}
+
+// DisableSSLVerification : Skips SSL verification for the service without discarding the wrappers, such as a
+// RetryTransport, that were installed around the HTTP client's transport. The transport is cloned rather than
+// changed, since it may be shared with other clients.
+func DisableSSLVerification(service *core.BaseService) {
+	current, _ := innermostTransport(service)
+
+	httpTransport, ok := current.(*http.Transport)
+	if !ok {
+		httpTransport = http.DefaultTransport.(*http.Transport)
+	}
+	tr := httpTransport.Clone()
+	if tr.TLSClientConfig == nil {
+		tr.TLSClientConfig = &tls.Config{}
+	}
+	tr.TLSClientConfig.InsecureSkipVerify = true
+	setInnermostTransport(service, tr)
+}
+
+// TransportConfig : Settings of the HTTP transport used by a service, and by its websocket connections
+type TransportConfig struct {
+	// The proxy to use for the requests. If nil, the proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// The certificate authorities used to verify the server certificates. If nil, the system pool is used.
+	RootCAs *x509.CertPool
+
+	// The client certificates presented to the server.
+	Certificates []tls.Certificate
+
+	// If true, the server certificates are not verified.
+	DisableSSLVerification bool
+
+	// Connection pool settings, see http.Transport. A value of 0 keeps the default.
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+
+	// The time limit for the TLS handshake. A value of 0 keeps the default.
+	TLSHandshakeTimeout time.Duration
+
+	// The time limit for a whole request, including reading the response body. A value of 0 keeps the timeout of the
+	// service's HTTP client.
+	Timeout time.Duration
+}
+
+// NewTransportConfig : Instantiate TransportConfig
+func NewTransportConfig() *TransportConfig {
+	return &TransportConfig{}
+}
+
+// SetProxyURL : Allow user to set the URL of the proxy used for every request
+func (config *TransportConfig) SetProxyURL(proxyURL string) (*TransportConfig, error) {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		return config, err
+	}
+	config.Proxy = http.ProxyURL(parsed)
+	return config, nil
+}
+
+// SetRootCAs : Allow user to set RootCAs
+func (config *TransportConfig) SetRootCAs(rootCAs *x509.CertPool) *TransportConfig {
+	config.RootCAs = rootCAs
+	return config
+}
+
+// AddRootCAsFromPEMFile : Allow user to add the certificate authorities of a PEM bundle to RootCAs
+func (config *TransportConfig) AddRootCAsFromPEMFile(path string) (*TransportConfig, error) {
+	pemCerts, err := ioutil.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	if config.RootCAs == nil {
+		config.RootCAs, err = x509.SystemCertPool()
+		if err != nil || config.RootCAs == nil {
+			config.RootCAs = x509.NewCertPool()
+		}
+	}
+	if !config.RootCAs.AppendCertsFromPEM(pemCerts) {
+		return config, fmt.Errorf("No certificate could be read from '%s'", path)
+	}
+	return config, nil
+}
+
+// SetClientCertificateFiles : Allow user to set the client certificate from a PEM encoded certificate and key pair
+func (config *TransportConfig) SetClientCertificateFiles(certFile string, keyFile string) (*TransportConfig, error) {
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return config, err
+	}
+	config.Certificates = []tls.Certificate{certificate}
+	return config, nil
+}
+
+// SetDisableSSLVerification : Allow user to set DisableSSLVerification
+func (config *TransportConfig) SetDisableSSLVerification(disableSSLVerification bool) *TransportConfig {
+	config.DisableSSLVerification = disableSSLVerification
+	return config
+}
+
+// SetTimeout : Allow user to set Timeout
+func (config *TransportConfig) SetTimeout(timeout time.Duration) *TransportConfig {
+	config.Timeout = timeout
+	return config
+}
+
+// NewTransport : Builds an http.Transport from the configuration, starting from the settings of
+// http.DefaultTransport
+func (config *TransportConfig) NewTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.Proxy != nil {
+		transport.Proxy = config.Proxy
+	}
+	if config.RootCAs != nil || len(config.Certificates) > 0 || config.DisableSSLVerification {
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:            config.RootCAs,
+			Certificates:       config.Certificates,
+			InsecureSkipVerify: config.DisableSSLVerification,
+		}
+	}
+	if config.MaxIdleConns > 0 {
+		transport.MaxIdleConns = config.MaxIdleConns
+	}
+	if config.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+	if config.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = config.MaxConnsPerHost
+	}
+	if config.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = config.IdleConnTimeout
+	}
+	if config.TLSHandshakeTimeout > 0 {
+		transport.TLSHandshakeTimeout = config.TLSHandshakeTimeout
+	}
+	return transport
+}
+
+// setInnermostTransport replaces the transport that sends the requests of the service, keeping its wrappers
+func setInnermostTransport(service *core.BaseService, transport http.RoundTripper) {
+	_, parent := innermostTransport(service)
+	if parent == nil {
+		service.Client.Transport = transport
+	} else {
+		parent.setBase(transport)
+	}
+}
+
+// ConfigureTransport : Replaces the transport of the service's HTTP client by one built from config. The wrappers
+// installed by the SDK, such as retries and interceptors, are kept, and so is a disabled SSL verification.
+func ConfigureTransport(service *core.BaseService, config *TransportConfig) {
+	transport := config.NewTransport()
+
+	current, _ := innermostTransport(service)
+	if httpTransport, ok := current.(*http.Transport); ok && httpTransport.TLSClientConfig != nil && httpTransport.TLSClientConfig.InsecureSkipVerify {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	setInnermostTransport(service, transport)
+	if config.Timeout > 0 {
+		service.Client.Timeout = config.Timeout
+	}
+}
+
+// SetHTTPClient : Sends the requests of the service through client. The wrappers installed by the SDK, such as
+// retries and interceptors, are kept around the transport of the client; the client itself is not modified.
+func SetHTTPClient(service *core.BaseService, client *http.Client) {
+	clientCopy := *client
+
+	_, parent := innermostTransport(service)
+	if parent != nil {
+		parent.setBase(client.Transport)
+		clientCopy.Transport = service.Client.Transport
+	}
+	service.SetHTTPClient(&clientCopy)
+}
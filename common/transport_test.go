@@ -0,0 +1,129 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeServerCertificate(t *testing.T, server *httptest.Server) string {
+	file, err := ioutil.TempFile("", "ca-*.pem")
+	assert.Nil(t, err)
+	defer file.Close()
+	err = pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.Nil(t, err)
+	return file.Name()
+}
+
+func TestConfigureTransportWithCustomCA(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if websocket.IsWebSocketUpgrade(req) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			assert.Nil(t, err)
+			conn.Close()
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caFile := writeServerCertificate(t, server)
+	defer os.Remove(caFile)
+
+	// Without the certificate authority, the server certificate is rejected.
+	service := newTestBaseService(t, server.URL)
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := service.Request(req, nil)
+	assert.NotNil(t, err)
+
+	config, err := NewTransportConfig().AddRootCAsFromPEMFile(caFile)
+	assert.Nil(t, err)
+	EnableRetries(service, NewRetryPolicy(2))
+	ConfigureTransport(service, config.SetTimeout(5*time.Second))
+
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err = service.Request(req, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 5*time.Second, service.Client.Timeout)
+	_, ok := service.Client.Transport.(*RetryTransport)
+	assert.True(t, ok)
+
+	wsURL := strings.Replace(server.URL, "https", "wss", 1)
+	conn, _, err := DialWebsocket(context.Background(), service, wsURL, nil)
+	assert.Nil(t, err)
+	conn.Close()
+}
+
+func TestConfigureTransportKeepsDisabledSSLVerification(t *testing.T) {
+	service := newTestBaseService(t, "https://example.com")
+	DisableSSLVerification(service)
+	ConfigureTransport(service, NewTransportConfig())
+
+	httpTransport, ok := service.Client.Transport.(*http.Transport)
+	assert.True(t, ok)
+	assert.True(t, httpTransport.TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestDisableSSLVerificationClonesTheTransport(t *testing.T) {
+	service := newTestBaseService(t, "https://example.com")
+	AddInterceptor(service, InterceptorFuncs{})
+	shared := &http.Transport{TLSClientConfig: &tls.Config{ServerName: "example.com"}}
+	SetHTTPClient(service, &http.Client{Transport: shared})
+
+	DisableSSLVerification(service)
+
+	assert.False(t, shared.TLSClientConfig.InsecureSkipVerify)
+	interceptorTransport, ok := service.Client.Transport.(*InterceptorTransport)
+	assert.True(t, ok)
+	httpTransport, ok := interceptorTransport.Base.(*http.Transport)
+	assert.True(t, ok)
+	assert.True(t, httpTransport != shared)
+	assert.True(t, httpTransport.TLSClientConfig.InsecureSkipVerify)
+	assert.Equal(t, "example.com", httpTransport.TLSClientConfig.ServerName)
+}
+
+func TestSetHTTPClientKeepsWrappers(t *testing.T) {
+	service := newTestBaseService(t, "https://example.com")
+	AddInterceptor(service, InterceptorFuncs{})
+
+	transport := &http.Transport{}
+	client := &http.Client{Transport: transport, Timeout: time.Minute}
+	SetHTTPClient(service, client)
+
+	assert.Equal(t, http.RoundTripper(transport), client.Transport)
+	assert.Equal(t, time.Minute, service.Client.Timeout)
+	interceptorTransport, ok := service.Client.Transport.(*InterceptorTransport)
+	assert.True(t, ok)
+	assert.Equal(t, http.RoundTripper(transport), interceptorTransport.Base)
+}
+
+func TestWebsocketDialerUsesProxy(t *testing.T) {
+	service := newTestBaseService(t, "https://example.com")
+	config, err := NewTransportConfig().SetProxyURL("http://proxy.example.com:8080")
+	assert.Nil(t, err)
+	ConfigureTransport(service, config)
+
+	dialer := websocketDialer(service)
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	proxyURL, err := dialer.Proxy(req)
+	assert.Nil(t, err)
+	assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:8080"}, proxyURL)
+}
+
+func TestWebsocketURL(t *testing.T) {
+	assert.Equal(t, "wss://example.com/api", WebsocketURL("https://example.com/api"))
+	assert.Equal(t, "ws://127.0.0.1:8080", WebsocketURL("http://127.0.0.1:8080"))
+	assert.Equal(t, "wss://example.com", WebsocketURL("wss://example.com"))
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/gorilla/websocket"
+)
+
+// websocketDialer builds a dialer sharing the proxy, TLS and timeout settings of the service's HTTP transport
+func websocketDialer(service *core.BaseService) *websocket.Dialer {
+	dialer := *websocket.DefaultDialer
+
+	transport, _ := innermostTransport(service)
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if httpTransport, ok := transport.(*http.Transport); ok {
+		dialer.Proxy = httpTransport.Proxy
+		dialer.NetDialContext = httpTransport.DialContext
+		if httpTransport.TLSClientConfig != nil {
+			dialer.TLSClientConfig = httpTransport.TLSClientConfig.Clone()
+		}
+		if httpTransport.TLSHandshakeTimeout > 0 {
+			dialer.HandshakeTimeout = httpTransport.TLSHandshakeTimeout
+		}
+	}
+	if service.Client.Timeout > 0 && service.Client.Timeout < dialer.HandshakeTimeout {
+		dialer.HandshakeTimeout = service.Client.Timeout
+	}
+	return &dialer
+}
+
+// WebsocketURL : Converts the URL of a service into the URL of its websocket endpoints, using wss for https and ws
+// for http
+func WebsocketURL(serviceURL string) string {
+	if strings.HasPrefix(serviceURL, "https") {
+		return "wss" + strings.TrimPrefix(serviceURL, "https")
+	}
+	if strings.HasPrefix(serviceURL, "http") {
+		return "ws" + strings.TrimPrefix(serviceURL, "http")
+	}
+	return serviceURL
+}
+
+// handshakeError converts the failure of a websocket handshake answered by the service into a *ServiceError
+func handshakeError(resp *http.Response, err error) error {
+	if resp == nil {
+		return err
+	}
+
+	response := &core.DetailedResponse{StatusCode: resp.StatusCode, Headers: resp.Header}
+	if resp.Body != nil {
+		body, _ := ioutil.ReadAll(resp.Body)
+		var responseMap map[string]interface{}
+		if json.Unmarshal(body, &responseMap) == nil {
+			response.Result = responseMap
+			if message, ok := responseMap["error"].(string); ok && message != "" {
+				err = errors.New(message)
+			}
+		} else if len(body) > 0 {
+			response.RawResult = body
+		}
+	}
+	return NewServiceError(response, err)
+}
+
+// DialWebsocket : Opens a websocket connection for the service. The handshake request goes through the interceptors
+// registered on the service, and honors the proxy and TLS settings of its HTTP client. When the service rejects the
+// handshake, the returned error is a *ServiceError.
+func DialWebsocket(ctx context.Context, service *core.BaseService, url string, headers http.Header) (*websocket.Conn, *http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.WithContext(ctx)
+	for name, values := range headers {
+		req.Header[name] = append([]string(nil), values...)
+	}
+
+	interceptors := GetInterceptors(service)
+	invoked, err := interceptRequest(interceptors, req)
+	if err != nil {
+		interceptResponse(interceptors[:invoked], req, nil, err)
+		return nil, nil, err
+	}
+
+	conn, resp, err := websocketDialer(service).DialContext(ctx, req.URL.String(), req.Header)
+	interceptResponse(interceptors, req, resp, err)
+	if err != nil {
+		return nil, resp, handshakeError(resp, err)
+	}
+	return conn, resp, nil
+}
@@ -18,11 +18,13 @@
 package comparecomplyv1
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/watson-developer-cloud/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // CompareComplyV1 : IBM Watson&trade; Compare and Comply analyzes governing documents to provide details about critical
@@ -43,10 +45,13 @@ const DefaultServiceName = "compare-comply"
 
 // CompareComplyV1Options : Service options
 type CompareComplyV1Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewCompareComplyV1 : constructs an instance of CompareComplyV1 with passed in options.
@@ -84,6 +89,16 @@ func NewCompareComplyV1(options *CompareComplyV1Options) (service *CompareComply
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &CompareComplyV1{
 		Service: baseService,
 		Version: options.Version,
@@ -99,12 +114,37 @@ func (compareComply *CompareComplyV1) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (compareComply *CompareComplyV1) DisableSSLVerification() {
-	compareComply.Service.DisableSSLVerification()
+	common.DisableSSLVerification(compareComply.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (compareComply *CompareComplyV1) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(compareComply.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (compareComply *CompareComplyV1) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(compareComply.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (compareComply *CompareComplyV1) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(compareComply.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (compareComply *CompareComplyV1) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(compareComply.Service, interceptor)
 }
 
 // ConvertToHTML : Convert document to HTML
 // Converts a document to HTML.
 func (compareComply *CompareComplyV1) ConvertToHTML(convertToHTMLOptions *ConvertToHTMLOptions) (result *HTMLReturn, response *core.DetailedResponse, err error) {
+	return compareComply.ConvertToHTMLWithContext(context.Background(), convertToHTMLOptions)
+}
+
+// ConvertToHTMLWithContext is an alternate form of the ConvertToHTML method which supports a Context parameter
+func (compareComply *CompareComplyV1) ConvertToHTMLWithContext(ctx context.Context, convertToHTMLOptions *ConvertToHTMLOptions) (result *HTMLReturn, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(convertToHTMLOptions, "convertToHTMLOptions cannot be nil")
 	if err != nil {
 		return
@@ -147,7 +187,10 @@ func (compareComply *CompareComplyV1) ConvertToHTML(convertToHTMLOptions *Conver
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(HTMLReturn))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*HTMLReturn)
@@ -162,6 +205,11 @@ func (compareComply *CompareComplyV1) ConvertToHTML(convertToHTMLOptions *Conver
 // ClassifyElements : Classify the elements of a document
 // Analyzes the structural and semantic elements of a document.
 func (compareComply *CompareComplyV1) ClassifyElements(classifyElementsOptions *ClassifyElementsOptions) (result *ClassifyReturn, response *core.DetailedResponse, err error) {
+	return compareComply.ClassifyElementsWithContext(context.Background(), classifyElementsOptions)
+}
+
+// ClassifyElementsWithContext is an alternate form of the ClassifyElements method which supports a Context parameter
+func (compareComply *CompareComplyV1) ClassifyElementsWithContext(ctx context.Context, classifyElementsOptions *ClassifyElementsOptions) (result *ClassifyReturn, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(classifyElementsOptions, "classifyElementsOptions cannot be nil")
 	if err != nil {
 		return
@@ -204,7 +252,10 @@ func (compareComply *CompareComplyV1) ClassifyElements(classifyElementsOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(ClassifyReturn))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassifyReturn)
@@ -219,6 +270,11 @@ func (compareComply *CompareComplyV1) ClassifyElements(classifyElementsOptions *
 // ExtractTables : Extract a document's tables
 // Analyzes the tables in a document.
 func (compareComply *CompareComplyV1) ExtractTables(extractTablesOptions *ExtractTablesOptions) (result *TableReturn, response *core.DetailedResponse, err error) {
+	return compareComply.ExtractTablesWithContext(context.Background(), extractTablesOptions)
+}
+
+// ExtractTablesWithContext is an alternate form of the ExtractTables method which supports a Context parameter
+func (compareComply *CompareComplyV1) ExtractTablesWithContext(ctx context.Context, extractTablesOptions *ExtractTablesOptions) (result *TableReturn, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(extractTablesOptions, "extractTablesOptions cannot be nil")
 	if err != nil {
 		return
@@ -261,7 +317,10 @@ func (compareComply *CompareComplyV1) ExtractTables(extractTablesOptions *Extrac
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(TableReturn))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TableReturn)
@@ -276,6 +335,11 @@ func (compareComply *CompareComplyV1) ExtractTables(extractTablesOptions *Extrac
 // CompareDocuments : Compare two documents
 // Compares two input documents. Documents must be in the same format.
 func (compareComply *CompareComplyV1) CompareDocuments(compareDocumentsOptions *CompareDocumentsOptions) (result *CompareReturn, response *core.DetailedResponse, err error) {
+	return compareComply.CompareDocumentsWithContext(context.Background(), compareDocumentsOptions)
+}
+
+// CompareDocumentsWithContext is an alternate form of the CompareDocuments method which supports a Context parameter
+func (compareComply *CompareComplyV1) CompareDocumentsWithContext(ctx context.Context, compareDocumentsOptions *CompareDocumentsOptions) (result *CompareReturn, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(compareDocumentsOptions, "compareDocumentsOptions cannot be nil")
 	if err != nil {
 		return
@@ -326,7 +390,10 @@ func (compareComply *CompareComplyV1) CompareDocuments(compareDocumentsOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(CompareReturn))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CompareReturn)
@@ -343,6 +410,11 @@ func (compareComply *CompareComplyV1) CompareDocuments(compareDocumentsOptions *
 // **Important:** Feedback is not immediately incorporated into the training model, nor is it guaranteed to be
 // incorporated at a later date. Instead, submitted feedback is used to suggest future updates to the training model.
 func (compareComply *CompareComplyV1) AddFeedback(addFeedbackOptions *AddFeedbackOptions) (result *FeedbackReturn, response *core.DetailedResponse, err error) {
+	return compareComply.AddFeedbackWithContext(context.Background(), addFeedbackOptions)
+}
+
+// AddFeedbackWithContext is an alternate form of the AddFeedback method which supports a Context parameter
+func (compareComply *CompareComplyV1) AddFeedbackWithContext(ctx context.Context, addFeedbackOptions *AddFeedbackOptions) (result *FeedbackReturn, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addFeedbackOptions, "addFeedbackOptions cannot be nil")
 	if err != nil {
 		return
@@ -394,7 +466,10 @@ func (compareComply *CompareComplyV1) AddFeedback(addFeedbackOptions *AddFeedbac
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(FeedbackReturn))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*FeedbackReturn)
@@ -409,6 +484,11 @@ func (compareComply *CompareComplyV1) AddFeedback(addFeedbackOptions *AddFeedbac
 // ListFeedback : List the feedback in a document
 // Lists the feedback in a document.
 func (compareComply *CompareComplyV1) ListFeedback(listFeedbackOptions *ListFeedbackOptions) (result *FeedbackList, response *core.DetailedResponse, err error) {
+	return compareComply.ListFeedbackWithContext(context.Background(), listFeedbackOptions)
+}
+
+// ListFeedbackWithContext is an alternate form of the ListFeedback method which supports a Context parameter
+func (compareComply *CompareComplyV1) ListFeedbackWithContext(ctx context.Context, listFeedbackOptions *ListFeedbackOptions) (result *FeedbackList, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listFeedbackOptions, "listFeedbackOptions")
 	if err != nil {
 		return
@@ -489,7 +569,10 @@ func (compareComply *CompareComplyV1) ListFeedback(listFeedbackOptions *ListFeed
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(FeedbackList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*FeedbackList)
@@ -504,6 +587,11 @@ func (compareComply *CompareComplyV1) ListFeedback(listFeedbackOptions *ListFeed
 // GetFeedback : Get a specified feedback entry
 // Gets a feedback entry with a specified `feedback_id`.
 func (compareComply *CompareComplyV1) GetFeedback(getFeedbackOptions *GetFeedbackOptions) (result *GetFeedback, response *core.DetailedResponse, err error) {
+	return compareComply.GetFeedbackWithContext(context.Background(), getFeedbackOptions)
+}
+
+// GetFeedbackWithContext is an alternate form of the GetFeedback method which supports a Context parameter
+func (compareComply *CompareComplyV1) GetFeedbackWithContext(ctx context.Context, getFeedbackOptions *GetFeedbackOptions) (result *GetFeedback, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getFeedbackOptions, "getFeedbackOptions cannot be nil")
 	if err != nil {
 		return
@@ -543,7 +631,10 @@ func (compareComply *CompareComplyV1) GetFeedback(getFeedbackOptions *GetFeedbac
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(GetFeedback))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*GetFeedback)
@@ -558,6 +649,11 @@ func (compareComply *CompareComplyV1) GetFeedback(getFeedbackOptions *GetFeedbac
 // DeleteFeedback : Delete a specified feedback entry
 // Deletes a feedback entry with a specified `feedback_id`.
 func (compareComply *CompareComplyV1) DeleteFeedback(deleteFeedbackOptions *DeleteFeedbackOptions) (result *FeedbackDeleted, response *core.DetailedResponse, err error) {
+	return compareComply.DeleteFeedbackWithContext(context.Background(), deleteFeedbackOptions)
+}
+
+// DeleteFeedbackWithContext is an alternate form of the DeleteFeedback method which supports a Context parameter
+func (compareComply *CompareComplyV1) DeleteFeedbackWithContext(ctx context.Context, deleteFeedbackOptions *DeleteFeedbackOptions) (result *FeedbackDeleted, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteFeedbackOptions, "deleteFeedbackOptions cannot be nil")
 	if err != nil {
 		return
@@ -597,7 +693,10 @@ func (compareComply *CompareComplyV1) DeleteFeedback(deleteFeedbackOptions *Dele
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(FeedbackDeleted))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*FeedbackDeleted)
@@ -617,6 +716,11 @@ func (compareComply *CompareComplyV1) DeleteFeedback(deleteFeedbackOptions *Dele
 // The use of IBM Cloud Object Storage with Compare and Comply is discussed at [Using batch
 // processing](https://cloud.ibm.com/docs/compare-comply?topic=compare-comply-batching#before-you-batch).
 func (compareComply *CompareComplyV1) CreateBatch(createBatchOptions *CreateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	return compareComply.CreateBatchWithContext(context.Background(), createBatchOptions)
+}
+
+// CreateBatchWithContext is an alternate form of the CreateBatch method which supports a Context parameter
+func (compareComply *CompareComplyV1) CreateBatchWithContext(ctx context.Context, createBatchOptions *CreateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createBatchOptions, "createBatchOptions cannot be nil")
 	if err != nil {
 		return
@@ -666,7 +770,10 @@ func (compareComply *CompareComplyV1) CreateBatch(createBatchOptions *CreateBatc
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(BatchStatus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*BatchStatus)
@@ -681,6 +788,11 @@ func (compareComply *CompareComplyV1) CreateBatch(createBatchOptions *CreateBatc
 // ListBatches : List submitted batch-processing jobs
 // Lists batch-processing jobs submitted by users.
 func (compareComply *CompareComplyV1) ListBatches(listBatchesOptions *ListBatchesOptions) (result *Batches, response *core.DetailedResponse, err error) {
+	return compareComply.ListBatchesWithContext(context.Background(), listBatchesOptions)
+}
+
+// ListBatchesWithContext is an alternate form of the ListBatches method which supports a Context parameter
+func (compareComply *CompareComplyV1) ListBatchesWithContext(ctx context.Context, listBatchesOptions *ListBatchesOptions) (result *Batches, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listBatchesOptions, "listBatchesOptions")
 	if err != nil {
 		return
@@ -712,7 +824,10 @@ func (compareComply *CompareComplyV1) ListBatches(listBatchesOptions *ListBatche
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(Batches))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Batches)
@@ -727,6 +842,11 @@ func (compareComply *CompareComplyV1) ListBatches(listBatchesOptions *ListBatche
 // GetBatch : Get information about a specific batch-processing job
 // Gets information about a batch-processing job with a specified ID.
 func (compareComply *CompareComplyV1) GetBatch(getBatchOptions *GetBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	return compareComply.GetBatchWithContext(context.Background(), getBatchOptions)
+}
+
+// GetBatchWithContext is an alternate form of the GetBatch method which supports a Context parameter
+func (compareComply *CompareComplyV1) GetBatchWithContext(ctx context.Context, getBatchOptions *GetBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getBatchOptions, "getBatchOptions cannot be nil")
 	if err != nil {
 		return
@@ -762,7 +882,10 @@ func (compareComply *CompareComplyV1) GetBatch(getBatchOptions *GetBatchOptions)
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(BatchStatus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*BatchStatus)
@@ -778,6 +901,11 @@ func (compareComply *CompareComplyV1) GetBatch(getBatchOptions *GetBatchOptions)
 // Updates a pending or active batch-processing job. You can rescan the input bucket to check for new documents or
 // cancel a job.
 func (compareComply *CompareComplyV1) UpdateBatch(updateBatchOptions *UpdateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	return compareComply.UpdateBatchWithContext(context.Background(), updateBatchOptions)
+}
+
+// UpdateBatchWithContext is an alternate form of the UpdateBatch method which supports a Context parameter
+func (compareComply *CompareComplyV1) UpdateBatchWithContext(ctx context.Context, updateBatchOptions *UpdateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateBatchOptions, "updateBatchOptions cannot be nil")
 	if err != nil {
 		return
@@ -818,7 +946,10 @@ func (compareComply *CompareComplyV1) UpdateBatch(updateBatchOptions *UpdateBatc
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = compareComply.Service.Request(request, new(BatchStatus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*BatchStatus)
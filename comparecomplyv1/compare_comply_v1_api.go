@@ -0,0 +1,72 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package comparecomplyv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// CompareComplyV1API : The operations of the CompareComplyV1 service.
+// It is implemented by *CompareComplyV1, and by *MockCompareComplyV1 for unit tests.
+type CompareComplyV1API interface {
+	ConvertToHTML(convertToHTMLOptions *ConvertToHTMLOptions) (result *HTMLReturn, response *core.DetailedResponse, err error)
+	ConvertToHTMLWithContext(ctx context.Context, convertToHTMLOptions *ConvertToHTMLOptions) (result *HTMLReturn, response *core.DetailedResponse, err error)
+	ClassifyElements(classifyElementsOptions *ClassifyElementsOptions) (result *ClassifyReturn, response *core.DetailedResponse, err error)
+	ClassifyElementsWithContext(ctx context.Context, classifyElementsOptions *ClassifyElementsOptions) (result *ClassifyReturn, response *core.DetailedResponse, err error)
+	ExtractTables(extractTablesOptions *ExtractTablesOptions) (result *TableReturn, response *core.DetailedResponse, err error)
+	ExtractTablesWithContext(ctx context.Context, extractTablesOptions *ExtractTablesOptions) (result *TableReturn, response *core.DetailedResponse, err error)
+	CompareDocuments(compareDocumentsOptions *CompareDocumentsOptions) (result *CompareReturn, response *core.DetailedResponse, err error)
+	CompareDocumentsWithContext(ctx context.Context, compareDocumentsOptions *CompareDocumentsOptions) (result *CompareReturn, response *core.DetailedResponse, err error)
+	AddFeedback(addFeedbackOptions *AddFeedbackOptions) (result *FeedbackReturn, response *core.DetailedResponse, err error)
+	AddFeedbackWithContext(ctx context.Context, addFeedbackOptions *AddFeedbackOptions) (result *FeedbackReturn, response *core.DetailedResponse, err error)
+	ListFeedback(listFeedbackOptions *ListFeedbackOptions) (result *FeedbackList, response *core.DetailedResponse, err error)
+	ListFeedbackWithContext(ctx context.Context, listFeedbackOptions *ListFeedbackOptions) (result *FeedbackList, response *core.DetailedResponse, err error)
+	GetFeedback(getFeedbackOptions *GetFeedbackOptions) (result *GetFeedback, response *core.DetailedResponse, err error)
+	GetFeedbackWithContext(ctx context.Context, getFeedbackOptions *GetFeedbackOptions) (result *GetFeedback, response *core.DetailedResponse, err error)
+	DeleteFeedback(deleteFeedbackOptions *DeleteFeedbackOptions) (result *FeedbackDeleted, response *core.DetailedResponse, err error)
+	DeleteFeedbackWithContext(ctx context.Context, deleteFeedbackOptions *DeleteFeedbackOptions) (result *FeedbackDeleted, response *core.DetailedResponse, err error)
+	CreateBatch(createBatchOptions *CreateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	CreateBatchWithContext(ctx context.Context, createBatchOptions *CreateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	ListBatches(listBatchesOptions *ListBatchesOptions) (result *Batches, response *core.DetailedResponse, err error)
+	ListBatchesWithContext(ctx context.Context, listBatchesOptions *ListBatchesOptions) (result *Batches, response *core.DetailedResponse, err error)
+	GetBatch(getBatchOptions *GetBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	GetBatchWithContext(ctx context.Context, getBatchOptions *GetBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	UpdateBatch(updateBatchOptions *UpdateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	UpdateBatchWithContext(ctx context.Context, updateBatchOptions *UpdateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	NewAddFeedbackOptions(feedbackData *FeedbackDataInput) *AddFeedbackOptions
+	NewClassifyElementsOptions(file io.ReadCloser) *ClassifyElementsOptions
+	NewCompareDocumentsOptions(file1 io.ReadCloser, file2 io.ReadCloser) *CompareDocumentsOptions
+	NewConvertToHTMLOptions(file io.ReadCloser) *ConvertToHTMLOptions
+	NewCreateBatchOptions(function string, inputCredentialsFile io.ReadCloser, inputBucketLocation string, inputBucketName string, outputCredentialsFile io.ReadCloser, outputBucketLocation string, outputBucketName string) *CreateBatchOptions
+	NewDeleteFeedbackOptions(feedbackID string) *DeleteFeedbackOptions
+	NewExtractTablesOptions(file io.ReadCloser) *ExtractTablesOptions
+	NewFeedbackDataInput(feedbackType string, location *Location, text string, originalLabels *OriginalLabelsIn, updatedLabels *UpdatedLabelsIn) (model *FeedbackDataInput, err error)
+	NewGetBatchOptions(batchID string) *GetBatchOptions
+	NewGetFeedbackOptions(feedbackID string) *GetFeedbackOptions
+	NewLabel(nature string, party string) (model *Label, err error)
+	NewListBatchesOptions() *ListBatchesOptions
+	NewListFeedbackOptions() *ListFeedbackOptions
+	NewLocation(begin int64, end int64) (model *Location, err error)
+	NewOriginalLabelsIn(types []TypeLabel, categories []Category) (model *OriginalLabelsIn, err error)
+	NewUpdateBatchOptions(batchID string, action string) *UpdateBatchOptions
+	NewUpdatedLabelsIn(types []TypeLabel, categories []Category) (model *UpdatedLabelsIn, err error)
+}
+
+var _ CompareComplyV1API = (*CompareComplyV1)(nil)
@@ -0,0 +1,312 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package comparecomplyv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockCompareComplyV1 : A mock implementation of CompareComplyV1API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *CompareComplyV1.
+type MockCompareComplyV1 struct {
+	common.MockRecorder
+
+	ConvertToHTMLFunc    func(ctx context.Context, convertToHTMLOptions *ConvertToHTMLOptions) (result *HTMLReturn, response *core.DetailedResponse, err error)
+	ClassifyElementsFunc func(ctx context.Context, classifyElementsOptions *ClassifyElementsOptions) (result *ClassifyReturn, response *core.DetailedResponse, err error)
+	ExtractTablesFunc    func(ctx context.Context, extractTablesOptions *ExtractTablesOptions) (result *TableReturn, response *core.DetailedResponse, err error)
+	CompareDocumentsFunc func(ctx context.Context, compareDocumentsOptions *CompareDocumentsOptions) (result *CompareReturn, response *core.DetailedResponse, err error)
+	AddFeedbackFunc      func(ctx context.Context, addFeedbackOptions *AddFeedbackOptions) (result *FeedbackReturn, response *core.DetailedResponse, err error)
+	ListFeedbackFunc     func(ctx context.Context, listFeedbackOptions *ListFeedbackOptions) (result *FeedbackList, response *core.DetailedResponse, err error)
+	GetFeedbackFunc      func(ctx context.Context, getFeedbackOptions *GetFeedbackOptions) (result *GetFeedback, response *core.DetailedResponse, err error)
+	DeleteFeedbackFunc   func(ctx context.Context, deleteFeedbackOptions *DeleteFeedbackOptions) (result *FeedbackDeleted, response *core.DetailedResponse, err error)
+	CreateBatchFunc      func(ctx context.Context, createBatchOptions *CreateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	ListBatchesFunc      func(ctx context.Context, listBatchesOptions *ListBatchesOptions) (result *Batches, response *core.DetailedResponse, err error)
+	GetBatchFunc         func(ctx context.Context, getBatchOptions *GetBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+	UpdateBatchFunc      func(ctx context.Context, updateBatchOptions *UpdateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error)
+}
+
+var _ CompareComplyV1API = (*MockCompareComplyV1)(nil)
+
+// ConvertToHTML records the call and invokes ConvertToHTMLFunc
+func (mock *MockCompareComplyV1) ConvertToHTML(convertToHTMLOptions *ConvertToHTMLOptions) (result *HTMLReturn, response *core.DetailedResponse, err error) {
+	return mock.ConvertToHTMLWithContext(context.Background(), convertToHTMLOptions)
+}
+
+// ConvertToHTMLWithContext records the call and invokes ConvertToHTMLFunc
+func (mock *MockCompareComplyV1) ConvertToHTMLWithContext(ctx context.Context, convertToHTMLOptions *ConvertToHTMLOptions) (result *HTMLReturn, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ConvertToHTML", convertToHTMLOptions)
+	if mock.ConvertToHTMLFunc != nil {
+		return mock.ConvertToHTMLFunc(ctx, convertToHTMLOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "ConvertToHTML")
+	return
+}
+
+// ClassifyElements records the call and invokes ClassifyElementsFunc
+func (mock *MockCompareComplyV1) ClassifyElements(classifyElementsOptions *ClassifyElementsOptions) (result *ClassifyReturn, response *core.DetailedResponse, err error) {
+	return mock.ClassifyElementsWithContext(context.Background(), classifyElementsOptions)
+}
+
+// ClassifyElementsWithContext records the call and invokes ClassifyElementsFunc
+func (mock *MockCompareComplyV1) ClassifyElementsWithContext(ctx context.Context, classifyElementsOptions *ClassifyElementsOptions) (result *ClassifyReturn, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ClassifyElements", classifyElementsOptions)
+	if mock.ClassifyElementsFunc != nil {
+		return mock.ClassifyElementsFunc(ctx, classifyElementsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "ClassifyElements")
+	return
+}
+
+// ExtractTables records the call and invokes ExtractTablesFunc
+func (mock *MockCompareComplyV1) ExtractTables(extractTablesOptions *ExtractTablesOptions) (result *TableReturn, response *core.DetailedResponse, err error) {
+	return mock.ExtractTablesWithContext(context.Background(), extractTablesOptions)
+}
+
+// ExtractTablesWithContext records the call and invokes ExtractTablesFunc
+func (mock *MockCompareComplyV1) ExtractTablesWithContext(ctx context.Context, extractTablesOptions *ExtractTablesOptions) (result *TableReturn, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ExtractTables", extractTablesOptions)
+	if mock.ExtractTablesFunc != nil {
+		return mock.ExtractTablesFunc(ctx, extractTablesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "ExtractTables")
+	return
+}
+
+// CompareDocuments records the call and invokes CompareDocumentsFunc
+func (mock *MockCompareComplyV1) CompareDocuments(compareDocumentsOptions *CompareDocumentsOptions) (result *CompareReturn, response *core.DetailedResponse, err error) {
+	return mock.CompareDocumentsWithContext(context.Background(), compareDocumentsOptions)
+}
+
+// CompareDocumentsWithContext records the call and invokes CompareDocumentsFunc
+func (mock *MockCompareComplyV1) CompareDocumentsWithContext(ctx context.Context, compareDocumentsOptions *CompareDocumentsOptions) (result *CompareReturn, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CompareDocuments", compareDocumentsOptions)
+	if mock.CompareDocumentsFunc != nil {
+		return mock.CompareDocumentsFunc(ctx, compareDocumentsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "CompareDocuments")
+	return
+}
+
+// AddFeedback records the call and invokes AddFeedbackFunc
+func (mock *MockCompareComplyV1) AddFeedback(addFeedbackOptions *AddFeedbackOptions) (result *FeedbackReturn, response *core.DetailedResponse, err error) {
+	return mock.AddFeedbackWithContext(context.Background(), addFeedbackOptions)
+}
+
+// AddFeedbackWithContext records the call and invokes AddFeedbackFunc
+func (mock *MockCompareComplyV1) AddFeedbackWithContext(ctx context.Context, addFeedbackOptions *AddFeedbackOptions) (result *FeedbackReturn, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddFeedback", addFeedbackOptions)
+	if mock.AddFeedbackFunc != nil {
+		return mock.AddFeedbackFunc(ctx, addFeedbackOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "AddFeedback")
+	return
+}
+
+// ListFeedback records the call and invokes ListFeedbackFunc
+func (mock *MockCompareComplyV1) ListFeedback(listFeedbackOptions *ListFeedbackOptions) (result *FeedbackList, response *core.DetailedResponse, err error) {
+	return mock.ListFeedbackWithContext(context.Background(), listFeedbackOptions)
+}
+
+// ListFeedbackWithContext records the call and invokes ListFeedbackFunc
+func (mock *MockCompareComplyV1) ListFeedbackWithContext(ctx context.Context, listFeedbackOptions *ListFeedbackOptions) (result *FeedbackList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListFeedback", listFeedbackOptions)
+	if mock.ListFeedbackFunc != nil {
+		return mock.ListFeedbackFunc(ctx, listFeedbackOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "ListFeedback")
+	return
+}
+
+// GetFeedback records the call and invokes GetFeedbackFunc
+func (mock *MockCompareComplyV1) GetFeedback(getFeedbackOptions *GetFeedbackOptions) (result *GetFeedback, response *core.DetailedResponse, err error) {
+	return mock.GetFeedbackWithContext(context.Background(), getFeedbackOptions)
+}
+
+// GetFeedbackWithContext records the call and invokes GetFeedbackFunc
+func (mock *MockCompareComplyV1) GetFeedbackWithContext(ctx context.Context, getFeedbackOptions *GetFeedbackOptions) (result *GetFeedback, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetFeedback", getFeedbackOptions)
+	if mock.GetFeedbackFunc != nil {
+		return mock.GetFeedbackFunc(ctx, getFeedbackOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "GetFeedback")
+	return
+}
+
+// DeleteFeedback records the call and invokes DeleteFeedbackFunc
+func (mock *MockCompareComplyV1) DeleteFeedback(deleteFeedbackOptions *DeleteFeedbackOptions) (result *FeedbackDeleted, response *core.DetailedResponse, err error) {
+	return mock.DeleteFeedbackWithContext(context.Background(), deleteFeedbackOptions)
+}
+
+// DeleteFeedbackWithContext records the call and invokes DeleteFeedbackFunc
+func (mock *MockCompareComplyV1) DeleteFeedbackWithContext(ctx context.Context, deleteFeedbackOptions *DeleteFeedbackOptions) (result *FeedbackDeleted, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteFeedback", deleteFeedbackOptions)
+	if mock.DeleteFeedbackFunc != nil {
+		return mock.DeleteFeedbackFunc(ctx, deleteFeedbackOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "DeleteFeedback")
+	return
+}
+
+// CreateBatch records the call and invokes CreateBatchFunc
+func (mock *MockCompareComplyV1) CreateBatch(createBatchOptions *CreateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	return mock.CreateBatchWithContext(context.Background(), createBatchOptions)
+}
+
+// CreateBatchWithContext records the call and invokes CreateBatchFunc
+func (mock *MockCompareComplyV1) CreateBatchWithContext(ctx context.Context, createBatchOptions *CreateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateBatch", createBatchOptions)
+	if mock.CreateBatchFunc != nil {
+		return mock.CreateBatchFunc(ctx, createBatchOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "CreateBatch")
+	return
+}
+
+// ListBatches records the call and invokes ListBatchesFunc
+func (mock *MockCompareComplyV1) ListBatches(listBatchesOptions *ListBatchesOptions) (result *Batches, response *core.DetailedResponse, err error) {
+	return mock.ListBatchesWithContext(context.Background(), listBatchesOptions)
+}
+
+// ListBatchesWithContext records the call and invokes ListBatchesFunc
+func (mock *MockCompareComplyV1) ListBatchesWithContext(ctx context.Context, listBatchesOptions *ListBatchesOptions) (result *Batches, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListBatches", listBatchesOptions)
+	if mock.ListBatchesFunc != nil {
+		return mock.ListBatchesFunc(ctx, listBatchesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "ListBatches")
+	return
+}
+
+// GetBatch records the call and invokes GetBatchFunc
+func (mock *MockCompareComplyV1) GetBatch(getBatchOptions *GetBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	return mock.GetBatchWithContext(context.Background(), getBatchOptions)
+}
+
+// GetBatchWithContext records the call and invokes GetBatchFunc
+func (mock *MockCompareComplyV1) GetBatchWithContext(ctx context.Context, getBatchOptions *GetBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetBatch", getBatchOptions)
+	if mock.GetBatchFunc != nil {
+		return mock.GetBatchFunc(ctx, getBatchOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "GetBatch")
+	return
+}
+
+// UpdateBatch records the call and invokes UpdateBatchFunc
+func (mock *MockCompareComplyV1) UpdateBatch(updateBatchOptions *UpdateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	return mock.UpdateBatchWithContext(context.Background(), updateBatchOptions)
+}
+
+// UpdateBatchWithContext records the call and invokes UpdateBatchFunc
+func (mock *MockCompareComplyV1) UpdateBatchWithContext(ctx context.Context, updateBatchOptions *UpdateBatchOptions) (result *BatchStatus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateBatch", updateBatchOptions)
+	if mock.UpdateBatchFunc != nil {
+		return mock.UpdateBatchFunc(ctx, updateBatchOptions)
+	}
+	err = common.ErrMockNotImplemented("MockCompareComplyV1", "UpdateBatch")
+	return
+}
+
+// NewAddFeedbackOptions delegates to CompareComplyV1.NewAddFeedbackOptions
+func (mock *MockCompareComplyV1) NewAddFeedbackOptions(feedbackData *FeedbackDataInput) *AddFeedbackOptions {
+	return new(CompareComplyV1).NewAddFeedbackOptions(feedbackData)
+}
+
+// NewClassifyElementsOptions delegates to CompareComplyV1.NewClassifyElementsOptions
+func (mock *MockCompareComplyV1) NewClassifyElementsOptions(file io.ReadCloser) *ClassifyElementsOptions {
+	return new(CompareComplyV1).NewClassifyElementsOptions(file)
+}
+
+// NewCompareDocumentsOptions delegates to CompareComplyV1.NewCompareDocumentsOptions
+func (mock *MockCompareComplyV1) NewCompareDocumentsOptions(file1 io.ReadCloser, file2 io.ReadCloser) *CompareDocumentsOptions {
+	return new(CompareComplyV1).NewCompareDocumentsOptions(file1, file2)
+}
+
+// NewConvertToHTMLOptions delegates to CompareComplyV1.NewConvertToHTMLOptions
+func (mock *MockCompareComplyV1) NewConvertToHTMLOptions(file io.ReadCloser) *ConvertToHTMLOptions {
+	return new(CompareComplyV1).NewConvertToHTMLOptions(file)
+}
+
+// NewCreateBatchOptions delegates to CompareComplyV1.NewCreateBatchOptions
+func (mock *MockCompareComplyV1) NewCreateBatchOptions(function string, inputCredentialsFile io.ReadCloser, inputBucketLocation string, inputBucketName string, outputCredentialsFile io.ReadCloser, outputBucketLocation string, outputBucketName string) *CreateBatchOptions {
+	return new(CompareComplyV1).NewCreateBatchOptions(function, inputCredentialsFile, inputBucketLocation, inputBucketName, outputCredentialsFile, outputBucketLocation, outputBucketName)
+}
+
+// NewDeleteFeedbackOptions delegates to CompareComplyV1.NewDeleteFeedbackOptions
+func (mock *MockCompareComplyV1) NewDeleteFeedbackOptions(feedbackID string) *DeleteFeedbackOptions {
+	return new(CompareComplyV1).NewDeleteFeedbackOptions(feedbackID)
+}
+
+// NewExtractTablesOptions delegates to CompareComplyV1.NewExtractTablesOptions
+func (mock *MockCompareComplyV1) NewExtractTablesOptions(file io.ReadCloser) *ExtractTablesOptions {
+	return new(CompareComplyV1).NewExtractTablesOptions(file)
+}
+
+// NewFeedbackDataInput delegates to CompareComplyV1.NewFeedbackDataInput
+func (mock *MockCompareComplyV1) NewFeedbackDataInput(feedbackType string, location *Location, text string, originalLabels *OriginalLabelsIn, updatedLabels *UpdatedLabelsIn) (model *FeedbackDataInput, err error) {
+	return new(CompareComplyV1).NewFeedbackDataInput(feedbackType, location, text, originalLabels, updatedLabels)
+}
+
+// NewGetBatchOptions delegates to CompareComplyV1.NewGetBatchOptions
+func (mock *MockCompareComplyV1) NewGetBatchOptions(batchID string) *GetBatchOptions {
+	return new(CompareComplyV1).NewGetBatchOptions(batchID)
+}
+
+// NewGetFeedbackOptions delegates to CompareComplyV1.NewGetFeedbackOptions
+func (mock *MockCompareComplyV1) NewGetFeedbackOptions(feedbackID string) *GetFeedbackOptions {
+	return new(CompareComplyV1).NewGetFeedbackOptions(feedbackID)
+}
+
+// NewLabel delegates to CompareComplyV1.NewLabel
+func (mock *MockCompareComplyV1) NewLabel(nature string, party string) (model *Label, err error) {
+	return new(CompareComplyV1).NewLabel(nature, party)
+}
+
+// NewListBatchesOptions delegates to CompareComplyV1.NewListBatchesOptions
+func (mock *MockCompareComplyV1) NewListBatchesOptions() *ListBatchesOptions {
+	return new(CompareComplyV1).NewListBatchesOptions()
+}
+
+// NewListFeedbackOptions delegates to CompareComplyV1.NewListFeedbackOptions
+func (mock *MockCompareComplyV1) NewListFeedbackOptions() *ListFeedbackOptions {
+	return new(CompareComplyV1).NewListFeedbackOptions()
+}
+
+// NewLocation delegates to CompareComplyV1.NewLocation
+func (mock *MockCompareComplyV1) NewLocation(begin int64, end int64) (model *Location, err error) {
+	return new(CompareComplyV1).NewLocation(begin, end)
+}
+
+// NewOriginalLabelsIn delegates to CompareComplyV1.NewOriginalLabelsIn
+func (mock *MockCompareComplyV1) NewOriginalLabelsIn(types []TypeLabel, categories []Category) (model *OriginalLabelsIn, err error) {
+	return new(CompareComplyV1).NewOriginalLabelsIn(types, categories)
+}
+
+// NewUpdateBatchOptions delegates to CompareComplyV1.NewUpdateBatchOptions
+func (mock *MockCompareComplyV1) NewUpdateBatchOptions(batchID string, action string) *UpdateBatchOptions {
+	return new(CompareComplyV1).NewUpdateBatchOptions(batchID, action)
+}
+
+// NewUpdatedLabelsIn delegates to CompareComplyV1.NewUpdatedLabelsIn
+func (mock *MockCompareComplyV1) NewUpdatedLabelsIn(types []TypeLabel, categories []Category) (model *UpdatedLabelsIn, err error) {
+	return new(CompareComplyV1).NewUpdatedLabelsIn(types, categories)
+}
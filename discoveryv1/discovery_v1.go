@@ -18,9 +18,11 @@
 package discoveryv1
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -49,10 +51,13 @@ const DefaultServiceName = "discovery"
 
 // DiscoveryV1Options : Service options
 type DiscoveryV1Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewDiscoveryV1 : constructs an instance of DiscoveryV1 with passed in options.
@@ -90,6 +95,16 @@ func NewDiscoveryV1(options *DiscoveryV1Options) (service *DiscoveryV1, err erro
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &DiscoveryV1{
 		Service: baseService,
 		Version: options.Version,
@@ -105,7 +120,27 @@ func (discovery *DiscoveryV1) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (discovery *DiscoveryV1) DisableSSLVerification() {
-	discovery.Service.DisableSSLVerification()
+	common.DisableSSLVerification(discovery.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (discovery *DiscoveryV1) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(discovery.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (discovery *DiscoveryV1) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(discovery.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (discovery *DiscoveryV1) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(discovery.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (discovery *DiscoveryV1) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(discovery.Service, interceptor)
 }
 
 // CreateEnvironment : Create an environment
@@ -114,6 +149,11 @@ func (discovery *DiscoveryV1) DisableSSLVerification() {
 // **Note**: You can create only one environment for private data per service instance. An attempt to create another
 // environment results in an error.
 func (discovery *DiscoveryV1) CreateEnvironment(createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	return discovery.CreateEnvironmentWithContext(context.Background(), createEnvironmentOptions)
+}
+
+// CreateEnvironmentWithContext is an alternate form of the CreateEnvironment method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateEnvironmentWithContext(ctx context.Context, createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createEnvironmentOptions, "createEnvironmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -165,7 +205,10 @@ func (discovery *DiscoveryV1) CreateEnvironment(createEnvironmentOptions *Create
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Environment))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Environment)
@@ -180,6 +223,11 @@ func (discovery *DiscoveryV1) CreateEnvironment(createEnvironmentOptions *Create
 // ListEnvironments : List environments
 // List existing environments for the service instance.
 func (discovery *DiscoveryV1) ListEnvironments(listEnvironmentsOptions *ListEnvironmentsOptions) (result *ListEnvironmentsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListEnvironmentsWithContext(context.Background(), listEnvironmentsOptions)
+}
+
+// ListEnvironmentsWithContext is an alternate form of the ListEnvironments method which supports a Context parameter
+func (discovery *DiscoveryV1) ListEnvironmentsWithContext(ctx context.Context, listEnvironmentsOptions *ListEnvironmentsOptions) (result *ListEnvironmentsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listEnvironmentsOptions, "listEnvironmentsOptions")
 	if err != nil {
 		return
@@ -215,7 +263,10 @@ func (discovery *DiscoveryV1) ListEnvironments(listEnvironmentsOptions *ListEnvi
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListEnvironmentsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListEnvironmentsResponse)
@@ -229,6 +280,11 @@ func (discovery *DiscoveryV1) ListEnvironments(listEnvironmentsOptions *ListEnvi
 
 // GetEnvironment : Get environment info
 func (discovery *DiscoveryV1) GetEnvironment(getEnvironmentOptions *GetEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	return discovery.GetEnvironmentWithContext(context.Background(), getEnvironmentOptions)
+}
+
+// GetEnvironmentWithContext is an alternate form of the GetEnvironment method which supports a Context parameter
+func (discovery *DiscoveryV1) GetEnvironmentWithContext(ctx context.Context, getEnvironmentOptions *GetEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getEnvironmentOptions, "getEnvironmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -264,7 +320,10 @@ func (discovery *DiscoveryV1) GetEnvironment(getEnvironmentOptions *GetEnvironme
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Environment))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Environment)
@@ -280,6 +339,11 @@ func (discovery *DiscoveryV1) GetEnvironment(getEnvironmentOptions *GetEnvironme
 // Updates an environment. The environment's **name** and  **description** parameters can be changed. You must specify a
 // **name** for the environment.
 func (discovery *DiscoveryV1) UpdateEnvironment(updateEnvironmentOptions *UpdateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	return discovery.UpdateEnvironmentWithContext(context.Background(), updateEnvironmentOptions)
+}
+
+// UpdateEnvironmentWithContext is an alternate form of the UpdateEnvironment method which supports a Context parameter
+func (discovery *DiscoveryV1) UpdateEnvironmentWithContext(ctx context.Context, updateEnvironmentOptions *UpdateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateEnvironmentOptions, "updateEnvironmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -331,7 +395,10 @@ func (discovery *DiscoveryV1) UpdateEnvironment(updateEnvironmentOptions *Update
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Environment))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Environment)
@@ -345,6 +412,11 @@ func (discovery *DiscoveryV1) UpdateEnvironment(updateEnvironmentOptions *Update
 
 // DeleteEnvironment : Delete environment
 func (discovery *DiscoveryV1) DeleteEnvironment(deleteEnvironmentOptions *DeleteEnvironmentOptions) (result *DeleteEnvironmentResponse, response *core.DetailedResponse, err error) {
+	return discovery.DeleteEnvironmentWithContext(context.Background(), deleteEnvironmentOptions)
+}
+
+// DeleteEnvironmentWithContext is an alternate form of the DeleteEnvironment method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteEnvironmentWithContext(ctx context.Context, deleteEnvironmentOptions *DeleteEnvironmentOptions) (result *DeleteEnvironmentResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteEnvironmentOptions, "deleteEnvironmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -380,7 +452,10 @@ func (discovery *DiscoveryV1) DeleteEnvironment(deleteEnvironmentOptions *Delete
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DeleteEnvironmentResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteEnvironmentResponse)
@@ -395,6 +470,11 @@ func (discovery *DiscoveryV1) DeleteEnvironment(deleteEnvironmentOptions *Delete
 // ListFields : List fields across collections
 // Gets a list of the unique fields (and their types) stored in the indexes of the specified collections.
 func (discovery *DiscoveryV1) ListFields(listFieldsOptions *ListFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListFieldsWithContext(context.Background(), listFieldsOptions)
+}
+
+// ListFieldsWithContext is an alternate form of the ListFields method which supports a Context parameter
+func (discovery *DiscoveryV1) ListFieldsWithContext(ctx context.Context, listFieldsOptions *ListFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listFieldsOptions, "listFieldsOptions cannot be nil")
 	if err != nil {
 		return
@@ -432,7 +512,10 @@ func (discovery *DiscoveryV1) ListFields(listFieldsOptions *ListFieldsOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListCollectionFieldsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionFieldsResponse)
@@ -455,6 +538,11 @@ func (discovery *DiscoveryV1) ListFields(listFieldsOptions *ListFieldsOptions) (
 // This makes it easier to use newer configuration files with older versions of the API and the service. It also makes
 // it possible for the tooling to add additional metadata and information to the configuration.
 func (discovery *DiscoveryV1) CreateConfiguration(createConfigurationOptions *CreateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	return discovery.CreateConfigurationWithContext(context.Background(), createConfigurationOptions)
+}
+
+// CreateConfigurationWithContext is an alternate form of the CreateConfiguration method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateConfigurationWithContext(ctx context.Context, createConfigurationOptions *CreateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createConfigurationOptions, "createConfigurationOptions cannot be nil")
 	if err != nil {
 		return
@@ -515,7 +603,10 @@ func (discovery *DiscoveryV1) CreateConfiguration(createConfigurationOptions *Cr
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Configuration))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Configuration)
@@ -530,6 +621,11 @@ func (discovery *DiscoveryV1) CreateConfiguration(createConfigurationOptions *Cr
 // ListConfigurations : List configurations
 // Lists existing configurations for the service instance.
 func (discovery *DiscoveryV1) ListConfigurations(listConfigurationsOptions *ListConfigurationsOptions) (result *ListConfigurationsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListConfigurationsWithContext(context.Background(), listConfigurationsOptions)
+}
+
+// ListConfigurationsWithContext is an alternate form of the ListConfigurations method which supports a Context parameter
+func (discovery *DiscoveryV1) ListConfigurationsWithContext(ctx context.Context, listConfigurationsOptions *ListConfigurationsOptions) (result *ListConfigurationsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listConfigurationsOptions, "listConfigurationsOptions cannot be nil")
 	if err != nil {
 		return
@@ -569,7 +665,10 @@ func (discovery *DiscoveryV1) ListConfigurations(listConfigurationsOptions *List
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListConfigurationsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListConfigurationsResponse)
@@ -583,6 +682,11 @@ func (discovery *DiscoveryV1) ListConfigurations(listConfigurationsOptions *List
 
 // GetConfiguration : Get configuration details
 func (discovery *DiscoveryV1) GetConfiguration(getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	return discovery.GetConfigurationWithContext(context.Background(), getConfigurationOptions)
+}
+
+// GetConfigurationWithContext is an alternate form of the GetConfiguration method which supports a Context parameter
+func (discovery *DiscoveryV1) GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getConfigurationOptions, "getConfigurationOptions cannot be nil")
 	if err != nil {
 		return
@@ -618,7 +722,10 @@ func (discovery *DiscoveryV1) GetConfiguration(getConfigurationOptions *GetConfi
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Configuration))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Configuration)
@@ -639,6 +746,11 @@ func (discovery *DiscoveryV1) GetConfiguration(getConfigurationOptions *GetConfi
 //   * Documents are processed with a snapshot of the configuration as it was at the time the document was submitted to
 // be ingested. This means that already submitted documents will not see any updates made to the configuration.
 func (discovery *DiscoveryV1) UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	return discovery.UpdateConfigurationWithContext(context.Background(), updateConfigurationOptions)
+}
+
+// UpdateConfigurationWithContext is an alternate form of the UpdateConfiguration method which supports a Context parameter
+func (discovery *DiscoveryV1) UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateConfigurationOptions, "updateConfigurationOptions cannot be nil")
 	if err != nil {
 		return
@@ -699,7 +811,10 @@ func (discovery *DiscoveryV1) UpdateConfiguration(updateConfigurationOptions *Up
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Configuration))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Configuration)
@@ -717,6 +832,11 @@ func (discovery *DiscoveryV1) UpdateConfiguration(updateConfigurationOptions *Up
 // continue to use the deleted configuration. Documents are always processed with a snapshot of the configuration as it
 // existed at the time the document was submitted.
 func (discovery *DiscoveryV1) DeleteConfiguration(deleteConfigurationOptions *DeleteConfigurationOptions) (result *DeleteConfigurationResponse, response *core.DetailedResponse, err error) {
+	return discovery.DeleteConfigurationWithContext(context.Background(), deleteConfigurationOptions)
+}
+
+// DeleteConfigurationWithContext is an alternate form of the DeleteConfiguration method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteConfigurationWithContext(ctx context.Context, deleteConfigurationOptions *DeleteConfigurationOptions) (result *DeleteConfigurationResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteConfigurationOptions, "deleteConfigurationOptions cannot be nil")
 	if err != nil {
 		return
@@ -752,7 +872,10 @@ func (discovery *DiscoveryV1) DeleteConfiguration(deleteConfigurationOptions *De
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DeleteConfigurationResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteConfigurationResponse)
@@ -766,6 +889,11 @@ func (discovery *DiscoveryV1) DeleteConfiguration(deleteConfigurationOptions *De
 
 // CreateCollection : Create a collection
 func (discovery *DiscoveryV1) CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return discovery.CreateCollectionWithContext(context.Background(), createCollectionOptions)
+}
+
+// CreateCollectionWithContext is an alternate form of the CreateCollection method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createCollectionOptions, "createCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -820,7 +948,10 @@ func (discovery *DiscoveryV1) CreateCollection(createCollectionOptions *CreateCo
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Collection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -835,6 +966,11 @@ func (discovery *DiscoveryV1) CreateCollection(createCollectionOptions *CreateCo
 // ListCollections : List collections
 // Lists existing collections for the service instance.
 func (discovery *DiscoveryV1) ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListCollectionsWithContext(context.Background(), listCollectionsOptions)
+}
+
+// ListCollectionsWithContext is an alternate form of the ListCollections method which supports a Context parameter
+func (discovery *DiscoveryV1) ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listCollectionsOptions, "listCollectionsOptions cannot be nil")
 	if err != nil {
 		return
@@ -874,7 +1010,10 @@ func (discovery *DiscoveryV1) ListCollections(listCollectionsOptions *ListCollec
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListCollectionsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionsResponse)
@@ -888,6 +1027,11 @@ func (discovery *DiscoveryV1) ListCollections(listCollectionsOptions *ListCollec
 
 // GetCollection : Get collection details
 func (discovery *DiscoveryV1) GetCollection(getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return discovery.GetCollectionWithContext(context.Background(), getCollectionOptions)
+}
+
+// GetCollectionWithContext is an alternate form of the GetCollection method which supports a Context parameter
+func (discovery *DiscoveryV1) GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCollectionOptions, "getCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -923,7 +1067,10 @@ func (discovery *DiscoveryV1) GetCollection(getCollectionOptions *GetCollectionO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Collection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -937,6 +1084,11 @@ func (discovery *DiscoveryV1) GetCollection(getCollectionOptions *GetCollectionO
 
 // UpdateCollection : Update a collection
 func (discovery *DiscoveryV1) UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return discovery.UpdateCollectionWithContext(context.Background(), updateCollectionOptions)
+}
+
+// UpdateCollectionWithContext is an alternate form of the UpdateCollection method which supports a Context parameter
+func (discovery *DiscoveryV1) UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateCollectionOptions, "updateCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -988,7 +1140,10 @@ func (discovery *DiscoveryV1) UpdateCollection(updateCollectionOptions *UpdateCo
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Collection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -1002,6 +1157,11 @@ func (discovery *DiscoveryV1) UpdateCollection(updateCollectionOptions *UpdateCo
 
 // DeleteCollection : Delete a collection
 func (discovery *DiscoveryV1) DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (result *DeleteCollectionResponse, response *core.DetailedResponse, err error) {
+	return discovery.DeleteCollectionWithContext(context.Background(), deleteCollectionOptions)
+}
+
+// DeleteCollectionWithContext is an alternate form of the DeleteCollection method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (result *DeleteCollectionResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteCollectionOptions, "deleteCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -1037,7 +1197,10 @@ func (discovery *DiscoveryV1) DeleteCollection(deleteCollectionOptions *DeleteCo
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DeleteCollectionResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteCollectionResponse)
@@ -1052,6 +1215,11 @@ func (discovery *DiscoveryV1) DeleteCollection(deleteCollectionOptions *DeleteCo
 // ListCollectionFields : List collection fields
 // Gets a list of the unique fields (and their types) stored in the index.
 func (discovery *DiscoveryV1) ListCollectionFields(listCollectionFieldsOptions *ListCollectionFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListCollectionFieldsWithContext(context.Background(), listCollectionFieldsOptions)
+}
+
+// ListCollectionFieldsWithContext is an alternate form of the ListCollectionFields method which supports a Context parameter
+func (discovery *DiscoveryV1) ListCollectionFieldsWithContext(ctx context.Context, listCollectionFieldsOptions *ListCollectionFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listCollectionFieldsOptions, "listCollectionFieldsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1087,7 +1255,10 @@ func (discovery *DiscoveryV1) ListCollectionFields(listCollectionFieldsOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListCollectionFieldsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionFieldsResponse)
@@ -1103,6 +1274,11 @@ func (discovery *DiscoveryV1) ListCollectionFields(listCollectionFieldsOptions *
 // Returns the current expansion list for the specified collection. If an expansion list is not specified, an object
 // with empty expansion arrays is returned.
 func (discovery *DiscoveryV1) ListExpansions(listExpansionsOptions *ListExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
+	return discovery.ListExpansionsWithContext(context.Background(), listExpansionsOptions)
+}
+
+// ListExpansionsWithContext is an alternate form of the ListExpansions method which supports a Context parameter
+func (discovery *DiscoveryV1) ListExpansionsWithContext(ctx context.Context, listExpansionsOptions *ListExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listExpansionsOptions, "listExpansionsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1138,7 +1314,10 @@ func (discovery *DiscoveryV1) ListExpansions(listExpansionsOptions *ListExpansio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Expansions))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Expansions)
@@ -1154,6 +1333,11 @@ func (discovery *DiscoveryV1) ListExpansions(listExpansionsOptions *ListExpansio
 // Create or replace the Expansion list for this collection. The maximum number of expanded terms per collection is
 // `500`. The current expansion list is replaced with the uploaded content.
 func (discovery *DiscoveryV1) CreateExpansions(createExpansionsOptions *CreateExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
+	return discovery.CreateExpansionsWithContext(context.Background(), createExpansionsOptions)
+}
+
+// CreateExpansionsWithContext is an alternate form of the CreateExpansions method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateExpansionsWithContext(ctx context.Context, createExpansionsOptions *CreateExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createExpansionsOptions, "createExpansionsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1199,7 +1383,10 @@ func (discovery *DiscoveryV1) CreateExpansions(createExpansionsOptions *CreateEx
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Expansions))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Expansions)
@@ -1215,6 +1402,11 @@ func (discovery *DiscoveryV1) CreateExpansions(createExpansionsOptions *CreateEx
 // Remove the expansion information for this collection. The expansion list must be deleted to disable query expansion
 // for a collection.
 func (discovery *DiscoveryV1) DeleteExpansions(deleteExpansionsOptions *DeleteExpansionsOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteExpansionsWithContext(context.Background(), deleteExpansionsOptions)
+}
+
+// DeleteExpansionsWithContext is an alternate form of the DeleteExpansions method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteExpansionsWithContext(ctx context.Context, deleteExpansionsOptions *DeleteExpansionsOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteExpansionsOptions, "deleteExpansionsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1249,7 +1441,10 @@ func (discovery *DiscoveryV1) DeleteExpansions(deleteExpansionsOptions *DeleteEx
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1257,6 +1452,11 @@ func (discovery *DiscoveryV1) DeleteExpansions(deleteExpansionsOptions *DeleteEx
 // GetTokenizationDictionaryStatus : Get tokenization dictionary status
 // Returns the current status of the tokenization dictionary for the specified collection.
 func (discovery *DiscoveryV1) GetTokenizationDictionaryStatus(getTokenizationDictionaryStatusOptions *GetTokenizationDictionaryStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetTokenizationDictionaryStatusWithContext(context.Background(), getTokenizationDictionaryStatusOptions)
+}
+
+// GetTokenizationDictionaryStatusWithContext is an alternate form of the GetTokenizationDictionaryStatus method which supports a Context parameter
+func (discovery *DiscoveryV1) GetTokenizationDictionaryStatusWithContext(ctx context.Context, getTokenizationDictionaryStatusOptions *GetTokenizationDictionaryStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getTokenizationDictionaryStatusOptions, "getTokenizationDictionaryStatusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1292,7 +1492,10 @@ func (discovery *DiscoveryV1) GetTokenizationDictionaryStatus(getTokenizationDic
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1307,6 +1510,11 @@ func (discovery *DiscoveryV1) GetTokenizationDictionaryStatus(getTokenizationDic
 // CreateTokenizationDictionary : Create tokenization dictionary
 // Upload a custom tokenization dictionary to use with the specified collection.
 func (discovery *DiscoveryV1) CreateTokenizationDictionary(createTokenizationDictionaryOptions *CreateTokenizationDictionaryOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return discovery.CreateTokenizationDictionaryWithContext(context.Background(), createTokenizationDictionaryOptions)
+}
+
+// CreateTokenizationDictionaryWithContext is an alternate form of the CreateTokenizationDictionary method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateTokenizationDictionaryWithContext(ctx context.Context, createTokenizationDictionaryOptions *CreateTokenizationDictionaryOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createTokenizationDictionaryOptions, "createTokenizationDictionaryOptions cannot be nil")
 	if err != nil {
 		return
@@ -1352,7 +1560,10 @@ func (discovery *DiscoveryV1) CreateTokenizationDictionary(createTokenizationDic
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1367,6 +1578,11 @@ func (discovery *DiscoveryV1) CreateTokenizationDictionary(createTokenizationDic
 // DeleteTokenizationDictionary : Delete tokenization dictionary
 // Delete the tokenization dictionary from the collection.
 func (discovery *DiscoveryV1) DeleteTokenizationDictionary(deleteTokenizationDictionaryOptions *DeleteTokenizationDictionaryOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteTokenizationDictionaryWithContext(context.Background(), deleteTokenizationDictionaryOptions)
+}
+
+// DeleteTokenizationDictionaryWithContext is an alternate form of the DeleteTokenizationDictionary method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteTokenizationDictionaryWithContext(ctx context.Context, deleteTokenizationDictionaryOptions *DeleteTokenizationDictionaryOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteTokenizationDictionaryOptions, "deleteTokenizationDictionaryOptions cannot be nil")
 	if err != nil {
 		return
@@ -1401,7 +1617,10 @@ func (discovery *DiscoveryV1) DeleteTokenizationDictionary(deleteTokenizationDic
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1409,6 +1628,11 @@ func (discovery *DiscoveryV1) DeleteTokenizationDictionary(deleteTokenizationDic
 // GetStopwordListStatus : Get stopword list status
 // Returns the current status of the stopword list for the specified collection.
 func (discovery *DiscoveryV1) GetStopwordListStatus(getStopwordListStatusOptions *GetStopwordListStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetStopwordListStatusWithContext(context.Background(), getStopwordListStatusOptions)
+}
+
+// GetStopwordListStatusWithContext is an alternate form of the GetStopwordListStatus method which supports a Context parameter
+func (discovery *DiscoveryV1) GetStopwordListStatusWithContext(ctx context.Context, getStopwordListStatusOptions *GetStopwordListStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getStopwordListStatusOptions, "getStopwordListStatusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1444,7 +1668,10 @@ func (discovery *DiscoveryV1) GetStopwordListStatus(getStopwordListStatusOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1459,6 +1686,11 @@ func (discovery *DiscoveryV1) GetStopwordListStatus(getStopwordListStatusOptions
 // CreateStopwordList : Create stopword list
 // Upload a custom stopword list to use with the specified collection.
 func (discovery *DiscoveryV1) CreateStopwordList(createStopwordListOptions *CreateStopwordListOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return discovery.CreateStopwordListWithContext(context.Background(), createStopwordListOptions)
+}
+
+// CreateStopwordListWithContext is an alternate form of the CreateStopwordList method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateStopwordListWithContext(ctx context.Context, createStopwordListOptions *CreateStopwordListOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createStopwordListOptions, "createStopwordListOptions cannot be nil")
 	if err != nil {
 		return
@@ -1497,7 +1729,10 @@ func (discovery *DiscoveryV1) CreateStopwordList(createStopwordListOptions *Crea
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TokenDictStatusResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TokenDictStatusResponse)
@@ -1513,6 +1748,11 @@ func (discovery *DiscoveryV1) CreateStopwordList(createStopwordListOptions *Crea
 // Delete a custom stopword list from the collection. After a custom stopword list is deleted, the default list is used
 // for the collection.
 func (discovery *DiscoveryV1) DeleteStopwordList(deleteStopwordListOptions *DeleteStopwordListOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteStopwordListWithContext(context.Background(), deleteStopwordListOptions)
+}
+
+// DeleteStopwordListWithContext is an alternate form of the DeleteStopwordList method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteStopwordListWithContext(ctx context.Context, deleteStopwordListOptions *DeleteStopwordListOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteStopwordListOptions, "deleteStopwordListOptions cannot be nil")
 	if err != nil {
 		return
@@ -1547,7 +1787,10 @@ func (discovery *DiscoveryV1) DeleteStopwordList(deleteStopwordListOptions *Dele
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1576,6 +1819,11 @@ func (discovery *DiscoveryV1) DeleteStopwordList(deleteStopwordListOptions *Dele
 //  **Note:** Documents can be added with a specific **document_id** by using the
 // **_/v1/environments/{environment_id}/collections/{collection_id}/documents** method.
 func (discovery *DiscoveryV1) AddDocument(addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return discovery.AddDocumentWithContext(context.Background(), addDocumentOptions)
+}
+
+// AddDocumentWithContext is an alternate form of the AddDocument method which supports a Context parameter
+func (discovery *DiscoveryV1) AddDocumentWithContext(ctx context.Context, addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addDocumentOptions, "addDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -1623,7 +1871,10 @@ func (discovery *DiscoveryV1) AddDocument(addDocumentOptions *AddDocumentOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -1640,6 +1891,11 @@ func (discovery *DiscoveryV1) AddDocument(addDocumentOptions *AddDocumentOptions
 // Instead, it returns only the document's processing status and any notices (warnings or errors) that were generated
 // when the document was ingested. Use the query API to retrieve the actual document content.
 func (discovery *DiscoveryV1) GetDocumentStatus(getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	return discovery.GetDocumentStatusWithContext(context.Background(), getDocumentStatusOptions)
+}
+
+// GetDocumentStatusWithContext is an alternate form of the GetDocumentStatus method which supports a Context parameter
+func (discovery *DiscoveryV1) GetDocumentStatusWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getDocumentStatusOptions, "getDocumentStatusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1675,7 +1931,10 @@ func (discovery *DiscoveryV1) GetDocumentStatus(getDocumentStatusOptions *GetDoc
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DocumentStatus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentStatus)
@@ -1694,6 +1953,11 @@ func (discovery *DiscoveryV1) GetDocumentStatus(getDocumentStatusOptions *GetDoc
 // **Note:** When uploading a new document with this method it automatically replaces any document stored with the same
 // **document_id** if it exists.
 func (discovery *DiscoveryV1) UpdateDocument(updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return discovery.UpdateDocumentWithContext(context.Background(), updateDocumentOptions)
+}
+
+// UpdateDocumentWithContext is an alternate form of the UpdateDocument method which supports a Context parameter
+func (discovery *DiscoveryV1) UpdateDocumentWithContext(ctx context.Context, updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateDocumentOptions, "updateDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -1741,7 +2005,10 @@ func (discovery *DiscoveryV1) UpdateDocument(updateDocumentOptions *UpdateDocume
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -1757,6 +2024,11 @@ func (discovery *DiscoveryV1) UpdateDocument(updateDocumentOptions *UpdateDocume
 // If the given document ID is invalid, or if the document is not found, then the a success response is returned (HTTP
 // status code `200`) with the status set to 'deleted'.
 func (discovery *DiscoveryV1) DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
+	return discovery.DeleteDocumentWithContext(context.Background(), deleteDocumentOptions)
+}
+
+// DeleteDocumentWithContext is an alternate form of the DeleteDocument method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteDocumentOptions, "deleteDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -1792,7 +2064,10 @@ func (discovery *DiscoveryV1) DeleteDocument(deleteDocumentOptions *DeleteDocume
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DeleteDocumentResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteDocumentResponse)
@@ -1808,6 +2083,11 @@ func (discovery *DiscoveryV1) DeleteDocument(deleteDocumentOptions *DeleteDocume
 // By using this method, you can construct long queries. For details, see the [Discovery
 // documentation](https://cloud.ibm.com/docs/discovery?topic=discovery-query-concepts#query-concepts).
 func (discovery *DiscoveryV1) Query(queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	return discovery.QueryWithContext(context.Background(), queryOptions)
+}
+
+// QueryWithContext is an alternate form of the Query method which supports a Context parameter
+func (discovery *DiscoveryV1) QueryWithContext(ctx context.Context, queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(queryOptions, "queryOptions cannot be nil")
 	if err != nil {
 		return
@@ -1913,7 +2193,10 @@ func (discovery *DiscoveryV1) Query(queryOptions *QueryOptions) (result *QueryRe
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(QueryResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryResponse)
@@ -1931,6 +2214,11 @@ func (discovery *DiscoveryV1) Query(queryOptions *QueryOptions) (result *QueryRe
 // documentation](https://cloud.ibm.com/docs/discovery?topic=discovery-query-concepts#query-concepts) for more details
 // on the query language.
 func (discovery *DiscoveryV1) QueryNotices(queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	return discovery.QueryNoticesWithContext(context.Background(), queryNoticesOptions)
+}
+
+// QueryNoticesWithContext is an alternate form of the QueryNotices method which supports a Context parameter
+func (discovery *DiscoveryV1) QueryNoticesWithContext(ctx context.Context, queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(queryNoticesOptions, "queryNoticesOptions cannot be nil")
 	if err != nil {
 		return
@@ -2018,7 +2306,10 @@ func (discovery *DiscoveryV1) QueryNotices(queryNoticesOptions *QueryNoticesOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(QueryNoticesResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryNoticesResponse)
@@ -2034,6 +2325,11 @@ func (discovery *DiscoveryV1) QueryNotices(queryNoticesOptions *QueryNoticesOpti
 // By using this method, you can construct long queries that search multiple collection. For details, see the [Discovery
 // documentation](https://cloud.ibm.com/docs/discovery?topic=discovery-query-concepts#query-concepts).
 func (discovery *DiscoveryV1) FederatedQuery(federatedQueryOptions *FederatedQueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	return discovery.FederatedQueryWithContext(context.Background(), federatedQueryOptions)
+}
+
+// FederatedQueryWithContext is an alternate form of the FederatedQuery method which supports a Context parameter
+func (discovery *DiscoveryV1) FederatedQueryWithContext(ctx context.Context, federatedQueryOptions *FederatedQueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(federatedQueryOptions, "federatedQueryOptions cannot be nil")
 	if err != nil {
 		return
@@ -2139,7 +2435,10 @@ func (discovery *DiscoveryV1) FederatedQuery(federatedQueryOptions *FederatedQue
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(QueryResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryResponse)
@@ -2157,6 +2456,11 @@ func (discovery *DiscoveryV1) FederatedQuery(federatedQueryOptions *FederatedQue
 // documentation](https://cloud.ibm.com/docs/discovery?topic=discovery-query-concepts#query-concepts) for more details
 // on the query language.
 func (discovery *DiscoveryV1) FederatedQueryNotices(federatedQueryNoticesOptions *FederatedQueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	return discovery.FederatedQueryNoticesWithContext(context.Background(), federatedQueryNoticesOptions)
+}
+
+// FederatedQueryNoticesWithContext is an alternate form of the FederatedQueryNotices method which supports a Context parameter
+func (discovery *DiscoveryV1) FederatedQueryNoticesWithContext(ctx context.Context, federatedQueryNoticesOptions *FederatedQueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(federatedQueryNoticesOptions, "federatedQueryNoticesOptions cannot be nil")
 	if err != nil {
 		return
@@ -2233,7 +2537,10 @@ func (discovery *DiscoveryV1) FederatedQueryNotices(federatedQueryNoticesOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(QueryNoticesResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryNoticesResponse)
@@ -2249,6 +2556,11 @@ func (discovery *DiscoveryV1) FederatedQueryNotices(federatedQueryNoticesOptions
 // Returns completion query suggestions for the specified prefix.  /n/n **Important:** this method is only valid when
 // using the Cloud Pak version of Discovery.
 func (discovery *DiscoveryV1) GetAutocompletion(getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
+	return discovery.GetAutocompletionWithContext(context.Background(), getAutocompletionOptions)
+}
+
+// GetAutocompletionWithContext is an alternate form of the GetAutocompletion method which supports a Context parameter
+func (discovery *DiscoveryV1) GetAutocompletionWithContext(ctx context.Context, getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getAutocompletionOptions, "getAutocompletionOptions cannot be nil")
 	if err != nil {
 		return
@@ -2292,7 +2604,10 @@ func (discovery *DiscoveryV1) GetAutocompletion(getAutocompletionOptions *GetAut
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Completions))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Completions)
@@ -2307,6 +2622,11 @@ func (discovery *DiscoveryV1) GetAutocompletion(getAutocompletionOptions *GetAut
 // ListTrainingData : List training data
 // Lists the training data for the specified collection.
 func (discovery *DiscoveryV1) ListTrainingData(listTrainingDataOptions *ListTrainingDataOptions) (result *TrainingDataSet, response *core.DetailedResponse, err error) {
+	return discovery.ListTrainingDataWithContext(context.Background(), listTrainingDataOptions)
+}
+
+// ListTrainingDataWithContext is an alternate form of the ListTrainingData method which supports a Context parameter
+func (discovery *DiscoveryV1) ListTrainingDataWithContext(ctx context.Context, listTrainingDataOptions *ListTrainingDataOptions) (result *TrainingDataSet, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listTrainingDataOptions, "listTrainingDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2342,7 +2662,10 @@ func (discovery *DiscoveryV1) ListTrainingData(listTrainingDataOptions *ListTrai
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingDataSet))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingDataSet)
@@ -2357,6 +2680,11 @@ func (discovery *DiscoveryV1) ListTrainingData(listTrainingDataOptions *ListTrai
 // AddTrainingData : Add query to training data
 // Adds a query to the training data for this collection. The query can contain a filter and natural language query.
 func (discovery *DiscoveryV1) AddTrainingData(addTrainingDataOptions *AddTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return discovery.AddTrainingDataWithContext(context.Background(), addTrainingDataOptions)
+}
+
+// AddTrainingDataWithContext is an alternate form of the AddTrainingData method which supports a Context parameter
+func (discovery *DiscoveryV1) AddTrainingDataWithContext(ctx context.Context, addTrainingDataOptions *AddTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addTrainingDataOptions, "addTrainingDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2408,7 +2736,10 @@ func (discovery *DiscoveryV1) AddTrainingData(addTrainingDataOptions *AddTrainin
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -2423,6 +2754,11 @@ func (discovery *DiscoveryV1) AddTrainingData(addTrainingDataOptions *AddTrainin
 // DeleteAllTrainingData : Delete all training data
 // Deletes all training data from a collection.
 func (discovery *DiscoveryV1) DeleteAllTrainingData(deleteAllTrainingDataOptions *DeleteAllTrainingDataOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteAllTrainingDataWithContext(context.Background(), deleteAllTrainingDataOptions)
+}
+
+// DeleteAllTrainingDataWithContext is an alternate form of the DeleteAllTrainingData method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteAllTrainingDataWithContext(ctx context.Context, deleteAllTrainingDataOptions *DeleteAllTrainingDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteAllTrainingDataOptions, "deleteAllTrainingDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2457,7 +2793,10 @@ func (discovery *DiscoveryV1) DeleteAllTrainingData(deleteAllTrainingDataOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2465,6 +2804,11 @@ func (discovery *DiscoveryV1) DeleteAllTrainingData(deleteAllTrainingDataOptions
 // GetTrainingData : Get details about a query
 // Gets details for a specific training data query, including the query string and all examples.
 func (discovery *DiscoveryV1) GetTrainingData(getTrainingDataOptions *GetTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return discovery.GetTrainingDataWithContext(context.Background(), getTrainingDataOptions)
+}
+
+// GetTrainingDataWithContext is an alternate form of the GetTrainingData method which supports a Context parameter
+func (discovery *DiscoveryV1) GetTrainingDataWithContext(ctx context.Context, getTrainingDataOptions *GetTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getTrainingDataOptions, "getTrainingDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2500,7 +2844,10 @@ func (discovery *DiscoveryV1) GetTrainingData(getTrainingDataOptions *GetTrainin
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -2515,6 +2862,11 @@ func (discovery *DiscoveryV1) GetTrainingData(getTrainingDataOptions *GetTrainin
 // DeleteTrainingData : Delete a training data query
 // Removes the training data query and all associated examples from the training data set.
 func (discovery *DiscoveryV1) DeleteTrainingData(deleteTrainingDataOptions *DeleteTrainingDataOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteTrainingDataWithContext(context.Background(), deleteTrainingDataOptions)
+}
+
+// DeleteTrainingDataWithContext is an alternate form of the DeleteTrainingData method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteTrainingDataWithContext(ctx context.Context, deleteTrainingDataOptions *DeleteTrainingDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteTrainingDataOptions, "deleteTrainingDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2549,7 +2901,10 @@ func (discovery *DiscoveryV1) DeleteTrainingData(deleteTrainingDataOptions *Dele
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2557,6 +2912,11 @@ func (discovery *DiscoveryV1) DeleteTrainingData(deleteTrainingDataOptions *Dele
 // ListTrainingExamples : List examples for a training data query
 // List all examples for this training data query.
 func (discovery *DiscoveryV1) ListTrainingExamples(listTrainingExamplesOptions *ListTrainingExamplesOptions) (result *TrainingExampleList, response *core.DetailedResponse, err error) {
+	return discovery.ListTrainingExamplesWithContext(context.Background(), listTrainingExamplesOptions)
+}
+
+// ListTrainingExamplesWithContext is an alternate form of the ListTrainingExamples method which supports a Context parameter
+func (discovery *DiscoveryV1) ListTrainingExamplesWithContext(ctx context.Context, listTrainingExamplesOptions *ListTrainingExamplesOptions) (result *TrainingExampleList, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listTrainingExamplesOptions, "listTrainingExamplesOptions cannot be nil")
 	if err != nil {
 		return
@@ -2592,7 +2952,10 @@ func (discovery *DiscoveryV1) ListTrainingExamples(listTrainingExamplesOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingExampleList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExampleList)
@@ -2607,6 +2970,11 @@ func (discovery *DiscoveryV1) ListTrainingExamples(listTrainingExamplesOptions *
 // CreateTrainingExample : Add example to training data query
 // Adds a example to this training data query.
 func (discovery *DiscoveryV1) CreateTrainingExample(createTrainingExampleOptions *CreateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	return discovery.CreateTrainingExampleWithContext(context.Background(), createTrainingExampleOptions)
+}
+
+// CreateTrainingExampleWithContext is an alternate form of the CreateTrainingExample method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateTrainingExampleWithContext(ctx context.Context, createTrainingExampleOptions *CreateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createTrainingExampleOptions, "createTrainingExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -2658,7 +3026,10 @@ func (discovery *DiscoveryV1) CreateTrainingExample(createTrainingExampleOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingExample))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExample)
@@ -2673,6 +3044,11 @@ func (discovery *DiscoveryV1) CreateTrainingExample(createTrainingExampleOptions
 // DeleteTrainingExample : Delete example for training data query
 // Deletes the example document with the given ID from the training data query.
 func (discovery *DiscoveryV1) DeleteTrainingExample(deleteTrainingExampleOptions *DeleteTrainingExampleOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteTrainingExampleWithContext(context.Background(), deleteTrainingExampleOptions)
+}
+
+// DeleteTrainingExampleWithContext is an alternate form of the DeleteTrainingExample method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteTrainingExampleWithContext(ctx context.Context, deleteTrainingExampleOptions *DeleteTrainingExampleOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteTrainingExampleOptions, "deleteTrainingExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -2707,7 +3083,10 @@ func (discovery *DiscoveryV1) DeleteTrainingExample(deleteTrainingExampleOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2715,6 +3094,11 @@ func (discovery *DiscoveryV1) DeleteTrainingExample(deleteTrainingExampleOptions
 // UpdateTrainingExample : Change label or cross reference for example
 // Changes the label or cross reference query for this training data example.
 func (discovery *DiscoveryV1) UpdateTrainingExample(updateTrainingExampleOptions *UpdateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	return discovery.UpdateTrainingExampleWithContext(context.Background(), updateTrainingExampleOptions)
+}
+
+// UpdateTrainingExampleWithContext is an alternate form of the UpdateTrainingExample method which supports a Context parameter
+func (discovery *DiscoveryV1) UpdateTrainingExampleWithContext(ctx context.Context, updateTrainingExampleOptions *UpdateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateTrainingExampleOptions, "updateTrainingExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -2763,7 +3147,10 @@ func (discovery *DiscoveryV1) UpdateTrainingExample(updateTrainingExampleOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingExample))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExample)
@@ -2778,6 +3165,11 @@ func (discovery *DiscoveryV1) UpdateTrainingExample(updateTrainingExampleOptions
 // GetTrainingExample : Get details for training data example
 // Gets the details for this training example.
 func (discovery *DiscoveryV1) GetTrainingExample(getTrainingExampleOptions *GetTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	return discovery.GetTrainingExampleWithContext(context.Background(), getTrainingExampleOptions)
+}
+
+// GetTrainingExampleWithContext is an alternate form of the GetTrainingExample method which supports a Context parameter
+func (discovery *DiscoveryV1) GetTrainingExampleWithContext(ctx context.Context, getTrainingExampleOptions *GetTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getTrainingExampleOptions, "getTrainingExampleOptions cannot be nil")
 	if err != nil {
 		return
@@ -2813,7 +3205,10 @@ func (discovery *DiscoveryV1) GetTrainingExample(getTrainingExampleOptions *GetT
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingExample))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingExample)
@@ -2833,6 +3228,11 @@ func (discovery *DiscoveryV1) GetTrainingExample(getTrainingExampleOptions *GetT
 // For more information about personal data and customer IDs, see [Information
 // security](https://cloud.ibm.com/docs/discovery?topic=discovery-information-security#information-security).
 func (discovery *DiscoveryV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2868,7 +3268,10 @@ func (discovery *DiscoveryV1) DeleteUserData(deleteUserDataOptions *DeleteUserDa
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2877,6 +3280,11 @@ func (discovery *DiscoveryV1) DeleteUserData(deleteUserDataOptions *DeleteUserDa
 // The **Events** API can be used to create log entries that are associated with specific queries. For example, you can
 // record which documents in the results set were "clicked" by a user and when that click occurred.
 func (discovery *DiscoveryV1) CreateEvent(createEventOptions *CreateEventOptions) (result *CreateEventResponse, response *core.DetailedResponse, err error) {
+	return discovery.CreateEventWithContext(context.Background(), createEventOptions)
+}
+
+// CreateEventWithContext is an alternate form of the CreateEvent method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateEventWithContext(ctx context.Context, createEventOptions *CreateEventOptions) (result *CreateEventResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createEventOptions, "createEventOptions cannot be nil")
 	if err != nil {
 		return
@@ -2925,7 +3333,10 @@ func (discovery *DiscoveryV1) CreateEvent(createEventOptions *CreateEventOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(CreateEventResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CreateEventResponse)
@@ -2941,6 +3352,11 @@ func (discovery *DiscoveryV1) CreateEvent(createEventOptions *CreateEventOptions
 // Searches the query and event log to find query sessions that match the specified criteria. Searching the **logs**
 // endpoint uses the standard Discovery query syntax for the parameters that are supported.
 func (discovery *DiscoveryV1) QueryLog(queryLogOptions *QueryLogOptions) (result *LogQueryResponse, response *core.DetailedResponse, err error) {
+	return discovery.QueryLogWithContext(context.Background(), queryLogOptions)
+}
+
+// QueryLogWithContext is an alternate form of the QueryLog method which supports a Context parameter
+func (discovery *DiscoveryV1) QueryLogWithContext(ctx context.Context, queryLogOptions *QueryLogOptions) (result *LogQueryResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(queryLogOptions, "queryLogOptions")
 	if err != nil {
 		return
@@ -2988,7 +3404,10 @@ func (discovery *DiscoveryV1) QueryLog(queryLogOptions *QueryLogOptions) (result
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(LogQueryResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LogQueryResponse)
@@ -3003,6 +3422,11 @@ func (discovery *DiscoveryV1) QueryLog(queryLogOptions *QueryLogOptions) (result
 // GetMetricsQuery : Number of queries over time
 // Total number of queries using the **natural_language_query** parameter over a specific time window.
 func (discovery *DiscoveryV1) GetMetricsQuery(getMetricsQueryOptions *GetMetricsQueryOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetMetricsQueryWithContext(context.Background(), getMetricsQueryOptions)
+}
+
+// GetMetricsQueryWithContext is an alternate form of the GetMetricsQuery method which supports a Context parameter
+func (discovery *DiscoveryV1) GetMetricsQueryWithContext(ctx context.Context, getMetricsQueryOptions *GetMetricsQueryOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getMetricsQueryOptions, "getMetricsQueryOptions")
 	if err != nil {
 		return
@@ -3044,7 +3468,10 @@ func (discovery *DiscoveryV1) GetMetricsQuery(getMetricsQueryOptions *GetMetrics
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3061,6 +3488,11 @@ func (discovery *DiscoveryV1) GetMetricsQuery(getMetricsQueryOptions *GetMetrics
 // specified time window. This metric requires having integrated event tracking in your application using the **Events**
 // API.
 func (discovery *DiscoveryV1) GetMetricsQueryEvent(getMetricsQueryEventOptions *GetMetricsQueryEventOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetMetricsQueryEventWithContext(context.Background(), getMetricsQueryEventOptions)
+}
+
+// GetMetricsQueryEventWithContext is an alternate form of the GetMetricsQueryEvent method which supports a Context parameter
+func (discovery *DiscoveryV1) GetMetricsQueryEventWithContext(ctx context.Context, getMetricsQueryEventOptions *GetMetricsQueryEventOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getMetricsQueryEventOptions, "getMetricsQueryEventOptions")
 	if err != nil {
 		return
@@ -3102,7 +3534,10 @@ func (discovery *DiscoveryV1) GetMetricsQueryEvent(getMetricsQueryEventOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3118,6 +3553,11 @@ func (discovery *DiscoveryV1) GetMetricsQueryEvent(getMetricsQueryEventOptions *
 // Total number of queries using the **natural_language_query** parameter that have no results returned over a specified
 // time window.
 func (discovery *DiscoveryV1) GetMetricsQueryNoResults(getMetricsQueryNoResultsOptions *GetMetricsQueryNoResultsOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetMetricsQueryNoResultsWithContext(context.Background(), getMetricsQueryNoResultsOptions)
+}
+
+// GetMetricsQueryNoResultsWithContext is an alternate form of the GetMetricsQueryNoResults method which supports a Context parameter
+func (discovery *DiscoveryV1) GetMetricsQueryNoResultsWithContext(ctx context.Context, getMetricsQueryNoResultsOptions *GetMetricsQueryNoResultsOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getMetricsQueryNoResultsOptions, "getMetricsQueryNoResultsOptions")
 	if err != nil {
 		return
@@ -3159,7 +3599,10 @@ func (discovery *DiscoveryV1) GetMetricsQueryNoResults(getMetricsQueryNoResultsO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3176,6 +3619,11 @@ func (discovery *DiscoveryV1) GetMetricsQueryNoResults(getMetricsQueryNoResultsO
 // a specified time window.  This metric requires having integrated event tracking in your application using the
 // **Events** API.
 func (discovery *DiscoveryV1) GetMetricsEventRate(getMetricsEventRateOptions *GetMetricsEventRateOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetMetricsEventRateWithContext(context.Background(), getMetricsEventRateOptions)
+}
+
+// GetMetricsEventRateWithContext is an alternate form of the GetMetricsEventRate method which supports a Context parameter
+func (discovery *DiscoveryV1) GetMetricsEventRateWithContext(ctx context.Context, getMetricsEventRateOptions *GetMetricsEventRateOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getMetricsEventRateOptions, "getMetricsEventRateOptions")
 	if err != nil {
 		return
@@ -3217,7 +3665,10 @@ func (discovery *DiscoveryV1) GetMetricsEventRate(getMetricsEventRateOptions *Ge
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(MetricResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricResponse)
@@ -3234,6 +3685,11 @@ func (discovery *DiscoveryV1) GetMetricsEventRate(getMetricsEventRateOptions *Ge
 // event rate within the recording period (queries and events are stored for 30 days). A query token is an individual
 // word or unigram within the query string.
 func (discovery *DiscoveryV1) GetMetricsQueryTokenEvent(getMetricsQueryTokenEventOptions *GetMetricsQueryTokenEventOptions) (result *MetricTokenResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetMetricsQueryTokenEventWithContext(context.Background(), getMetricsQueryTokenEventOptions)
+}
+
+// GetMetricsQueryTokenEventWithContext is an alternate form of the GetMetricsQueryTokenEvent method which supports a Context parameter
+func (discovery *DiscoveryV1) GetMetricsQueryTokenEventWithContext(ctx context.Context, getMetricsQueryTokenEventOptions *GetMetricsQueryTokenEventOptions) (result *MetricTokenResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getMetricsQueryTokenEventOptions, "getMetricsQueryTokenEventOptions")
 	if err != nil {
 		return
@@ -3269,7 +3725,10 @@ func (discovery *DiscoveryV1) GetMetricsQueryTokenEvent(getMetricsQueryTokenEven
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(MetricTokenResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*MetricTokenResponse)
@@ -3286,6 +3745,11 @@ func (discovery *DiscoveryV1) GetMetricsQueryTokenEvent(getMetricsQueryTokenEven
 //
 //  **Note:**  All credentials are sent over an encrypted connection and encrypted at rest.
 func (discovery *DiscoveryV1) ListCredentials(listCredentialsOptions *ListCredentialsOptions) (result *CredentialsList, response *core.DetailedResponse, err error) {
+	return discovery.ListCredentialsWithContext(context.Background(), listCredentialsOptions)
+}
+
+// ListCredentialsWithContext is an alternate form of the ListCredentials method which supports a Context parameter
+func (discovery *DiscoveryV1) ListCredentialsWithContext(ctx context.Context, listCredentialsOptions *ListCredentialsOptions) (result *CredentialsList, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listCredentialsOptions, "listCredentialsOptions cannot be nil")
 	if err != nil {
 		return
@@ -3321,7 +3785,10 @@ func (discovery *DiscoveryV1) ListCredentials(listCredentialsOptions *ListCreden
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(CredentialsList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CredentialsList)
@@ -3339,6 +3806,11 @@ func (discovery *DiscoveryV1) ListCredentials(listCredentialsOptions *ListCreden
 //
 // **Note:** All credentials are sent over an encrypted connection and encrypted at rest.
 func (discovery *DiscoveryV1) CreateCredentials(createCredentialsOptions *CreateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	return discovery.CreateCredentialsWithContext(context.Background(), createCredentialsOptions)
+}
+
+// CreateCredentialsWithContext is an alternate form of the CreateCredentials method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateCredentialsWithContext(ctx context.Context, createCredentialsOptions *CreateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createCredentialsOptions, "createCredentialsOptions cannot be nil")
 	if err != nil {
 		return
@@ -3390,7 +3862,10 @@ func (discovery *DiscoveryV1) CreateCredentials(createCredentialsOptions *Create
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Credentials))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Credentials)
@@ -3408,6 +3883,11 @@ func (discovery *DiscoveryV1) CreateCredentials(createCredentialsOptions *Create
 //  **Note:** Secure credential information such as a password or SSH key is never returned and must be obtained from
 // the source system.
 func (discovery *DiscoveryV1) GetCredentials(getCredentialsOptions *GetCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	return discovery.GetCredentialsWithContext(context.Background(), getCredentialsOptions)
+}
+
+// GetCredentialsWithContext is an alternate form of the GetCredentials method which supports a Context parameter
+func (discovery *DiscoveryV1) GetCredentialsWithContext(ctx context.Context, getCredentialsOptions *GetCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCredentialsOptions, "getCredentialsOptions cannot be nil")
 	if err != nil {
 		return
@@ -3443,7 +3923,10 @@ func (discovery *DiscoveryV1) GetCredentials(getCredentialsOptions *GetCredentia
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Credentials))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Credentials)
@@ -3460,6 +3943,11 @@ func (discovery *DiscoveryV1) GetCredentials(getCredentialsOptions *GetCredentia
 //
 // **Note:** All credentials are sent over an encrypted connection and encrypted at rest.
 func (discovery *DiscoveryV1) UpdateCredentials(updateCredentialsOptions *UpdateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	return discovery.UpdateCredentialsWithContext(context.Background(), updateCredentialsOptions)
+}
+
+// UpdateCredentialsWithContext is an alternate form of the UpdateCredentials method which supports a Context parameter
+func (discovery *DiscoveryV1) UpdateCredentialsWithContext(ctx context.Context, updateCredentialsOptions *UpdateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateCredentialsOptions, "updateCredentialsOptions cannot be nil")
 	if err != nil {
 		return
@@ -3511,7 +3999,10 @@ func (discovery *DiscoveryV1) UpdateCredentials(updateCredentialsOptions *Update
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Credentials))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Credentials)
@@ -3526,6 +4017,11 @@ func (discovery *DiscoveryV1) UpdateCredentials(updateCredentialsOptions *Update
 // DeleteCredentials : Delete credentials
 // Deletes a set of stored credentials from your Discovery instance.
 func (discovery *DiscoveryV1) DeleteCredentials(deleteCredentialsOptions *DeleteCredentialsOptions) (result *DeleteCredentials, response *core.DetailedResponse, err error) {
+	return discovery.DeleteCredentialsWithContext(context.Background(), deleteCredentialsOptions)
+}
+
+// DeleteCredentialsWithContext is an alternate form of the DeleteCredentials method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteCredentialsWithContext(ctx context.Context, deleteCredentialsOptions *DeleteCredentialsOptions) (result *DeleteCredentials, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteCredentialsOptions, "deleteCredentialsOptions cannot be nil")
 	if err != nil {
 		return
@@ -3561,7 +4057,10 @@ func (discovery *DiscoveryV1) DeleteCredentials(deleteCredentialsOptions *Delete
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DeleteCredentials))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteCredentials)
@@ -3576,6 +4075,11 @@ func (discovery *DiscoveryV1) DeleteCredentials(deleteCredentialsOptions *Delete
 // ListGateways : List Gateways
 // List the currently configured gateways.
 func (discovery *DiscoveryV1) ListGateways(listGatewaysOptions *ListGatewaysOptions) (result *GatewayList, response *core.DetailedResponse, err error) {
+	return discovery.ListGatewaysWithContext(context.Background(), listGatewaysOptions)
+}
+
+// ListGatewaysWithContext is an alternate form of the ListGateways method which supports a Context parameter
+func (discovery *DiscoveryV1) ListGatewaysWithContext(ctx context.Context, listGatewaysOptions *ListGatewaysOptions) (result *GatewayList, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listGatewaysOptions, "listGatewaysOptions cannot be nil")
 	if err != nil {
 		return
@@ -3611,7 +4115,10 @@ func (discovery *DiscoveryV1) ListGateways(listGatewaysOptions *ListGatewaysOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(GatewayList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*GatewayList)
@@ -3626,6 +4133,11 @@ func (discovery *DiscoveryV1) ListGateways(listGatewaysOptions *ListGatewaysOpti
 // CreateGateway : Create Gateway
 // Create a gateway configuration to use with a remotely installed gateway.
 func (discovery *DiscoveryV1) CreateGateway(createGatewayOptions *CreateGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
+	return discovery.CreateGatewayWithContext(context.Background(), createGatewayOptions)
+}
+
+// CreateGatewayWithContext is an alternate form of the CreateGateway method which supports a Context parameter
+func (discovery *DiscoveryV1) CreateGatewayWithContext(ctx context.Context, createGatewayOptions *CreateGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createGatewayOptions, "createGatewayOptions cannot be nil")
 	if err != nil {
 		return
@@ -3671,7 +4183,10 @@ func (discovery *DiscoveryV1) CreateGateway(createGatewayOptions *CreateGatewayO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Gateway))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Gateway)
@@ -3686,6 +4201,11 @@ func (discovery *DiscoveryV1) CreateGateway(createGatewayOptions *CreateGatewayO
 // GetGateway : List Gateway Details
 // List information about the specified gateway.
 func (discovery *DiscoveryV1) GetGateway(getGatewayOptions *GetGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
+	return discovery.GetGatewayWithContext(context.Background(), getGatewayOptions)
+}
+
+// GetGatewayWithContext is an alternate form of the GetGateway method which supports a Context parameter
+func (discovery *DiscoveryV1) GetGatewayWithContext(ctx context.Context, getGatewayOptions *GetGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getGatewayOptions, "getGatewayOptions cannot be nil")
 	if err != nil {
 		return
@@ -3721,7 +4241,10 @@ func (discovery *DiscoveryV1) GetGateway(getGatewayOptions *GetGatewayOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Gateway))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Gateway)
@@ -3736,6 +4259,11 @@ func (discovery *DiscoveryV1) GetGateway(getGatewayOptions *GetGatewayOptions) (
 // DeleteGateway : Delete Gateway
 // Delete the specified gateway configuration.
 func (discovery *DiscoveryV1) DeleteGateway(deleteGatewayOptions *DeleteGatewayOptions) (result *GatewayDelete, response *core.DetailedResponse, err error) {
+	return discovery.DeleteGatewayWithContext(context.Background(), deleteGatewayOptions)
+}
+
+// DeleteGatewayWithContext is an alternate form of the DeleteGateway method which supports a Context parameter
+func (discovery *DiscoveryV1) DeleteGatewayWithContext(ctx context.Context, deleteGatewayOptions *DeleteGatewayOptions) (result *GatewayDelete, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteGatewayOptions, "deleteGatewayOptions cannot be nil")
 	if err != nil {
 		return
@@ -3771,7 +4299,10 @@ func (discovery *DiscoveryV1) DeleteGateway(deleteGatewayOptions *DeleteGatewayO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(GatewayDelete))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*GatewayDelete)
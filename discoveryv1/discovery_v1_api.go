@@ -0,0 +1,228 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// DiscoveryV1API : The operations of the DiscoveryV1 service.
+// It is implemented by *DiscoveryV1, and by *MockDiscoveryV1 for unit tests.
+type DiscoveryV1API interface {
+	CreateEnvironment(createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	CreateEnvironmentWithContext(ctx context.Context, createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	ListEnvironments(listEnvironmentsOptions *ListEnvironmentsOptions) (result *ListEnvironmentsResponse, response *core.DetailedResponse, err error)
+	ListEnvironmentsWithContext(ctx context.Context, listEnvironmentsOptions *ListEnvironmentsOptions) (result *ListEnvironmentsResponse, response *core.DetailedResponse, err error)
+	GetEnvironment(getEnvironmentOptions *GetEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	GetEnvironmentWithContext(ctx context.Context, getEnvironmentOptions *GetEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	UpdateEnvironment(updateEnvironmentOptions *UpdateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	UpdateEnvironmentWithContext(ctx context.Context, updateEnvironmentOptions *UpdateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	DeleteEnvironment(deleteEnvironmentOptions *DeleteEnvironmentOptions) (result *DeleteEnvironmentResponse, response *core.DetailedResponse, err error)
+	DeleteEnvironmentWithContext(ctx context.Context, deleteEnvironmentOptions *DeleteEnvironmentOptions) (result *DeleteEnvironmentResponse, response *core.DetailedResponse, err error)
+	ListFields(listFieldsOptions *ListFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error)
+	ListFieldsWithContext(ctx context.Context, listFieldsOptions *ListFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error)
+	CreateConfiguration(createConfigurationOptions *CreateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	CreateConfigurationWithContext(ctx context.Context, createConfigurationOptions *CreateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	ListConfigurations(listConfigurationsOptions *ListConfigurationsOptions) (result *ListConfigurationsResponse, response *core.DetailedResponse, err error)
+	ListConfigurationsWithContext(ctx context.Context, listConfigurationsOptions *ListConfigurationsOptions) (result *ListConfigurationsResponse, response *core.DetailedResponse, err error)
+	GetConfiguration(getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	DeleteConfiguration(deleteConfigurationOptions *DeleteConfigurationOptions) (result *DeleteConfigurationResponse, response *core.DetailedResponse, err error)
+	DeleteConfigurationWithContext(ctx context.Context, deleteConfigurationOptions *DeleteConfigurationOptions) (result *DeleteConfigurationResponse, response *core.DetailedResponse, err error)
+	CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error)
+	ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error)
+	GetCollection(getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (result *DeleteCollectionResponse, response *core.DetailedResponse, err error)
+	DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (result *DeleteCollectionResponse, response *core.DetailedResponse, err error)
+	ListCollectionFields(listCollectionFieldsOptions *ListCollectionFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error)
+	ListCollectionFieldsWithContext(ctx context.Context, listCollectionFieldsOptions *ListCollectionFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error)
+	ListExpansions(listExpansionsOptions *ListExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error)
+	ListExpansionsWithContext(ctx context.Context, listExpansionsOptions *ListExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error)
+	CreateExpansions(createExpansionsOptions *CreateExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error)
+	CreateExpansionsWithContext(ctx context.Context, createExpansionsOptions *CreateExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error)
+	DeleteExpansions(deleteExpansionsOptions *DeleteExpansionsOptions) (response *core.DetailedResponse, err error)
+	DeleteExpansionsWithContext(ctx context.Context, deleteExpansionsOptions *DeleteExpansionsOptions) (response *core.DetailedResponse, err error)
+	GetTokenizationDictionaryStatus(getTokenizationDictionaryStatusOptions *GetTokenizationDictionaryStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	GetTokenizationDictionaryStatusWithContext(ctx context.Context, getTokenizationDictionaryStatusOptions *GetTokenizationDictionaryStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	CreateTokenizationDictionary(createTokenizationDictionaryOptions *CreateTokenizationDictionaryOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	CreateTokenizationDictionaryWithContext(ctx context.Context, createTokenizationDictionaryOptions *CreateTokenizationDictionaryOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	DeleteTokenizationDictionary(deleteTokenizationDictionaryOptions *DeleteTokenizationDictionaryOptions) (response *core.DetailedResponse, err error)
+	DeleteTokenizationDictionaryWithContext(ctx context.Context, deleteTokenizationDictionaryOptions *DeleteTokenizationDictionaryOptions) (response *core.DetailedResponse, err error)
+	GetStopwordListStatus(getStopwordListStatusOptions *GetStopwordListStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	GetStopwordListStatusWithContext(ctx context.Context, getStopwordListStatusOptions *GetStopwordListStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	CreateStopwordList(createStopwordListOptions *CreateStopwordListOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	CreateStopwordListWithContext(ctx context.Context, createStopwordListOptions *CreateStopwordListOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	DeleteStopwordList(deleteStopwordListOptions *DeleteStopwordListOptions) (response *core.DetailedResponse, err error)
+	DeleteStopwordListWithContext(ctx context.Context, deleteStopwordListOptions *DeleteStopwordListOptions) (response *core.DetailedResponse, err error)
+	AddDocument(addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	AddDocumentWithContext(ctx context.Context, addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	GetDocumentStatus(getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	GetDocumentStatusWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	UpdateDocument(updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	UpdateDocumentWithContext(ctx context.Context, updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error)
+	DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error)
+	Query(queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error)
+	QueryWithContext(ctx context.Context, queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error)
+	QueryNotices(queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error)
+	QueryNoticesWithContext(ctx context.Context, queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error)
+	FederatedQuery(federatedQueryOptions *FederatedQueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error)
+	FederatedQueryWithContext(ctx context.Context, federatedQueryOptions *FederatedQueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error)
+	FederatedQueryNotices(federatedQueryNoticesOptions *FederatedQueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error)
+	FederatedQueryNoticesWithContext(ctx context.Context, federatedQueryNoticesOptions *FederatedQueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error)
+	GetAutocompletion(getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error)
+	GetAutocompletionWithContext(ctx context.Context, getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error)
+	ListTrainingData(listTrainingDataOptions *ListTrainingDataOptions) (result *TrainingDataSet, response *core.DetailedResponse, err error)
+	ListTrainingDataWithContext(ctx context.Context, listTrainingDataOptions *ListTrainingDataOptions) (result *TrainingDataSet, response *core.DetailedResponse, err error)
+	AddTrainingData(addTrainingDataOptions *AddTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	AddTrainingDataWithContext(ctx context.Context, addTrainingDataOptions *AddTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	DeleteAllTrainingData(deleteAllTrainingDataOptions *DeleteAllTrainingDataOptions) (response *core.DetailedResponse, err error)
+	DeleteAllTrainingDataWithContext(ctx context.Context, deleteAllTrainingDataOptions *DeleteAllTrainingDataOptions) (response *core.DetailedResponse, err error)
+	GetTrainingData(getTrainingDataOptions *GetTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	GetTrainingDataWithContext(ctx context.Context, getTrainingDataOptions *GetTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	DeleteTrainingData(deleteTrainingDataOptions *DeleteTrainingDataOptions) (response *core.DetailedResponse, err error)
+	DeleteTrainingDataWithContext(ctx context.Context, deleteTrainingDataOptions *DeleteTrainingDataOptions) (response *core.DetailedResponse, err error)
+	ListTrainingExamples(listTrainingExamplesOptions *ListTrainingExamplesOptions) (result *TrainingExampleList, response *core.DetailedResponse, err error)
+	ListTrainingExamplesWithContext(ctx context.Context, listTrainingExamplesOptions *ListTrainingExamplesOptions) (result *TrainingExampleList, response *core.DetailedResponse, err error)
+	CreateTrainingExample(createTrainingExampleOptions *CreateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	CreateTrainingExampleWithContext(ctx context.Context, createTrainingExampleOptions *CreateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	DeleteTrainingExample(deleteTrainingExampleOptions *DeleteTrainingExampleOptions) (response *core.DetailedResponse, err error)
+	DeleteTrainingExampleWithContext(ctx context.Context, deleteTrainingExampleOptions *DeleteTrainingExampleOptions) (response *core.DetailedResponse, err error)
+	UpdateTrainingExample(updateTrainingExampleOptions *UpdateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	UpdateTrainingExampleWithContext(ctx context.Context, updateTrainingExampleOptions *UpdateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	GetTrainingExample(getTrainingExampleOptions *GetTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	GetTrainingExampleWithContext(ctx context.Context, getTrainingExampleOptions *GetTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	CreateEvent(createEventOptions *CreateEventOptions) (result *CreateEventResponse, response *core.DetailedResponse, err error)
+	CreateEventWithContext(ctx context.Context, createEventOptions *CreateEventOptions) (result *CreateEventResponse, response *core.DetailedResponse, err error)
+	QueryLog(queryLogOptions *QueryLogOptions) (result *LogQueryResponse, response *core.DetailedResponse, err error)
+	QueryLogWithContext(ctx context.Context, queryLogOptions *QueryLogOptions) (result *LogQueryResponse, response *core.DetailedResponse, err error)
+	GetMetricsQuery(getMetricsQueryOptions *GetMetricsQueryOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryWithContext(ctx context.Context, getMetricsQueryOptions *GetMetricsQueryOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryEvent(getMetricsQueryEventOptions *GetMetricsQueryEventOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryEventWithContext(ctx context.Context, getMetricsQueryEventOptions *GetMetricsQueryEventOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryNoResults(getMetricsQueryNoResultsOptions *GetMetricsQueryNoResultsOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryNoResultsWithContext(ctx context.Context, getMetricsQueryNoResultsOptions *GetMetricsQueryNoResultsOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsEventRate(getMetricsEventRateOptions *GetMetricsEventRateOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsEventRateWithContext(ctx context.Context, getMetricsEventRateOptions *GetMetricsEventRateOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryTokenEvent(getMetricsQueryTokenEventOptions *GetMetricsQueryTokenEventOptions) (result *MetricTokenResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryTokenEventWithContext(ctx context.Context, getMetricsQueryTokenEventOptions *GetMetricsQueryTokenEventOptions) (result *MetricTokenResponse, response *core.DetailedResponse, err error)
+	ListCredentials(listCredentialsOptions *ListCredentialsOptions) (result *CredentialsList, response *core.DetailedResponse, err error)
+	ListCredentialsWithContext(ctx context.Context, listCredentialsOptions *ListCredentialsOptions) (result *CredentialsList, response *core.DetailedResponse, err error)
+	CreateCredentials(createCredentialsOptions *CreateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	CreateCredentialsWithContext(ctx context.Context, createCredentialsOptions *CreateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	GetCredentials(getCredentialsOptions *GetCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	GetCredentialsWithContext(ctx context.Context, getCredentialsOptions *GetCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	UpdateCredentials(updateCredentialsOptions *UpdateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	UpdateCredentialsWithContext(ctx context.Context, updateCredentialsOptions *UpdateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	DeleteCredentials(deleteCredentialsOptions *DeleteCredentialsOptions) (result *DeleteCredentials, response *core.DetailedResponse, err error)
+	DeleteCredentialsWithContext(ctx context.Context, deleteCredentialsOptions *DeleteCredentialsOptions) (result *DeleteCredentials, response *core.DetailedResponse, err error)
+	ListGateways(listGatewaysOptions *ListGatewaysOptions) (result *GatewayList, response *core.DetailedResponse, err error)
+	ListGatewaysWithContext(ctx context.Context, listGatewaysOptions *ListGatewaysOptions) (result *GatewayList, response *core.DetailedResponse, err error)
+	CreateGateway(createGatewayOptions *CreateGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error)
+	CreateGatewayWithContext(ctx context.Context, createGatewayOptions *CreateGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error)
+	GetGateway(getGatewayOptions *GetGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error)
+	GetGatewayWithContext(ctx context.Context, getGatewayOptions *GetGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error)
+	DeleteGateway(deleteGatewayOptions *DeleteGatewayOptions) (result *GatewayDelete, response *core.DetailedResponse, err error)
+	DeleteGatewayWithContext(ctx context.Context, deleteGatewayOptions *DeleteGatewayOptions) (result *GatewayDelete, response *core.DetailedResponse, err error)
+	NewAddDocumentOptions(environmentID string, collectionID string) *AddDocumentOptions
+	NewAddTrainingDataOptions(environmentID string, collectionID string) *AddTrainingDataOptions
+	NewConfiguration(name string) (model *Configuration, err error)
+	NewCreateCollectionOptions(environmentID string, name string) *CreateCollectionOptions
+	NewCreateConfigurationOptions(environmentID string, name string) *CreateConfigurationOptions
+	NewCreateCredentialsOptions(environmentID string) *CreateCredentialsOptions
+	NewCreateEnvironmentOptions(name string) *CreateEnvironmentOptions
+	NewCreateEventOptions(typeVar string, data *EventData) *CreateEventOptions
+	NewCreateExpansionsOptions(environmentID string, collectionID string, expansions []Expansion) *CreateExpansionsOptions
+	NewCreateGatewayOptions(environmentID string) *CreateGatewayOptions
+	NewCreateStopwordListOptions(environmentID string, collectionID string, stopwordFile io.ReadCloser, stopwordFilename string) *CreateStopwordListOptions
+	NewCreateTokenizationDictionaryOptions(environmentID string, collectionID string) *CreateTokenizationDictionaryOptions
+	NewCreateTrainingExampleOptions(environmentID string, collectionID string, queryID string) *CreateTrainingExampleOptions
+	NewDeleteAllTrainingDataOptions(environmentID string, collectionID string) *DeleteAllTrainingDataOptions
+	NewDeleteCollectionOptions(environmentID string, collectionID string) *DeleteCollectionOptions
+	NewDeleteConfigurationOptions(environmentID string, configurationID string) *DeleteConfigurationOptions
+	NewDeleteCredentialsOptions(environmentID string, credentialID string) *DeleteCredentialsOptions
+	NewDeleteDocumentOptions(environmentID string, collectionID string, documentID string) *DeleteDocumentOptions
+	NewDeleteEnvironmentOptions(environmentID string) *DeleteEnvironmentOptions
+	NewDeleteExpansionsOptions(environmentID string, collectionID string) *DeleteExpansionsOptions
+	NewDeleteGatewayOptions(environmentID string, gatewayID string) *DeleteGatewayOptions
+	NewDeleteStopwordListOptions(environmentID string, collectionID string) *DeleteStopwordListOptions
+	NewDeleteTokenizationDictionaryOptions(environmentID string, collectionID string) *DeleteTokenizationDictionaryOptions
+	NewDeleteTrainingDataOptions(environmentID string, collectionID string, queryID string) *DeleteTrainingDataOptions
+	NewDeleteTrainingExampleOptions(environmentID string, collectionID string, queryID string, exampleID string) *DeleteTrainingExampleOptions
+	NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions
+	NewEnrichment(destinationField string, sourceField string, enrichment string) (model *Enrichment, err error)
+	NewEventData(environmentID string, sessionToken string, collectionID string, documentID string) (model *EventData, err error)
+	NewExpansion(expandedTerms []string) (model *Expansion, err error)
+	NewExpansions(expansions []Expansion) (model *Expansions, err error)
+	NewFederatedQueryNoticesOptions(environmentID string, collectionIds []string) *FederatedQueryNoticesOptions
+	NewFederatedQueryOptions(environmentID string, collectionIds string) *FederatedQueryOptions
+	NewGetAutocompletionOptions(environmentID string, collectionID string, prefix string) *GetAutocompletionOptions
+	NewGetCollectionOptions(environmentID string, collectionID string) *GetCollectionOptions
+	NewGetConfigurationOptions(environmentID string, configurationID string) *GetConfigurationOptions
+	NewGetCredentialsOptions(environmentID string, credentialID string) *GetCredentialsOptions
+	NewGetDocumentStatusOptions(environmentID string, collectionID string, documentID string) *GetDocumentStatusOptions
+	NewGetEnvironmentOptions(environmentID string) *GetEnvironmentOptions
+	NewGetGatewayOptions(environmentID string, gatewayID string) *GetGatewayOptions
+	NewGetMetricsEventRateOptions() *GetMetricsEventRateOptions
+	NewGetMetricsQueryEventOptions() *GetMetricsQueryEventOptions
+	NewGetMetricsQueryNoResultsOptions() *GetMetricsQueryNoResultsOptions
+	NewGetMetricsQueryOptions() *GetMetricsQueryOptions
+	NewGetMetricsQueryTokenEventOptions() *GetMetricsQueryTokenEventOptions
+	NewGetStopwordListStatusOptions(environmentID string, collectionID string) *GetStopwordListStatusOptions
+	NewGetTokenizationDictionaryStatusOptions(environmentID string, collectionID string) *GetTokenizationDictionaryStatusOptions
+	NewGetTrainingDataOptions(environmentID string, collectionID string, queryID string) *GetTrainingDataOptions
+	NewGetTrainingExampleOptions(environmentID string, collectionID string, queryID string, exampleID string) *GetTrainingExampleOptions
+	NewListCollectionFieldsOptions(environmentID string, collectionID string) *ListCollectionFieldsOptions
+	NewListCollectionsOptions(environmentID string) *ListCollectionsOptions
+	NewListConfigurationsOptions(environmentID string) *ListConfigurationsOptions
+	NewListCredentialsOptions(environmentID string) *ListCredentialsOptions
+	NewListEnvironmentsOptions() *ListEnvironmentsOptions
+	NewListExpansionsOptions(environmentID string, collectionID string) *ListExpansionsOptions
+	NewListFieldsOptions(environmentID string, collectionIds []string) *ListFieldsOptions
+	NewListGatewaysOptions(environmentID string) *ListGatewaysOptions
+	NewListTrainingDataOptions(environmentID string, collectionID string) *ListTrainingDataOptions
+	NewListTrainingExamplesOptions(environmentID string, collectionID string, queryID string) *ListTrainingExamplesOptions
+	NewQueryLogOptions() *QueryLogOptions
+	NewQueryNoticesOptions(environmentID string, collectionID string) *QueryNoticesOptions
+	NewQueryOptions(environmentID string, collectionID string) *QueryOptions
+	NewSourceOptionsBuckets(name string) (model *SourceOptionsBuckets, err error)
+	NewSourceOptionsFolder(ownerUserID string, folderID string) (model *SourceOptionsFolder, err error)
+	NewSourceOptionsObject(name string) (model *SourceOptionsObject, err error)
+	NewSourceOptionsSiteColl(siteCollectionPath string) (model *SourceOptionsSiteColl, err error)
+	NewSourceOptionsWebCrawl(URL string) (model *SourceOptionsWebCrawl, err error)
+	NewTokenDictRule(text string, tokens []string, partOfSpeech string) (model *TokenDictRule, err error)
+	NewUpdateCollectionOptions(environmentID string, collectionID string, name string) *UpdateCollectionOptions
+	NewUpdateConfigurationOptions(environmentID string, configurationID string, name string) *UpdateConfigurationOptions
+	NewUpdateCredentialsOptions(environmentID string, credentialID string) *UpdateCredentialsOptions
+	NewUpdateDocumentOptions(environmentID string, collectionID string, documentID string) *UpdateDocumentOptions
+	NewUpdateEnvironmentOptions(environmentID string) *UpdateEnvironmentOptions
+	NewUpdateTrainingExampleOptions(environmentID string, collectionID string, queryID string, exampleID string) *UpdateTrainingExampleOptions
+}
+
+var _ DiscoveryV1API = (*DiscoveryV1)(nil)
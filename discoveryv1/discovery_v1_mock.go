@@ -0,0 +1,1392 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockDiscoveryV1 : A mock implementation of DiscoveryV1API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *DiscoveryV1.
+type MockDiscoveryV1 struct {
+	common.MockRecorder
+
+	CreateEnvironmentFunc               func(ctx context.Context, createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	ListEnvironmentsFunc                func(ctx context.Context, listEnvironmentsOptions *ListEnvironmentsOptions) (result *ListEnvironmentsResponse, response *core.DetailedResponse, err error)
+	GetEnvironmentFunc                  func(ctx context.Context, getEnvironmentOptions *GetEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	UpdateEnvironmentFunc               func(ctx context.Context, updateEnvironmentOptions *UpdateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error)
+	DeleteEnvironmentFunc               func(ctx context.Context, deleteEnvironmentOptions *DeleteEnvironmentOptions) (result *DeleteEnvironmentResponse, response *core.DetailedResponse, err error)
+	ListFieldsFunc                      func(ctx context.Context, listFieldsOptions *ListFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error)
+	CreateConfigurationFunc             func(ctx context.Context, createConfigurationOptions *CreateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	ListConfigurationsFunc              func(ctx context.Context, listConfigurationsOptions *ListConfigurationsOptions) (result *ListConfigurationsResponse, response *core.DetailedResponse, err error)
+	GetConfigurationFunc                func(ctx context.Context, getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	UpdateConfigurationFunc             func(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+	DeleteConfigurationFunc             func(ctx context.Context, deleteConfigurationOptions *DeleteConfigurationOptions) (result *DeleteConfigurationResponse, response *core.DetailedResponse, err error)
+	CreateCollectionFunc                func(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	ListCollectionsFunc                 func(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error)
+	GetCollectionFunc                   func(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	UpdateCollectionFunc                func(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	DeleteCollectionFunc                func(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (result *DeleteCollectionResponse, response *core.DetailedResponse, err error)
+	ListCollectionFieldsFunc            func(ctx context.Context, listCollectionFieldsOptions *ListCollectionFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error)
+	ListExpansionsFunc                  func(ctx context.Context, listExpansionsOptions *ListExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error)
+	CreateExpansionsFunc                func(ctx context.Context, createExpansionsOptions *CreateExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error)
+	DeleteExpansionsFunc                func(ctx context.Context, deleteExpansionsOptions *DeleteExpansionsOptions) (response *core.DetailedResponse, err error)
+	GetTokenizationDictionaryStatusFunc func(ctx context.Context, getTokenizationDictionaryStatusOptions *GetTokenizationDictionaryStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	CreateTokenizationDictionaryFunc    func(ctx context.Context, createTokenizationDictionaryOptions *CreateTokenizationDictionaryOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	DeleteTokenizationDictionaryFunc    func(ctx context.Context, deleteTokenizationDictionaryOptions *DeleteTokenizationDictionaryOptions) (response *core.DetailedResponse, err error)
+	GetStopwordListStatusFunc           func(ctx context.Context, getStopwordListStatusOptions *GetStopwordListStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	CreateStopwordListFunc              func(ctx context.Context, createStopwordListOptions *CreateStopwordListOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error)
+	DeleteStopwordListFunc              func(ctx context.Context, deleteStopwordListOptions *DeleteStopwordListOptions) (response *core.DetailedResponse, err error)
+	AddDocumentFunc                     func(ctx context.Context, addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	GetDocumentStatusFunc               func(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	UpdateDocumentFunc                  func(ctx context.Context, updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	DeleteDocumentFunc                  func(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error)
+	QueryFunc                           func(ctx context.Context, queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error)
+	QueryNoticesFunc                    func(ctx context.Context, queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error)
+	FederatedQueryFunc                  func(ctx context.Context, federatedQueryOptions *FederatedQueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error)
+	FederatedQueryNoticesFunc           func(ctx context.Context, federatedQueryNoticesOptions *FederatedQueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error)
+	GetAutocompletionFunc               func(ctx context.Context, getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error)
+	ListTrainingDataFunc                func(ctx context.Context, listTrainingDataOptions *ListTrainingDataOptions) (result *TrainingDataSet, response *core.DetailedResponse, err error)
+	AddTrainingDataFunc                 func(ctx context.Context, addTrainingDataOptions *AddTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	DeleteAllTrainingDataFunc           func(ctx context.Context, deleteAllTrainingDataOptions *DeleteAllTrainingDataOptions) (response *core.DetailedResponse, err error)
+	GetTrainingDataFunc                 func(ctx context.Context, getTrainingDataOptions *GetTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	DeleteTrainingDataFunc              func(ctx context.Context, deleteTrainingDataOptions *DeleteTrainingDataOptions) (response *core.DetailedResponse, err error)
+	ListTrainingExamplesFunc            func(ctx context.Context, listTrainingExamplesOptions *ListTrainingExamplesOptions) (result *TrainingExampleList, response *core.DetailedResponse, err error)
+	CreateTrainingExampleFunc           func(ctx context.Context, createTrainingExampleOptions *CreateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	DeleteTrainingExampleFunc           func(ctx context.Context, deleteTrainingExampleOptions *DeleteTrainingExampleOptions) (response *core.DetailedResponse, err error)
+	UpdateTrainingExampleFunc           func(ctx context.Context, updateTrainingExampleOptions *UpdateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	GetTrainingExampleFunc              func(ctx context.Context, getTrainingExampleOptions *GetTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error)
+	DeleteUserDataFunc                  func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	CreateEventFunc                     func(ctx context.Context, createEventOptions *CreateEventOptions) (result *CreateEventResponse, response *core.DetailedResponse, err error)
+	QueryLogFunc                        func(ctx context.Context, queryLogOptions *QueryLogOptions) (result *LogQueryResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryFunc                 func(ctx context.Context, getMetricsQueryOptions *GetMetricsQueryOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryEventFunc            func(ctx context.Context, getMetricsQueryEventOptions *GetMetricsQueryEventOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryNoResultsFunc        func(ctx context.Context, getMetricsQueryNoResultsOptions *GetMetricsQueryNoResultsOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsEventRateFunc             func(ctx context.Context, getMetricsEventRateOptions *GetMetricsEventRateOptions) (result *MetricResponse, response *core.DetailedResponse, err error)
+	GetMetricsQueryTokenEventFunc       func(ctx context.Context, getMetricsQueryTokenEventOptions *GetMetricsQueryTokenEventOptions) (result *MetricTokenResponse, response *core.DetailedResponse, err error)
+	ListCredentialsFunc                 func(ctx context.Context, listCredentialsOptions *ListCredentialsOptions) (result *CredentialsList, response *core.DetailedResponse, err error)
+	CreateCredentialsFunc               func(ctx context.Context, createCredentialsOptions *CreateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	GetCredentialsFunc                  func(ctx context.Context, getCredentialsOptions *GetCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	UpdateCredentialsFunc               func(ctx context.Context, updateCredentialsOptions *UpdateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error)
+	DeleteCredentialsFunc               func(ctx context.Context, deleteCredentialsOptions *DeleteCredentialsOptions) (result *DeleteCredentials, response *core.DetailedResponse, err error)
+	ListGatewaysFunc                    func(ctx context.Context, listGatewaysOptions *ListGatewaysOptions) (result *GatewayList, response *core.DetailedResponse, err error)
+	CreateGatewayFunc                   func(ctx context.Context, createGatewayOptions *CreateGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error)
+	GetGatewayFunc                      func(ctx context.Context, getGatewayOptions *GetGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error)
+	DeleteGatewayFunc                   func(ctx context.Context, deleteGatewayOptions *DeleteGatewayOptions) (result *GatewayDelete, response *core.DetailedResponse, err error)
+}
+
+var _ DiscoveryV1API = (*MockDiscoveryV1)(nil)
+
+// CreateEnvironment records the call and invokes CreateEnvironmentFunc
+func (mock *MockDiscoveryV1) CreateEnvironment(createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	return mock.CreateEnvironmentWithContext(context.Background(), createEnvironmentOptions)
+}
+
+// CreateEnvironmentWithContext records the call and invokes CreateEnvironmentFunc
+func (mock *MockDiscoveryV1) CreateEnvironmentWithContext(ctx context.Context, createEnvironmentOptions *CreateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateEnvironment", createEnvironmentOptions)
+	if mock.CreateEnvironmentFunc != nil {
+		return mock.CreateEnvironmentFunc(ctx, createEnvironmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateEnvironment")
+	return
+}
+
+// ListEnvironments records the call and invokes ListEnvironmentsFunc
+func (mock *MockDiscoveryV1) ListEnvironments(listEnvironmentsOptions *ListEnvironmentsOptions) (result *ListEnvironmentsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListEnvironmentsWithContext(context.Background(), listEnvironmentsOptions)
+}
+
+// ListEnvironmentsWithContext records the call and invokes ListEnvironmentsFunc
+func (mock *MockDiscoveryV1) ListEnvironmentsWithContext(ctx context.Context, listEnvironmentsOptions *ListEnvironmentsOptions) (result *ListEnvironmentsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListEnvironments", listEnvironmentsOptions)
+	if mock.ListEnvironmentsFunc != nil {
+		return mock.ListEnvironmentsFunc(ctx, listEnvironmentsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListEnvironments")
+	return
+}
+
+// GetEnvironment records the call and invokes GetEnvironmentFunc
+func (mock *MockDiscoveryV1) GetEnvironment(getEnvironmentOptions *GetEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	return mock.GetEnvironmentWithContext(context.Background(), getEnvironmentOptions)
+}
+
+// GetEnvironmentWithContext records the call and invokes GetEnvironmentFunc
+func (mock *MockDiscoveryV1) GetEnvironmentWithContext(ctx context.Context, getEnvironmentOptions *GetEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetEnvironment", getEnvironmentOptions)
+	if mock.GetEnvironmentFunc != nil {
+		return mock.GetEnvironmentFunc(ctx, getEnvironmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetEnvironment")
+	return
+}
+
+// UpdateEnvironment records the call and invokes UpdateEnvironmentFunc
+func (mock *MockDiscoveryV1) UpdateEnvironment(updateEnvironmentOptions *UpdateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	return mock.UpdateEnvironmentWithContext(context.Background(), updateEnvironmentOptions)
+}
+
+// UpdateEnvironmentWithContext records the call and invokes UpdateEnvironmentFunc
+func (mock *MockDiscoveryV1) UpdateEnvironmentWithContext(ctx context.Context, updateEnvironmentOptions *UpdateEnvironmentOptions) (result *Environment, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateEnvironment", updateEnvironmentOptions)
+	if mock.UpdateEnvironmentFunc != nil {
+		return mock.UpdateEnvironmentFunc(ctx, updateEnvironmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "UpdateEnvironment")
+	return
+}
+
+// DeleteEnvironment records the call and invokes DeleteEnvironmentFunc
+func (mock *MockDiscoveryV1) DeleteEnvironment(deleteEnvironmentOptions *DeleteEnvironmentOptions) (result *DeleteEnvironmentResponse, response *core.DetailedResponse, err error) {
+	return mock.DeleteEnvironmentWithContext(context.Background(), deleteEnvironmentOptions)
+}
+
+// DeleteEnvironmentWithContext records the call and invokes DeleteEnvironmentFunc
+func (mock *MockDiscoveryV1) DeleteEnvironmentWithContext(ctx context.Context, deleteEnvironmentOptions *DeleteEnvironmentOptions) (result *DeleteEnvironmentResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteEnvironment", deleteEnvironmentOptions)
+	if mock.DeleteEnvironmentFunc != nil {
+		return mock.DeleteEnvironmentFunc(ctx, deleteEnvironmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteEnvironment")
+	return
+}
+
+// ListFields records the call and invokes ListFieldsFunc
+func (mock *MockDiscoveryV1) ListFields(listFieldsOptions *ListFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListFieldsWithContext(context.Background(), listFieldsOptions)
+}
+
+// ListFieldsWithContext records the call and invokes ListFieldsFunc
+func (mock *MockDiscoveryV1) ListFieldsWithContext(ctx context.Context, listFieldsOptions *ListFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListFields", listFieldsOptions)
+	if mock.ListFieldsFunc != nil {
+		return mock.ListFieldsFunc(ctx, listFieldsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListFields")
+	return
+}
+
+// CreateConfiguration records the call and invokes CreateConfigurationFunc
+func (mock *MockDiscoveryV1) CreateConfiguration(createConfigurationOptions *CreateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	return mock.CreateConfigurationWithContext(context.Background(), createConfigurationOptions)
+}
+
+// CreateConfigurationWithContext records the call and invokes CreateConfigurationFunc
+func (mock *MockDiscoveryV1) CreateConfigurationWithContext(ctx context.Context, createConfigurationOptions *CreateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateConfiguration", createConfigurationOptions)
+	if mock.CreateConfigurationFunc != nil {
+		return mock.CreateConfigurationFunc(ctx, createConfigurationOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateConfiguration")
+	return
+}
+
+// ListConfigurations records the call and invokes ListConfigurationsFunc
+func (mock *MockDiscoveryV1) ListConfigurations(listConfigurationsOptions *ListConfigurationsOptions) (result *ListConfigurationsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListConfigurationsWithContext(context.Background(), listConfigurationsOptions)
+}
+
+// ListConfigurationsWithContext records the call and invokes ListConfigurationsFunc
+func (mock *MockDiscoveryV1) ListConfigurationsWithContext(ctx context.Context, listConfigurationsOptions *ListConfigurationsOptions) (result *ListConfigurationsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListConfigurations", listConfigurationsOptions)
+	if mock.ListConfigurationsFunc != nil {
+		return mock.ListConfigurationsFunc(ctx, listConfigurationsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListConfigurations")
+	return
+}
+
+// GetConfiguration records the call and invokes GetConfigurationFunc
+func (mock *MockDiscoveryV1) GetConfiguration(getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	return mock.GetConfigurationWithContext(context.Background(), getConfigurationOptions)
+}
+
+// GetConfigurationWithContext records the call and invokes GetConfigurationFunc
+func (mock *MockDiscoveryV1) GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetConfiguration", getConfigurationOptions)
+	if mock.GetConfigurationFunc != nil {
+		return mock.GetConfigurationFunc(ctx, getConfigurationOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetConfiguration")
+	return
+}
+
+// UpdateConfiguration records the call and invokes UpdateConfigurationFunc
+func (mock *MockDiscoveryV1) UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	return mock.UpdateConfigurationWithContext(context.Background(), updateConfigurationOptions)
+}
+
+// UpdateConfigurationWithContext records the call and invokes UpdateConfigurationFunc
+func (mock *MockDiscoveryV1) UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateConfiguration", updateConfigurationOptions)
+	if mock.UpdateConfigurationFunc != nil {
+		return mock.UpdateConfigurationFunc(ctx, updateConfigurationOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "UpdateConfiguration")
+	return
+}
+
+// DeleteConfiguration records the call and invokes DeleteConfigurationFunc
+func (mock *MockDiscoveryV1) DeleteConfiguration(deleteConfigurationOptions *DeleteConfigurationOptions) (result *DeleteConfigurationResponse, response *core.DetailedResponse, err error) {
+	return mock.DeleteConfigurationWithContext(context.Background(), deleteConfigurationOptions)
+}
+
+// DeleteConfigurationWithContext records the call and invokes DeleteConfigurationFunc
+func (mock *MockDiscoveryV1) DeleteConfigurationWithContext(ctx context.Context, deleteConfigurationOptions *DeleteConfigurationOptions) (result *DeleteConfigurationResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteConfiguration", deleteConfigurationOptions)
+	if mock.DeleteConfigurationFunc != nil {
+		return mock.DeleteConfigurationFunc(ctx, deleteConfigurationOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteConfiguration")
+	return
+}
+
+// CreateCollection records the call and invokes CreateCollectionFunc
+func (mock *MockDiscoveryV1) CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return mock.CreateCollectionWithContext(context.Background(), createCollectionOptions)
+}
+
+// CreateCollectionWithContext records the call and invokes CreateCollectionFunc
+func (mock *MockDiscoveryV1) CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateCollection", createCollectionOptions)
+	if mock.CreateCollectionFunc != nil {
+		return mock.CreateCollectionFunc(ctx, createCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateCollection")
+	return
+}
+
+// ListCollections records the call and invokes ListCollectionsFunc
+func (mock *MockDiscoveryV1) ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListCollectionsWithContext(context.Background(), listCollectionsOptions)
+}
+
+// ListCollectionsWithContext records the call and invokes ListCollectionsFunc
+func (mock *MockDiscoveryV1) ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListCollections", listCollectionsOptions)
+	if mock.ListCollectionsFunc != nil {
+		return mock.ListCollectionsFunc(ctx, listCollectionsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListCollections")
+	return
+}
+
+// GetCollection records the call and invokes GetCollectionFunc
+func (mock *MockDiscoveryV1) GetCollection(getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return mock.GetCollectionWithContext(context.Background(), getCollectionOptions)
+}
+
+// GetCollectionWithContext records the call and invokes GetCollectionFunc
+func (mock *MockDiscoveryV1) GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetCollection", getCollectionOptions)
+	if mock.GetCollectionFunc != nil {
+		return mock.GetCollectionFunc(ctx, getCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetCollection")
+	return
+}
+
+// UpdateCollection records the call and invokes UpdateCollectionFunc
+func (mock *MockDiscoveryV1) UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return mock.UpdateCollectionWithContext(context.Background(), updateCollectionOptions)
+}
+
+// UpdateCollectionWithContext records the call and invokes UpdateCollectionFunc
+func (mock *MockDiscoveryV1) UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateCollection", updateCollectionOptions)
+	if mock.UpdateCollectionFunc != nil {
+		return mock.UpdateCollectionFunc(ctx, updateCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "UpdateCollection")
+	return
+}
+
+// DeleteCollection records the call and invokes DeleteCollectionFunc
+func (mock *MockDiscoveryV1) DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (result *DeleteCollectionResponse, response *core.DetailedResponse, err error) {
+	return mock.DeleteCollectionWithContext(context.Background(), deleteCollectionOptions)
+}
+
+// DeleteCollectionWithContext records the call and invokes DeleteCollectionFunc
+func (mock *MockDiscoveryV1) DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (result *DeleteCollectionResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteCollection", deleteCollectionOptions)
+	if mock.DeleteCollectionFunc != nil {
+		return mock.DeleteCollectionFunc(ctx, deleteCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteCollection")
+	return
+}
+
+// ListCollectionFields records the call and invokes ListCollectionFieldsFunc
+func (mock *MockDiscoveryV1) ListCollectionFields(listCollectionFieldsOptions *ListCollectionFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListCollectionFieldsWithContext(context.Background(), listCollectionFieldsOptions)
+}
+
+// ListCollectionFieldsWithContext records the call and invokes ListCollectionFieldsFunc
+func (mock *MockDiscoveryV1) ListCollectionFieldsWithContext(ctx context.Context, listCollectionFieldsOptions *ListCollectionFieldsOptions) (result *ListCollectionFieldsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListCollectionFields", listCollectionFieldsOptions)
+	if mock.ListCollectionFieldsFunc != nil {
+		return mock.ListCollectionFieldsFunc(ctx, listCollectionFieldsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListCollectionFields")
+	return
+}
+
+// ListExpansions records the call and invokes ListExpansionsFunc
+func (mock *MockDiscoveryV1) ListExpansions(listExpansionsOptions *ListExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
+	return mock.ListExpansionsWithContext(context.Background(), listExpansionsOptions)
+}
+
+// ListExpansionsWithContext records the call and invokes ListExpansionsFunc
+func (mock *MockDiscoveryV1) ListExpansionsWithContext(ctx context.Context, listExpansionsOptions *ListExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListExpansions", listExpansionsOptions)
+	if mock.ListExpansionsFunc != nil {
+		return mock.ListExpansionsFunc(ctx, listExpansionsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListExpansions")
+	return
+}
+
+// CreateExpansions records the call and invokes CreateExpansionsFunc
+func (mock *MockDiscoveryV1) CreateExpansions(createExpansionsOptions *CreateExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
+	return mock.CreateExpansionsWithContext(context.Background(), createExpansionsOptions)
+}
+
+// CreateExpansionsWithContext records the call and invokes CreateExpansionsFunc
+func (mock *MockDiscoveryV1) CreateExpansionsWithContext(ctx context.Context, createExpansionsOptions *CreateExpansionsOptions) (result *Expansions, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateExpansions", createExpansionsOptions)
+	if mock.CreateExpansionsFunc != nil {
+		return mock.CreateExpansionsFunc(ctx, createExpansionsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateExpansions")
+	return
+}
+
+// DeleteExpansions records the call and invokes DeleteExpansionsFunc
+func (mock *MockDiscoveryV1) DeleteExpansions(deleteExpansionsOptions *DeleteExpansionsOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteExpansionsWithContext(context.Background(), deleteExpansionsOptions)
+}
+
+// DeleteExpansionsWithContext records the call and invokes DeleteExpansionsFunc
+func (mock *MockDiscoveryV1) DeleteExpansionsWithContext(ctx context.Context, deleteExpansionsOptions *DeleteExpansionsOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteExpansions", deleteExpansionsOptions)
+	if mock.DeleteExpansionsFunc != nil {
+		return mock.DeleteExpansionsFunc(ctx, deleteExpansionsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteExpansions")
+	return
+}
+
+// GetTokenizationDictionaryStatus records the call and invokes GetTokenizationDictionaryStatusFunc
+func (mock *MockDiscoveryV1) GetTokenizationDictionaryStatus(getTokenizationDictionaryStatusOptions *GetTokenizationDictionaryStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return mock.GetTokenizationDictionaryStatusWithContext(context.Background(), getTokenizationDictionaryStatusOptions)
+}
+
+// GetTokenizationDictionaryStatusWithContext records the call and invokes GetTokenizationDictionaryStatusFunc
+func (mock *MockDiscoveryV1) GetTokenizationDictionaryStatusWithContext(ctx context.Context, getTokenizationDictionaryStatusOptions *GetTokenizationDictionaryStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetTokenizationDictionaryStatus", getTokenizationDictionaryStatusOptions)
+	if mock.GetTokenizationDictionaryStatusFunc != nil {
+		return mock.GetTokenizationDictionaryStatusFunc(ctx, getTokenizationDictionaryStatusOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetTokenizationDictionaryStatus")
+	return
+}
+
+// CreateTokenizationDictionary records the call and invokes CreateTokenizationDictionaryFunc
+func (mock *MockDiscoveryV1) CreateTokenizationDictionary(createTokenizationDictionaryOptions *CreateTokenizationDictionaryOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return mock.CreateTokenizationDictionaryWithContext(context.Background(), createTokenizationDictionaryOptions)
+}
+
+// CreateTokenizationDictionaryWithContext records the call and invokes CreateTokenizationDictionaryFunc
+func (mock *MockDiscoveryV1) CreateTokenizationDictionaryWithContext(ctx context.Context, createTokenizationDictionaryOptions *CreateTokenizationDictionaryOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateTokenizationDictionary", createTokenizationDictionaryOptions)
+	if mock.CreateTokenizationDictionaryFunc != nil {
+		return mock.CreateTokenizationDictionaryFunc(ctx, createTokenizationDictionaryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateTokenizationDictionary")
+	return
+}
+
+// DeleteTokenizationDictionary records the call and invokes DeleteTokenizationDictionaryFunc
+func (mock *MockDiscoveryV1) DeleteTokenizationDictionary(deleteTokenizationDictionaryOptions *DeleteTokenizationDictionaryOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteTokenizationDictionaryWithContext(context.Background(), deleteTokenizationDictionaryOptions)
+}
+
+// DeleteTokenizationDictionaryWithContext records the call and invokes DeleteTokenizationDictionaryFunc
+func (mock *MockDiscoveryV1) DeleteTokenizationDictionaryWithContext(ctx context.Context, deleteTokenizationDictionaryOptions *DeleteTokenizationDictionaryOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteTokenizationDictionary", deleteTokenizationDictionaryOptions)
+	if mock.DeleteTokenizationDictionaryFunc != nil {
+		return mock.DeleteTokenizationDictionaryFunc(ctx, deleteTokenizationDictionaryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteTokenizationDictionary")
+	return
+}
+
+// GetStopwordListStatus records the call and invokes GetStopwordListStatusFunc
+func (mock *MockDiscoveryV1) GetStopwordListStatus(getStopwordListStatusOptions *GetStopwordListStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return mock.GetStopwordListStatusWithContext(context.Background(), getStopwordListStatusOptions)
+}
+
+// GetStopwordListStatusWithContext records the call and invokes GetStopwordListStatusFunc
+func (mock *MockDiscoveryV1) GetStopwordListStatusWithContext(ctx context.Context, getStopwordListStatusOptions *GetStopwordListStatusOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetStopwordListStatus", getStopwordListStatusOptions)
+	if mock.GetStopwordListStatusFunc != nil {
+		return mock.GetStopwordListStatusFunc(ctx, getStopwordListStatusOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetStopwordListStatus")
+	return
+}
+
+// CreateStopwordList records the call and invokes CreateStopwordListFunc
+func (mock *MockDiscoveryV1) CreateStopwordList(createStopwordListOptions *CreateStopwordListOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	return mock.CreateStopwordListWithContext(context.Background(), createStopwordListOptions)
+}
+
+// CreateStopwordListWithContext records the call and invokes CreateStopwordListFunc
+func (mock *MockDiscoveryV1) CreateStopwordListWithContext(ctx context.Context, createStopwordListOptions *CreateStopwordListOptions) (result *TokenDictStatusResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateStopwordList", createStopwordListOptions)
+	if mock.CreateStopwordListFunc != nil {
+		return mock.CreateStopwordListFunc(ctx, createStopwordListOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateStopwordList")
+	return
+}
+
+// DeleteStopwordList records the call and invokes DeleteStopwordListFunc
+func (mock *MockDiscoveryV1) DeleteStopwordList(deleteStopwordListOptions *DeleteStopwordListOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteStopwordListWithContext(context.Background(), deleteStopwordListOptions)
+}
+
+// DeleteStopwordListWithContext records the call and invokes DeleteStopwordListFunc
+func (mock *MockDiscoveryV1) DeleteStopwordListWithContext(ctx context.Context, deleteStopwordListOptions *DeleteStopwordListOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteStopwordList", deleteStopwordListOptions)
+	if mock.DeleteStopwordListFunc != nil {
+		return mock.DeleteStopwordListFunc(ctx, deleteStopwordListOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteStopwordList")
+	return
+}
+
+// AddDocument records the call and invokes AddDocumentFunc
+func (mock *MockDiscoveryV1) AddDocument(addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return mock.AddDocumentWithContext(context.Background(), addDocumentOptions)
+}
+
+// AddDocumentWithContext records the call and invokes AddDocumentFunc
+func (mock *MockDiscoveryV1) AddDocumentWithContext(ctx context.Context, addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddDocument", addDocumentOptions)
+	if mock.AddDocumentFunc != nil {
+		return mock.AddDocumentFunc(ctx, addDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "AddDocument")
+	return
+}
+
+// GetDocumentStatus records the call and invokes GetDocumentStatusFunc
+func (mock *MockDiscoveryV1) GetDocumentStatus(getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	return mock.GetDocumentStatusWithContext(context.Background(), getDocumentStatusOptions)
+}
+
+// GetDocumentStatusWithContext records the call and invokes GetDocumentStatusFunc
+func (mock *MockDiscoveryV1) GetDocumentStatusWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetDocumentStatus", getDocumentStatusOptions)
+	if mock.GetDocumentStatusFunc != nil {
+		return mock.GetDocumentStatusFunc(ctx, getDocumentStatusOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetDocumentStatus")
+	return
+}
+
+// UpdateDocument records the call and invokes UpdateDocumentFunc
+func (mock *MockDiscoveryV1) UpdateDocument(updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return mock.UpdateDocumentWithContext(context.Background(), updateDocumentOptions)
+}
+
+// UpdateDocumentWithContext records the call and invokes UpdateDocumentFunc
+func (mock *MockDiscoveryV1) UpdateDocumentWithContext(ctx context.Context, updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateDocument", updateDocumentOptions)
+	if mock.UpdateDocumentFunc != nil {
+		return mock.UpdateDocumentFunc(ctx, updateDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "UpdateDocument")
+	return
+}
+
+// DeleteDocument records the call and invokes DeleteDocumentFunc
+func (mock *MockDiscoveryV1) DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
+	return mock.DeleteDocumentWithContext(context.Background(), deleteDocumentOptions)
+}
+
+// DeleteDocumentWithContext records the call and invokes DeleteDocumentFunc
+func (mock *MockDiscoveryV1) DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteDocument", deleteDocumentOptions)
+	if mock.DeleteDocumentFunc != nil {
+		return mock.DeleteDocumentFunc(ctx, deleteDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteDocument")
+	return
+}
+
+// Query records the call and invokes QueryFunc
+func (mock *MockDiscoveryV1) Query(queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	return mock.QueryWithContext(context.Background(), queryOptions)
+}
+
+// QueryWithContext records the call and invokes QueryFunc
+func (mock *MockDiscoveryV1) QueryWithContext(ctx context.Context, queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Query", queryOptions)
+	if mock.QueryFunc != nil {
+		return mock.QueryFunc(ctx, queryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "Query")
+	return
+}
+
+// QueryNotices records the call and invokes QueryNoticesFunc
+func (mock *MockDiscoveryV1) QueryNotices(queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	return mock.QueryNoticesWithContext(context.Background(), queryNoticesOptions)
+}
+
+// QueryNoticesWithContext records the call and invokes QueryNoticesFunc
+func (mock *MockDiscoveryV1) QueryNoticesWithContext(ctx context.Context, queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "QueryNotices", queryNoticesOptions)
+	if mock.QueryNoticesFunc != nil {
+		return mock.QueryNoticesFunc(ctx, queryNoticesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "QueryNotices")
+	return
+}
+
+// FederatedQuery records the call and invokes FederatedQueryFunc
+func (mock *MockDiscoveryV1) FederatedQuery(federatedQueryOptions *FederatedQueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	return mock.FederatedQueryWithContext(context.Background(), federatedQueryOptions)
+}
+
+// FederatedQueryWithContext records the call and invokes FederatedQueryFunc
+func (mock *MockDiscoveryV1) FederatedQueryWithContext(ctx context.Context, federatedQueryOptions *FederatedQueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "FederatedQuery", federatedQueryOptions)
+	if mock.FederatedQueryFunc != nil {
+		return mock.FederatedQueryFunc(ctx, federatedQueryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "FederatedQuery")
+	return
+}
+
+// FederatedQueryNotices records the call and invokes FederatedQueryNoticesFunc
+func (mock *MockDiscoveryV1) FederatedQueryNotices(federatedQueryNoticesOptions *FederatedQueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	return mock.FederatedQueryNoticesWithContext(context.Background(), federatedQueryNoticesOptions)
+}
+
+// FederatedQueryNoticesWithContext records the call and invokes FederatedQueryNoticesFunc
+func (mock *MockDiscoveryV1) FederatedQueryNoticesWithContext(ctx context.Context, federatedQueryNoticesOptions *FederatedQueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "FederatedQueryNotices", federatedQueryNoticesOptions)
+	if mock.FederatedQueryNoticesFunc != nil {
+		return mock.FederatedQueryNoticesFunc(ctx, federatedQueryNoticesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "FederatedQueryNotices")
+	return
+}
+
+// GetAutocompletion records the call and invokes GetAutocompletionFunc
+func (mock *MockDiscoveryV1) GetAutocompletion(getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
+	return mock.GetAutocompletionWithContext(context.Background(), getAutocompletionOptions)
+}
+
+// GetAutocompletionWithContext records the call and invokes GetAutocompletionFunc
+func (mock *MockDiscoveryV1) GetAutocompletionWithContext(ctx context.Context, getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetAutocompletion", getAutocompletionOptions)
+	if mock.GetAutocompletionFunc != nil {
+		return mock.GetAutocompletionFunc(ctx, getAutocompletionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetAutocompletion")
+	return
+}
+
+// ListTrainingData records the call and invokes ListTrainingDataFunc
+func (mock *MockDiscoveryV1) ListTrainingData(listTrainingDataOptions *ListTrainingDataOptions) (result *TrainingDataSet, response *core.DetailedResponse, err error) {
+	return mock.ListTrainingDataWithContext(context.Background(), listTrainingDataOptions)
+}
+
+// ListTrainingDataWithContext records the call and invokes ListTrainingDataFunc
+func (mock *MockDiscoveryV1) ListTrainingDataWithContext(ctx context.Context, listTrainingDataOptions *ListTrainingDataOptions) (result *TrainingDataSet, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListTrainingData", listTrainingDataOptions)
+	if mock.ListTrainingDataFunc != nil {
+		return mock.ListTrainingDataFunc(ctx, listTrainingDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListTrainingData")
+	return
+}
+
+// AddTrainingData records the call and invokes AddTrainingDataFunc
+func (mock *MockDiscoveryV1) AddTrainingData(addTrainingDataOptions *AddTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return mock.AddTrainingDataWithContext(context.Background(), addTrainingDataOptions)
+}
+
+// AddTrainingDataWithContext records the call and invokes AddTrainingDataFunc
+func (mock *MockDiscoveryV1) AddTrainingDataWithContext(ctx context.Context, addTrainingDataOptions *AddTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddTrainingData", addTrainingDataOptions)
+	if mock.AddTrainingDataFunc != nil {
+		return mock.AddTrainingDataFunc(ctx, addTrainingDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "AddTrainingData")
+	return
+}
+
+// DeleteAllTrainingData records the call and invokes DeleteAllTrainingDataFunc
+func (mock *MockDiscoveryV1) DeleteAllTrainingData(deleteAllTrainingDataOptions *DeleteAllTrainingDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteAllTrainingDataWithContext(context.Background(), deleteAllTrainingDataOptions)
+}
+
+// DeleteAllTrainingDataWithContext records the call and invokes DeleteAllTrainingDataFunc
+func (mock *MockDiscoveryV1) DeleteAllTrainingDataWithContext(ctx context.Context, deleteAllTrainingDataOptions *DeleteAllTrainingDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteAllTrainingData", deleteAllTrainingDataOptions)
+	if mock.DeleteAllTrainingDataFunc != nil {
+		return mock.DeleteAllTrainingDataFunc(ctx, deleteAllTrainingDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteAllTrainingData")
+	return
+}
+
+// GetTrainingData records the call and invokes GetTrainingDataFunc
+func (mock *MockDiscoveryV1) GetTrainingData(getTrainingDataOptions *GetTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return mock.GetTrainingDataWithContext(context.Background(), getTrainingDataOptions)
+}
+
+// GetTrainingDataWithContext records the call and invokes GetTrainingDataFunc
+func (mock *MockDiscoveryV1) GetTrainingDataWithContext(ctx context.Context, getTrainingDataOptions *GetTrainingDataOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetTrainingData", getTrainingDataOptions)
+	if mock.GetTrainingDataFunc != nil {
+		return mock.GetTrainingDataFunc(ctx, getTrainingDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetTrainingData")
+	return
+}
+
+// DeleteTrainingData records the call and invokes DeleteTrainingDataFunc
+func (mock *MockDiscoveryV1) DeleteTrainingData(deleteTrainingDataOptions *DeleteTrainingDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteTrainingDataWithContext(context.Background(), deleteTrainingDataOptions)
+}
+
+// DeleteTrainingDataWithContext records the call and invokes DeleteTrainingDataFunc
+func (mock *MockDiscoveryV1) DeleteTrainingDataWithContext(ctx context.Context, deleteTrainingDataOptions *DeleteTrainingDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteTrainingData", deleteTrainingDataOptions)
+	if mock.DeleteTrainingDataFunc != nil {
+		return mock.DeleteTrainingDataFunc(ctx, deleteTrainingDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteTrainingData")
+	return
+}
+
+// ListTrainingExamples records the call and invokes ListTrainingExamplesFunc
+func (mock *MockDiscoveryV1) ListTrainingExamples(listTrainingExamplesOptions *ListTrainingExamplesOptions) (result *TrainingExampleList, response *core.DetailedResponse, err error) {
+	return mock.ListTrainingExamplesWithContext(context.Background(), listTrainingExamplesOptions)
+}
+
+// ListTrainingExamplesWithContext records the call and invokes ListTrainingExamplesFunc
+func (mock *MockDiscoveryV1) ListTrainingExamplesWithContext(ctx context.Context, listTrainingExamplesOptions *ListTrainingExamplesOptions) (result *TrainingExampleList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListTrainingExamples", listTrainingExamplesOptions)
+	if mock.ListTrainingExamplesFunc != nil {
+		return mock.ListTrainingExamplesFunc(ctx, listTrainingExamplesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListTrainingExamples")
+	return
+}
+
+// CreateTrainingExample records the call and invokes CreateTrainingExampleFunc
+func (mock *MockDiscoveryV1) CreateTrainingExample(createTrainingExampleOptions *CreateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	return mock.CreateTrainingExampleWithContext(context.Background(), createTrainingExampleOptions)
+}
+
+// CreateTrainingExampleWithContext records the call and invokes CreateTrainingExampleFunc
+func (mock *MockDiscoveryV1) CreateTrainingExampleWithContext(ctx context.Context, createTrainingExampleOptions *CreateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateTrainingExample", createTrainingExampleOptions)
+	if mock.CreateTrainingExampleFunc != nil {
+		return mock.CreateTrainingExampleFunc(ctx, createTrainingExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateTrainingExample")
+	return
+}
+
+// DeleteTrainingExample records the call and invokes DeleteTrainingExampleFunc
+func (mock *MockDiscoveryV1) DeleteTrainingExample(deleteTrainingExampleOptions *DeleteTrainingExampleOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteTrainingExampleWithContext(context.Background(), deleteTrainingExampleOptions)
+}
+
+// DeleteTrainingExampleWithContext records the call and invokes DeleteTrainingExampleFunc
+func (mock *MockDiscoveryV1) DeleteTrainingExampleWithContext(ctx context.Context, deleteTrainingExampleOptions *DeleteTrainingExampleOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteTrainingExample", deleteTrainingExampleOptions)
+	if mock.DeleteTrainingExampleFunc != nil {
+		return mock.DeleteTrainingExampleFunc(ctx, deleteTrainingExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteTrainingExample")
+	return
+}
+
+// UpdateTrainingExample records the call and invokes UpdateTrainingExampleFunc
+func (mock *MockDiscoveryV1) UpdateTrainingExample(updateTrainingExampleOptions *UpdateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	return mock.UpdateTrainingExampleWithContext(context.Background(), updateTrainingExampleOptions)
+}
+
+// UpdateTrainingExampleWithContext records the call and invokes UpdateTrainingExampleFunc
+func (mock *MockDiscoveryV1) UpdateTrainingExampleWithContext(ctx context.Context, updateTrainingExampleOptions *UpdateTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateTrainingExample", updateTrainingExampleOptions)
+	if mock.UpdateTrainingExampleFunc != nil {
+		return mock.UpdateTrainingExampleFunc(ctx, updateTrainingExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "UpdateTrainingExample")
+	return
+}
+
+// GetTrainingExample records the call and invokes GetTrainingExampleFunc
+func (mock *MockDiscoveryV1) GetTrainingExample(getTrainingExampleOptions *GetTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	return mock.GetTrainingExampleWithContext(context.Background(), getTrainingExampleOptions)
+}
+
+// GetTrainingExampleWithContext records the call and invokes GetTrainingExampleFunc
+func (mock *MockDiscoveryV1) GetTrainingExampleWithContext(ctx context.Context, getTrainingExampleOptions *GetTrainingExampleOptions) (result *TrainingExample, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetTrainingExample", getTrainingExampleOptions)
+	if mock.GetTrainingExampleFunc != nil {
+		return mock.GetTrainingExampleFunc(ctx, getTrainingExampleOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetTrainingExample")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockDiscoveryV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockDiscoveryV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteUserData")
+	return
+}
+
+// CreateEvent records the call and invokes CreateEventFunc
+func (mock *MockDiscoveryV1) CreateEvent(createEventOptions *CreateEventOptions) (result *CreateEventResponse, response *core.DetailedResponse, err error) {
+	return mock.CreateEventWithContext(context.Background(), createEventOptions)
+}
+
+// CreateEventWithContext records the call and invokes CreateEventFunc
+func (mock *MockDiscoveryV1) CreateEventWithContext(ctx context.Context, createEventOptions *CreateEventOptions) (result *CreateEventResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateEvent", createEventOptions)
+	if mock.CreateEventFunc != nil {
+		return mock.CreateEventFunc(ctx, createEventOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateEvent")
+	return
+}
+
+// QueryLog records the call and invokes QueryLogFunc
+func (mock *MockDiscoveryV1) QueryLog(queryLogOptions *QueryLogOptions) (result *LogQueryResponse, response *core.DetailedResponse, err error) {
+	return mock.QueryLogWithContext(context.Background(), queryLogOptions)
+}
+
+// QueryLogWithContext records the call and invokes QueryLogFunc
+func (mock *MockDiscoveryV1) QueryLogWithContext(ctx context.Context, queryLogOptions *QueryLogOptions) (result *LogQueryResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "QueryLog", queryLogOptions)
+	if mock.QueryLogFunc != nil {
+		return mock.QueryLogFunc(ctx, queryLogOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "QueryLog")
+	return
+}
+
+// GetMetricsQuery records the call and invokes GetMetricsQueryFunc
+func (mock *MockDiscoveryV1) GetMetricsQuery(getMetricsQueryOptions *GetMetricsQueryOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return mock.GetMetricsQueryWithContext(context.Background(), getMetricsQueryOptions)
+}
+
+// GetMetricsQueryWithContext records the call and invokes GetMetricsQueryFunc
+func (mock *MockDiscoveryV1) GetMetricsQueryWithContext(ctx context.Context, getMetricsQueryOptions *GetMetricsQueryOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetMetricsQuery", getMetricsQueryOptions)
+	if mock.GetMetricsQueryFunc != nil {
+		return mock.GetMetricsQueryFunc(ctx, getMetricsQueryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetMetricsQuery")
+	return
+}
+
+// GetMetricsQueryEvent records the call and invokes GetMetricsQueryEventFunc
+func (mock *MockDiscoveryV1) GetMetricsQueryEvent(getMetricsQueryEventOptions *GetMetricsQueryEventOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return mock.GetMetricsQueryEventWithContext(context.Background(), getMetricsQueryEventOptions)
+}
+
+// GetMetricsQueryEventWithContext records the call and invokes GetMetricsQueryEventFunc
+func (mock *MockDiscoveryV1) GetMetricsQueryEventWithContext(ctx context.Context, getMetricsQueryEventOptions *GetMetricsQueryEventOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetMetricsQueryEvent", getMetricsQueryEventOptions)
+	if mock.GetMetricsQueryEventFunc != nil {
+		return mock.GetMetricsQueryEventFunc(ctx, getMetricsQueryEventOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetMetricsQueryEvent")
+	return
+}
+
+// GetMetricsQueryNoResults records the call and invokes GetMetricsQueryNoResultsFunc
+func (mock *MockDiscoveryV1) GetMetricsQueryNoResults(getMetricsQueryNoResultsOptions *GetMetricsQueryNoResultsOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return mock.GetMetricsQueryNoResultsWithContext(context.Background(), getMetricsQueryNoResultsOptions)
+}
+
+// GetMetricsQueryNoResultsWithContext records the call and invokes GetMetricsQueryNoResultsFunc
+func (mock *MockDiscoveryV1) GetMetricsQueryNoResultsWithContext(ctx context.Context, getMetricsQueryNoResultsOptions *GetMetricsQueryNoResultsOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetMetricsQueryNoResults", getMetricsQueryNoResultsOptions)
+	if mock.GetMetricsQueryNoResultsFunc != nil {
+		return mock.GetMetricsQueryNoResultsFunc(ctx, getMetricsQueryNoResultsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetMetricsQueryNoResults")
+	return
+}
+
+// GetMetricsEventRate records the call and invokes GetMetricsEventRateFunc
+func (mock *MockDiscoveryV1) GetMetricsEventRate(getMetricsEventRateOptions *GetMetricsEventRateOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	return mock.GetMetricsEventRateWithContext(context.Background(), getMetricsEventRateOptions)
+}
+
+// GetMetricsEventRateWithContext records the call and invokes GetMetricsEventRateFunc
+func (mock *MockDiscoveryV1) GetMetricsEventRateWithContext(ctx context.Context, getMetricsEventRateOptions *GetMetricsEventRateOptions) (result *MetricResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetMetricsEventRate", getMetricsEventRateOptions)
+	if mock.GetMetricsEventRateFunc != nil {
+		return mock.GetMetricsEventRateFunc(ctx, getMetricsEventRateOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetMetricsEventRate")
+	return
+}
+
+// GetMetricsQueryTokenEvent records the call and invokes GetMetricsQueryTokenEventFunc
+func (mock *MockDiscoveryV1) GetMetricsQueryTokenEvent(getMetricsQueryTokenEventOptions *GetMetricsQueryTokenEventOptions) (result *MetricTokenResponse, response *core.DetailedResponse, err error) {
+	return mock.GetMetricsQueryTokenEventWithContext(context.Background(), getMetricsQueryTokenEventOptions)
+}
+
+// GetMetricsQueryTokenEventWithContext records the call and invokes GetMetricsQueryTokenEventFunc
+func (mock *MockDiscoveryV1) GetMetricsQueryTokenEventWithContext(ctx context.Context, getMetricsQueryTokenEventOptions *GetMetricsQueryTokenEventOptions) (result *MetricTokenResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetMetricsQueryTokenEvent", getMetricsQueryTokenEventOptions)
+	if mock.GetMetricsQueryTokenEventFunc != nil {
+		return mock.GetMetricsQueryTokenEventFunc(ctx, getMetricsQueryTokenEventOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetMetricsQueryTokenEvent")
+	return
+}
+
+// ListCredentials records the call and invokes ListCredentialsFunc
+func (mock *MockDiscoveryV1) ListCredentials(listCredentialsOptions *ListCredentialsOptions) (result *CredentialsList, response *core.DetailedResponse, err error) {
+	return mock.ListCredentialsWithContext(context.Background(), listCredentialsOptions)
+}
+
+// ListCredentialsWithContext records the call and invokes ListCredentialsFunc
+func (mock *MockDiscoveryV1) ListCredentialsWithContext(ctx context.Context, listCredentialsOptions *ListCredentialsOptions) (result *CredentialsList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListCredentials", listCredentialsOptions)
+	if mock.ListCredentialsFunc != nil {
+		return mock.ListCredentialsFunc(ctx, listCredentialsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListCredentials")
+	return
+}
+
+// CreateCredentials records the call and invokes CreateCredentialsFunc
+func (mock *MockDiscoveryV1) CreateCredentials(createCredentialsOptions *CreateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	return mock.CreateCredentialsWithContext(context.Background(), createCredentialsOptions)
+}
+
+// CreateCredentialsWithContext records the call and invokes CreateCredentialsFunc
+func (mock *MockDiscoveryV1) CreateCredentialsWithContext(ctx context.Context, createCredentialsOptions *CreateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateCredentials", createCredentialsOptions)
+	if mock.CreateCredentialsFunc != nil {
+		return mock.CreateCredentialsFunc(ctx, createCredentialsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateCredentials")
+	return
+}
+
+// GetCredentials records the call and invokes GetCredentialsFunc
+func (mock *MockDiscoveryV1) GetCredentials(getCredentialsOptions *GetCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	return mock.GetCredentialsWithContext(context.Background(), getCredentialsOptions)
+}
+
+// GetCredentialsWithContext records the call and invokes GetCredentialsFunc
+func (mock *MockDiscoveryV1) GetCredentialsWithContext(ctx context.Context, getCredentialsOptions *GetCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetCredentials", getCredentialsOptions)
+	if mock.GetCredentialsFunc != nil {
+		return mock.GetCredentialsFunc(ctx, getCredentialsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetCredentials")
+	return
+}
+
+// UpdateCredentials records the call and invokes UpdateCredentialsFunc
+func (mock *MockDiscoveryV1) UpdateCredentials(updateCredentialsOptions *UpdateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	return mock.UpdateCredentialsWithContext(context.Background(), updateCredentialsOptions)
+}
+
+// UpdateCredentialsWithContext records the call and invokes UpdateCredentialsFunc
+func (mock *MockDiscoveryV1) UpdateCredentialsWithContext(ctx context.Context, updateCredentialsOptions *UpdateCredentialsOptions) (result *Credentials, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateCredentials", updateCredentialsOptions)
+	if mock.UpdateCredentialsFunc != nil {
+		return mock.UpdateCredentialsFunc(ctx, updateCredentialsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "UpdateCredentials")
+	return
+}
+
+// DeleteCredentials records the call and invokes DeleteCredentialsFunc
+func (mock *MockDiscoveryV1) DeleteCredentials(deleteCredentialsOptions *DeleteCredentialsOptions) (result *DeleteCredentials, response *core.DetailedResponse, err error) {
+	return mock.DeleteCredentialsWithContext(context.Background(), deleteCredentialsOptions)
+}
+
+// DeleteCredentialsWithContext records the call and invokes DeleteCredentialsFunc
+func (mock *MockDiscoveryV1) DeleteCredentialsWithContext(ctx context.Context, deleteCredentialsOptions *DeleteCredentialsOptions) (result *DeleteCredentials, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteCredentials", deleteCredentialsOptions)
+	if mock.DeleteCredentialsFunc != nil {
+		return mock.DeleteCredentialsFunc(ctx, deleteCredentialsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteCredentials")
+	return
+}
+
+// ListGateways records the call and invokes ListGatewaysFunc
+func (mock *MockDiscoveryV1) ListGateways(listGatewaysOptions *ListGatewaysOptions) (result *GatewayList, response *core.DetailedResponse, err error) {
+	return mock.ListGatewaysWithContext(context.Background(), listGatewaysOptions)
+}
+
+// ListGatewaysWithContext records the call and invokes ListGatewaysFunc
+func (mock *MockDiscoveryV1) ListGatewaysWithContext(ctx context.Context, listGatewaysOptions *ListGatewaysOptions) (result *GatewayList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListGateways", listGatewaysOptions)
+	if mock.ListGatewaysFunc != nil {
+		return mock.ListGatewaysFunc(ctx, listGatewaysOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "ListGateways")
+	return
+}
+
+// CreateGateway records the call and invokes CreateGatewayFunc
+func (mock *MockDiscoveryV1) CreateGateway(createGatewayOptions *CreateGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
+	return mock.CreateGatewayWithContext(context.Background(), createGatewayOptions)
+}
+
+// CreateGatewayWithContext records the call and invokes CreateGatewayFunc
+func (mock *MockDiscoveryV1) CreateGatewayWithContext(ctx context.Context, createGatewayOptions *CreateGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateGateway", createGatewayOptions)
+	if mock.CreateGatewayFunc != nil {
+		return mock.CreateGatewayFunc(ctx, createGatewayOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "CreateGateway")
+	return
+}
+
+// GetGateway records the call and invokes GetGatewayFunc
+func (mock *MockDiscoveryV1) GetGateway(getGatewayOptions *GetGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
+	return mock.GetGatewayWithContext(context.Background(), getGatewayOptions)
+}
+
+// GetGatewayWithContext records the call and invokes GetGatewayFunc
+func (mock *MockDiscoveryV1) GetGatewayWithContext(ctx context.Context, getGatewayOptions *GetGatewayOptions) (result *Gateway, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetGateway", getGatewayOptions)
+	if mock.GetGatewayFunc != nil {
+		return mock.GetGatewayFunc(ctx, getGatewayOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "GetGateway")
+	return
+}
+
+// DeleteGateway records the call and invokes DeleteGatewayFunc
+func (mock *MockDiscoveryV1) DeleteGateway(deleteGatewayOptions *DeleteGatewayOptions) (result *GatewayDelete, response *core.DetailedResponse, err error) {
+	return mock.DeleteGatewayWithContext(context.Background(), deleteGatewayOptions)
+}
+
+// DeleteGatewayWithContext records the call and invokes DeleteGatewayFunc
+func (mock *MockDiscoveryV1) DeleteGatewayWithContext(ctx context.Context, deleteGatewayOptions *DeleteGatewayOptions) (result *GatewayDelete, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteGateway", deleteGatewayOptions)
+	if mock.DeleteGatewayFunc != nil {
+		return mock.DeleteGatewayFunc(ctx, deleteGatewayOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV1", "DeleteGateway")
+	return
+}
+
+// NewAddDocumentOptions delegates to DiscoveryV1.NewAddDocumentOptions
+func (mock *MockDiscoveryV1) NewAddDocumentOptions(environmentID string, collectionID string) *AddDocumentOptions {
+	return new(DiscoveryV1).NewAddDocumentOptions(environmentID, collectionID)
+}
+
+// NewAddTrainingDataOptions delegates to DiscoveryV1.NewAddTrainingDataOptions
+func (mock *MockDiscoveryV1) NewAddTrainingDataOptions(environmentID string, collectionID string) *AddTrainingDataOptions {
+	return new(DiscoveryV1).NewAddTrainingDataOptions(environmentID, collectionID)
+}
+
+// NewConfiguration delegates to DiscoveryV1.NewConfiguration
+func (mock *MockDiscoveryV1) NewConfiguration(name string) (model *Configuration, err error) {
+	return new(DiscoveryV1).NewConfiguration(name)
+}
+
+// NewCreateCollectionOptions delegates to DiscoveryV1.NewCreateCollectionOptions
+func (mock *MockDiscoveryV1) NewCreateCollectionOptions(environmentID string, name string) *CreateCollectionOptions {
+	return new(DiscoveryV1).NewCreateCollectionOptions(environmentID, name)
+}
+
+// NewCreateConfigurationOptions delegates to DiscoveryV1.NewCreateConfigurationOptions
+func (mock *MockDiscoveryV1) NewCreateConfigurationOptions(environmentID string, name string) *CreateConfigurationOptions {
+	return new(DiscoveryV1).NewCreateConfigurationOptions(environmentID, name)
+}
+
+// NewCreateCredentialsOptions delegates to DiscoveryV1.NewCreateCredentialsOptions
+func (mock *MockDiscoveryV1) NewCreateCredentialsOptions(environmentID string) *CreateCredentialsOptions {
+	return new(DiscoveryV1).NewCreateCredentialsOptions(environmentID)
+}
+
+// NewCreateEnvironmentOptions delegates to DiscoveryV1.NewCreateEnvironmentOptions
+func (mock *MockDiscoveryV1) NewCreateEnvironmentOptions(name string) *CreateEnvironmentOptions {
+	return new(DiscoveryV1).NewCreateEnvironmentOptions(name)
+}
+
+// NewCreateEventOptions delegates to DiscoveryV1.NewCreateEventOptions
+func (mock *MockDiscoveryV1) NewCreateEventOptions(typeVar string, data *EventData) *CreateEventOptions {
+	return new(DiscoveryV1).NewCreateEventOptions(typeVar, data)
+}
+
+// NewCreateExpansionsOptions delegates to DiscoveryV1.NewCreateExpansionsOptions
+func (mock *MockDiscoveryV1) NewCreateExpansionsOptions(environmentID string, collectionID string, expansions []Expansion) *CreateExpansionsOptions {
+	return new(DiscoveryV1).NewCreateExpansionsOptions(environmentID, collectionID, expansions)
+}
+
+// NewCreateGatewayOptions delegates to DiscoveryV1.NewCreateGatewayOptions
+func (mock *MockDiscoveryV1) NewCreateGatewayOptions(environmentID string) *CreateGatewayOptions {
+	return new(DiscoveryV1).NewCreateGatewayOptions(environmentID)
+}
+
+// NewCreateStopwordListOptions delegates to DiscoveryV1.NewCreateStopwordListOptions
+func (mock *MockDiscoveryV1) NewCreateStopwordListOptions(environmentID string, collectionID string, stopwordFile io.ReadCloser, stopwordFilename string) *CreateStopwordListOptions {
+	return new(DiscoveryV1).NewCreateStopwordListOptions(environmentID, collectionID, stopwordFile, stopwordFilename)
+}
+
+// NewCreateTokenizationDictionaryOptions delegates to DiscoveryV1.NewCreateTokenizationDictionaryOptions
+func (mock *MockDiscoveryV1) NewCreateTokenizationDictionaryOptions(environmentID string, collectionID string) *CreateTokenizationDictionaryOptions {
+	return new(DiscoveryV1).NewCreateTokenizationDictionaryOptions(environmentID, collectionID)
+}
+
+// NewCreateTrainingExampleOptions delegates to DiscoveryV1.NewCreateTrainingExampleOptions
+func (mock *MockDiscoveryV1) NewCreateTrainingExampleOptions(environmentID string, collectionID string, queryID string) *CreateTrainingExampleOptions {
+	return new(DiscoveryV1).NewCreateTrainingExampleOptions(environmentID, collectionID, queryID)
+}
+
+// NewDeleteAllTrainingDataOptions delegates to DiscoveryV1.NewDeleteAllTrainingDataOptions
+func (mock *MockDiscoveryV1) NewDeleteAllTrainingDataOptions(environmentID string, collectionID string) *DeleteAllTrainingDataOptions {
+	return new(DiscoveryV1).NewDeleteAllTrainingDataOptions(environmentID, collectionID)
+}
+
+// NewDeleteCollectionOptions delegates to DiscoveryV1.NewDeleteCollectionOptions
+func (mock *MockDiscoveryV1) NewDeleteCollectionOptions(environmentID string, collectionID string) *DeleteCollectionOptions {
+	return new(DiscoveryV1).NewDeleteCollectionOptions(environmentID, collectionID)
+}
+
+// NewDeleteConfigurationOptions delegates to DiscoveryV1.NewDeleteConfigurationOptions
+func (mock *MockDiscoveryV1) NewDeleteConfigurationOptions(environmentID string, configurationID string) *DeleteConfigurationOptions {
+	return new(DiscoveryV1).NewDeleteConfigurationOptions(environmentID, configurationID)
+}
+
+// NewDeleteCredentialsOptions delegates to DiscoveryV1.NewDeleteCredentialsOptions
+func (mock *MockDiscoveryV1) NewDeleteCredentialsOptions(environmentID string, credentialID string) *DeleteCredentialsOptions {
+	return new(DiscoveryV1).NewDeleteCredentialsOptions(environmentID, credentialID)
+}
+
+// NewDeleteDocumentOptions delegates to DiscoveryV1.NewDeleteDocumentOptions
+func (mock *MockDiscoveryV1) NewDeleteDocumentOptions(environmentID string, collectionID string, documentID string) *DeleteDocumentOptions {
+	return new(DiscoveryV1).NewDeleteDocumentOptions(environmentID, collectionID, documentID)
+}
+
+// NewDeleteEnvironmentOptions delegates to DiscoveryV1.NewDeleteEnvironmentOptions
+func (mock *MockDiscoveryV1) NewDeleteEnvironmentOptions(environmentID string) *DeleteEnvironmentOptions {
+	return new(DiscoveryV1).NewDeleteEnvironmentOptions(environmentID)
+}
+
+// NewDeleteExpansionsOptions delegates to DiscoveryV1.NewDeleteExpansionsOptions
+func (mock *MockDiscoveryV1) NewDeleteExpansionsOptions(environmentID string, collectionID string) *DeleteExpansionsOptions {
+	return new(DiscoveryV1).NewDeleteExpansionsOptions(environmentID, collectionID)
+}
+
+// NewDeleteGatewayOptions delegates to DiscoveryV1.NewDeleteGatewayOptions
+func (mock *MockDiscoveryV1) NewDeleteGatewayOptions(environmentID string, gatewayID string) *DeleteGatewayOptions {
+	return new(DiscoveryV1).NewDeleteGatewayOptions(environmentID, gatewayID)
+}
+
+// NewDeleteStopwordListOptions delegates to DiscoveryV1.NewDeleteStopwordListOptions
+func (mock *MockDiscoveryV1) NewDeleteStopwordListOptions(environmentID string, collectionID string) *DeleteStopwordListOptions {
+	return new(DiscoveryV1).NewDeleteStopwordListOptions(environmentID, collectionID)
+}
+
+// NewDeleteTokenizationDictionaryOptions delegates to DiscoveryV1.NewDeleteTokenizationDictionaryOptions
+func (mock *MockDiscoveryV1) NewDeleteTokenizationDictionaryOptions(environmentID string, collectionID string) *DeleteTokenizationDictionaryOptions {
+	return new(DiscoveryV1).NewDeleteTokenizationDictionaryOptions(environmentID, collectionID)
+}
+
+// NewDeleteTrainingDataOptions delegates to DiscoveryV1.NewDeleteTrainingDataOptions
+func (mock *MockDiscoveryV1) NewDeleteTrainingDataOptions(environmentID string, collectionID string, queryID string) *DeleteTrainingDataOptions {
+	return new(DiscoveryV1).NewDeleteTrainingDataOptions(environmentID, collectionID, queryID)
+}
+
+// NewDeleteTrainingExampleOptions delegates to DiscoveryV1.NewDeleteTrainingExampleOptions
+func (mock *MockDiscoveryV1) NewDeleteTrainingExampleOptions(environmentID string, collectionID string, queryID string, exampleID string) *DeleteTrainingExampleOptions {
+	return new(DiscoveryV1).NewDeleteTrainingExampleOptions(environmentID, collectionID, queryID, exampleID)
+}
+
+// NewDeleteUserDataOptions delegates to DiscoveryV1.NewDeleteUserDataOptions
+func (mock *MockDiscoveryV1) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(DiscoveryV1).NewDeleteUserDataOptions(customerID)
+}
+
+// NewEnrichment delegates to DiscoveryV1.NewEnrichment
+func (mock *MockDiscoveryV1) NewEnrichment(destinationField string, sourceField string, enrichment string) (model *Enrichment, err error) {
+	return new(DiscoveryV1).NewEnrichment(destinationField, sourceField, enrichment)
+}
+
+// NewEventData delegates to DiscoveryV1.NewEventData
+func (mock *MockDiscoveryV1) NewEventData(environmentID string, sessionToken string, collectionID string, documentID string) (model *EventData, err error) {
+	return new(DiscoveryV1).NewEventData(environmentID, sessionToken, collectionID, documentID)
+}
+
+// NewExpansion delegates to DiscoveryV1.NewExpansion
+func (mock *MockDiscoveryV1) NewExpansion(expandedTerms []string) (model *Expansion, err error) {
+	return new(DiscoveryV1).NewExpansion(expandedTerms)
+}
+
+// NewExpansions delegates to DiscoveryV1.NewExpansions
+func (mock *MockDiscoveryV1) NewExpansions(expansions []Expansion) (model *Expansions, err error) {
+	return new(DiscoveryV1).NewExpansions(expansions)
+}
+
+// NewFederatedQueryNoticesOptions delegates to DiscoveryV1.NewFederatedQueryNoticesOptions
+func (mock *MockDiscoveryV1) NewFederatedQueryNoticesOptions(environmentID string, collectionIds []string) *FederatedQueryNoticesOptions {
+	return new(DiscoveryV1).NewFederatedQueryNoticesOptions(environmentID, collectionIds)
+}
+
+// NewFederatedQueryOptions delegates to DiscoveryV1.NewFederatedQueryOptions
+func (mock *MockDiscoveryV1) NewFederatedQueryOptions(environmentID string, collectionIds string) *FederatedQueryOptions {
+	return new(DiscoveryV1).NewFederatedQueryOptions(environmentID, collectionIds)
+}
+
+// NewGetAutocompletionOptions delegates to DiscoveryV1.NewGetAutocompletionOptions
+func (mock *MockDiscoveryV1) NewGetAutocompletionOptions(environmentID string, collectionID string, prefix string) *GetAutocompletionOptions {
+	return new(DiscoveryV1).NewGetAutocompletionOptions(environmentID, collectionID, prefix)
+}
+
+// NewGetCollectionOptions delegates to DiscoveryV1.NewGetCollectionOptions
+func (mock *MockDiscoveryV1) NewGetCollectionOptions(environmentID string, collectionID string) *GetCollectionOptions {
+	return new(DiscoveryV1).NewGetCollectionOptions(environmentID, collectionID)
+}
+
+// NewGetConfigurationOptions delegates to DiscoveryV1.NewGetConfigurationOptions
+func (mock *MockDiscoveryV1) NewGetConfigurationOptions(environmentID string, configurationID string) *GetConfigurationOptions {
+	return new(DiscoveryV1).NewGetConfigurationOptions(environmentID, configurationID)
+}
+
+// NewGetCredentialsOptions delegates to DiscoveryV1.NewGetCredentialsOptions
+func (mock *MockDiscoveryV1) NewGetCredentialsOptions(environmentID string, credentialID string) *GetCredentialsOptions {
+	return new(DiscoveryV1).NewGetCredentialsOptions(environmentID, credentialID)
+}
+
+// NewGetDocumentStatusOptions delegates to DiscoveryV1.NewGetDocumentStatusOptions
+func (mock *MockDiscoveryV1) NewGetDocumentStatusOptions(environmentID string, collectionID string, documentID string) *GetDocumentStatusOptions {
+	return new(DiscoveryV1).NewGetDocumentStatusOptions(environmentID, collectionID, documentID)
+}
+
+// NewGetEnvironmentOptions delegates to DiscoveryV1.NewGetEnvironmentOptions
+func (mock *MockDiscoveryV1) NewGetEnvironmentOptions(environmentID string) *GetEnvironmentOptions {
+	return new(DiscoveryV1).NewGetEnvironmentOptions(environmentID)
+}
+
+// NewGetGatewayOptions delegates to DiscoveryV1.NewGetGatewayOptions
+func (mock *MockDiscoveryV1) NewGetGatewayOptions(environmentID string, gatewayID string) *GetGatewayOptions {
+	return new(DiscoveryV1).NewGetGatewayOptions(environmentID, gatewayID)
+}
+
+// NewGetMetricsEventRateOptions delegates to DiscoveryV1.NewGetMetricsEventRateOptions
+func (mock *MockDiscoveryV1) NewGetMetricsEventRateOptions() *GetMetricsEventRateOptions {
+	return new(DiscoveryV1).NewGetMetricsEventRateOptions()
+}
+
+// NewGetMetricsQueryEventOptions delegates to DiscoveryV1.NewGetMetricsQueryEventOptions
+func (mock *MockDiscoveryV1) NewGetMetricsQueryEventOptions() *GetMetricsQueryEventOptions {
+	return new(DiscoveryV1).NewGetMetricsQueryEventOptions()
+}
+
+// NewGetMetricsQueryNoResultsOptions delegates to DiscoveryV1.NewGetMetricsQueryNoResultsOptions
+func (mock *MockDiscoveryV1) NewGetMetricsQueryNoResultsOptions() *GetMetricsQueryNoResultsOptions {
+	return new(DiscoveryV1).NewGetMetricsQueryNoResultsOptions()
+}
+
+// NewGetMetricsQueryOptions delegates to DiscoveryV1.NewGetMetricsQueryOptions
+func (mock *MockDiscoveryV1) NewGetMetricsQueryOptions() *GetMetricsQueryOptions {
+	return new(DiscoveryV1).NewGetMetricsQueryOptions()
+}
+
+// NewGetMetricsQueryTokenEventOptions delegates to DiscoveryV1.NewGetMetricsQueryTokenEventOptions
+func (mock *MockDiscoveryV1) NewGetMetricsQueryTokenEventOptions() *GetMetricsQueryTokenEventOptions {
+	return new(DiscoveryV1).NewGetMetricsQueryTokenEventOptions()
+}
+
+// NewGetStopwordListStatusOptions delegates to DiscoveryV1.NewGetStopwordListStatusOptions
+func (mock *MockDiscoveryV1) NewGetStopwordListStatusOptions(environmentID string, collectionID string) *GetStopwordListStatusOptions {
+	return new(DiscoveryV1).NewGetStopwordListStatusOptions(environmentID, collectionID)
+}
+
+// NewGetTokenizationDictionaryStatusOptions delegates to DiscoveryV1.NewGetTokenizationDictionaryStatusOptions
+func (mock *MockDiscoveryV1) NewGetTokenizationDictionaryStatusOptions(environmentID string, collectionID string) *GetTokenizationDictionaryStatusOptions {
+	return new(DiscoveryV1).NewGetTokenizationDictionaryStatusOptions(environmentID, collectionID)
+}
+
+// NewGetTrainingDataOptions delegates to DiscoveryV1.NewGetTrainingDataOptions
+func (mock *MockDiscoveryV1) NewGetTrainingDataOptions(environmentID string, collectionID string, queryID string) *GetTrainingDataOptions {
+	return new(DiscoveryV1).NewGetTrainingDataOptions(environmentID, collectionID, queryID)
+}
+
+// NewGetTrainingExampleOptions delegates to DiscoveryV1.NewGetTrainingExampleOptions
+func (mock *MockDiscoveryV1) NewGetTrainingExampleOptions(environmentID string, collectionID string, queryID string, exampleID string) *GetTrainingExampleOptions {
+	return new(DiscoveryV1).NewGetTrainingExampleOptions(environmentID, collectionID, queryID, exampleID)
+}
+
+// NewListCollectionFieldsOptions delegates to DiscoveryV1.NewListCollectionFieldsOptions
+func (mock *MockDiscoveryV1) NewListCollectionFieldsOptions(environmentID string, collectionID string) *ListCollectionFieldsOptions {
+	return new(DiscoveryV1).NewListCollectionFieldsOptions(environmentID, collectionID)
+}
+
+// NewListCollectionsOptions delegates to DiscoveryV1.NewListCollectionsOptions
+func (mock *MockDiscoveryV1) NewListCollectionsOptions(environmentID string) *ListCollectionsOptions {
+	return new(DiscoveryV1).NewListCollectionsOptions(environmentID)
+}
+
+// NewListConfigurationsOptions delegates to DiscoveryV1.NewListConfigurationsOptions
+func (mock *MockDiscoveryV1) NewListConfigurationsOptions(environmentID string) *ListConfigurationsOptions {
+	return new(DiscoveryV1).NewListConfigurationsOptions(environmentID)
+}
+
+// NewListCredentialsOptions delegates to DiscoveryV1.NewListCredentialsOptions
+func (mock *MockDiscoveryV1) NewListCredentialsOptions(environmentID string) *ListCredentialsOptions {
+	return new(DiscoveryV1).NewListCredentialsOptions(environmentID)
+}
+
+// NewListEnvironmentsOptions delegates to DiscoveryV1.NewListEnvironmentsOptions
+func (mock *MockDiscoveryV1) NewListEnvironmentsOptions() *ListEnvironmentsOptions {
+	return new(DiscoveryV1).NewListEnvironmentsOptions()
+}
+
+// NewListExpansionsOptions delegates to DiscoveryV1.NewListExpansionsOptions
+func (mock *MockDiscoveryV1) NewListExpansionsOptions(environmentID string, collectionID string) *ListExpansionsOptions {
+	return new(DiscoveryV1).NewListExpansionsOptions(environmentID, collectionID)
+}
+
+// NewListFieldsOptions delegates to DiscoveryV1.NewListFieldsOptions
+func (mock *MockDiscoveryV1) NewListFieldsOptions(environmentID string, collectionIds []string) *ListFieldsOptions {
+	return new(DiscoveryV1).NewListFieldsOptions(environmentID, collectionIds)
+}
+
+// NewListGatewaysOptions delegates to DiscoveryV1.NewListGatewaysOptions
+func (mock *MockDiscoveryV1) NewListGatewaysOptions(environmentID string) *ListGatewaysOptions {
+	return new(DiscoveryV1).NewListGatewaysOptions(environmentID)
+}
+
+// NewListTrainingDataOptions delegates to DiscoveryV1.NewListTrainingDataOptions
+func (mock *MockDiscoveryV1) NewListTrainingDataOptions(environmentID string, collectionID string) *ListTrainingDataOptions {
+	return new(DiscoveryV1).NewListTrainingDataOptions(environmentID, collectionID)
+}
+
+// NewListTrainingExamplesOptions delegates to DiscoveryV1.NewListTrainingExamplesOptions
+func (mock *MockDiscoveryV1) NewListTrainingExamplesOptions(environmentID string, collectionID string, queryID string) *ListTrainingExamplesOptions {
+	return new(DiscoveryV1).NewListTrainingExamplesOptions(environmentID, collectionID, queryID)
+}
+
+// NewQueryLogOptions delegates to DiscoveryV1.NewQueryLogOptions
+func (mock *MockDiscoveryV1) NewQueryLogOptions() *QueryLogOptions {
+	return new(DiscoveryV1).NewQueryLogOptions()
+}
+
+// NewQueryNoticesOptions delegates to DiscoveryV1.NewQueryNoticesOptions
+func (mock *MockDiscoveryV1) NewQueryNoticesOptions(environmentID string, collectionID string) *QueryNoticesOptions {
+	return new(DiscoveryV1).NewQueryNoticesOptions(environmentID, collectionID)
+}
+
+// NewQueryOptions delegates to DiscoveryV1.NewQueryOptions
+func (mock *MockDiscoveryV1) NewQueryOptions(environmentID string, collectionID string) *QueryOptions {
+	return new(DiscoveryV1).NewQueryOptions(environmentID, collectionID)
+}
+
+// NewSourceOptionsBuckets delegates to DiscoveryV1.NewSourceOptionsBuckets
+func (mock *MockDiscoveryV1) NewSourceOptionsBuckets(name string) (model *SourceOptionsBuckets, err error) {
+	return new(DiscoveryV1).NewSourceOptionsBuckets(name)
+}
+
+// NewSourceOptionsFolder delegates to DiscoveryV1.NewSourceOptionsFolder
+func (mock *MockDiscoveryV1) NewSourceOptionsFolder(ownerUserID string, folderID string) (model *SourceOptionsFolder, err error) {
+	return new(DiscoveryV1).NewSourceOptionsFolder(ownerUserID, folderID)
+}
+
+// NewSourceOptionsObject delegates to DiscoveryV1.NewSourceOptionsObject
+func (mock *MockDiscoveryV1) NewSourceOptionsObject(name string) (model *SourceOptionsObject, err error) {
+	return new(DiscoveryV1).NewSourceOptionsObject(name)
+}
+
+// NewSourceOptionsSiteColl delegates to DiscoveryV1.NewSourceOptionsSiteColl
+func (mock *MockDiscoveryV1) NewSourceOptionsSiteColl(siteCollectionPath string) (model *SourceOptionsSiteColl, err error) {
+	return new(DiscoveryV1).NewSourceOptionsSiteColl(siteCollectionPath)
+}
+
+// NewSourceOptionsWebCrawl delegates to DiscoveryV1.NewSourceOptionsWebCrawl
+func (mock *MockDiscoveryV1) NewSourceOptionsWebCrawl(URL string) (model *SourceOptionsWebCrawl, err error) {
+	return new(DiscoveryV1).NewSourceOptionsWebCrawl(URL)
+}
+
+// NewTokenDictRule delegates to DiscoveryV1.NewTokenDictRule
+func (mock *MockDiscoveryV1) NewTokenDictRule(text string, tokens []string, partOfSpeech string) (model *TokenDictRule, err error) {
+	return new(DiscoveryV1).NewTokenDictRule(text, tokens, partOfSpeech)
+}
+
+// NewUpdateCollectionOptions delegates to DiscoveryV1.NewUpdateCollectionOptions
+func (mock *MockDiscoveryV1) NewUpdateCollectionOptions(environmentID string, collectionID string, name string) *UpdateCollectionOptions {
+	return new(DiscoveryV1).NewUpdateCollectionOptions(environmentID, collectionID, name)
+}
+
+// NewUpdateConfigurationOptions delegates to DiscoveryV1.NewUpdateConfigurationOptions
+func (mock *MockDiscoveryV1) NewUpdateConfigurationOptions(environmentID string, configurationID string, name string) *UpdateConfigurationOptions {
+	return new(DiscoveryV1).NewUpdateConfigurationOptions(environmentID, configurationID, name)
+}
+
+// NewUpdateCredentialsOptions delegates to DiscoveryV1.NewUpdateCredentialsOptions
+func (mock *MockDiscoveryV1) NewUpdateCredentialsOptions(environmentID string, credentialID string) *UpdateCredentialsOptions {
+	return new(DiscoveryV1).NewUpdateCredentialsOptions(environmentID, credentialID)
+}
+
+// NewUpdateDocumentOptions delegates to DiscoveryV1.NewUpdateDocumentOptions
+func (mock *MockDiscoveryV1) NewUpdateDocumentOptions(environmentID string, collectionID string, documentID string) *UpdateDocumentOptions {
+	return new(DiscoveryV1).NewUpdateDocumentOptions(environmentID, collectionID, documentID)
+}
+
+// NewUpdateEnvironmentOptions delegates to DiscoveryV1.NewUpdateEnvironmentOptions
+func (mock *MockDiscoveryV1) NewUpdateEnvironmentOptions(environmentID string) *UpdateEnvironmentOptions {
+	return new(DiscoveryV1).NewUpdateEnvironmentOptions(environmentID)
+}
+
+// NewUpdateTrainingExampleOptions delegates to DiscoveryV1.NewUpdateTrainingExampleOptions
+func (mock *MockDiscoveryV1) NewUpdateTrainingExampleOptions(environmentID string, collectionID string, queryID string, exampleID string) *UpdateTrainingExampleOptions {
+	return new(DiscoveryV1).NewUpdateTrainingExampleOptions(environmentID, collectionID, queryID, exampleID)
+}
@@ -18,8 +18,10 @@
 package discoveryv2
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/IBM/go-sdk-core/core"
@@ -47,10 +49,13 @@ const DefaultServiceName = "discovery"
 
 // DiscoveryV2Options : Service options
 type DiscoveryV2Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewDiscoveryV2 : constructs an instance of DiscoveryV2 with passed in options.
@@ -88,6 +93,16 @@ func NewDiscoveryV2(options *DiscoveryV2Options) (service *DiscoveryV2, err erro
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &DiscoveryV2{
 		Service: baseService,
 		Version: options.Version,
@@ -103,12 +118,37 @@ func (discovery *DiscoveryV2) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (discovery *DiscoveryV2) DisableSSLVerification() {
-	discovery.Service.DisableSSLVerification()
+	common.DisableSSLVerification(discovery.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (discovery *DiscoveryV2) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(discovery.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (discovery *DiscoveryV2) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(discovery.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (discovery *DiscoveryV2) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(discovery.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (discovery *DiscoveryV2) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(discovery.Service, interceptor)
 }
 
 // ListCollections : List collections
 // Lists existing collections for the specified project.
 func (discovery *DiscoveryV2) ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListCollectionsWithContext(context.Background(), listCollectionsOptions)
+}
+
+// ListCollectionsWithContext is an alternate form of the ListCollections method which supports a Context parameter
+func (discovery *DiscoveryV2) ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listCollectionsOptions, "listCollectionsOptions cannot be nil")
 	if err != nil {
 		return
@@ -144,7 +184,10 @@ func (discovery *DiscoveryV2) ListCollections(listCollectionsOptions *ListCollec
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListCollectionsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListCollectionsResponse)
@@ -159,6 +202,11 @@ func (discovery *DiscoveryV2) ListCollections(listCollectionsOptions *ListCollec
 // CreateCollection : Create a collection
 // Create a new collection in the specified project.
 func (discovery *DiscoveryV2) CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	return discovery.CreateCollectionWithContext(context.Background(), createCollectionOptions)
+}
+
+// CreateCollectionWithContext is an alternate form of the CreateCollection method which supports a Context parameter
+func (discovery *DiscoveryV2) CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createCollectionOptions, "createCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -213,7 +261,10 @@ func (discovery *DiscoveryV2) CreateCollection(createCollectionOptions *CreateCo
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(CollectionDetails))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CollectionDetails)
@@ -228,6 +279,11 @@ func (discovery *DiscoveryV2) CreateCollection(createCollectionOptions *CreateCo
 // GetCollection : Get collection
 // Get details about the specified collection.
 func (discovery *DiscoveryV2) GetCollection(getCollectionOptions *GetCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	return discovery.GetCollectionWithContext(context.Background(), getCollectionOptions)
+}
+
+// GetCollectionWithContext is an alternate form of the GetCollection method which supports a Context parameter
+func (discovery *DiscoveryV2) GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCollectionOptions, "getCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -263,7 +319,10 @@ func (discovery *DiscoveryV2) GetCollection(getCollectionOptions *GetCollectionO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(CollectionDetails))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CollectionDetails)
@@ -278,6 +337,11 @@ func (discovery *DiscoveryV2) GetCollection(getCollectionOptions *GetCollectionO
 // UpdateCollection : Update a collection
 // Updates the specified collection's name, description, and enrichments.
 func (discovery *DiscoveryV2) UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	return discovery.UpdateCollectionWithContext(context.Background(), updateCollectionOptions)
+}
+
+// UpdateCollectionWithContext is an alternate form of the UpdateCollection method which supports a Context parameter
+func (discovery *DiscoveryV2) UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateCollectionOptions, "updateCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -329,7 +393,10 @@ func (discovery *DiscoveryV2) UpdateCollection(updateCollectionOptions *UpdateCo
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(CollectionDetails))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CollectionDetails)
@@ -345,6 +412,11 @@ func (discovery *DiscoveryV2) UpdateCollection(updateCollectionOptions *UpdateCo
 // Deletes the specified collection from the project. All documents stored in the specified collection and not shared is
 // also deleted.
 func (discovery *DiscoveryV2) DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteCollectionWithContext(context.Background(), deleteCollectionOptions)
+}
+
+// DeleteCollectionWithContext is an alternate form of the DeleteCollection method which supports a Context parameter
+func (discovery *DiscoveryV2) DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteCollectionOptions, "deleteCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -379,7 +451,10 @@ func (discovery *DiscoveryV2) DeleteCollection(deleteCollectionOptions *DeleteCo
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -392,6 +467,11 @@ func (discovery *DiscoveryV2) DeleteCollection(deleteCollectionOptions *DeleteCo
 // the standard default settings, and see [the Projects API documentation](#create-project) for details about how to set
 // custom default query settings.
 func (discovery *DiscoveryV2) Query(queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	return discovery.QueryWithContext(context.Background(), queryOptions)
+}
+
+// QueryWithContext is an alternate form of the Query method which supports a Context parameter
+func (discovery *DiscoveryV2) QueryWithContext(ctx context.Context, queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(queryOptions, "queryOptions cannot be nil")
 	if err != nil {
 		return
@@ -476,7 +556,10 @@ func (discovery *DiscoveryV2) Query(queryOptions *QueryOptions) (result *QueryRe
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(QueryResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryResponse)
@@ -491,6 +574,11 @@ func (discovery *DiscoveryV2) Query(queryOptions *QueryOptions) (result *QueryRe
 // GetAutocompletion : Get Autocomplete Suggestions
 // Returns completion query suggestions for the specified prefix.
 func (discovery *DiscoveryV2) GetAutocompletion(getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
+	return discovery.GetAutocompletionWithContext(context.Background(), getAutocompletionOptions)
+}
+
+// GetAutocompletionWithContext is an alternate form of the GetAutocompletion method which supports a Context parameter
+func (discovery *DiscoveryV2) GetAutocompletionWithContext(ctx context.Context, getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getAutocompletionOptions, "getAutocompletionOptions cannot be nil")
 	if err != nil {
 		return
@@ -537,7 +625,10 @@ func (discovery *DiscoveryV2) GetAutocompletion(getAutocompletionOptions *GetAut
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Completions))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Completions)
@@ -553,6 +644,11 @@ func (discovery *DiscoveryV2) GetAutocompletion(getAutocompletionOptions *GetAut
 // Queries for notices (errors or warnings) that might have been generated by the system. Notices are generated when
 // ingesting documents and performing relevance training.
 func (discovery *DiscoveryV2) QueryNotices(queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	return discovery.QueryNoticesWithContext(context.Background(), queryNoticesOptions)
+}
+
+// QueryNoticesWithContext is an alternate form of the QueryNotices method which supports a Context parameter
+func (discovery *DiscoveryV2) QueryNoticesWithContext(ctx context.Context, queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(queryNoticesOptions, "queryNoticesOptions cannot be nil")
 	if err != nil {
 		return
@@ -604,7 +700,10 @@ func (discovery *DiscoveryV2) QueryNotices(queryNoticesOptions *QueryNoticesOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(QueryNoticesResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*QueryNoticesResponse)
@@ -619,6 +718,11 @@ func (discovery *DiscoveryV2) QueryNotices(queryNoticesOptions *QueryNoticesOpti
 // ListFields : List fields
 // Gets a list of the unique fields (and their types) stored in the the specified collections.
 func (discovery *DiscoveryV2) ListFields(listFieldsOptions *ListFieldsOptions) (result *ListFieldsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListFieldsWithContext(context.Background(), listFieldsOptions)
+}
+
+// ListFieldsWithContext is an alternate form of the ListFields method which supports a Context parameter
+func (discovery *DiscoveryV2) ListFieldsWithContext(ctx context.Context, listFieldsOptions *ListFieldsOptions) (result *ListFieldsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listFieldsOptions, "listFieldsOptions cannot be nil")
 	if err != nil {
 		return
@@ -658,7 +762,10 @@ func (discovery *DiscoveryV2) ListFields(listFieldsOptions *ListFieldsOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListFieldsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListFieldsResponse)
@@ -673,6 +780,11 @@ func (discovery *DiscoveryV2) ListFields(listFieldsOptions *ListFieldsOptions) (
 // GetComponentSettings : List component settings
 // Returns default configuration settings for components.
 func (discovery *DiscoveryV2) GetComponentSettings(getComponentSettingsOptions *GetComponentSettingsOptions) (result *ComponentSettingsResponse, response *core.DetailedResponse, err error) {
+	return discovery.GetComponentSettingsWithContext(context.Background(), getComponentSettingsOptions)
+}
+
+// GetComponentSettingsWithContext is an alternate form of the GetComponentSettings method which supports a Context parameter
+func (discovery *DiscoveryV2) GetComponentSettingsWithContext(ctx context.Context, getComponentSettingsOptions *GetComponentSettingsOptions) (result *ComponentSettingsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getComponentSettingsOptions, "getComponentSettingsOptions cannot be nil")
 	if err != nil {
 		return
@@ -708,7 +820,10 @@ func (discovery *DiscoveryV2) GetComponentSettings(getComponentSettingsOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ComponentSettingsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ComponentSettingsResponse)
@@ -748,6 +863,11 @@ func (discovery *DiscoveryV2) GetComponentSettings(getComponentSettingsOptions *
 // **Note:** This operation only works on collections created to accept direct file uploads. It cannot be used to modify
 // a collection that connects to an external source such as Microsoft SharePoint.
 func (discovery *DiscoveryV2) AddDocument(addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return discovery.AddDocumentWithContext(context.Background(), addDocumentOptions)
+}
+
+// AddDocumentWithContext is an alternate form of the AddDocument method which supports a Context parameter
+func (discovery *DiscoveryV2) AddDocumentWithContext(ctx context.Context, addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addDocumentOptions, "addDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -798,7 +918,10 @@ func (discovery *DiscoveryV2) AddDocument(addDocumentOptions *AddDocumentOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -826,6 +949,11 @@ func (discovery *DiscoveryV2) AddDocument(addDocumentOptions *AddDocumentOptions
 // **Note:** If an uploaded document is segmented, all segments will be overwritten, even if the updated version of the
 // document has fewer segments.
 func (discovery *DiscoveryV2) UpdateDocument(updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return discovery.UpdateDocumentWithContext(context.Background(), updateDocumentOptions)
+}
+
+// UpdateDocumentWithContext is an alternate form of the UpdateDocument method which supports a Context parameter
+func (discovery *DiscoveryV2) UpdateDocumentWithContext(ctx context.Context, updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateDocumentOptions, "updateDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -876,7 +1004,10 @@ func (discovery *DiscoveryV2) UpdateDocument(updateDocumentOptions *UpdateDocume
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DocumentAccepted))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentAccepted)
@@ -898,6 +1029,11 @@ func (discovery *DiscoveryV2) UpdateDocument(updateDocumentOptions *UpdateDocume
 // **Note:** Segments of an uploaded document cannot be deleted individually. Delete all segments by deleting using the
 // `parent_document_id` of a segment result.
 func (discovery *DiscoveryV2) DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
+	return discovery.DeleteDocumentWithContext(context.Background(), deleteDocumentOptions)
+}
+
+// DeleteDocumentWithContext is an alternate form of the DeleteDocument method which supports a Context parameter
+func (discovery *DiscoveryV2) DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteDocumentOptions, "deleteDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -936,7 +1072,10 @@ func (discovery *DiscoveryV2) DeleteDocument(deleteDocumentOptions *DeleteDocume
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(DeleteDocumentResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteDocumentResponse)
@@ -951,6 +1090,11 @@ func (discovery *DiscoveryV2) DeleteDocument(deleteDocumentOptions *DeleteDocume
 // ListTrainingQueries : List training queries
 // List the training queries for the specified project.
 func (discovery *DiscoveryV2) ListTrainingQueries(listTrainingQueriesOptions *ListTrainingQueriesOptions) (result *TrainingQuerySet, response *core.DetailedResponse, err error) {
+	return discovery.ListTrainingQueriesWithContext(context.Background(), listTrainingQueriesOptions)
+}
+
+// ListTrainingQueriesWithContext is an alternate form of the ListTrainingQueries method which supports a Context parameter
+func (discovery *DiscoveryV2) ListTrainingQueriesWithContext(ctx context.Context, listTrainingQueriesOptions *ListTrainingQueriesOptions) (result *TrainingQuerySet, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listTrainingQueriesOptions, "listTrainingQueriesOptions cannot be nil")
 	if err != nil {
 		return
@@ -986,7 +1130,10 @@ func (discovery *DiscoveryV2) ListTrainingQueries(listTrainingQueriesOptions *Li
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingQuerySet))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuerySet)
@@ -1001,6 +1148,11 @@ func (discovery *DiscoveryV2) ListTrainingQueries(listTrainingQueriesOptions *Li
 // DeleteTrainingQueries : Delete training queries
 // Removes all training queries for the specified project.
 func (discovery *DiscoveryV2) DeleteTrainingQueries(deleteTrainingQueriesOptions *DeleteTrainingQueriesOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteTrainingQueriesWithContext(context.Background(), deleteTrainingQueriesOptions)
+}
+
+// DeleteTrainingQueriesWithContext is an alternate form of the DeleteTrainingQueries method which supports a Context parameter
+func (discovery *DiscoveryV2) DeleteTrainingQueriesWithContext(ctx context.Context, deleteTrainingQueriesOptions *DeleteTrainingQueriesOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteTrainingQueriesOptions, "deleteTrainingQueriesOptions cannot be nil")
 	if err != nil {
 		return
@@ -1035,7 +1187,10 @@ func (discovery *DiscoveryV2) DeleteTrainingQueries(deleteTrainingQueriesOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1043,6 +1198,11 @@ func (discovery *DiscoveryV2) DeleteTrainingQueries(deleteTrainingQueriesOptions
 // CreateTrainingQuery : Create training query
 // Add a query to the training data for this project. The query can contain a filter and natural language query.
 func (discovery *DiscoveryV2) CreateTrainingQuery(createTrainingQueryOptions *CreateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return discovery.CreateTrainingQueryWithContext(context.Background(), createTrainingQueryOptions)
+}
+
+// CreateTrainingQueryWithContext is an alternate form of the CreateTrainingQuery method which supports a Context parameter
+func (discovery *DiscoveryV2) CreateTrainingQueryWithContext(ctx context.Context, createTrainingQueryOptions *CreateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createTrainingQueryOptions, "createTrainingQueryOptions cannot be nil")
 	if err != nil {
 		return
@@ -1094,7 +1254,10 @@ func (discovery *DiscoveryV2) CreateTrainingQuery(createTrainingQueryOptions *Cr
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -1109,6 +1272,11 @@ func (discovery *DiscoveryV2) CreateTrainingQuery(createTrainingQueryOptions *Cr
 // GetTrainingQuery : Get a training data query
 // Get details for a specific training data query, including the query string and all examples.
 func (discovery *DiscoveryV2) GetTrainingQuery(getTrainingQueryOptions *GetTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return discovery.GetTrainingQueryWithContext(context.Background(), getTrainingQueryOptions)
+}
+
+// GetTrainingQueryWithContext is an alternate form of the GetTrainingQuery method which supports a Context parameter
+func (discovery *DiscoveryV2) GetTrainingQueryWithContext(ctx context.Context, getTrainingQueryOptions *GetTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getTrainingQueryOptions, "getTrainingQueryOptions cannot be nil")
 	if err != nil {
 		return
@@ -1144,7 +1312,10 @@ func (discovery *DiscoveryV2) GetTrainingQuery(getTrainingQueryOptions *GetTrain
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -1159,6 +1330,11 @@ func (discovery *DiscoveryV2) GetTrainingQuery(getTrainingQueryOptions *GetTrain
 // UpdateTrainingQuery : Update a training query
 // Updates an existing training query and it's examples.
 func (discovery *DiscoveryV2) UpdateTrainingQuery(updateTrainingQueryOptions *UpdateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return discovery.UpdateTrainingQueryWithContext(context.Background(), updateTrainingQueryOptions)
+}
+
+// UpdateTrainingQueryWithContext is an alternate form of the UpdateTrainingQuery method which supports a Context parameter
+func (discovery *DiscoveryV2) UpdateTrainingQueryWithContext(ctx context.Context, updateTrainingQueryOptions *UpdateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateTrainingQueryOptions, "updateTrainingQueryOptions cannot be nil")
 	if err != nil {
 		return
@@ -1210,7 +1386,10 @@ func (discovery *DiscoveryV2) UpdateTrainingQuery(updateTrainingQueryOptions *Up
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(TrainingQuery))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingQuery)
@@ -1225,6 +1404,11 @@ func (discovery *DiscoveryV2) UpdateTrainingQuery(updateTrainingQueryOptions *Up
 // ListEnrichments : List Enrichments
 // List the enrichments available to this project.
 func (discovery *DiscoveryV2) ListEnrichments(listEnrichmentsOptions *ListEnrichmentsOptions) (result *Enrichments, response *core.DetailedResponse, err error) {
+	return discovery.ListEnrichmentsWithContext(context.Background(), listEnrichmentsOptions)
+}
+
+// ListEnrichmentsWithContext is an alternate form of the ListEnrichments method which supports a Context parameter
+func (discovery *DiscoveryV2) ListEnrichmentsWithContext(ctx context.Context, listEnrichmentsOptions *ListEnrichmentsOptions) (result *Enrichments, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listEnrichmentsOptions, "listEnrichmentsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1260,7 +1444,10 @@ func (discovery *DiscoveryV2) ListEnrichments(listEnrichmentsOptions *ListEnrich
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Enrichments))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Enrichments)
@@ -1275,6 +1462,11 @@ func (discovery *DiscoveryV2) ListEnrichments(listEnrichmentsOptions *ListEnrich
 // CreateEnrichment : Create an enrichment
 // Create an enrichment for use with the specified project/.
 func (discovery *DiscoveryV2) CreateEnrichment(createEnrichmentOptions *CreateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	return discovery.CreateEnrichmentWithContext(context.Background(), createEnrichmentOptions)
+}
+
+// CreateEnrichmentWithContext is an alternate form of the CreateEnrichment method which supports a Context parameter
+func (discovery *DiscoveryV2) CreateEnrichmentWithContext(ctx context.Context, createEnrichmentOptions *CreateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createEnrichmentOptions, "createEnrichmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -1319,7 +1511,10 @@ func (discovery *DiscoveryV2) CreateEnrichment(createEnrichmentOptions *CreateEn
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Enrichment))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Enrichment)
@@ -1334,6 +1529,11 @@ func (discovery *DiscoveryV2) CreateEnrichment(createEnrichmentOptions *CreateEn
 // GetEnrichment : Get enrichment
 // Get details about a specific enrichment.
 func (discovery *DiscoveryV2) GetEnrichment(getEnrichmentOptions *GetEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	return discovery.GetEnrichmentWithContext(context.Background(), getEnrichmentOptions)
+}
+
+// GetEnrichmentWithContext is an alternate form of the GetEnrichment method which supports a Context parameter
+func (discovery *DiscoveryV2) GetEnrichmentWithContext(ctx context.Context, getEnrichmentOptions *GetEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getEnrichmentOptions, "getEnrichmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -1369,7 +1569,10 @@ func (discovery *DiscoveryV2) GetEnrichment(getEnrichmentOptions *GetEnrichmentO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Enrichment))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Enrichment)
@@ -1384,6 +1587,11 @@ func (discovery *DiscoveryV2) GetEnrichment(getEnrichmentOptions *GetEnrichmentO
 // UpdateEnrichment : Update an enrichment
 // Updates an existing enrichment's name and description.
 func (discovery *DiscoveryV2) UpdateEnrichment(updateEnrichmentOptions *UpdateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	return discovery.UpdateEnrichmentWithContext(context.Background(), updateEnrichmentOptions)
+}
+
+// UpdateEnrichmentWithContext is an alternate form of the UpdateEnrichment method which supports a Context parameter
+func (discovery *DiscoveryV2) UpdateEnrichmentWithContext(ctx context.Context, updateEnrichmentOptions *UpdateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateEnrichmentOptions, "updateEnrichmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -1432,7 +1640,10 @@ func (discovery *DiscoveryV2) UpdateEnrichment(updateEnrichmentOptions *UpdateEn
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(Enrichment))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Enrichment)
@@ -1449,6 +1660,11 @@ func (discovery *DiscoveryV2) UpdateEnrichment(updateEnrichmentOptions *UpdateEn
 //
 // **Note:** Only enrichments that have been manually created can be deleted.
 func (discovery *DiscoveryV2) DeleteEnrichment(deleteEnrichmentOptions *DeleteEnrichmentOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteEnrichmentWithContext(context.Background(), deleteEnrichmentOptions)
+}
+
+// DeleteEnrichmentWithContext is an alternate form of the DeleteEnrichment method which supports a Context parameter
+func (discovery *DiscoveryV2) DeleteEnrichmentWithContext(ctx context.Context, deleteEnrichmentOptions *DeleteEnrichmentOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteEnrichmentOptions, "deleteEnrichmentOptions cannot be nil")
 	if err != nil {
 		return
@@ -1483,7 +1699,10 @@ func (discovery *DiscoveryV2) DeleteEnrichment(deleteEnrichmentOptions *DeleteEn
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1491,6 +1710,11 @@ func (discovery *DiscoveryV2) DeleteEnrichment(deleteEnrichmentOptions *DeleteEn
 // ListProjects : List projects
 // Lists existing projects for this instance.
 func (discovery *DiscoveryV2) ListProjects(listProjectsOptions *ListProjectsOptions) (result *ListProjectsResponse, response *core.DetailedResponse, err error) {
+	return discovery.ListProjectsWithContext(context.Background(), listProjectsOptions)
+}
+
+// ListProjectsWithContext is an alternate form of the ListProjects method which supports a Context parameter
+func (discovery *DiscoveryV2) ListProjectsWithContext(ctx context.Context, listProjectsOptions *ListProjectsOptions) (result *ListProjectsResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listProjectsOptions, "listProjectsOptions")
 	if err != nil {
 		return
@@ -1522,7 +1746,10 @@ func (discovery *DiscoveryV2) ListProjects(listProjectsOptions *ListProjectsOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ListProjectsResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListProjectsResponse)
@@ -1537,6 +1764,11 @@ func (discovery *DiscoveryV2) ListProjects(listProjectsOptions *ListProjectsOpti
 // CreateProject : Create a Project
 // Create a new project for this instance.
 func (discovery *DiscoveryV2) CreateProject(createProjectOptions *CreateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	return discovery.CreateProjectWithContext(context.Background(), createProjectOptions)
+}
+
+// CreateProjectWithContext is an alternate form of the CreateProject method which supports a Context parameter
+func (discovery *DiscoveryV2) CreateProjectWithContext(ctx context.Context, createProjectOptions *CreateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createProjectOptions, "createProjectOptions cannot be nil")
 	if err != nil {
 		return
@@ -1588,7 +1820,10 @@ func (discovery *DiscoveryV2) CreateProject(createProjectOptions *CreateProjectO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ProjectDetails))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ProjectDetails)
@@ -1603,6 +1838,11 @@ func (discovery *DiscoveryV2) CreateProject(createProjectOptions *CreateProjectO
 // GetProject : Get project
 // Get details on the specified project.
 func (discovery *DiscoveryV2) GetProject(getProjectOptions *GetProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	return discovery.GetProjectWithContext(context.Background(), getProjectOptions)
+}
+
+// GetProjectWithContext is an alternate form of the GetProject method which supports a Context parameter
+func (discovery *DiscoveryV2) GetProjectWithContext(ctx context.Context, getProjectOptions *GetProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getProjectOptions, "getProjectOptions cannot be nil")
 	if err != nil {
 		return
@@ -1638,7 +1878,10 @@ func (discovery *DiscoveryV2) GetProject(getProjectOptions *GetProjectOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ProjectDetails))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ProjectDetails)
@@ -1653,6 +1896,11 @@ func (discovery *DiscoveryV2) GetProject(getProjectOptions *GetProjectOptions) (
 // UpdateProject : Update a project
 // Update the specified project's name.
 func (discovery *DiscoveryV2) UpdateProject(updateProjectOptions *UpdateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	return discovery.UpdateProjectWithContext(context.Background(), updateProjectOptions)
+}
+
+// UpdateProjectWithContext is an alternate form of the UpdateProject method which supports a Context parameter
+func (discovery *DiscoveryV2) UpdateProjectWithContext(ctx context.Context, updateProjectOptions *UpdateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateProjectOptions, "updateProjectOptions cannot be nil")
 	if err != nil {
 		return
@@ -1698,7 +1946,10 @@ func (discovery *DiscoveryV2) UpdateProject(updateProjectOptions *UpdateProjectO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, new(ProjectDetails))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ProjectDetails)
@@ -1716,6 +1967,11 @@ func (discovery *DiscoveryV2) UpdateProject(updateProjectOptions *UpdateProjectO
 // **Important:** Deleting a project deletes everything that is part of the specified project, including all
 // collections.
 func (discovery *DiscoveryV2) DeleteProject(deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteProjectWithContext(context.Background(), deleteProjectOptions)
+}
+
+// DeleteProjectWithContext is an alternate form of the DeleteProject method which supports a Context parameter
+func (discovery *DiscoveryV2) DeleteProjectWithContext(ctx context.Context, deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteProjectOptions, "deleteProjectOptions cannot be nil")
 	if err != nil {
 		return
@@ -1750,7 +2006,10 @@ func (discovery *DiscoveryV2) DeleteProject(deleteProjectOptions *DeleteProjectO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1765,6 +2024,11 @@ func (discovery *DiscoveryV2) DeleteProject(deleteProjectOptions *DeleteProjectO
 //
 // **Note:** This method is only supported on IBM Cloud instances of Discovery.
 func (discovery *DiscoveryV2) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return discovery.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (discovery *DiscoveryV2) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -1800,7 +2064,10 @@ func (discovery *DiscoveryV2) DeleteUserData(deleteUserDataOptions *DeleteUserDa
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = discovery.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -0,0 +1,658 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discoveryv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockDiscoveryV2 : A mock implementation of DiscoveryV2API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *DiscoveryV2.
+type MockDiscoveryV2 struct {
+	common.MockRecorder
+
+	ListCollectionsFunc       func(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error)
+	CreateCollectionFunc      func(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error)
+	GetCollectionFunc         func(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error)
+	UpdateCollectionFunc      func(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error)
+	DeleteCollectionFunc      func(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error)
+	QueryFunc                 func(ctx context.Context, queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error)
+	GetAutocompletionFunc     func(ctx context.Context, getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error)
+	QueryNoticesFunc          func(ctx context.Context, queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error)
+	ListFieldsFunc            func(ctx context.Context, listFieldsOptions *ListFieldsOptions) (result *ListFieldsResponse, response *core.DetailedResponse, err error)
+	GetComponentSettingsFunc  func(ctx context.Context, getComponentSettingsOptions *GetComponentSettingsOptions) (result *ComponentSettingsResponse, response *core.DetailedResponse, err error)
+	AddDocumentFunc           func(ctx context.Context, addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	UpdateDocumentFunc        func(ctx context.Context, updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error)
+	DeleteDocumentFunc        func(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error)
+	ListTrainingQueriesFunc   func(ctx context.Context, listTrainingQueriesOptions *ListTrainingQueriesOptions) (result *TrainingQuerySet, response *core.DetailedResponse, err error)
+	DeleteTrainingQueriesFunc func(ctx context.Context, deleteTrainingQueriesOptions *DeleteTrainingQueriesOptions) (response *core.DetailedResponse, err error)
+	CreateTrainingQueryFunc   func(ctx context.Context, createTrainingQueryOptions *CreateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	GetTrainingQueryFunc      func(ctx context.Context, getTrainingQueryOptions *GetTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	UpdateTrainingQueryFunc   func(ctx context.Context, updateTrainingQueryOptions *UpdateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error)
+	ListEnrichmentsFunc       func(ctx context.Context, listEnrichmentsOptions *ListEnrichmentsOptions) (result *Enrichments, response *core.DetailedResponse, err error)
+	CreateEnrichmentFunc      func(ctx context.Context, createEnrichmentOptions *CreateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error)
+	GetEnrichmentFunc         func(ctx context.Context, getEnrichmentOptions *GetEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error)
+	UpdateEnrichmentFunc      func(ctx context.Context, updateEnrichmentOptions *UpdateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error)
+	DeleteEnrichmentFunc      func(ctx context.Context, deleteEnrichmentOptions *DeleteEnrichmentOptions) (response *core.DetailedResponse, err error)
+	ListProjectsFunc          func(ctx context.Context, listProjectsOptions *ListProjectsOptions) (result *ListProjectsResponse, response *core.DetailedResponse, err error)
+	CreateProjectFunc         func(ctx context.Context, createProjectOptions *CreateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error)
+	GetProjectFunc            func(ctx context.Context, getProjectOptions *GetProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error)
+	UpdateProjectFunc         func(ctx context.Context, updateProjectOptions *UpdateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error)
+	DeleteProjectFunc         func(ctx context.Context, deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataFunc        func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ DiscoveryV2API = (*MockDiscoveryV2)(nil)
+
+// ListCollections records the call and invokes ListCollectionsFunc
+func (mock *MockDiscoveryV2) ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListCollectionsWithContext(context.Background(), listCollectionsOptions)
+}
+
+// ListCollectionsWithContext records the call and invokes ListCollectionsFunc
+func (mock *MockDiscoveryV2) ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *ListCollectionsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListCollections", listCollectionsOptions)
+	if mock.ListCollectionsFunc != nil {
+		return mock.ListCollectionsFunc(ctx, listCollectionsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "ListCollections")
+	return
+}
+
+// CreateCollection records the call and invokes CreateCollectionFunc
+func (mock *MockDiscoveryV2) CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	return mock.CreateCollectionWithContext(context.Background(), createCollectionOptions)
+}
+
+// CreateCollectionWithContext records the call and invokes CreateCollectionFunc
+func (mock *MockDiscoveryV2) CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateCollection", createCollectionOptions)
+	if mock.CreateCollectionFunc != nil {
+		return mock.CreateCollectionFunc(ctx, createCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "CreateCollection")
+	return
+}
+
+// GetCollection records the call and invokes GetCollectionFunc
+func (mock *MockDiscoveryV2) GetCollection(getCollectionOptions *GetCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	return mock.GetCollectionWithContext(context.Background(), getCollectionOptions)
+}
+
+// GetCollectionWithContext records the call and invokes GetCollectionFunc
+func (mock *MockDiscoveryV2) GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetCollection", getCollectionOptions)
+	if mock.GetCollectionFunc != nil {
+		return mock.GetCollectionFunc(ctx, getCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "GetCollection")
+	return
+}
+
+// UpdateCollection records the call and invokes UpdateCollectionFunc
+func (mock *MockDiscoveryV2) UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	return mock.UpdateCollectionWithContext(context.Background(), updateCollectionOptions)
+}
+
+// UpdateCollectionWithContext records the call and invokes UpdateCollectionFunc
+func (mock *MockDiscoveryV2) UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *CollectionDetails, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateCollection", updateCollectionOptions)
+	if mock.UpdateCollectionFunc != nil {
+		return mock.UpdateCollectionFunc(ctx, updateCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "UpdateCollection")
+	return
+}
+
+// DeleteCollection records the call and invokes DeleteCollectionFunc
+func (mock *MockDiscoveryV2) DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteCollectionWithContext(context.Background(), deleteCollectionOptions)
+}
+
+// DeleteCollectionWithContext records the call and invokes DeleteCollectionFunc
+func (mock *MockDiscoveryV2) DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteCollection", deleteCollectionOptions)
+	if mock.DeleteCollectionFunc != nil {
+		return mock.DeleteCollectionFunc(ctx, deleteCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "DeleteCollection")
+	return
+}
+
+// Query records the call and invokes QueryFunc
+func (mock *MockDiscoveryV2) Query(queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	return mock.QueryWithContext(context.Background(), queryOptions)
+}
+
+// QueryWithContext records the call and invokes QueryFunc
+func (mock *MockDiscoveryV2) QueryWithContext(ctx context.Context, queryOptions *QueryOptions) (result *QueryResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Query", queryOptions)
+	if mock.QueryFunc != nil {
+		return mock.QueryFunc(ctx, queryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "Query")
+	return
+}
+
+// GetAutocompletion records the call and invokes GetAutocompletionFunc
+func (mock *MockDiscoveryV2) GetAutocompletion(getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
+	return mock.GetAutocompletionWithContext(context.Background(), getAutocompletionOptions)
+}
+
+// GetAutocompletionWithContext records the call and invokes GetAutocompletionFunc
+func (mock *MockDiscoveryV2) GetAutocompletionWithContext(ctx context.Context, getAutocompletionOptions *GetAutocompletionOptions) (result *Completions, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetAutocompletion", getAutocompletionOptions)
+	if mock.GetAutocompletionFunc != nil {
+		return mock.GetAutocompletionFunc(ctx, getAutocompletionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "GetAutocompletion")
+	return
+}
+
+// QueryNotices records the call and invokes QueryNoticesFunc
+func (mock *MockDiscoveryV2) QueryNotices(queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	return mock.QueryNoticesWithContext(context.Background(), queryNoticesOptions)
+}
+
+// QueryNoticesWithContext records the call and invokes QueryNoticesFunc
+func (mock *MockDiscoveryV2) QueryNoticesWithContext(ctx context.Context, queryNoticesOptions *QueryNoticesOptions) (result *QueryNoticesResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "QueryNotices", queryNoticesOptions)
+	if mock.QueryNoticesFunc != nil {
+		return mock.QueryNoticesFunc(ctx, queryNoticesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "QueryNotices")
+	return
+}
+
+// ListFields records the call and invokes ListFieldsFunc
+func (mock *MockDiscoveryV2) ListFields(listFieldsOptions *ListFieldsOptions) (result *ListFieldsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListFieldsWithContext(context.Background(), listFieldsOptions)
+}
+
+// ListFieldsWithContext records the call and invokes ListFieldsFunc
+func (mock *MockDiscoveryV2) ListFieldsWithContext(ctx context.Context, listFieldsOptions *ListFieldsOptions) (result *ListFieldsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListFields", listFieldsOptions)
+	if mock.ListFieldsFunc != nil {
+		return mock.ListFieldsFunc(ctx, listFieldsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "ListFields")
+	return
+}
+
+// GetComponentSettings records the call and invokes GetComponentSettingsFunc
+func (mock *MockDiscoveryV2) GetComponentSettings(getComponentSettingsOptions *GetComponentSettingsOptions) (result *ComponentSettingsResponse, response *core.DetailedResponse, err error) {
+	return mock.GetComponentSettingsWithContext(context.Background(), getComponentSettingsOptions)
+}
+
+// GetComponentSettingsWithContext records the call and invokes GetComponentSettingsFunc
+func (mock *MockDiscoveryV2) GetComponentSettingsWithContext(ctx context.Context, getComponentSettingsOptions *GetComponentSettingsOptions) (result *ComponentSettingsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetComponentSettings", getComponentSettingsOptions)
+	if mock.GetComponentSettingsFunc != nil {
+		return mock.GetComponentSettingsFunc(ctx, getComponentSettingsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "GetComponentSettings")
+	return
+}
+
+// AddDocument records the call and invokes AddDocumentFunc
+func (mock *MockDiscoveryV2) AddDocument(addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return mock.AddDocumentWithContext(context.Background(), addDocumentOptions)
+}
+
+// AddDocumentWithContext records the call and invokes AddDocumentFunc
+func (mock *MockDiscoveryV2) AddDocumentWithContext(ctx context.Context, addDocumentOptions *AddDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddDocument", addDocumentOptions)
+	if mock.AddDocumentFunc != nil {
+		return mock.AddDocumentFunc(ctx, addDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "AddDocument")
+	return
+}
+
+// UpdateDocument records the call and invokes UpdateDocumentFunc
+func (mock *MockDiscoveryV2) UpdateDocument(updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	return mock.UpdateDocumentWithContext(context.Background(), updateDocumentOptions)
+}
+
+// UpdateDocumentWithContext records the call and invokes UpdateDocumentFunc
+func (mock *MockDiscoveryV2) UpdateDocumentWithContext(ctx context.Context, updateDocumentOptions *UpdateDocumentOptions) (result *DocumentAccepted, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateDocument", updateDocumentOptions)
+	if mock.UpdateDocumentFunc != nil {
+		return mock.UpdateDocumentFunc(ctx, updateDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "UpdateDocument")
+	return
+}
+
+// DeleteDocument records the call and invokes DeleteDocumentFunc
+func (mock *MockDiscoveryV2) DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
+	return mock.DeleteDocumentWithContext(context.Background(), deleteDocumentOptions)
+}
+
+// DeleteDocumentWithContext records the call and invokes DeleteDocumentFunc
+func (mock *MockDiscoveryV2) DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (result *DeleteDocumentResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteDocument", deleteDocumentOptions)
+	if mock.DeleteDocumentFunc != nil {
+		return mock.DeleteDocumentFunc(ctx, deleteDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "DeleteDocument")
+	return
+}
+
+// ListTrainingQueries records the call and invokes ListTrainingQueriesFunc
+func (mock *MockDiscoveryV2) ListTrainingQueries(listTrainingQueriesOptions *ListTrainingQueriesOptions) (result *TrainingQuerySet, response *core.DetailedResponse, err error) {
+	return mock.ListTrainingQueriesWithContext(context.Background(), listTrainingQueriesOptions)
+}
+
+// ListTrainingQueriesWithContext records the call and invokes ListTrainingQueriesFunc
+func (mock *MockDiscoveryV2) ListTrainingQueriesWithContext(ctx context.Context, listTrainingQueriesOptions *ListTrainingQueriesOptions) (result *TrainingQuerySet, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListTrainingQueries", listTrainingQueriesOptions)
+	if mock.ListTrainingQueriesFunc != nil {
+		return mock.ListTrainingQueriesFunc(ctx, listTrainingQueriesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "ListTrainingQueries")
+	return
+}
+
+// DeleteTrainingQueries records the call and invokes DeleteTrainingQueriesFunc
+func (mock *MockDiscoveryV2) DeleteTrainingQueries(deleteTrainingQueriesOptions *DeleteTrainingQueriesOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteTrainingQueriesWithContext(context.Background(), deleteTrainingQueriesOptions)
+}
+
+// DeleteTrainingQueriesWithContext records the call and invokes DeleteTrainingQueriesFunc
+func (mock *MockDiscoveryV2) DeleteTrainingQueriesWithContext(ctx context.Context, deleteTrainingQueriesOptions *DeleteTrainingQueriesOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteTrainingQueries", deleteTrainingQueriesOptions)
+	if mock.DeleteTrainingQueriesFunc != nil {
+		return mock.DeleteTrainingQueriesFunc(ctx, deleteTrainingQueriesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "DeleteTrainingQueries")
+	return
+}
+
+// CreateTrainingQuery records the call and invokes CreateTrainingQueryFunc
+func (mock *MockDiscoveryV2) CreateTrainingQuery(createTrainingQueryOptions *CreateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return mock.CreateTrainingQueryWithContext(context.Background(), createTrainingQueryOptions)
+}
+
+// CreateTrainingQueryWithContext records the call and invokes CreateTrainingQueryFunc
+func (mock *MockDiscoveryV2) CreateTrainingQueryWithContext(ctx context.Context, createTrainingQueryOptions *CreateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateTrainingQuery", createTrainingQueryOptions)
+	if mock.CreateTrainingQueryFunc != nil {
+		return mock.CreateTrainingQueryFunc(ctx, createTrainingQueryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "CreateTrainingQuery")
+	return
+}
+
+// GetTrainingQuery records the call and invokes GetTrainingQueryFunc
+func (mock *MockDiscoveryV2) GetTrainingQuery(getTrainingQueryOptions *GetTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return mock.GetTrainingQueryWithContext(context.Background(), getTrainingQueryOptions)
+}
+
+// GetTrainingQueryWithContext records the call and invokes GetTrainingQueryFunc
+func (mock *MockDiscoveryV2) GetTrainingQueryWithContext(ctx context.Context, getTrainingQueryOptions *GetTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetTrainingQuery", getTrainingQueryOptions)
+	if mock.GetTrainingQueryFunc != nil {
+		return mock.GetTrainingQueryFunc(ctx, getTrainingQueryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "GetTrainingQuery")
+	return
+}
+
+// UpdateTrainingQuery records the call and invokes UpdateTrainingQueryFunc
+func (mock *MockDiscoveryV2) UpdateTrainingQuery(updateTrainingQueryOptions *UpdateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	return mock.UpdateTrainingQueryWithContext(context.Background(), updateTrainingQueryOptions)
+}
+
+// UpdateTrainingQueryWithContext records the call and invokes UpdateTrainingQueryFunc
+func (mock *MockDiscoveryV2) UpdateTrainingQueryWithContext(ctx context.Context, updateTrainingQueryOptions *UpdateTrainingQueryOptions) (result *TrainingQuery, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateTrainingQuery", updateTrainingQueryOptions)
+	if mock.UpdateTrainingQueryFunc != nil {
+		return mock.UpdateTrainingQueryFunc(ctx, updateTrainingQueryOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "UpdateTrainingQuery")
+	return
+}
+
+// ListEnrichments records the call and invokes ListEnrichmentsFunc
+func (mock *MockDiscoveryV2) ListEnrichments(listEnrichmentsOptions *ListEnrichmentsOptions) (result *Enrichments, response *core.DetailedResponse, err error) {
+	return mock.ListEnrichmentsWithContext(context.Background(), listEnrichmentsOptions)
+}
+
+// ListEnrichmentsWithContext records the call and invokes ListEnrichmentsFunc
+func (mock *MockDiscoveryV2) ListEnrichmentsWithContext(ctx context.Context, listEnrichmentsOptions *ListEnrichmentsOptions) (result *Enrichments, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListEnrichments", listEnrichmentsOptions)
+	if mock.ListEnrichmentsFunc != nil {
+		return mock.ListEnrichmentsFunc(ctx, listEnrichmentsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "ListEnrichments")
+	return
+}
+
+// CreateEnrichment records the call and invokes CreateEnrichmentFunc
+func (mock *MockDiscoveryV2) CreateEnrichment(createEnrichmentOptions *CreateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	return mock.CreateEnrichmentWithContext(context.Background(), createEnrichmentOptions)
+}
+
+// CreateEnrichmentWithContext records the call and invokes CreateEnrichmentFunc
+func (mock *MockDiscoveryV2) CreateEnrichmentWithContext(ctx context.Context, createEnrichmentOptions *CreateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateEnrichment", createEnrichmentOptions)
+	if mock.CreateEnrichmentFunc != nil {
+		return mock.CreateEnrichmentFunc(ctx, createEnrichmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "CreateEnrichment")
+	return
+}
+
+// GetEnrichment records the call and invokes GetEnrichmentFunc
+func (mock *MockDiscoveryV2) GetEnrichment(getEnrichmentOptions *GetEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	return mock.GetEnrichmentWithContext(context.Background(), getEnrichmentOptions)
+}
+
+// GetEnrichmentWithContext records the call and invokes GetEnrichmentFunc
+func (mock *MockDiscoveryV2) GetEnrichmentWithContext(ctx context.Context, getEnrichmentOptions *GetEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetEnrichment", getEnrichmentOptions)
+	if mock.GetEnrichmentFunc != nil {
+		return mock.GetEnrichmentFunc(ctx, getEnrichmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "GetEnrichment")
+	return
+}
+
+// UpdateEnrichment records the call and invokes UpdateEnrichmentFunc
+func (mock *MockDiscoveryV2) UpdateEnrichment(updateEnrichmentOptions *UpdateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	return mock.UpdateEnrichmentWithContext(context.Background(), updateEnrichmentOptions)
+}
+
+// UpdateEnrichmentWithContext records the call and invokes UpdateEnrichmentFunc
+func (mock *MockDiscoveryV2) UpdateEnrichmentWithContext(ctx context.Context, updateEnrichmentOptions *UpdateEnrichmentOptions) (result *Enrichment, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateEnrichment", updateEnrichmentOptions)
+	if mock.UpdateEnrichmentFunc != nil {
+		return mock.UpdateEnrichmentFunc(ctx, updateEnrichmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "UpdateEnrichment")
+	return
+}
+
+// DeleteEnrichment records the call and invokes DeleteEnrichmentFunc
+func (mock *MockDiscoveryV2) DeleteEnrichment(deleteEnrichmentOptions *DeleteEnrichmentOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteEnrichmentWithContext(context.Background(), deleteEnrichmentOptions)
+}
+
+// DeleteEnrichmentWithContext records the call and invokes DeleteEnrichmentFunc
+func (mock *MockDiscoveryV2) DeleteEnrichmentWithContext(ctx context.Context, deleteEnrichmentOptions *DeleteEnrichmentOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteEnrichment", deleteEnrichmentOptions)
+	if mock.DeleteEnrichmentFunc != nil {
+		return mock.DeleteEnrichmentFunc(ctx, deleteEnrichmentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "DeleteEnrichment")
+	return
+}
+
+// ListProjects records the call and invokes ListProjectsFunc
+func (mock *MockDiscoveryV2) ListProjects(listProjectsOptions *ListProjectsOptions) (result *ListProjectsResponse, response *core.DetailedResponse, err error) {
+	return mock.ListProjectsWithContext(context.Background(), listProjectsOptions)
+}
+
+// ListProjectsWithContext records the call and invokes ListProjectsFunc
+func (mock *MockDiscoveryV2) ListProjectsWithContext(ctx context.Context, listProjectsOptions *ListProjectsOptions) (result *ListProjectsResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListProjects", listProjectsOptions)
+	if mock.ListProjectsFunc != nil {
+		return mock.ListProjectsFunc(ctx, listProjectsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "ListProjects")
+	return
+}
+
+// CreateProject records the call and invokes CreateProjectFunc
+func (mock *MockDiscoveryV2) CreateProject(createProjectOptions *CreateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	return mock.CreateProjectWithContext(context.Background(), createProjectOptions)
+}
+
+// CreateProjectWithContext records the call and invokes CreateProjectFunc
+func (mock *MockDiscoveryV2) CreateProjectWithContext(ctx context.Context, createProjectOptions *CreateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateProject", createProjectOptions)
+	if mock.CreateProjectFunc != nil {
+		return mock.CreateProjectFunc(ctx, createProjectOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "CreateProject")
+	return
+}
+
+// GetProject records the call and invokes GetProjectFunc
+func (mock *MockDiscoveryV2) GetProject(getProjectOptions *GetProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	return mock.GetProjectWithContext(context.Background(), getProjectOptions)
+}
+
+// GetProjectWithContext records the call and invokes GetProjectFunc
+func (mock *MockDiscoveryV2) GetProjectWithContext(ctx context.Context, getProjectOptions *GetProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetProject", getProjectOptions)
+	if mock.GetProjectFunc != nil {
+		return mock.GetProjectFunc(ctx, getProjectOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "GetProject")
+	return
+}
+
+// UpdateProject records the call and invokes UpdateProjectFunc
+func (mock *MockDiscoveryV2) UpdateProject(updateProjectOptions *UpdateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	return mock.UpdateProjectWithContext(context.Background(), updateProjectOptions)
+}
+
+// UpdateProjectWithContext records the call and invokes UpdateProjectFunc
+func (mock *MockDiscoveryV2) UpdateProjectWithContext(ctx context.Context, updateProjectOptions *UpdateProjectOptions) (result *ProjectDetails, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateProject", updateProjectOptions)
+	if mock.UpdateProjectFunc != nil {
+		return mock.UpdateProjectFunc(ctx, updateProjectOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "UpdateProject")
+	return
+}
+
+// DeleteProject records the call and invokes DeleteProjectFunc
+func (mock *MockDiscoveryV2) DeleteProject(deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteProjectWithContext(context.Background(), deleteProjectOptions)
+}
+
+// DeleteProjectWithContext records the call and invokes DeleteProjectFunc
+func (mock *MockDiscoveryV2) DeleteProjectWithContext(ctx context.Context, deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteProject", deleteProjectOptions)
+	if mock.DeleteProjectFunc != nil {
+		return mock.DeleteProjectFunc(ctx, deleteProjectOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "DeleteProject")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockDiscoveryV2) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockDiscoveryV2) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockDiscoveryV2", "DeleteUserData")
+	return
+}
+
+// NewAddDocumentOptions delegates to DiscoveryV2.NewAddDocumentOptions
+func (mock *MockDiscoveryV2) NewAddDocumentOptions(projectID string, collectionID string) *AddDocumentOptions {
+	return new(DiscoveryV2).NewAddDocumentOptions(projectID, collectionID)
+}
+
+// NewCollectionDetails delegates to DiscoveryV2.NewCollectionDetails
+func (mock *MockDiscoveryV2) NewCollectionDetails(name string) (model *CollectionDetails, err error) {
+	return new(DiscoveryV2).NewCollectionDetails(name)
+}
+
+// NewCreateCollectionOptions delegates to DiscoveryV2.NewCreateCollectionOptions
+func (mock *MockDiscoveryV2) NewCreateCollectionOptions(projectID string, name string) *CreateCollectionOptions {
+	return new(DiscoveryV2).NewCreateCollectionOptions(projectID, name)
+}
+
+// NewCreateEnrichmentOptions delegates to DiscoveryV2.NewCreateEnrichmentOptions
+func (mock *MockDiscoveryV2) NewCreateEnrichmentOptions(projectID string, enrichment *CreateEnrichment) *CreateEnrichmentOptions {
+	return new(DiscoveryV2).NewCreateEnrichmentOptions(projectID, enrichment)
+}
+
+// NewCreateProjectOptions delegates to DiscoveryV2.NewCreateProjectOptions
+func (mock *MockDiscoveryV2) NewCreateProjectOptions(name string, typeVar string) *CreateProjectOptions {
+	return new(DiscoveryV2).NewCreateProjectOptions(name, typeVar)
+}
+
+// NewCreateTrainingQueryOptions delegates to DiscoveryV2.NewCreateTrainingQueryOptions
+func (mock *MockDiscoveryV2) NewCreateTrainingQueryOptions(projectID string, naturalLanguageQuery string, examples []TrainingExample) *CreateTrainingQueryOptions {
+	return new(DiscoveryV2).NewCreateTrainingQueryOptions(projectID, naturalLanguageQuery, examples)
+}
+
+// NewDeleteCollectionOptions delegates to DiscoveryV2.NewDeleteCollectionOptions
+func (mock *MockDiscoveryV2) NewDeleteCollectionOptions(projectID string, collectionID string) *DeleteCollectionOptions {
+	return new(DiscoveryV2).NewDeleteCollectionOptions(projectID, collectionID)
+}
+
+// NewDeleteDocumentOptions delegates to DiscoveryV2.NewDeleteDocumentOptions
+func (mock *MockDiscoveryV2) NewDeleteDocumentOptions(projectID string, collectionID string, documentID string) *DeleteDocumentOptions {
+	return new(DiscoveryV2).NewDeleteDocumentOptions(projectID, collectionID, documentID)
+}
+
+// NewDeleteEnrichmentOptions delegates to DiscoveryV2.NewDeleteEnrichmentOptions
+func (mock *MockDiscoveryV2) NewDeleteEnrichmentOptions(projectID string, enrichmentID string) *DeleteEnrichmentOptions {
+	return new(DiscoveryV2).NewDeleteEnrichmentOptions(projectID, enrichmentID)
+}
+
+// NewDeleteProjectOptions delegates to DiscoveryV2.NewDeleteProjectOptions
+func (mock *MockDiscoveryV2) NewDeleteProjectOptions(projectID string) *DeleteProjectOptions {
+	return new(DiscoveryV2).NewDeleteProjectOptions(projectID)
+}
+
+// NewDeleteTrainingQueriesOptions delegates to DiscoveryV2.NewDeleteTrainingQueriesOptions
+func (mock *MockDiscoveryV2) NewDeleteTrainingQueriesOptions(projectID string) *DeleteTrainingQueriesOptions {
+	return new(DiscoveryV2).NewDeleteTrainingQueriesOptions(projectID)
+}
+
+// NewDeleteUserDataOptions delegates to DiscoveryV2.NewDeleteUserDataOptions
+func (mock *MockDiscoveryV2) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(DiscoveryV2).NewDeleteUserDataOptions(customerID)
+}
+
+// NewGetAutocompletionOptions delegates to DiscoveryV2.NewGetAutocompletionOptions
+func (mock *MockDiscoveryV2) NewGetAutocompletionOptions(projectID string, prefix string) *GetAutocompletionOptions {
+	return new(DiscoveryV2).NewGetAutocompletionOptions(projectID, prefix)
+}
+
+// NewGetCollectionOptions delegates to DiscoveryV2.NewGetCollectionOptions
+func (mock *MockDiscoveryV2) NewGetCollectionOptions(projectID string, collectionID string) *GetCollectionOptions {
+	return new(DiscoveryV2).NewGetCollectionOptions(projectID, collectionID)
+}
+
+// NewGetComponentSettingsOptions delegates to DiscoveryV2.NewGetComponentSettingsOptions
+func (mock *MockDiscoveryV2) NewGetComponentSettingsOptions(projectID string) *GetComponentSettingsOptions {
+	return new(DiscoveryV2).NewGetComponentSettingsOptions(projectID)
+}
+
+// NewGetEnrichmentOptions delegates to DiscoveryV2.NewGetEnrichmentOptions
+func (mock *MockDiscoveryV2) NewGetEnrichmentOptions(projectID string, enrichmentID string) *GetEnrichmentOptions {
+	return new(DiscoveryV2).NewGetEnrichmentOptions(projectID, enrichmentID)
+}
+
+// NewGetProjectOptions delegates to DiscoveryV2.NewGetProjectOptions
+func (mock *MockDiscoveryV2) NewGetProjectOptions(projectID string) *GetProjectOptions {
+	return new(DiscoveryV2).NewGetProjectOptions(projectID)
+}
+
+// NewGetTrainingQueryOptions delegates to DiscoveryV2.NewGetTrainingQueryOptions
+func (mock *MockDiscoveryV2) NewGetTrainingQueryOptions(projectID string, queryID string) *GetTrainingQueryOptions {
+	return new(DiscoveryV2).NewGetTrainingQueryOptions(projectID, queryID)
+}
+
+// NewListCollectionsOptions delegates to DiscoveryV2.NewListCollectionsOptions
+func (mock *MockDiscoveryV2) NewListCollectionsOptions(projectID string) *ListCollectionsOptions {
+	return new(DiscoveryV2).NewListCollectionsOptions(projectID)
+}
+
+// NewListEnrichmentsOptions delegates to DiscoveryV2.NewListEnrichmentsOptions
+func (mock *MockDiscoveryV2) NewListEnrichmentsOptions(projectID string) *ListEnrichmentsOptions {
+	return new(DiscoveryV2).NewListEnrichmentsOptions(projectID)
+}
+
+// NewListFieldsOptions delegates to DiscoveryV2.NewListFieldsOptions
+func (mock *MockDiscoveryV2) NewListFieldsOptions(projectID string) *ListFieldsOptions {
+	return new(DiscoveryV2).NewListFieldsOptions(projectID)
+}
+
+// NewListProjectsOptions delegates to DiscoveryV2.NewListProjectsOptions
+func (mock *MockDiscoveryV2) NewListProjectsOptions() *ListProjectsOptions {
+	return new(DiscoveryV2).NewListProjectsOptions()
+}
+
+// NewListTrainingQueriesOptions delegates to DiscoveryV2.NewListTrainingQueriesOptions
+func (mock *MockDiscoveryV2) NewListTrainingQueriesOptions(projectID string) *ListTrainingQueriesOptions {
+	return new(DiscoveryV2).NewListTrainingQueriesOptions(projectID)
+}
+
+// NewQueryNoticesOptions delegates to DiscoveryV2.NewQueryNoticesOptions
+func (mock *MockDiscoveryV2) NewQueryNoticesOptions(projectID string) *QueryNoticesOptions {
+	return new(DiscoveryV2).NewQueryNoticesOptions(projectID)
+}
+
+// NewQueryOptions delegates to DiscoveryV2.NewQueryOptions
+func (mock *MockDiscoveryV2) NewQueryOptions(projectID string) *QueryOptions {
+	return new(DiscoveryV2).NewQueryOptions(projectID)
+}
+
+// NewTrainingExample delegates to DiscoveryV2.NewTrainingExample
+func (mock *MockDiscoveryV2) NewTrainingExample(documentID string, collectionID string, relevance int64) (model *TrainingExample, err error) {
+	return new(DiscoveryV2).NewTrainingExample(documentID, collectionID, relevance)
+}
+
+// NewTrainingQuery delegates to DiscoveryV2.NewTrainingQuery
+func (mock *MockDiscoveryV2) NewTrainingQuery(naturalLanguageQuery string, examples []TrainingExample) (model *TrainingQuery, err error) {
+	return new(DiscoveryV2).NewTrainingQuery(naturalLanguageQuery, examples)
+}
+
+// NewUpdateCollectionOptions delegates to DiscoveryV2.NewUpdateCollectionOptions
+func (mock *MockDiscoveryV2) NewUpdateCollectionOptions(projectID string, collectionID string) *UpdateCollectionOptions {
+	return new(DiscoveryV2).NewUpdateCollectionOptions(projectID, collectionID)
+}
+
+// NewUpdateDocumentOptions delegates to DiscoveryV2.NewUpdateDocumentOptions
+func (mock *MockDiscoveryV2) NewUpdateDocumentOptions(projectID string, collectionID string, documentID string) *UpdateDocumentOptions {
+	return new(DiscoveryV2).NewUpdateDocumentOptions(projectID, collectionID, documentID)
+}
+
+// NewUpdateEnrichmentOptions delegates to DiscoveryV2.NewUpdateEnrichmentOptions
+func (mock *MockDiscoveryV2) NewUpdateEnrichmentOptions(projectID string, enrichmentID string, name string) *UpdateEnrichmentOptions {
+	return new(DiscoveryV2).NewUpdateEnrichmentOptions(projectID, enrichmentID, name)
+}
+
+// NewUpdateProjectOptions delegates to DiscoveryV2.NewUpdateProjectOptions
+func (mock *MockDiscoveryV2) NewUpdateProjectOptions(projectID string) *UpdateProjectOptions {
+	return new(DiscoveryV2).NewUpdateProjectOptions(projectID)
+}
+
+// NewUpdateTrainingQueryOptions delegates to DiscoveryV2.NewUpdateTrainingQueryOptions
+func (mock *MockDiscoveryV2) NewUpdateTrainingQueryOptions(projectID string, queryID string, naturalLanguageQuery string, examples []TrainingExample) *UpdateTrainingQueryOptions {
+	return new(DiscoveryV2).NewUpdateTrainingQueryOptions(projectID, queryID, naturalLanguageQuery, examples)
+}
@@ -68,7 +68,10 @@ func main() {
 		SetSpeakerLabels(true).
 		SetTimestamps(true)
 
-	service.RecognizeUsingWebsocket(recognizeUsingWebsocketOptions, callback)
+	websocketErr := service.RecognizeUsingWebsocket(recognizeUsingWebsocketOptions, callback)
+	if websocketErr != nil {
+		panic(websocketErr)
+	}
 }
 
 type myCallBack struct{}
@@ -1,6 +1,6 @@
 module github.com/watson-developer-cloud/go-sdk
 
-go 1.12
+go 1.13
 
 require (
 	github.com/IBM/go-sdk-core v0.0.0-20200217212347-fe152a0e9e89
@@ -0,0 +1,178 @@
+// Package mocktest : Checks the generated mocks of the services against the services they stand for
+package mocktest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	"github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// mockContextKey tags the context passed to the methods, so that the check can tell it apart from context.Background()
+type mockContextKey struct{}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// Check : Returns the differences between a mock, such as a *MockAssistantV1, and the behavior expected of it.
+// Each operation with a Func field must record the call and pass its context and arguments to the function, the
+// variants without a context passing context.Background(), and must fail with common.ErrMockNotImplemented when the
+// function is nil. Each options or model constructor of the mock must return the same value as the constructor of
+// the service.
+func Check(mock interface{}, service interface{}) []string {
+	var problems []string
+	mockValue := reflect.ValueOf(mock)
+	mockType := mockValue.Type()
+	mockName := mockType.Elem().Name()
+	for i := 0; i < mockType.NumMethod(); i++ {
+		method := mockType.Method(i)
+		switch {
+		case strings.HasPrefix(method.Name, "New") && strings.HasSuffix(method.Name, "Pager"):
+			// The pagers are bound to the service or the mock they page through
+		case strings.HasPrefix(method.Name, "New"):
+			problems = append(problems, checkConstructor(mockValue, reflect.ValueOf(service), method.Name)...)
+		case strings.HasSuffix(method.Name, "WithContext"):
+		default:
+			if field := mockValue.Elem().FieldByName(method.Name + "Func"); field.IsValid() {
+				problems = append(problems, checkOperation(mockValue, mockName, method.Name, field)...)
+			}
+		}
+	}
+	return problems
+}
+
+// forwarded records the arguments received by the Func field of an operation
+type forwarded struct {
+	called bool
+	args   []reflect.Value
+}
+
+// checkOperation checks the variants of an operation, with and without a context
+func checkOperation(mock reflect.Value, mockName string, name string, field reflect.Value) []string {
+	var problems []string
+	fail := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf("%s.%s: %s", mockName, name, fmt.Sprintf(format, args...)))
+	}
+
+	received := new(forwarded)
+	field.Set(reflect.MakeFunc(field.Type(), func(args []reflect.Value) []reflect.Value {
+		received.called = true
+		received.args = args
+		results := make([]reflect.Value, field.Type().NumOut())
+		for i := range results {
+			results[i] = reflect.Zero(field.Type().Out(i))
+		}
+		return results
+	}))
+
+	ctx := context.WithValue(context.Background(), mockContextKey{}, name)
+	args := sampleArgs(field.Type(), ctx)
+	calls := func() []common.MockCall {
+		return mock.MethodByName("CallsTo").Call([]reflect.Value{reflect.ValueOf(name)})[0].Interface().([]common.MockCall)
+	}
+	check := func(method string, args []reflect.Value, expected []reflect.Value, recordedContext context.Context) {
+		*received = forwarded{}
+		recorded := len(calls())
+		mock.MethodByName(method).Call(args)
+		if !received.called {
+			fail("%s does not call %sFunc", method, name)
+			return
+		}
+		for i := range expected {
+			if !reflect.DeepEqual(received.args[i].Interface(), expected[i].Interface()) {
+				fail("%s passes %v instead of %v to %sFunc", method, received.args[i], expected[i], name)
+			}
+		}
+		if all := calls(); len(all) != recorded+1 {
+			fail("%s does not record the call", method)
+		} else if all[recorded].Context != recordedContext {
+			fail("%s records the wrong context", method)
+		}
+	}
+
+	method := name
+	if mock.MethodByName(name + "WithContext").IsValid() {
+		method = name + "WithContext"
+		check(method, args, args, ctx)
+		background := append([]reflect.Value{reflect.ValueOf(context.Background())}, args[1:]...)
+		check(name, args[1:], background, context.Background())
+	} else {
+		// The operations that only take a context record the call with context.Background()
+		check(name, args, args, context.Background())
+	}
+
+	field.Set(reflect.Zero(field.Type()))
+	results := mock.MethodByName(method).Call(args)
+	err, _ := results[len(results)-1].Interface().(error)
+	if !reflect.DeepEqual(err, common.ErrMockNotImplemented(mockName, name)) {
+		fail("returns %v instead of the not implemented error when %sFunc is nil", err, name)
+	}
+	return problems
+}
+
+// checkConstructor compares a constructor of the mock with the one of the service
+func checkConstructor(mock reflect.Value, service reflect.Value, name string) []string {
+	serviceMethod := service.MethodByName(name)
+	if !serviceMethod.IsValid() {
+		return []string{fmt.Sprintf("%s.%s: the service has no such constructor", mock.Type().Elem().Name(), name)}
+	}
+	mockMethod := mock.MethodByName(name)
+	args := sampleArgs(mockMethod.Type(), nil)
+	mockResults := mockMethod.Call(args)
+	serviceResults := serviceMethod.Call(args)
+	for i := range mockResults {
+		if !reflect.DeepEqual(mockResults[i].Interface(), serviceResults[i].Interface()) {
+			return []string{fmt.Sprintf("%s.%s: returns %v instead of %v", mock.Type().Elem().Name(), name,
+				mockResults[i], serviceResults[i])}
+		}
+	}
+	return nil
+}
+
+// sampleArgs returns non-zero arguments for a function, with ctx for its context
+func sampleArgs(function reflect.Type, ctx context.Context) []reflect.Value {
+	args := make([]reflect.Value, function.NumIn())
+	for i := range args {
+		if function.In(i) == contextType {
+			args[i] = reflect.ValueOf(ctx)
+			continue
+		}
+		args[i] = sample(function.In(i))
+	}
+	return args
+}
+
+// sample returns a non-zero value of a type where it can, and the zero value otherwise
+func sample(t reflect.Type) reflect.Value {
+	value := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		value.SetString("value")
+	case reflect.Bool:
+		value.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		value.SetFloat(0.5)
+	case reflect.Slice:
+		value = reflect.Append(value, sample(t.Elem()))
+	case reflect.Map:
+		value = reflect.MakeMap(t)
+	case reflect.Ptr:
+		value = reflect.New(t.Elem())
+		if t.Elem().Kind() != reflect.Struct {
+			value.Elem().Set(sample(t.Elem()))
+		}
+	case reflect.Interface:
+		var reader io.ReadCloser = ioutil.NopCloser(strings.NewReader("value"))
+		if reflect.TypeOf(reader).Implements(t) {
+			value.Set(reflect.ValueOf(reader))
+		}
+	}
+	return value
+}
@@ -0,0 +1,90 @@
+package mocktest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/watson-developer-cloud/go-sdk/common"
+)
+
+type GetThingOptions struct {
+	ThingID *string
+}
+
+type Thing struct {
+	Name *string
+}
+
+type ExampleV1 struct{}
+
+func (service *ExampleV1) NewGetThingOptions(thingID string) *GetThingOptions {
+	return &GetThingOptions{ThingID: core.StringPtr(thingID)}
+}
+
+// MockExampleV1 follows the generated mocks
+type MockExampleV1 struct {
+	common.MockRecorder
+
+	GetThingFunc     func(ctx context.Context, getThingOptions *GetThingOptions) (*Thing, *core.DetailedResponse, error)
+	WaitForThingFunc func(ctx context.Context, thingID string) (*Thing, error)
+}
+
+func (mock *MockExampleV1) NewGetThingOptions(thingID string) *GetThingOptions {
+	return new(ExampleV1).NewGetThingOptions(thingID)
+}
+
+func (mock *MockExampleV1) GetThing(getThingOptions *GetThingOptions) (*Thing, *core.DetailedResponse, error) {
+	return mock.GetThingWithContext(context.Background(), getThingOptions)
+}
+
+func (mock *MockExampleV1) GetThingWithContext(ctx context.Context, getThingOptions *GetThingOptions) (*Thing, *core.DetailedResponse, error) {
+	mock.Record(ctx, "GetThing", getThingOptions)
+	if mock.GetThingFunc != nil {
+		return mock.GetThingFunc(ctx, getThingOptions)
+	}
+	return nil, nil, common.ErrMockNotImplemented("MockExampleV1", "GetThing")
+}
+
+func (mock *MockExampleV1) WaitForThing(ctx context.Context, thingID string) (*Thing, error) {
+	mock.Record(context.Background(), "WaitForThing", ctx, thingID)
+	if mock.WaitForThingFunc != nil {
+		return mock.WaitForThingFunc(ctx, thingID)
+	}
+	return nil, common.ErrMockNotImplemented("MockExampleV1", "WaitForThing")
+}
+
+// BrokenExampleV1 drops the context, records nothing and builds the options differently
+type BrokenExampleV1 struct {
+	common.MockRecorder
+
+	GetThingFunc func(ctx context.Context, getThingOptions *GetThingOptions) (*Thing, *core.DetailedResponse, error)
+}
+
+func (mock *BrokenExampleV1) NewGetThingOptions(thingID string) *GetThingOptions {
+	return &GetThingOptions{}
+}
+
+func (mock *BrokenExampleV1) GetThing(getThingOptions *GetThingOptions) (*Thing, *core.DetailedResponse, error) {
+	return mock.GetThingWithContext(context.TODO(), getThingOptions)
+}
+
+func (mock *BrokenExampleV1) GetThingWithContext(ctx context.Context, getThingOptions *GetThingOptions) (*Thing, *core.DetailedResponse, error) {
+	if mock.GetThingFunc != nil {
+		return mock.GetThingFunc(context.Background(), getThingOptions)
+	}
+	return nil, nil, nil
+}
+
+func TestCheck(t *testing.T) {
+	assert.Empty(t, Check(new(MockExampleV1), new(ExampleV1)))
+
+	problems := Check(new(BrokenExampleV1), new(ExampleV1))
+	assert.Len(t, problems, 5)
+	assert.Contains(t, problems[0], `GetThingWithContext passes context.Background instead of`)
+	assert.Contains(t, problems, `BrokenExampleV1.GetThing: GetThingWithContext does not record the call`)
+	assert.Contains(t, problems, `BrokenExampleV1.GetThing: GetThing does not record the call`)
+	assert.Contains(t, problems, `BrokenExampleV1.GetThing: returns <nil> instead of the not implemented error when GetThingFunc is nil`)
+	assert.Contains(t, problems[4], `BrokenExampleV1.NewGetThingOptions: returns`)
+}
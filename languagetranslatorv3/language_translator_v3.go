@@ -18,11 +18,13 @@
 package languagetranslatorv3
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/watson-developer-cloud/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // LanguageTranslatorV3 : IBM Watson&trade; Language Translator translates text from one language to another. The
@@ -45,10 +47,13 @@ const DefaultServiceName = "language_translator"
 
 // LanguageTranslatorV3Options : Service options
 type LanguageTranslatorV3Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewLanguageTranslatorV3 : constructs an instance of LanguageTranslatorV3 with passed in options.
@@ -86,6 +91,16 @@ func NewLanguageTranslatorV3(options *LanguageTranslatorV3Options) (service *Lan
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &LanguageTranslatorV3{
 		Service: baseService,
 		Version: options.Version,
@@ -101,13 +116,38 @@ func (languageTranslator *LanguageTranslatorV3) SetServiceURL(url string) error
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (languageTranslator *LanguageTranslatorV3) DisableSSLVerification() {
-	languageTranslator.Service.DisableSSLVerification()
+	common.DisableSSLVerification(languageTranslator.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (languageTranslator *LanguageTranslatorV3) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(languageTranslator.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (languageTranslator *LanguageTranslatorV3) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(languageTranslator.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (languageTranslator *LanguageTranslatorV3) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(languageTranslator.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (languageTranslator *LanguageTranslatorV3) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(languageTranslator.Service, interceptor)
 }
 
 // ListLanguages : List supported languages
 // Lists all supported languages. The method returns an array of supported languages with information about each
 // language. Languages are listed in alphabetical order by language code (for example, `af`, `ar`).
 func (languageTranslator *LanguageTranslatorV3) ListLanguages(listLanguagesOptions *ListLanguagesOptions) (result *Languages, response *core.DetailedResponse, err error) {
+	return languageTranslator.ListLanguagesWithContext(context.Background(), listLanguagesOptions)
+}
+
+// ListLanguagesWithContext is an alternate form of the ListLanguages method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) ListLanguagesWithContext(ctx context.Context, listLanguagesOptions *ListLanguagesOptions) (result *Languages, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listLanguagesOptions, "listLanguagesOptions")
 	if err != nil {
 		return
@@ -139,7 +179,10 @@ func (languageTranslator *LanguageTranslatorV3) ListLanguages(listLanguagesOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(Languages))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Languages)
@@ -157,6 +200,11 @@ func (languageTranslator *LanguageTranslatorV3) ListLanguages(listLanguagesOptio
 // language to have the service attempt to detect the language from the input text. If you omit the source language, the
 // request must contain sufficient input text for the service to identify the source language.
 func (languageTranslator *LanguageTranslatorV3) Translate(translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error) {
+	return languageTranslator.TranslateWithContext(context.Background(), translateOptions)
+}
+
+// TranslateWithContext is an alternate form of the Translate method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) TranslateWithContext(ctx context.Context, translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(translateOptions, "translateOptions cannot be nil")
 	if err != nil {
 		return
@@ -211,7 +259,10 @@ func (languageTranslator *LanguageTranslatorV3) Translate(translateOptions *Tran
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(TranslationResult))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationResult)
@@ -227,6 +278,11 @@ func (languageTranslator *LanguageTranslatorV3) Translate(translateOptions *Tran
 // Lists the languages that the service can identify. Returns the language code (for example, `en` for English or `es`
 // for Spanish) and name of each language.
 func (languageTranslator *LanguageTranslatorV3) ListIdentifiableLanguages(listIdentifiableLanguagesOptions *ListIdentifiableLanguagesOptions) (result *IdentifiableLanguages, response *core.DetailedResponse, err error) {
+	return languageTranslator.ListIdentifiableLanguagesWithContext(context.Background(), listIdentifiableLanguagesOptions)
+}
+
+// ListIdentifiableLanguagesWithContext is an alternate form of the ListIdentifiableLanguages method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) ListIdentifiableLanguagesWithContext(ctx context.Context, listIdentifiableLanguagesOptions *ListIdentifiableLanguagesOptions) (result *IdentifiableLanguages, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listIdentifiableLanguagesOptions, "listIdentifiableLanguagesOptions")
 	if err != nil {
 		return
@@ -258,7 +314,10 @@ func (languageTranslator *LanguageTranslatorV3) ListIdentifiableLanguages(listId
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(IdentifiableLanguages))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*IdentifiableLanguages)
@@ -273,6 +332,11 @@ func (languageTranslator *LanguageTranslatorV3) ListIdentifiableLanguages(listId
 // Identify : Identify language
 // Identifies the language of the input text.
 func (languageTranslator *LanguageTranslatorV3) Identify(identifyOptions *IdentifyOptions) (result *IdentifiedLanguages, response *core.DetailedResponse, err error) {
+	return languageTranslator.IdentifyWithContext(context.Background(), identifyOptions)
+}
+
+// IdentifyWithContext is an alternate form of the Identify method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) IdentifyWithContext(ctx context.Context, identifyOptions *IdentifyOptions) (result *IdentifiedLanguages, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(identifyOptions, "identifyOptions cannot be nil")
 	if err != nil {
 		return
@@ -314,7 +378,10 @@ func (languageTranslator *LanguageTranslatorV3) Identify(identifyOptions *Identi
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(IdentifiedLanguages))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*IdentifiedLanguages)
@@ -329,6 +396,11 @@ func (languageTranslator *LanguageTranslatorV3) Identify(identifyOptions *Identi
 // ListModels : List models
 // Lists available translation models.
 func (languageTranslator *LanguageTranslatorV3) ListModels(listModelsOptions *ListModelsOptions) (result *TranslationModels, response *core.DetailedResponse, err error) {
+	return languageTranslator.ListModelsWithContext(context.Background(), listModelsOptions)
+}
+
+// ListModelsWithContext is an alternate form of the ListModels method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *TranslationModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listModelsOptions, "listModelsOptions")
 	if err != nil {
 		return
@@ -370,7 +442,10 @@ func (languageTranslator *LanguageTranslatorV3) ListModels(listModelsOptions *Li
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(TranslationModels))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationModels)
@@ -440,6 +515,11 @@ func (languageTranslator *LanguageTranslatorV3) ListModels(listModelsOptions *Li
 //
 // `--form "forced_glossary=@glossary;type=text/csv"`.
 func (languageTranslator *LanguageTranslatorV3) CreateModel(createModelOptions *CreateModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
+	return languageTranslator.CreateModelWithContext(context.Background(), createModelOptions)
+}
+
+// CreateModelWithContext is an alternate form of the CreateModel method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) CreateModelWithContext(ctx context.Context, createModelOptions *CreateModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createModelOptions, "createModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -493,7 +573,10 @@ func (languageTranslator *LanguageTranslatorV3) CreateModel(createModelOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(TranslationModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationModel)
@@ -508,6 +591,11 @@ func (languageTranslator *LanguageTranslatorV3) CreateModel(createModelOptions *
 // DeleteModel : Delete model
 // Deletes a custom translation model.
 func (languageTranslator *LanguageTranslatorV3) DeleteModel(deleteModelOptions *DeleteModelOptions) (result *DeleteModelResult, response *core.DetailedResponse, err error) {
+	return languageTranslator.DeleteModelWithContext(context.Background(), deleteModelOptions)
+}
+
+// DeleteModelWithContext is an alternate form of the DeleteModel method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) DeleteModelWithContext(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResult, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteModelOptions, "deleteModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -543,7 +631,10 @@ func (languageTranslator *LanguageTranslatorV3) DeleteModel(deleteModelOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(DeleteModelResult))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteModelResult)
@@ -559,6 +650,11 @@ func (languageTranslator *LanguageTranslatorV3) DeleteModel(deleteModelOptions *
 // Gets information about a translation model, including training status for custom models. Use this API call to poll
 // the status of your customization request. A successfully completed training has a status of `available`.
 func (languageTranslator *LanguageTranslatorV3) GetModel(getModelOptions *GetModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
+	return languageTranslator.GetModelWithContext(context.Background(), getModelOptions)
+}
+
+// GetModelWithContext is an alternate form of the GetModel method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) GetModelWithContext(ctx context.Context, getModelOptions *GetModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getModelOptions, "getModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -594,7 +690,10 @@ func (languageTranslator *LanguageTranslatorV3) GetModel(getModelOptions *GetMod
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(TranslationModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TranslationModel)
@@ -609,6 +708,11 @@ func (languageTranslator *LanguageTranslatorV3) GetModel(getModelOptions *GetMod
 // ListDocuments : List documents
 // Lists documents that have been submitted for translation.
 func (languageTranslator *LanguageTranslatorV3) ListDocuments(listDocumentsOptions *ListDocumentsOptions) (result *DocumentList, response *core.DetailedResponse, err error) {
+	return languageTranslator.ListDocumentsWithContext(context.Background(), listDocumentsOptions)
+}
+
+// ListDocumentsWithContext is an alternate form of the ListDocuments method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) ListDocumentsWithContext(ctx context.Context, listDocumentsOptions *ListDocumentsOptions) (result *DocumentList, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listDocumentsOptions, "listDocumentsOptions")
 	if err != nil {
 		return
@@ -640,7 +744,10 @@ func (languageTranslator *LanguageTranslatorV3) ListDocuments(listDocumentsOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(DocumentList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentList)
@@ -656,6 +763,11 @@ func (languageTranslator *LanguageTranslatorV3) ListDocuments(listDocumentsOptio
 // Submit a document for translation. You can submit the document contents in the `file` parameter, or you can reference
 // a previously submitted document by document ID.
 func (languageTranslator *LanguageTranslatorV3) TranslateDocument(translateDocumentOptions *TranslateDocumentOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	return languageTranslator.TranslateDocumentWithContext(context.Background(), translateDocumentOptions)
+}
+
+// TranslateDocumentWithContext is an alternate form of the TranslateDocument method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) TranslateDocumentWithContext(ctx context.Context, translateDocumentOptions *TranslateDocumentOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(translateDocumentOptions, "translateDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -706,7 +818,10 @@ func (languageTranslator *LanguageTranslatorV3) TranslateDocument(translateDocum
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(DocumentStatus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentStatus)
@@ -721,6 +836,11 @@ func (languageTranslator *LanguageTranslatorV3) TranslateDocument(translateDocum
 // GetDocumentStatus : Get document status
 // Gets the translation status of a document.
 func (languageTranslator *LanguageTranslatorV3) GetDocumentStatus(getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	return languageTranslator.GetDocumentStatusWithContext(context.Background(), getDocumentStatusOptions)
+}
+
+// GetDocumentStatusWithContext is an alternate form of the GetDocumentStatus method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) GetDocumentStatusWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getDocumentStatusOptions, "getDocumentStatusOptions cannot be nil")
 	if err != nil {
 		return
@@ -756,7 +876,10 @@ func (languageTranslator *LanguageTranslatorV3) GetDocumentStatus(getDocumentSta
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(DocumentStatus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DocumentStatus)
@@ -771,6 +894,11 @@ func (languageTranslator *LanguageTranslatorV3) GetDocumentStatus(getDocumentSta
 // DeleteDocument : Delete document
 // Deletes a document.
 func (languageTranslator *LanguageTranslatorV3) DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (response *core.DetailedResponse, err error) {
+	return languageTranslator.DeleteDocumentWithContext(context.Background(), deleteDocumentOptions)
+}
+
+// DeleteDocumentWithContext is an alternate form of the DeleteDocument method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteDocumentOptions, "deleteDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -805,7 +933,10 @@ func (languageTranslator *LanguageTranslatorV3) DeleteDocument(deleteDocumentOpt
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -813,6 +944,11 @@ func (languageTranslator *LanguageTranslatorV3) DeleteDocument(deleteDocumentOpt
 // GetTranslatedDocument : Get translated document
 // Gets the translated document associated with the given document ID.
 func (languageTranslator *LanguageTranslatorV3) GetTranslatedDocument(getTranslatedDocumentOptions *GetTranslatedDocumentOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return languageTranslator.GetTranslatedDocumentWithContext(context.Background(), getTranslatedDocumentOptions)
+}
+
+// GetTranslatedDocumentWithContext is an alternate form of the GetTranslatedDocument method which supports a Context parameter
+func (languageTranslator *LanguageTranslatorV3) GetTranslatedDocumentWithContext(ctx context.Context, getTranslatedDocumentOptions *GetTranslatedDocumentOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getTranslatedDocumentOptions, "getTranslatedDocumentOptions cannot be nil")
 	if err != nil {
 		return
@@ -851,7 +987,10 @@ func (languageTranslator *LanguageTranslatorV3) GetTranslatedDocument(getTransla
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = languageTranslator.Service.Request(request, new(io.ReadCloser))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
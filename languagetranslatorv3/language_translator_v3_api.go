@@ -0,0 +1,70 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languagetranslatorv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// LanguageTranslatorV3API : The operations of the LanguageTranslatorV3 service.
+// It is implemented by *LanguageTranslatorV3, and by *MockLanguageTranslatorV3 for unit tests.
+type LanguageTranslatorV3API interface {
+	ListLanguages(listLanguagesOptions *ListLanguagesOptions) (result *Languages, response *core.DetailedResponse, err error)
+	ListLanguagesWithContext(ctx context.Context, listLanguagesOptions *ListLanguagesOptions) (result *Languages, response *core.DetailedResponse, err error)
+	Translate(translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error)
+	TranslateWithContext(ctx context.Context, translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error)
+	ListIdentifiableLanguages(listIdentifiableLanguagesOptions *ListIdentifiableLanguagesOptions) (result *IdentifiableLanguages, response *core.DetailedResponse, err error)
+	ListIdentifiableLanguagesWithContext(ctx context.Context, listIdentifiableLanguagesOptions *ListIdentifiableLanguagesOptions) (result *IdentifiableLanguages, response *core.DetailedResponse, err error)
+	Identify(identifyOptions *IdentifyOptions) (result *IdentifiedLanguages, response *core.DetailedResponse, err error)
+	IdentifyWithContext(ctx context.Context, identifyOptions *IdentifyOptions) (result *IdentifiedLanguages, response *core.DetailedResponse, err error)
+	ListModels(listModelsOptions *ListModelsOptions) (result *TranslationModels, response *core.DetailedResponse, err error)
+	ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *TranslationModels, response *core.DetailedResponse, err error)
+	CreateModel(createModelOptions *CreateModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error)
+	CreateModelWithContext(ctx context.Context, createModelOptions *CreateModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error)
+	DeleteModel(deleteModelOptions *DeleteModelOptions) (result *DeleteModelResult, response *core.DetailedResponse, err error)
+	DeleteModelWithContext(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResult, response *core.DetailedResponse, err error)
+	GetModel(getModelOptions *GetModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error)
+	GetModelWithContext(ctx context.Context, getModelOptions *GetModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error)
+	ListDocuments(listDocumentsOptions *ListDocumentsOptions) (result *DocumentList, response *core.DetailedResponse, err error)
+	ListDocumentsWithContext(ctx context.Context, listDocumentsOptions *ListDocumentsOptions) (result *DocumentList, response *core.DetailedResponse, err error)
+	TranslateDocument(translateDocumentOptions *TranslateDocumentOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	TranslateDocumentWithContext(ctx context.Context, translateDocumentOptions *TranslateDocumentOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	GetDocumentStatus(getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	GetDocumentStatusWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (response *core.DetailedResponse, err error)
+	DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (response *core.DetailedResponse, err error)
+	GetTranslatedDocument(getTranslatedDocumentOptions *GetTranslatedDocumentOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetTranslatedDocumentWithContext(ctx context.Context, getTranslatedDocumentOptions *GetTranslatedDocumentOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	NewCreateModelOptions(baseModelID string) *CreateModelOptions
+	NewDeleteDocumentOptions(documentID string) *DeleteDocumentOptions
+	NewDeleteModelOptions(modelID string) *DeleteModelOptions
+	NewGetDocumentStatusOptions(documentID string) *GetDocumentStatusOptions
+	NewGetModelOptions(modelID string) *GetModelOptions
+	NewGetTranslatedDocumentOptions(documentID string) *GetTranslatedDocumentOptions
+	NewIdentifyOptions(text string) *IdentifyOptions
+	NewListDocumentsOptions() *ListDocumentsOptions
+	NewListIdentifiableLanguagesOptions() *ListIdentifiableLanguagesOptions
+	NewListLanguagesOptions() *ListLanguagesOptions
+	NewListModelsOptions() *ListModelsOptions
+	NewTranslateDocumentOptions(file io.ReadCloser, filename string) *TranslateDocumentOptions
+	NewTranslateOptions(text []string) *TranslateOptions
+}
+
+var _ LanguageTranslatorV3API = (*LanguageTranslatorV3)(nil)
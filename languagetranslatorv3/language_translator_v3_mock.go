@@ -0,0 +1,308 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languagetranslatorv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockLanguageTranslatorV3 : A mock implementation of LanguageTranslatorV3API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *LanguageTranslatorV3.
+type MockLanguageTranslatorV3 struct {
+	common.MockRecorder
+
+	ListLanguagesFunc             func(ctx context.Context, listLanguagesOptions *ListLanguagesOptions) (result *Languages, response *core.DetailedResponse, err error)
+	TranslateFunc                 func(ctx context.Context, translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error)
+	ListIdentifiableLanguagesFunc func(ctx context.Context, listIdentifiableLanguagesOptions *ListIdentifiableLanguagesOptions) (result *IdentifiableLanguages, response *core.DetailedResponse, err error)
+	IdentifyFunc                  func(ctx context.Context, identifyOptions *IdentifyOptions) (result *IdentifiedLanguages, response *core.DetailedResponse, err error)
+	ListModelsFunc                func(ctx context.Context, listModelsOptions *ListModelsOptions) (result *TranslationModels, response *core.DetailedResponse, err error)
+	CreateModelFunc               func(ctx context.Context, createModelOptions *CreateModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error)
+	DeleteModelFunc               func(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResult, response *core.DetailedResponse, err error)
+	GetModelFunc                  func(ctx context.Context, getModelOptions *GetModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error)
+	ListDocumentsFunc             func(ctx context.Context, listDocumentsOptions *ListDocumentsOptions) (result *DocumentList, response *core.DetailedResponse, err error)
+	TranslateDocumentFunc         func(ctx context.Context, translateDocumentOptions *TranslateDocumentOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	GetDocumentStatusFunc         func(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error)
+	DeleteDocumentFunc            func(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (response *core.DetailedResponse, err error)
+	GetTranslatedDocumentFunc     func(ctx context.Context, getTranslatedDocumentOptions *GetTranslatedDocumentOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+}
+
+var _ LanguageTranslatorV3API = (*MockLanguageTranslatorV3)(nil)
+
+// ListLanguages records the call and invokes ListLanguagesFunc
+func (mock *MockLanguageTranslatorV3) ListLanguages(listLanguagesOptions *ListLanguagesOptions) (result *Languages, response *core.DetailedResponse, err error) {
+	return mock.ListLanguagesWithContext(context.Background(), listLanguagesOptions)
+}
+
+// ListLanguagesWithContext records the call and invokes ListLanguagesFunc
+func (mock *MockLanguageTranslatorV3) ListLanguagesWithContext(ctx context.Context, listLanguagesOptions *ListLanguagesOptions) (result *Languages, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListLanguages", listLanguagesOptions)
+	if mock.ListLanguagesFunc != nil {
+		return mock.ListLanguagesFunc(ctx, listLanguagesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "ListLanguages")
+	return
+}
+
+// Translate records the call and invokes TranslateFunc
+func (mock *MockLanguageTranslatorV3) Translate(translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error) {
+	return mock.TranslateWithContext(context.Background(), translateOptions)
+}
+
+// TranslateWithContext records the call and invokes TranslateFunc
+func (mock *MockLanguageTranslatorV3) TranslateWithContext(ctx context.Context, translateOptions *TranslateOptions) (result *TranslationResult, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Translate", translateOptions)
+	if mock.TranslateFunc != nil {
+		return mock.TranslateFunc(ctx, translateOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "Translate")
+	return
+}
+
+// ListIdentifiableLanguages records the call and invokes ListIdentifiableLanguagesFunc
+func (mock *MockLanguageTranslatorV3) ListIdentifiableLanguages(listIdentifiableLanguagesOptions *ListIdentifiableLanguagesOptions) (result *IdentifiableLanguages, response *core.DetailedResponse, err error) {
+	return mock.ListIdentifiableLanguagesWithContext(context.Background(), listIdentifiableLanguagesOptions)
+}
+
+// ListIdentifiableLanguagesWithContext records the call and invokes ListIdentifiableLanguagesFunc
+func (mock *MockLanguageTranslatorV3) ListIdentifiableLanguagesWithContext(ctx context.Context, listIdentifiableLanguagesOptions *ListIdentifiableLanguagesOptions) (result *IdentifiableLanguages, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListIdentifiableLanguages", listIdentifiableLanguagesOptions)
+	if mock.ListIdentifiableLanguagesFunc != nil {
+		return mock.ListIdentifiableLanguagesFunc(ctx, listIdentifiableLanguagesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "ListIdentifiableLanguages")
+	return
+}
+
+// Identify records the call and invokes IdentifyFunc
+func (mock *MockLanguageTranslatorV3) Identify(identifyOptions *IdentifyOptions) (result *IdentifiedLanguages, response *core.DetailedResponse, err error) {
+	return mock.IdentifyWithContext(context.Background(), identifyOptions)
+}
+
+// IdentifyWithContext records the call and invokes IdentifyFunc
+func (mock *MockLanguageTranslatorV3) IdentifyWithContext(ctx context.Context, identifyOptions *IdentifyOptions) (result *IdentifiedLanguages, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Identify", identifyOptions)
+	if mock.IdentifyFunc != nil {
+		return mock.IdentifyFunc(ctx, identifyOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "Identify")
+	return
+}
+
+// ListModels records the call and invokes ListModelsFunc
+func (mock *MockLanguageTranslatorV3) ListModels(listModelsOptions *ListModelsOptions) (result *TranslationModels, response *core.DetailedResponse, err error) {
+	return mock.ListModelsWithContext(context.Background(), listModelsOptions)
+}
+
+// ListModelsWithContext records the call and invokes ListModelsFunc
+func (mock *MockLanguageTranslatorV3) ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *TranslationModels, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListModels", listModelsOptions)
+	if mock.ListModelsFunc != nil {
+		return mock.ListModelsFunc(ctx, listModelsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "ListModels")
+	return
+}
+
+// CreateModel records the call and invokes CreateModelFunc
+func (mock *MockLanguageTranslatorV3) CreateModel(createModelOptions *CreateModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
+	return mock.CreateModelWithContext(context.Background(), createModelOptions)
+}
+
+// CreateModelWithContext records the call and invokes CreateModelFunc
+func (mock *MockLanguageTranslatorV3) CreateModelWithContext(ctx context.Context, createModelOptions *CreateModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateModel", createModelOptions)
+	if mock.CreateModelFunc != nil {
+		return mock.CreateModelFunc(ctx, createModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "CreateModel")
+	return
+}
+
+// DeleteModel records the call and invokes DeleteModelFunc
+func (mock *MockLanguageTranslatorV3) DeleteModel(deleteModelOptions *DeleteModelOptions) (result *DeleteModelResult, response *core.DetailedResponse, err error) {
+	return mock.DeleteModelWithContext(context.Background(), deleteModelOptions)
+}
+
+// DeleteModelWithContext records the call and invokes DeleteModelFunc
+func (mock *MockLanguageTranslatorV3) DeleteModelWithContext(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResult, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteModel", deleteModelOptions)
+	if mock.DeleteModelFunc != nil {
+		return mock.DeleteModelFunc(ctx, deleteModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "DeleteModel")
+	return
+}
+
+// GetModel records the call and invokes GetModelFunc
+func (mock *MockLanguageTranslatorV3) GetModel(getModelOptions *GetModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
+	return mock.GetModelWithContext(context.Background(), getModelOptions)
+}
+
+// GetModelWithContext records the call and invokes GetModelFunc
+func (mock *MockLanguageTranslatorV3) GetModelWithContext(ctx context.Context, getModelOptions *GetModelOptions) (result *TranslationModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetModel", getModelOptions)
+	if mock.GetModelFunc != nil {
+		return mock.GetModelFunc(ctx, getModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "GetModel")
+	return
+}
+
+// ListDocuments records the call and invokes ListDocumentsFunc
+func (mock *MockLanguageTranslatorV3) ListDocuments(listDocumentsOptions *ListDocumentsOptions) (result *DocumentList, response *core.DetailedResponse, err error) {
+	return mock.ListDocumentsWithContext(context.Background(), listDocumentsOptions)
+}
+
+// ListDocumentsWithContext records the call and invokes ListDocumentsFunc
+func (mock *MockLanguageTranslatorV3) ListDocumentsWithContext(ctx context.Context, listDocumentsOptions *ListDocumentsOptions) (result *DocumentList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListDocuments", listDocumentsOptions)
+	if mock.ListDocumentsFunc != nil {
+		return mock.ListDocumentsFunc(ctx, listDocumentsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "ListDocuments")
+	return
+}
+
+// TranslateDocument records the call and invokes TranslateDocumentFunc
+func (mock *MockLanguageTranslatorV3) TranslateDocument(translateDocumentOptions *TranslateDocumentOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	return mock.TranslateDocumentWithContext(context.Background(), translateDocumentOptions)
+}
+
+// TranslateDocumentWithContext records the call and invokes TranslateDocumentFunc
+func (mock *MockLanguageTranslatorV3) TranslateDocumentWithContext(ctx context.Context, translateDocumentOptions *TranslateDocumentOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "TranslateDocument", translateDocumentOptions)
+	if mock.TranslateDocumentFunc != nil {
+		return mock.TranslateDocumentFunc(ctx, translateDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "TranslateDocument")
+	return
+}
+
+// GetDocumentStatus records the call and invokes GetDocumentStatusFunc
+func (mock *MockLanguageTranslatorV3) GetDocumentStatus(getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	return mock.GetDocumentStatusWithContext(context.Background(), getDocumentStatusOptions)
+}
+
+// GetDocumentStatusWithContext records the call and invokes GetDocumentStatusFunc
+func (mock *MockLanguageTranslatorV3) GetDocumentStatusWithContext(ctx context.Context, getDocumentStatusOptions *GetDocumentStatusOptions) (result *DocumentStatus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetDocumentStatus", getDocumentStatusOptions)
+	if mock.GetDocumentStatusFunc != nil {
+		return mock.GetDocumentStatusFunc(ctx, getDocumentStatusOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "GetDocumentStatus")
+	return
+}
+
+// DeleteDocument records the call and invokes DeleteDocumentFunc
+func (mock *MockLanguageTranslatorV3) DeleteDocument(deleteDocumentOptions *DeleteDocumentOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteDocumentWithContext(context.Background(), deleteDocumentOptions)
+}
+
+// DeleteDocumentWithContext records the call and invokes DeleteDocumentFunc
+func (mock *MockLanguageTranslatorV3) DeleteDocumentWithContext(ctx context.Context, deleteDocumentOptions *DeleteDocumentOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteDocument", deleteDocumentOptions)
+	if mock.DeleteDocumentFunc != nil {
+		return mock.DeleteDocumentFunc(ctx, deleteDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "DeleteDocument")
+	return
+}
+
+// GetTranslatedDocument records the call and invokes GetTranslatedDocumentFunc
+func (mock *MockLanguageTranslatorV3) GetTranslatedDocument(getTranslatedDocumentOptions *GetTranslatedDocumentOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return mock.GetTranslatedDocumentWithContext(context.Background(), getTranslatedDocumentOptions)
+}
+
+// GetTranslatedDocumentWithContext records the call and invokes GetTranslatedDocumentFunc
+func (mock *MockLanguageTranslatorV3) GetTranslatedDocumentWithContext(ctx context.Context, getTranslatedDocumentOptions *GetTranslatedDocumentOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetTranslatedDocument", getTranslatedDocumentOptions)
+	if mock.GetTranslatedDocumentFunc != nil {
+		return mock.GetTranslatedDocumentFunc(ctx, getTranslatedDocumentOptions)
+	}
+	err = common.ErrMockNotImplemented("MockLanguageTranslatorV3", "GetTranslatedDocument")
+	return
+}
+
+// NewCreateModelOptions delegates to LanguageTranslatorV3.NewCreateModelOptions
+func (mock *MockLanguageTranslatorV3) NewCreateModelOptions(baseModelID string) *CreateModelOptions {
+	return new(LanguageTranslatorV3).NewCreateModelOptions(baseModelID)
+}
+
+// NewDeleteDocumentOptions delegates to LanguageTranslatorV3.NewDeleteDocumentOptions
+func (mock *MockLanguageTranslatorV3) NewDeleteDocumentOptions(documentID string) *DeleteDocumentOptions {
+	return new(LanguageTranslatorV3).NewDeleteDocumentOptions(documentID)
+}
+
+// NewDeleteModelOptions delegates to LanguageTranslatorV3.NewDeleteModelOptions
+func (mock *MockLanguageTranslatorV3) NewDeleteModelOptions(modelID string) *DeleteModelOptions {
+	return new(LanguageTranslatorV3).NewDeleteModelOptions(modelID)
+}
+
+// NewGetDocumentStatusOptions delegates to LanguageTranslatorV3.NewGetDocumentStatusOptions
+func (mock *MockLanguageTranslatorV3) NewGetDocumentStatusOptions(documentID string) *GetDocumentStatusOptions {
+	return new(LanguageTranslatorV3).NewGetDocumentStatusOptions(documentID)
+}
+
+// NewGetModelOptions delegates to LanguageTranslatorV3.NewGetModelOptions
+func (mock *MockLanguageTranslatorV3) NewGetModelOptions(modelID string) *GetModelOptions {
+	return new(LanguageTranslatorV3).NewGetModelOptions(modelID)
+}
+
+// NewGetTranslatedDocumentOptions delegates to LanguageTranslatorV3.NewGetTranslatedDocumentOptions
+func (mock *MockLanguageTranslatorV3) NewGetTranslatedDocumentOptions(documentID string) *GetTranslatedDocumentOptions {
+	return new(LanguageTranslatorV3).NewGetTranslatedDocumentOptions(documentID)
+}
+
+// NewIdentifyOptions delegates to LanguageTranslatorV3.NewIdentifyOptions
+func (mock *MockLanguageTranslatorV3) NewIdentifyOptions(text string) *IdentifyOptions {
+	return new(LanguageTranslatorV3).NewIdentifyOptions(text)
+}
+
+// NewListDocumentsOptions delegates to LanguageTranslatorV3.NewListDocumentsOptions
+func (mock *MockLanguageTranslatorV3) NewListDocumentsOptions() *ListDocumentsOptions {
+	return new(LanguageTranslatorV3).NewListDocumentsOptions()
+}
+
+// NewListIdentifiableLanguagesOptions delegates to LanguageTranslatorV3.NewListIdentifiableLanguagesOptions
+func (mock *MockLanguageTranslatorV3) NewListIdentifiableLanguagesOptions() *ListIdentifiableLanguagesOptions {
+	return new(LanguageTranslatorV3).NewListIdentifiableLanguagesOptions()
+}
+
+// NewListLanguagesOptions delegates to LanguageTranslatorV3.NewListLanguagesOptions
+func (mock *MockLanguageTranslatorV3) NewListLanguagesOptions() *ListLanguagesOptions {
+	return new(LanguageTranslatorV3).NewListLanguagesOptions()
+}
+
+// NewListModelsOptions delegates to LanguageTranslatorV3.NewListModelsOptions
+func (mock *MockLanguageTranslatorV3) NewListModelsOptions() *ListModelsOptions {
+	return new(LanguageTranslatorV3).NewListModelsOptions()
+}
+
+// NewTranslateDocumentOptions delegates to LanguageTranslatorV3.NewTranslateDocumentOptions
+func (mock *MockLanguageTranslatorV3) NewTranslateDocumentOptions(file io.ReadCloser, filename string) *TranslateDocumentOptions {
+	return new(LanguageTranslatorV3).NewTranslateDocumentOptions(file, filename)
+}
+
+// NewTranslateOptions delegates to LanguageTranslatorV3.NewTranslateOptions
+func (mock *MockLanguageTranslatorV3) NewTranslateOptions(text []string) *TranslateOptions {
+	return new(LanguageTranslatorV3).NewTranslateOptions(text)
+}
@@ -18,11 +18,13 @@
 package naturallanguageclassifierv1
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/watson-developer-cloud/go-sdk/common"
 	"io"
+	"net/http"
 )
 
 // NaturalLanguageClassifierV1 : IBM Watson&trade; Natural Language Classifier uses machine learning algorithms to
@@ -43,9 +45,12 @@ const DefaultServiceName = "natural_language_classifier"
 
 // NaturalLanguageClassifierV1Options : Service options
 type NaturalLanguageClassifierV1Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewNaturalLanguageClassifierV1 : constructs an instance of NaturalLanguageClassifierV1 with passed in options.
@@ -83,6 +88,16 @@ func NewNaturalLanguageClassifierV1(options *NaturalLanguageClassifierV1Options)
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &NaturalLanguageClassifierV1{
 		Service: baseService,
 	}
@@ -97,13 +112,38 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) SetServiceURL(url
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (naturalLanguageClassifier *NaturalLanguageClassifierV1) DisableSSLVerification() {
-	naturalLanguageClassifier.Service.DisableSSLVerification()
+	common.DisableSSLVerification(naturalLanguageClassifier.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(naturalLanguageClassifier.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(naturalLanguageClassifier.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(naturalLanguageClassifier.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(naturalLanguageClassifier.Service, interceptor)
 }
 
 // Classify : Classify a phrase
 // Returns label information for the input. The status must be `Available` before you can use the classifier to classify
 // text.
 func (naturalLanguageClassifier *NaturalLanguageClassifierV1) Classify(classifyOptions *ClassifyOptions) (result *Classification, response *core.DetailedResponse, err error) {
+	return naturalLanguageClassifier.ClassifyWithContext(context.Background(), classifyOptions)
+}
+
+// ClassifyWithContext is an alternate form of the Classify method which supports a Context parameter
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ClassifyWithContext(ctx context.Context, classifyOptions *ClassifyOptions) (result *Classification, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(classifyOptions, "classifyOptions cannot be nil")
 	if err != nil {
 		return
@@ -148,7 +188,10 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) Classify(classifyO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageClassifier.Service.Request(request, new(Classification))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classification)
@@ -166,6 +209,11 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) Classify(classifyO
 //
 // Note that classifying Japanese texts is a beta feature.
 func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ClassifyCollection(classifyCollectionOptions *ClassifyCollectionOptions) (result *ClassificationCollection, response *core.DetailedResponse, err error) {
+	return naturalLanguageClassifier.ClassifyCollectionWithContext(context.Background(), classifyCollectionOptions)
+}
+
+// ClassifyCollectionWithContext is an alternate form of the ClassifyCollection method which supports a Context parameter
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ClassifyCollectionWithContext(ctx context.Context, classifyCollectionOptions *ClassifyCollectionOptions) (result *ClassificationCollection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(classifyCollectionOptions, "classifyCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -210,7 +258,10 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ClassifyCollection
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageClassifier.Service.Request(request, new(ClassificationCollection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassificationCollection)
@@ -225,6 +276,11 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ClassifyCollection
 // CreateClassifier : Create classifier
 // Sends data to create and train a classifier and returns information about the new classifier.
 func (naturalLanguageClassifier *NaturalLanguageClassifierV1) CreateClassifier(createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return naturalLanguageClassifier.CreateClassifierWithContext(context.Background(), createClassifierOptions)
+}
+
+// CreateClassifierWithContext is an alternate form of the CreateClassifier method which supports a Context parameter
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) CreateClassifierWithContext(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createClassifierOptions, "createClassifierOptions cannot be nil")
 	if err != nil {
 		return
@@ -264,7 +320,10 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) CreateClassifier(c
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageClassifier.Service.Request(request, new(Classifier))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -279,6 +338,11 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) CreateClassifier(c
 // ListClassifiers : List classifiers
 // Returns an empty array if no classifiers are available.
 func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ListClassifiers(listClassifiersOptions *ListClassifiersOptions) (result *ClassifierList, response *core.DetailedResponse, err error) {
+	return naturalLanguageClassifier.ListClassifiersWithContext(context.Background(), listClassifiersOptions)
+}
+
+// ListClassifiersWithContext is an alternate form of the ListClassifiers method which supports a Context parameter
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ListClassifiersWithContext(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *ClassifierList, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listClassifiersOptions, "listClassifiersOptions")
 	if err != nil {
 		return
@@ -309,7 +373,10 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ListClassifiers(li
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageClassifier.Service.Request(request, new(ClassifierList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassifierList)
@@ -324,6 +391,11 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) ListClassifiers(li
 // GetClassifier : Get information about a classifier
 // Returns status and other information about a classifier.
 func (naturalLanguageClassifier *NaturalLanguageClassifierV1) GetClassifier(getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return naturalLanguageClassifier.GetClassifierWithContext(context.Background(), getClassifierOptions)
+}
+
+// GetClassifierWithContext is an alternate form of the GetClassifier method which supports a Context parameter
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) GetClassifierWithContext(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getClassifierOptions, "getClassifierOptions cannot be nil")
 	if err != nil {
 		return
@@ -358,7 +430,10 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) GetClassifier(getC
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageClassifier.Service.Request(request, new(Classifier))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -372,6 +447,11 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) GetClassifier(getC
 
 // DeleteClassifier : Delete classifier
 func (naturalLanguageClassifier *NaturalLanguageClassifierV1) DeleteClassifier(deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
+	return naturalLanguageClassifier.DeleteClassifierWithContext(context.Background(), deleteClassifierOptions)
+}
+
+// DeleteClassifierWithContext is an alternate form of the DeleteClassifier method which supports a Context parameter
+func (naturalLanguageClassifier *NaturalLanguageClassifierV1) DeleteClassifierWithContext(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteClassifierOptions, "deleteClassifierOptions cannot be nil")
 	if err != nil {
 		return
@@ -406,7 +486,10 @@ func (naturalLanguageClassifier *NaturalLanguageClassifierV1) DeleteClassifier(d
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageClassifier.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -0,0 +1,50 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageclassifierv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// NaturalLanguageClassifierV1API : The operations of the NaturalLanguageClassifierV1 service.
+// It is implemented by *NaturalLanguageClassifierV1, and by *MockNaturalLanguageClassifierV1 for unit tests.
+type NaturalLanguageClassifierV1API interface {
+	Classify(classifyOptions *ClassifyOptions) (result *Classification, response *core.DetailedResponse, err error)
+	ClassifyWithContext(ctx context.Context, classifyOptions *ClassifyOptions) (result *Classification, response *core.DetailedResponse, err error)
+	ClassifyCollection(classifyCollectionOptions *ClassifyCollectionOptions) (result *ClassificationCollection, response *core.DetailedResponse, err error)
+	ClassifyCollectionWithContext(ctx context.Context, classifyCollectionOptions *ClassifyCollectionOptions) (result *ClassificationCollection, response *core.DetailedResponse, err error)
+	CreateClassifier(createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	CreateClassifierWithContext(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	ListClassifiers(listClassifiersOptions *ListClassifiersOptions) (result *ClassifierList, response *core.DetailedResponse, err error)
+	ListClassifiersWithContext(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *ClassifierList, response *core.DetailedResponse, err error)
+	GetClassifier(getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	GetClassifierWithContext(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	DeleteClassifier(deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error)
+	DeleteClassifierWithContext(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error)
+	NewClassifyCollectionOptions(classifierID string, collection []ClassifyInput) *ClassifyCollectionOptions
+	NewClassifyInput(text string) (model *ClassifyInput, err error)
+	NewClassifyOptions(classifierID string, text string) *ClassifyOptions
+	NewCreateClassifierOptions(trainingMetadata io.ReadCloser, trainingData io.ReadCloser) *CreateClassifierOptions
+	NewDeleteClassifierOptions(classifierID string) *DeleteClassifierOptions
+	NewGetClassifierOptions(classifierID string) *GetClassifierOptions
+	NewListClassifiersOptions() *ListClassifiersOptions
+}
+
+var _ NaturalLanguageClassifierV1API = (*NaturalLanguageClassifierV1)(nil)
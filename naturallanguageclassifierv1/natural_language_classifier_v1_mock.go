@@ -0,0 +1,166 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageclassifierv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockNaturalLanguageClassifierV1 : A mock implementation of NaturalLanguageClassifierV1API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *NaturalLanguageClassifierV1.
+type MockNaturalLanguageClassifierV1 struct {
+	common.MockRecorder
+
+	ClassifyFunc           func(ctx context.Context, classifyOptions *ClassifyOptions) (result *Classification, response *core.DetailedResponse, err error)
+	ClassifyCollectionFunc func(ctx context.Context, classifyCollectionOptions *ClassifyCollectionOptions) (result *ClassificationCollection, response *core.DetailedResponse, err error)
+	CreateClassifierFunc   func(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	ListClassifiersFunc    func(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *ClassifierList, response *core.DetailedResponse, err error)
+	GetClassifierFunc      func(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	DeleteClassifierFunc   func(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ NaturalLanguageClassifierV1API = (*MockNaturalLanguageClassifierV1)(nil)
+
+// Classify records the call and invokes ClassifyFunc
+func (mock *MockNaturalLanguageClassifierV1) Classify(classifyOptions *ClassifyOptions) (result *Classification, response *core.DetailedResponse, err error) {
+	return mock.ClassifyWithContext(context.Background(), classifyOptions)
+}
+
+// ClassifyWithContext records the call and invokes ClassifyFunc
+func (mock *MockNaturalLanguageClassifierV1) ClassifyWithContext(ctx context.Context, classifyOptions *ClassifyOptions) (result *Classification, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Classify", classifyOptions)
+	if mock.ClassifyFunc != nil {
+		return mock.ClassifyFunc(ctx, classifyOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageClassifierV1", "Classify")
+	return
+}
+
+// ClassifyCollection records the call and invokes ClassifyCollectionFunc
+func (mock *MockNaturalLanguageClassifierV1) ClassifyCollection(classifyCollectionOptions *ClassifyCollectionOptions) (result *ClassificationCollection, response *core.DetailedResponse, err error) {
+	return mock.ClassifyCollectionWithContext(context.Background(), classifyCollectionOptions)
+}
+
+// ClassifyCollectionWithContext records the call and invokes ClassifyCollectionFunc
+func (mock *MockNaturalLanguageClassifierV1) ClassifyCollectionWithContext(ctx context.Context, classifyCollectionOptions *ClassifyCollectionOptions) (result *ClassificationCollection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ClassifyCollection", classifyCollectionOptions)
+	if mock.ClassifyCollectionFunc != nil {
+		return mock.ClassifyCollectionFunc(ctx, classifyCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageClassifierV1", "ClassifyCollection")
+	return
+}
+
+// CreateClassifier records the call and invokes CreateClassifierFunc
+func (mock *MockNaturalLanguageClassifierV1) CreateClassifier(createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return mock.CreateClassifierWithContext(context.Background(), createClassifierOptions)
+}
+
+// CreateClassifierWithContext records the call and invokes CreateClassifierFunc
+func (mock *MockNaturalLanguageClassifierV1) CreateClassifierWithContext(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateClassifier", createClassifierOptions)
+	if mock.CreateClassifierFunc != nil {
+		return mock.CreateClassifierFunc(ctx, createClassifierOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageClassifierV1", "CreateClassifier")
+	return
+}
+
+// ListClassifiers records the call and invokes ListClassifiersFunc
+func (mock *MockNaturalLanguageClassifierV1) ListClassifiers(listClassifiersOptions *ListClassifiersOptions) (result *ClassifierList, response *core.DetailedResponse, err error) {
+	return mock.ListClassifiersWithContext(context.Background(), listClassifiersOptions)
+}
+
+// ListClassifiersWithContext records the call and invokes ListClassifiersFunc
+func (mock *MockNaturalLanguageClassifierV1) ListClassifiersWithContext(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *ClassifierList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListClassifiers", listClassifiersOptions)
+	if mock.ListClassifiersFunc != nil {
+		return mock.ListClassifiersFunc(ctx, listClassifiersOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageClassifierV1", "ListClassifiers")
+	return
+}
+
+// GetClassifier records the call and invokes GetClassifierFunc
+func (mock *MockNaturalLanguageClassifierV1) GetClassifier(getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return mock.GetClassifierWithContext(context.Background(), getClassifierOptions)
+}
+
+// GetClassifierWithContext records the call and invokes GetClassifierFunc
+func (mock *MockNaturalLanguageClassifierV1) GetClassifierWithContext(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetClassifier", getClassifierOptions)
+	if mock.GetClassifierFunc != nil {
+		return mock.GetClassifierFunc(ctx, getClassifierOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageClassifierV1", "GetClassifier")
+	return
+}
+
+// DeleteClassifier records the call and invokes DeleteClassifierFunc
+func (mock *MockNaturalLanguageClassifierV1) DeleteClassifier(deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteClassifierWithContext(context.Background(), deleteClassifierOptions)
+}
+
+// DeleteClassifierWithContext records the call and invokes DeleteClassifierFunc
+func (mock *MockNaturalLanguageClassifierV1) DeleteClassifierWithContext(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteClassifier", deleteClassifierOptions)
+	if mock.DeleteClassifierFunc != nil {
+		return mock.DeleteClassifierFunc(ctx, deleteClassifierOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageClassifierV1", "DeleteClassifier")
+	return
+}
+
+// NewClassifyCollectionOptions delegates to NaturalLanguageClassifierV1.NewClassifyCollectionOptions
+func (mock *MockNaturalLanguageClassifierV1) NewClassifyCollectionOptions(classifierID string, collection []ClassifyInput) *ClassifyCollectionOptions {
+	return new(NaturalLanguageClassifierV1).NewClassifyCollectionOptions(classifierID, collection)
+}
+
+// NewClassifyInput delegates to NaturalLanguageClassifierV1.NewClassifyInput
+func (mock *MockNaturalLanguageClassifierV1) NewClassifyInput(text string) (model *ClassifyInput, err error) {
+	return new(NaturalLanguageClassifierV1).NewClassifyInput(text)
+}
+
+// NewClassifyOptions delegates to NaturalLanguageClassifierV1.NewClassifyOptions
+func (mock *MockNaturalLanguageClassifierV1) NewClassifyOptions(classifierID string, text string) *ClassifyOptions {
+	return new(NaturalLanguageClassifierV1).NewClassifyOptions(classifierID, text)
+}
+
+// NewCreateClassifierOptions delegates to NaturalLanguageClassifierV1.NewCreateClassifierOptions
+func (mock *MockNaturalLanguageClassifierV1) NewCreateClassifierOptions(trainingMetadata io.ReadCloser, trainingData io.ReadCloser) *CreateClassifierOptions {
+	return new(NaturalLanguageClassifierV1).NewCreateClassifierOptions(trainingMetadata, trainingData)
+}
+
+// NewDeleteClassifierOptions delegates to NaturalLanguageClassifierV1.NewDeleteClassifierOptions
+func (mock *MockNaturalLanguageClassifierV1) NewDeleteClassifierOptions(classifierID string) *DeleteClassifierOptions {
+	return new(NaturalLanguageClassifierV1).NewDeleteClassifierOptions(classifierID)
+}
+
+// NewGetClassifierOptions delegates to NaturalLanguageClassifierV1.NewGetClassifierOptions
+func (mock *MockNaturalLanguageClassifierV1) NewGetClassifierOptions(classifierID string) *GetClassifierOptions {
+	return new(NaturalLanguageClassifierV1).NewGetClassifierOptions(classifierID)
+}
+
+// NewListClassifiersOptions delegates to NaturalLanguageClassifierV1.NewListClassifiersOptions
+func (mock *MockNaturalLanguageClassifierV1) NewListClassifiersOptions() *ListClassifiersOptions {
+	return new(NaturalLanguageClassifierV1).NewListClassifiersOptions()
+}
@@ -18,10 +18,12 @@
 package naturallanguageunderstandingv1
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/go-openapi/strfmt"
 	common "github.com/watson-developer-cloud/go-sdk/common"
+	"net/http"
 )
 
 // NaturalLanguageUnderstandingV1 : Analyze various features of text content at scale. Provide text, raw HTML, or a
@@ -48,10 +50,13 @@ const DefaultServiceName = "natural-language-understanding"
 
 // NaturalLanguageUnderstandingV1Options : Service options
 type NaturalLanguageUnderstandingV1Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewNaturalLanguageUnderstandingV1 : constructs an instance of NaturalLanguageUnderstandingV1 with passed in options.
@@ -89,6 +94,16 @@ func NewNaturalLanguageUnderstandingV1(options *NaturalLanguageUnderstandingV1Op
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &NaturalLanguageUnderstandingV1{
 		Service: baseService,
 		Version: options.Version,
@@ -104,7 +119,27 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) SetServiceUR
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) DisableSSLVerification() {
-	naturalLanguageUnderstanding.Service.DisableSSLVerification()
+	common.DisableSSLVerification(naturalLanguageUnderstanding.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(naturalLanguageUnderstanding.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(naturalLanguageUnderstanding.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(naturalLanguageUnderstanding.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(naturalLanguageUnderstanding.Service, interceptor)
 }
 
 // Analyze : Analyze text
@@ -124,6 +159,11 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) DisableSSLVe
 // the
 // language](https://cloud.ibm.com/docs/natural-language-understanding?topic=natural-language-understanding-detectable-languages).
 func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) Analyze(analyzeOptions *AnalyzeOptions) (result *AnalysisResults, response *core.DetailedResponse, err error) {
+	return naturalLanguageUnderstanding.AnalyzeWithContext(context.Background(), analyzeOptions)
+}
+
+// AnalyzeWithContext is an alternate form of the Analyze method which supports a Context parameter
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) AnalyzeWithContext(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalysisResults, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(analyzeOptions, "analyzeOptions cannot be nil")
 	if err != nil {
 		return
@@ -196,7 +236,10 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) Analyze(anal
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageUnderstanding.Service.Request(request, new(AnalysisResults))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AnalysisResults)
@@ -213,6 +256,11 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) Analyze(anal
 // models](https://cloud.ibm.com/docs/natural-language-understanding?topic=natural-language-understanding-customizing)
 // that are deployed to your Natural Language Understanding service.
 func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) ListModels(listModelsOptions *ListModelsOptions) (result *ListModelsResults, response *core.DetailedResponse, err error) {
+	return naturalLanguageUnderstanding.ListModelsWithContext(context.Background(), listModelsOptions)
+}
+
+// ListModelsWithContext is an alternate form of the ListModels method which supports a Context parameter
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *ListModelsResults, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listModelsOptions, "listModelsOptions")
 	if err != nil {
 		return
@@ -244,7 +292,10 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) ListModels(l
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageUnderstanding.Service.Request(request, new(ListModelsResults))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ListModelsResults)
@@ -259,6 +310,11 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) ListModels(l
 // DeleteModel : Delete model
 // Deletes a custom model.
 func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) DeleteModel(deleteModelOptions *DeleteModelOptions) (result *DeleteModelResults, response *core.DetailedResponse, err error) {
+	return naturalLanguageUnderstanding.DeleteModelWithContext(context.Background(), deleteModelOptions)
+}
+
+// DeleteModelWithContext is an alternate form of the DeleteModel method which supports a Context parameter
+func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) DeleteModelWithContext(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResults, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteModelOptions, "deleteModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -294,7 +350,10 @@ func (naturalLanguageUnderstanding *NaturalLanguageUnderstandingV1) DeleteModel(
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = naturalLanguageUnderstanding.Service.Request(request, new(DeleteModelResults))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*DeleteModelResults)
@@ -0,0 +1,39 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageunderstandingv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// NaturalLanguageUnderstandingV1API : The operations of the NaturalLanguageUnderstandingV1 service.
+// It is implemented by *NaturalLanguageUnderstandingV1, and by *MockNaturalLanguageUnderstandingV1 for unit tests.
+type NaturalLanguageUnderstandingV1API interface {
+	Analyze(analyzeOptions *AnalyzeOptions) (result *AnalysisResults, response *core.DetailedResponse, err error)
+	AnalyzeWithContext(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalysisResults, response *core.DetailedResponse, err error)
+	ListModels(listModelsOptions *ListModelsOptions) (result *ListModelsResults, response *core.DetailedResponse, err error)
+	ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *ListModelsResults, response *core.DetailedResponse, err error)
+	DeleteModel(deleteModelOptions *DeleteModelOptions) (result *DeleteModelResults, response *core.DetailedResponse, err error)
+	DeleteModelWithContext(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResults, response *core.DetailedResponse, err error)
+	NewAnalyzeOptions(features *Features) *AnalyzeOptions
+	NewDeleteModelOptions(modelID string) *DeleteModelOptions
+	NewListModelsOptions() *ListModelsOptions
+}
+
+var _ NaturalLanguageUnderstandingV1API = (*NaturalLanguageUnderstandingV1)(nil)
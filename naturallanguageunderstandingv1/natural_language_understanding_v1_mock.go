@@ -0,0 +1,97 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package naturallanguageunderstandingv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockNaturalLanguageUnderstandingV1 : A mock implementation of NaturalLanguageUnderstandingV1API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *NaturalLanguageUnderstandingV1.
+type MockNaturalLanguageUnderstandingV1 struct {
+	common.MockRecorder
+
+	AnalyzeFunc     func(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalysisResults, response *core.DetailedResponse, err error)
+	ListModelsFunc  func(ctx context.Context, listModelsOptions *ListModelsOptions) (result *ListModelsResults, response *core.DetailedResponse, err error)
+	DeleteModelFunc func(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResults, response *core.DetailedResponse, err error)
+}
+
+var _ NaturalLanguageUnderstandingV1API = (*MockNaturalLanguageUnderstandingV1)(nil)
+
+// Analyze records the call and invokes AnalyzeFunc
+func (mock *MockNaturalLanguageUnderstandingV1) Analyze(analyzeOptions *AnalyzeOptions) (result *AnalysisResults, response *core.DetailedResponse, err error) {
+	return mock.AnalyzeWithContext(context.Background(), analyzeOptions)
+}
+
+// AnalyzeWithContext records the call and invokes AnalyzeFunc
+func (mock *MockNaturalLanguageUnderstandingV1) AnalyzeWithContext(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalysisResults, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Analyze", analyzeOptions)
+	if mock.AnalyzeFunc != nil {
+		return mock.AnalyzeFunc(ctx, analyzeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageUnderstandingV1", "Analyze")
+	return
+}
+
+// ListModels records the call and invokes ListModelsFunc
+func (mock *MockNaturalLanguageUnderstandingV1) ListModels(listModelsOptions *ListModelsOptions) (result *ListModelsResults, response *core.DetailedResponse, err error) {
+	return mock.ListModelsWithContext(context.Background(), listModelsOptions)
+}
+
+// ListModelsWithContext records the call and invokes ListModelsFunc
+func (mock *MockNaturalLanguageUnderstandingV1) ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *ListModelsResults, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListModels", listModelsOptions)
+	if mock.ListModelsFunc != nil {
+		return mock.ListModelsFunc(ctx, listModelsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageUnderstandingV1", "ListModels")
+	return
+}
+
+// DeleteModel records the call and invokes DeleteModelFunc
+func (mock *MockNaturalLanguageUnderstandingV1) DeleteModel(deleteModelOptions *DeleteModelOptions) (result *DeleteModelResults, response *core.DetailedResponse, err error) {
+	return mock.DeleteModelWithContext(context.Background(), deleteModelOptions)
+}
+
+// DeleteModelWithContext records the call and invokes DeleteModelFunc
+func (mock *MockNaturalLanguageUnderstandingV1) DeleteModelWithContext(ctx context.Context, deleteModelOptions *DeleteModelOptions) (result *DeleteModelResults, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteModel", deleteModelOptions)
+	if mock.DeleteModelFunc != nil {
+		return mock.DeleteModelFunc(ctx, deleteModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockNaturalLanguageUnderstandingV1", "DeleteModel")
+	return
+}
+
+// NewAnalyzeOptions delegates to NaturalLanguageUnderstandingV1.NewAnalyzeOptions
+func (mock *MockNaturalLanguageUnderstandingV1) NewAnalyzeOptions(features *Features) *AnalyzeOptions {
+	return new(NaturalLanguageUnderstandingV1).NewAnalyzeOptions(features)
+}
+
+// NewDeleteModelOptions delegates to NaturalLanguageUnderstandingV1.NewDeleteModelOptions
+func (mock *MockNaturalLanguageUnderstandingV1) NewDeleteModelOptions(modelID string) *DeleteModelOptions {
+	return new(NaturalLanguageUnderstandingV1).NewDeleteModelOptions(modelID)
+}
+
+// NewListModelsOptions delegates to NaturalLanguageUnderstandingV1.NewListModelsOptions
+func (mock *MockNaturalLanguageUnderstandingV1) NewListModelsOptions() *ListModelsOptions {
+	return new(NaturalLanguageUnderstandingV1).NewListModelsOptions()
+}
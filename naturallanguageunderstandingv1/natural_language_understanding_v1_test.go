@@ -21,6 +21,7 @@ import (
 	"github.com/IBM/go-sdk-core/core"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/common"
 	"github.com/watson-developer-cloud/go-sdk/naturallanguageunderstandingv1"
 	"net/http"
 	"net/http/httptest"
@@ -73,6 +74,47 @@ var _ = Describe(`NaturalLanguageUnderstandingV1`, func() {
 				Expect(result).ToNot(BeNil())
 			})
 		})
+		Context(`Successfully - Analyze text after being rate limited`, func() {
+			calls := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.Path).To(Equal(analyzePath))
+				Expect(req.Method).To(Equal("POST"))
+				calls++
+				res.Header().Set("Content-type", "application/json")
+				if calls == 1 {
+					res.Header().Set("Retry-After", "0")
+					res.WriteHeader(429)
+					fmt.Fprintf(res, `{"error": "Too many requests", "code": 429}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{}`)
+			}))
+			It(`Succeed to call Analyze with a RetryPolicy`, func() {
+				defer testServer.Close()
+
+				testService, testServiceErr := naturallanguageunderstandingv1.NewNaturalLanguageUnderstandingV1(&naturallanguageunderstandingv1.NaturalLanguageUnderstandingV1Options{
+					URL:     testServer.URL,
+					Version: version,
+					Authenticator: &core.BearerTokenAuthenticator{
+						BearerToken: bearerToken,
+					},
+					RetryPolicy: common.NewRetryPolicy(3),
+				})
+				Expect(testServiceErr).To(BeNil())
+				Expect(testService).ToNot(BeNil())
+
+				analyzeOptions := testService.NewAnalyzeOptions(features)
+				result, response, operationErr := testService.Analyze(analyzeOptions)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+				Expect(calls).To(Equal(2))
+			})
+		})
 	})
 	Describe(`ListModels(listModelsOptions *ListModelsOptions)`, func() {
 		listModelsPath := "/v1/models"
@@ -18,8 +18,10 @@
 package personalityinsightsv3
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 
 	"github.com/IBM/go-sdk-core/core"
 	common "github.com/watson-developer-cloud/go-sdk/common"
@@ -56,10 +58,13 @@ const DefaultServiceName = "personality_insights"
 
 // PersonalityInsightsV3Options : Service options
 type PersonalityInsightsV3Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewPersonalityInsightsV3 : constructs an instance of PersonalityInsightsV3 with passed in options.
@@ -97,6 +102,16 @@ func NewPersonalityInsightsV3(options *PersonalityInsightsV3Options) (service *P
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &PersonalityInsightsV3{
 		Service: baseService,
 		Version: options.Version,
@@ -112,7 +127,27 @@ func (personalityInsights *PersonalityInsightsV3) SetServiceURL(url string) erro
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (personalityInsights *PersonalityInsightsV3) DisableSSLVerification() {
-	personalityInsights.Service.DisableSSLVerification()
+	common.DisableSSLVerification(personalityInsights.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (personalityInsights *PersonalityInsightsV3) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(personalityInsights.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (personalityInsights *PersonalityInsightsV3) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(personalityInsights.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (personalityInsights *PersonalityInsightsV3) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(personalityInsights.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (personalityInsights *PersonalityInsightsV3) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(personalityInsights.Service, interceptor)
 }
 
 // Profile : Get profile
@@ -151,6 +186,11 @@ func (personalityInsights *PersonalityInsightsV3) DisableSSLVerification() {
 // * [Understanding a CSV
 // profile](https://cloud.ibm.com/docs/personality-insights?topic=personality-insights-outputCSV#outputCSV).
 func (personalityInsights *PersonalityInsightsV3) Profile(profileOptions *ProfileOptions) (result *Profile, response *core.DetailedResponse, err error) {
+	return personalityInsights.ProfileWithContext(context.Background(), profileOptions)
+}
+
+// ProfileWithContext is an alternate form of the Profile method which supports a Context parameter
+func (personalityInsights *PersonalityInsightsV3) ProfileWithContext(ctx context.Context, profileOptions *ProfileOptions) (result *Profile, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(profileOptions, "profileOptions cannot be nil")
 	if err != nil {
 		return
@@ -210,7 +250,10 @@ func (personalityInsights *PersonalityInsightsV3) Profile(profileOptions *Profil
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = personalityInsights.Service.Request(request, new(Profile))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Profile)
@@ -258,6 +301,11 @@ func (personalityInsights *PersonalityInsightsV3) Profile(profileOptions *Profil
 // * [Understanding a CSV
 // profile](https://cloud.ibm.com/docs/personality-insights?topic=personality-insights-outputCSV#outputCSV).
 func (personalityInsights *PersonalityInsightsV3) ProfileAsCsv(profileOptions *ProfileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return personalityInsights.ProfileAsCsvWithContext(context.Background(), profileOptions)
+}
+
+// ProfileAsCsvWithContext is an alternate form of the ProfileAsCsv method which supports a Context parameter
+func (personalityInsights *PersonalityInsightsV3) ProfileAsCsvWithContext(ctx context.Context, profileOptions *ProfileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(profileOptions, "profileOptions cannot be nil")
 	if err != nil {
 		return
@@ -317,7 +365,10 @@ func (personalityInsights *PersonalityInsightsV3) ProfileAsCsv(profileOptions *P
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = personalityInsights.Service.Request(request, new(io.ReadCloser))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
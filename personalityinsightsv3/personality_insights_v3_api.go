@@ -0,0 +1,38 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package personalityinsightsv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// PersonalityInsightsV3API : The operations of the PersonalityInsightsV3 service.
+// It is implemented by *PersonalityInsightsV3, and by *MockPersonalityInsightsV3 for unit tests.
+type PersonalityInsightsV3API interface {
+	Profile(profileOptions *ProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+	ProfileWithContext(ctx context.Context, profileOptions *ProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+	ProfileAsCsv(profileOptions *ProfileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	ProfileAsCsvWithContext(ctx context.Context, profileOptions *ProfileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	NewContent(contentItems []ContentItem) (model *Content, err error)
+	NewContentItem(content string) (model *ContentItem, err error)
+	NewProfileOptions() *ProfileOptions
+}
+
+var _ PersonalityInsightsV3API = (*PersonalityInsightsV3)(nil)
@@ -0,0 +1,82 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package personalityinsightsv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockPersonalityInsightsV3 : A mock implementation of PersonalityInsightsV3API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *PersonalityInsightsV3.
+type MockPersonalityInsightsV3 struct {
+	common.MockRecorder
+
+	ProfileFunc      func(ctx context.Context, profileOptions *ProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+	ProfileAsCsvFunc func(ctx context.Context, profileOptions *ProfileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+}
+
+var _ PersonalityInsightsV3API = (*MockPersonalityInsightsV3)(nil)
+
+// Profile records the call and invokes ProfileFunc
+func (mock *MockPersonalityInsightsV3) Profile(profileOptions *ProfileOptions) (result *Profile, response *core.DetailedResponse, err error) {
+	return mock.ProfileWithContext(context.Background(), profileOptions)
+}
+
+// ProfileWithContext records the call and invokes ProfileFunc
+func (mock *MockPersonalityInsightsV3) ProfileWithContext(ctx context.Context, profileOptions *ProfileOptions) (result *Profile, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Profile", profileOptions)
+	if mock.ProfileFunc != nil {
+		return mock.ProfileFunc(ctx, profileOptions)
+	}
+	err = common.ErrMockNotImplemented("MockPersonalityInsightsV3", "Profile")
+	return
+}
+
+// ProfileAsCsv records the call and invokes ProfileAsCsvFunc
+func (mock *MockPersonalityInsightsV3) ProfileAsCsv(profileOptions *ProfileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return mock.ProfileAsCsvWithContext(context.Background(), profileOptions)
+}
+
+// ProfileAsCsvWithContext records the call and invokes ProfileAsCsvFunc
+func (mock *MockPersonalityInsightsV3) ProfileAsCsvWithContext(ctx context.Context, profileOptions *ProfileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ProfileAsCsv", profileOptions)
+	if mock.ProfileAsCsvFunc != nil {
+		return mock.ProfileAsCsvFunc(ctx, profileOptions)
+	}
+	err = common.ErrMockNotImplemented("MockPersonalityInsightsV3", "ProfileAsCsv")
+	return
+}
+
+// NewContent delegates to PersonalityInsightsV3.NewContent
+func (mock *MockPersonalityInsightsV3) NewContent(contentItems []ContentItem) (model *Content, err error) {
+	return new(PersonalityInsightsV3).NewContent(contentItems)
+}
+
+// NewContentItem delegates to PersonalityInsightsV3.NewContentItem
+func (mock *MockPersonalityInsightsV3) NewContentItem(content string) (model *ContentItem, err error) {
+	return new(PersonalityInsightsV3).NewContentItem(content)
+}
+
+// NewProfileOptions delegates to PersonalityInsightsV3.NewProfileOptions
+func (mock *MockPersonalityInsightsV3) NewProfileOptions() *ProfileOptions {
+	return new(PersonalityInsightsV3).NewProfileOptions()
+}
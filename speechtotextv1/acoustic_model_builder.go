@@ -0,0 +1,428 @@
+package speechtotextv1
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+const (
+	DEFAULT_ACOUSTIC_MODEL_POLLING_INTERVAL = 10 * time.Second
+	DEFAULT_ACOUSTIC_MODEL_ANALYSIS_TIMEOUT = 30 * time.Minute
+	DEFAULT_ACOUSTIC_MODEL_TRAINING_TIMEOUT = 2 * time.Hour
+)
+
+// Constants associated with the BuildAcousticModelOptions.ArchiveFormat property.
+// The format of the archive in which the audio files are uploaded.
+const (
+	BuildAcousticModelOptions_ArchiveFormat_TarGz = "tar.gz"
+	BuildAcousticModelOptions_ArchiveFormat_Zip   = "zip"
+)
+
+// archiveContentTypes are the content types of the archive formats, as expected by AddAudio
+var archiveContentTypes = map[string]string{
+	BuildAcousticModelOptions_ArchiveFormat_TarGz: "application/gzip",
+	BuildAcousticModelOptions_ArchiveFormat_Zip:   "application/zip",
+}
+
+// archivedAudioExtensions are the extensions of the audio files that an archive can hold without a
+// Contained-Content-Type
+var archivedAudioExtensions = map[string]bool{
+	".flac": true,
+	".mp3":  true,
+	".mpeg": true,
+	".ogg":  true,
+	".opus": true,
+	".wav":  true,
+	".webm": true,
+}
+
+// BuildAcousticModelOptions : The BuildAcousticModel options.
+type BuildAcousticModelOptions struct {
+	// The options of the custom acoustic model to create. Either CreateAcousticModelOptions or CustomizationID is
+	// required.
+	CreateAcousticModelOptions *CreateAcousticModelOptions
+
+	// The customization ID (GUID) of an existing custom acoustic model to add the audio to.
+	CustomizationID *string
+
+	// The directory of the audio files.
+	AudioDirectory *string `validate:"required"`
+
+	// The name of the archive-type audio resource. The default is the name of the directory.
+	AudioName *string
+
+	// The format of the archive, `zip` by default.
+	ArchiveFormat *string
+
+	// The format of the audio files, if they are of type `audio/alaw`, `audio/basic`, `audio/l16` or `audio/mulaw`. All
+	// the files of the directory are then archived. Otherwise, only the FLAC, MP3, MPEG, Ogg, Opus, WAV and WebM files of
+	// the directory are archived, based on their extension.
+	ContainedContentType *string
+
+	// If `true`, the archive replaces an audio resource of the same name of an existing custom acoustic model.
+	AllowOverwrite *bool
+
+	// The customization ID (GUID) of a trained custom language model to use during the training.
+	CustomLanguageModelID *string
+
+	// The time waited between two checks of the status of the audio or of the model.
+	PollingInterval *time.Duration
+
+	// The maximum time waited for the audio to be analyzed, and for the model to be ready.
+	AnalysisTimeout *time.Duration
+
+	// The maximum time waited for the training to end.
+	TrainingTimeout *time.Duration
+}
+
+// NewBuildAcousticModelOptions : Instantiate BuildAcousticModelOptions
+func (speechToText *SpeechToTextV1) NewBuildAcousticModelOptions(audioDirectory string) *BuildAcousticModelOptions {
+	return &BuildAcousticModelOptions{
+		AudioDirectory: core.StringPtr(audioDirectory),
+	}
+}
+
+// SetCreateAcousticModelOptions : Allow user to set CreateAcousticModelOptions
+func (options *BuildAcousticModelOptions) SetCreateAcousticModelOptions(createAcousticModelOptions *CreateAcousticModelOptions) *BuildAcousticModelOptions {
+	options.CreateAcousticModelOptions = createAcousticModelOptions
+	return options
+}
+
+// SetCustomizationID : Allow user to set CustomizationID
+func (options *BuildAcousticModelOptions) SetCustomizationID(customizationID string) *BuildAcousticModelOptions {
+	options.CustomizationID = core.StringPtr(customizationID)
+	return options
+}
+
+// SetAudioDirectory : Allow user to set AudioDirectory
+func (options *BuildAcousticModelOptions) SetAudioDirectory(audioDirectory string) *BuildAcousticModelOptions {
+	options.AudioDirectory = core.StringPtr(audioDirectory)
+	return options
+}
+
+// SetAudioName : Allow user to set AudioName
+func (options *BuildAcousticModelOptions) SetAudioName(audioName string) *BuildAcousticModelOptions {
+	options.AudioName = core.StringPtr(audioName)
+	return options
+}
+
+// SetArchiveFormat : Allow user to set ArchiveFormat
+func (options *BuildAcousticModelOptions) SetArchiveFormat(archiveFormat string) *BuildAcousticModelOptions {
+	options.ArchiveFormat = core.StringPtr(archiveFormat)
+	return options
+}
+
+// SetContainedContentType : Allow user to set ContainedContentType
+func (options *BuildAcousticModelOptions) SetContainedContentType(containedContentType string) *BuildAcousticModelOptions {
+	options.ContainedContentType = core.StringPtr(containedContentType)
+	return options
+}
+
+// SetAllowOverwrite : Allow user to set AllowOverwrite
+func (options *BuildAcousticModelOptions) SetAllowOverwrite(allowOverwrite bool) *BuildAcousticModelOptions {
+	options.AllowOverwrite = core.BoolPtr(allowOverwrite)
+	return options
+}
+
+// SetCustomLanguageModelID : Allow user to set CustomLanguageModelID
+func (options *BuildAcousticModelOptions) SetCustomLanguageModelID(customLanguageModelID string) *BuildAcousticModelOptions {
+	options.CustomLanguageModelID = core.StringPtr(customLanguageModelID)
+	return options
+}
+
+// SetPollingInterval : Allow user to set PollingInterval
+func (options *BuildAcousticModelOptions) SetPollingInterval(pollingInterval time.Duration) *BuildAcousticModelOptions {
+	options.PollingInterval = &pollingInterval
+	return options
+}
+
+// SetAnalysisTimeout : Allow user to set AnalysisTimeout
+func (options *BuildAcousticModelOptions) SetAnalysisTimeout(analysisTimeout time.Duration) *BuildAcousticModelOptions {
+	options.AnalysisTimeout = &analysisTimeout
+	return options
+}
+
+// SetTrainingTimeout : Allow user to set TrainingTimeout
+func (options *BuildAcousticModelOptions) SetTrainingTimeout(trainingTimeout time.Duration) *BuildAcousticModelOptions {
+	options.TrainingTimeout = &trainingTimeout
+	return options
+}
+
+// AcousticModelBuild : The outcome of BuildAcousticModel
+type AcousticModelBuild struct {
+	// The customization ID (GUID) of the custom acoustic model.
+	CustomizationID string
+
+	// The custom acoustic model, as it was last checked.
+	AcousticModel *AcousticModel
+
+	// The archive-type audio resource, as it was last checked.
+	Audio *AudioListing
+
+	// The warnings of the training.
+	TrainingWarnings []TrainingWarning
+}
+
+// BuildAcousticModel : Build a custom acoustic model from a directory of audio files
+// Creates a custom acoustic model, unless the options name an existing one, packages the audio files of a directory
+// into an archive, adds it as an audio resource and waits for the service to analyze it. Once the model is ready, it
+// is trained, with a custom language model if the options name one, and the call waits for it to be available. Each
+// wait fails after the timeout of the options.
+//
+// When a step fails, the build is returned along with the error, so that the model created can be inspected or
+// deleted.
+func (speechToText *SpeechToTextV1) BuildAcousticModel(ctx context.Context, buildAcousticModelOptions *BuildAcousticModelOptions) (*AcousticModelBuild, error) {
+	if err := core.ValidateNotNil(buildAcousticModelOptions, "buildAcousticModelOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(buildAcousticModelOptions, "buildAcousticModelOptions"); err != nil {
+		return nil, err
+	}
+	options := buildAcousticModelOptions
+	if (options.CreateAcousticModelOptions == nil) == (options.CustomizationID == nil) {
+		return nil, fmt.Errorf("Either CreateAcousticModelOptions or CustomizationID is required")
+	}
+	archiveFormat := BuildAcousticModelOptions_ArchiveFormat_Zip
+	if options.ArchiveFormat != nil {
+		archiveFormat = *options.ArchiveFormat
+	}
+	contentType, ok := archiveContentTypes[archiveFormat]
+	if !ok {
+		return nil, fmt.Errorf("Unknown archive format '%s'", archiveFormat)
+	}
+
+	// Package the audio first, so that an empty directory does not leave a model behind
+	audioFiles, err := listAudioFiles(*options.AudioDirectory, options.ContainedContentType != nil)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := ioutil.TempFile("", "audio-*."+archiveFormat)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+	if err := writeAudioArchive(archive, archiveFormat, audioFiles); err != nil {
+		return nil, err
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	build := &AcousticModelBuild{}
+	if options.CustomizationID != nil {
+		build.CustomizationID = *options.CustomizationID
+	} else {
+		acousticModel, _, err := speechToText.CreateAcousticModelWithContext(ctx, options.CreateAcousticModelOptions)
+		if err != nil {
+			return nil, err
+		}
+		build.CustomizationID = *acousticModel.CustomizationID
+		build.AcousticModel = acousticModel
+	}
+
+	audioName := filepath.Base(filepath.Clean(*options.AudioDirectory))
+	if options.AudioName != nil {
+		audioName = *options.AudioName
+	}
+	// The archive is closed by the deferred call rather than by the upload
+	addAudioOptions := speechToText.NewAddAudioOptions(build.CustomizationID, audioName, ioutil.NopCloser(archive)).
+		SetContentType(contentType)
+	addAudioOptions.ContainedContentType = options.ContainedContentType
+	addAudioOptions.AllowOverwrite = options.AllowOverwrite
+	if _, err := speechToText.AddAudioWithContext(ctx, addAudioOptions); err != nil {
+		return build, err
+	}
+
+	err = pollStatus(ctx, options.pollingInterval(), options.analysisTimeout(), func() (bool, error) {
+		audio, _, err := speechToText.GetAudioWithContext(ctx, speechToText.NewGetAudioOptions(build.CustomizationID, audioName))
+		if err != nil {
+			return false, err
+		}
+		build.Audio = audio
+		if audio.Container == nil || audio.Container.Status == nil {
+			return false, nil
+		}
+		switch *audio.Container.Status {
+		case AudioResource_Status_Ok:
+			return true, nil
+		case AudioResource_Status_Invalid:
+			var invalid []string
+			for _, resource := range audio.Audio {
+				if resource.Status != nil && *resource.Status == AudioResource_Status_Invalid {
+					invalid = append(invalid, core.StringNilMapper(resource.Name))
+				}
+			}
+			return false, fmt.Errorf("The audio resource %s is invalid, because of the files %s", audioName, strings.Join(invalid, ", "))
+		}
+		return false, nil
+	})
+	if err == errPollTimeout {
+		err = fmt.Errorf("The audio resource %s was not analyzed within %s", audioName, options.analysisTimeout())
+	}
+	if err != nil {
+		return build, err
+	}
+
+	err = speechToText.waitForAcousticModel(ctx, options, build, options.analysisTimeout(), AcousticModel_Status_Ready, AcousticModel_Status_Available)
+	if err != nil {
+		return build, err
+	}
+
+	trainOptions := speechToText.NewTrainAcousticModelOptions(build.CustomizationID)
+	trainOptions.CustomLanguageModelID = options.CustomLanguageModelID
+	training, _, err := speechToText.TrainAcousticModelWithContext(ctx, trainOptions)
+	if err != nil {
+		return build, err
+	}
+	build.TrainingWarnings = training.Warnings
+
+	err = speechToText.waitForAcousticModel(ctx, options, build, options.trainingTimeout(), AcousticModel_Status_Available)
+	return build, err
+}
+
+// waitForAcousticModel waits for the custom acoustic model to reach one of the given statuses
+func (speechToText *SpeechToTextV1) waitForAcousticModel(ctx context.Context, options *BuildAcousticModelOptions, build *AcousticModelBuild, timeout time.Duration, statuses ...string) error {
+	err := pollStatus(ctx, options.pollingInterval(), timeout, func() (bool, error) {
+		acousticModel, _, err := speechToText.GetAcousticModelWithContext(ctx, speechToText.NewGetAcousticModelOptions(build.CustomizationID))
+		if err != nil {
+			return false, err
+		}
+		build.AcousticModel = acousticModel
+		if acousticModel.Status == nil {
+			return false, nil
+		}
+		if *acousticModel.Status == AcousticModel_Status_Failed {
+			if acousticModel.Warnings != nil {
+				return false, fmt.Errorf("The custom acoustic model %s failed, with the warnings: %s", build.CustomizationID, *acousticModel.Warnings)
+			}
+			return false, fmt.Errorf("The custom acoustic model %s failed", build.CustomizationID)
+		}
+		for _, status := range statuses {
+			if *acousticModel.Status == status {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err == errPollTimeout {
+		err = fmt.Errorf("The custom acoustic model %s was not %s within %s", build.CustomizationID, statuses[0], timeout)
+	}
+	return err
+}
+
+func (options *BuildAcousticModelOptions) pollingInterval() time.Duration {
+	if options.PollingInterval != nil {
+		return *options.PollingInterval
+	}
+	return DEFAULT_ACOUSTIC_MODEL_POLLING_INTERVAL
+}
+
+func (options *BuildAcousticModelOptions) analysisTimeout() time.Duration {
+	if options.AnalysisTimeout != nil {
+		return *options.AnalysisTimeout
+	}
+	return DEFAULT_ACOUSTIC_MODEL_ANALYSIS_TIMEOUT
+}
+
+func (options *BuildAcousticModelOptions) trainingTimeout() time.Duration {
+	if options.TrainingTimeout != nil {
+		return *options.TrainingTimeout
+	}
+	return DEFAULT_ACOUSTIC_MODEL_TRAINING_TIMEOUT
+}
+
+// listAudioFiles returns the audio files of a directory, sorted by name. Hidden files are skipped, and so are files
+// without an audio extension unless all files are included.
+func listAudioFiles(directory string, all bool) ([]string, error) {
+	entries, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.Mode().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if all || archivedAudioExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+			files = append(files, filepath.Join(directory, entry.Name()))
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("The directory %s has no audio files", directory)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// writeAudioArchive writes the files, at the root of an archive of the given format
+func writeAudioArchive(writer io.Writer, archiveFormat string, files []string) error {
+	if archiveFormat == BuildAcousticModelOptions_ArchiveFormat_Zip {
+		archive := zip.NewWriter(writer)
+		for _, file := range files {
+			err := copyArchivedFile(file, func(info os.FileInfo) (io.Writer, error) {
+				header, err := zip.FileInfoHeader(info)
+				if err != nil {
+					return nil, err
+				}
+				header.Method = zip.Deflate
+				return archive.CreateHeader(header)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return archive.Close()
+	}
+
+	compressor := gzip.NewWriter(writer)
+	archive := tar.NewWriter(compressor)
+	for _, file := range files {
+		err := copyArchivedFile(file, func(info os.FileInfo) (io.Writer, error) {
+			header, err := tar.FileInfoHeader(info, "")
+			if err != nil {
+				return nil, err
+			}
+			return archive, archive.WriteHeader(header)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	return compressor.Close()
+}
+
+// copyArchivedFile copies a file to the entry of an archive created for it
+func copyArchivedFile(file string, createEntry func(info os.FileInfo) (io.Writer, error)) error {
+	reader, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	info, err := reader.Stat()
+	if err != nil {
+		return err
+	}
+	entry, err := createEntry(info)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, reader)
+	return err
+}
@@ -0,0 +1,283 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// acousticServer plays the service side of the customization of an acoustic model. The audio and the model take
+// two checks to change status.
+type acousticServer struct {
+	*httptest.Server
+	lock                  sync.Mutex
+	audioName             string
+	contentType           string
+	containedContentType  string
+	archived              map[string]string
+	audioViews            int
+	invalidAudio          bool
+	modelViews            int
+	trained               bool
+	customLanguageModelID string
+
+	// If set, the training fails with these warnings, or without warnings if it is empty.
+	failTrain *string
+
+	// If true, the first check of the model omits the status.
+	omitStatus bool
+}
+
+func newAcousticServer() *acousticServer {
+	server := &acousticServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	return server
+}
+
+func (server *acousticServer) serve(res http.ResponseWriter, req *http.Request) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	res.Header().Set("Content-type", "application/json")
+	path := strings.TrimPrefix(req.URL.Path, "/v1/acoustic_customizations")
+
+	switch {
+	case req.Method == "POST" && path == "":
+		res.WriteHeader(201)
+		fmt.Fprint(res, `{"customization_id": "cust"}`)
+	case req.Method == "POST" && strings.HasPrefix(path, "/cust/audio/"):
+		server.audioName = strings.TrimPrefix(path, "/cust/audio/")
+		server.contentType = req.Header.Get("Content-Type")
+		server.containedContentType = req.Header.Get("Contained-Content-Type")
+		body, _ := ioutil.ReadAll(req.Body)
+		server.archived = readArchive(server.contentType, body)
+		res.WriteHeader(201)
+		fmt.Fprint(res, `{}`)
+	case req.Method == "GET" && strings.HasPrefix(path, "/cust/audio/"):
+		server.audioViews++
+		status := "being_processed"
+		if server.audioViews > 1 {
+			status = "ok"
+			if server.invalidAudio {
+				status = "invalid"
+			}
+		}
+		fmt.Fprintf(res, `{"container": {"name": "%s", "duration": 10, "details": {}, "status": "%s"},
+			"audio": [{"name": "a.wav", "duration": 5, "details": {}, "status": "%s"}]}`, server.audioName, status, status)
+	case req.Method == "GET" && path == "/cust":
+		server.modelViews++
+		status := "pending"
+		if server.trained {
+			status = "training"
+			if server.modelViews > 1 {
+				status = "available"
+			}
+		} else if server.modelViews > 1 {
+			status = "ready"
+		}
+		switch {
+		case server.omitStatus && server.modelViews == 1:
+			fmt.Fprint(res, `{"customization_id": "cust"}`)
+		case status == "available" && server.failTrain != nil && *server.failTrain != "":
+			fmt.Fprintf(res, `{"customization_id": "cust", "status": "failed", "warnings": "%s"}`, *server.failTrain)
+		case status == "available" && server.failTrain != nil:
+			fmt.Fprint(res, `{"customization_id": "cust", "status": "failed"}`)
+		default:
+			fmt.Fprintf(res, `{"customization_id": "cust", "status": "%s"}`, status)
+		}
+	case req.Method == "POST" && path == "/cust/train":
+		server.trained = true
+		server.modelViews = 0
+		server.customLanguageModelID = req.URL.Query().Get("custom_language_model_id")
+		fmt.Fprint(res, `{"warnings": [{"code": "invalid_audio_files", "message": "some files are invalid"}]}`)
+	default:
+		Fail(fmt.Sprintf("unexpected request %s %s", req.Method, req.URL.Path))
+	}
+}
+
+// readArchive returns the content of the files of an archive, by name
+func readArchive(contentType string, body []byte) map[string]string {
+	files := map[string]string{}
+	if contentType == "application/zip" {
+		archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		Expect(err).To(BeNil())
+		for _, file := range archive.File {
+			reader, err := file.Open()
+			Expect(err).To(BeNil())
+			content, _ := ioutil.ReadAll(reader)
+			files[file.Name] = string(content)
+		}
+		return files
+	}
+
+	decompressor, err := gzip.NewReader(bytes.NewReader(body))
+	Expect(err).To(BeNil())
+	archive := tar.NewReader(decompressor)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return files
+		}
+		Expect(err).To(BeNil())
+		content, _ := ioutil.ReadAll(archive)
+		files[header.Name] = string(content)
+	}
+}
+
+var _ = Describe(`BuildAcousticModel(ctx context.Context, buildAcousticModelOptions *BuildAcousticModelOptions)`, func() {
+	var directory string
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "audio")
+		Expect(err).To(BeNil())
+		Expect(ioutil.WriteFile(filepath.Join(directory, "a.wav"), []byte("first audio"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(directory, "b.WAV"), []byte("second audio"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(directory, "transcripts.txt"), []byte("not audio"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(directory, ".DS_Store"), []byte("hidden"), 0600)).To(Succeed())
+	})
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	buildOptions := func(testService *speechtotextv1.SpeechToTextV1) *speechtotextv1.BuildAcousticModelOptions {
+		return testService.NewBuildAcousticModelOptions(directory).
+			SetCreateAcousticModelOptions(testService.NewCreateAcousticModelOptions("model", "en-US_BroadbandModel")).
+			SetPollingInterval(time.Millisecond)
+	}
+
+	It(`Uploads a zip archive of the audio files and trains the model`, func() {
+		testServer := newAcousticServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		options := buildOptions(testService).SetAudioName("calls").SetCustomLanguageModelID("lm")
+		build, err := testService.BuildAcousticModel(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(build.CustomizationID).To(Equal("cust"))
+		Expect(*build.AcousticModel.Status).To(Equal(speechtotextv1.AcousticModel_Status_Available))
+		Expect(*build.Audio.Container.Status).To(Equal(speechtotextv1.AudioResource_Status_Ok))
+		Expect(build.TrainingWarnings).To(HaveLen(1))
+		Expect(testServer.audioName).To(Equal("calls"))
+		Expect(testServer.contentType).To(Equal("application/zip"))
+		Expect(testServer.containedContentType).To(BeEmpty())
+		Expect(testServer.archived).To(Equal(map[string]string{"a.wav": "first audio", "b.WAV": "second audio"}))
+		Expect(testServer.customLanguageModelID).To(Equal("lm"))
+	})
+	It(`Uploads a tar.gz archive of raw audio files`, func() {
+		testServer := newAcousticServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		options := buildOptions(testService).
+			SetArchiveFormat(speechtotextv1.BuildAcousticModelOptions_ArchiveFormat_TarGz).
+			SetContainedContentType("audio/l16; rate=16000")
+		build, err := testService.BuildAcousticModel(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(*build.AcousticModel.Status).To(Equal(speechtotextv1.AcousticModel_Status_Available))
+		Expect(testServer.audioName).To(Equal(filepath.Base(directory)))
+		Expect(testServer.contentType).To(Equal("application/gzip"))
+		Expect(testServer.containedContentType).To(Equal("audio/l16; rate=16000"))
+		Expect(testServer.archived).To(HaveLen(3))
+		Expect(testServer.archived).To(HaveKeyWithValue("transcripts.txt", "not audio"))
+		Expect(testServer.customLanguageModelID).To(BeEmpty())
+	})
+	It(`Reports invalid audio`, func() {
+		testServer := newAcousticServer()
+		testServer.invalidAudio = true
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		build, err := testService.BuildAcousticModel(context.Background(), buildOptions(testService))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("a.wav"))
+		Expect(build.CustomizationID).To(Equal("cust"))
+		Expect(testServer.trained).To(BeFalse())
+	})
+	It(`Reports a failed training with its warnings, if any`, func() {
+		for _, warnings := range []string{"", "some files are too short"} {
+			testServer := newAcousticServer()
+			testServer.failTrain = core.StringPtr(warnings)
+
+			testService := newWebsocketTestService(testServer.URL)
+			build, err := testService.BuildAcousticModel(context.Background(), buildOptions(testService))
+			testServer.Close()
+			Expect(err).ToNot(BeNil())
+			Expect(*build.AcousticModel.Status).To(Equal(speechtotextv1.AcousticModel_Status_Failed))
+			if warnings == "" {
+				Expect(err.Error()).To(Equal("The custom acoustic model cust failed"))
+			} else {
+				Expect(err.Error()).To(HaveSuffix("failed, with the warnings: " + warnings))
+			}
+		}
+	})
+	It(`Keeps waiting when a check omits the status`, func() {
+		testServer := newAcousticServer()
+		testServer.omitStatus = true
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		build, err := testService.BuildAcousticModel(context.Background(), buildOptions(testService))
+		Expect(err).To(BeNil())
+		Expect(*build.AcousticModel.Status).To(Equal(speechtotextv1.AcousticModel_Status_Available))
+	})
+	It(`Returns the error of the context when its deadline expires`, func() {
+		testServer := newAcousticServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := testService.BuildAcousticModel(ctx, buildOptions(testService).SetPollingInterval(time.Second))
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+	It(`Returns an error for invalid options before creating a model`, func() {
+		testService := newWebsocketTestService("http://localhost:0")
+		_, err := testService.BuildAcousticModel(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = testService.BuildAcousticModel(context.Background(), testService.NewBuildAcousticModelOptions(directory))
+		Expect(err).ToNot(BeNil())
+
+		_, err = testService.BuildAcousticModel(context.Background(), buildOptions(testService).SetArchiveFormat("rar"))
+		Expect(err).ToNot(BeNil())
+
+		empty, err := ioutil.TempDir("", "empty")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(empty)
+		_, err = testService.BuildAcousticModel(context.Background(), buildOptions(testService).SetAudioDirectory(empty))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,173 @@
+package speechtotextv1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// AUDIO_SNIFF_SIZE is the number of bytes read from the beginning of audio to detect its format
+const AUDIO_SNIFF_SIZE = WAV_HEADER_SIZE
+
+// ErrUnknownAudioFormat : The error returned when the format of audio cannot be detected from its first bytes
+var ErrUnknownAudioFormat = errors.New("The format of the audio could not be detected")
+
+// SniffContentType : Detects the content type of audio from its first bytes. WAV, FLAC, Ogg (Opus or Vorbis), MP3
+// and WebM audio are recognized from their headers, as is raw audio in the Sun .au format, which is described as
+// audio/l16, audio/mulaw or audio/alaw with its rate and channels.
+//
+// The returned reader produces the audio from its beginning, including the bytes that were read to detect the
+// format, except for the header of .au files, which the service does not accept. Closing it closes the audio. It is
+// returned even when the format is unknown, with ErrUnknownAudioFormat, so that the audio can still be sent.
+func SniffContentType(audio io.ReadCloser) (contentType string, sniffed io.ReadCloser, err error) {
+	header := make([]byte, AUDIO_SNIFF_SIZE)
+	n, err := io.ReadFull(audio, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	header = header[:n]
+
+	contentType, headerSize, ok := detectContentType(header)
+	if !ok {
+		headerSize = 0
+		err = ErrUnknownAudioFormat
+	} else {
+		err = nil
+	}
+	sniffed = &sniffedAudio{Reader: io.MultiReader(bytes.NewReader(header[headerSize:]), audio), audio: audio}
+	return contentType, sniffed, err
+}
+
+// sniffedAudio replays the bytes read to detect the format of audio before the rest of it
+type sniffedAudio struct {
+	io.Reader
+	audio io.ReadCloser
+}
+
+func (audio *sniffedAudio) Close() error {
+	return audio.audio.Close()
+}
+
+// withDetectedContentType returns the options unchanged if they have a content type, or a copy of them with the
+// content type detected from the audio. The content type is left unset when the format is unknown, for the service
+// to detect it.
+func (recognizeOptions *RecognizeOptions) withDetectedContentType() (*RecognizeOptions, error) {
+	if recognizeOptions.ContentType != nil || recognizeOptions.Audio == nil {
+		return recognizeOptions, nil
+	}
+	contentType, audio, err := SniffContentType(recognizeOptions.Audio)
+	if err != nil && err != ErrUnknownAudioFormat {
+		return nil, err
+	}
+
+	detected := *recognizeOptions
+	detected.Audio = audio
+	if err == nil {
+		detected.ContentType = &contentType
+	}
+	return &detected, nil
+}
+
+// withDetectedContentType returns the options unchanged if they have a content type, or a copy of them with the
+// content type detected from the audio
+func (recognizeWSOptions *RecognizeUsingWebsocketOptions) withDetectedContentType() (*RecognizeUsingWebsocketOptions, error) {
+	if recognizeWSOptions.ContentType != nil || recognizeWSOptions.Audio == nil {
+		return recognizeWSOptions, nil
+	}
+	recognizeOptions, err := recognizeWSOptions.RecognizeOptions.withDetectedContentType()
+	if err != nil {
+		return nil, err
+	}
+
+	detected := *recognizeWSOptions
+	detected.RecognizeOptions = *recognizeOptions
+	return &detected, nil
+}
+
+// The encodings of .au files that the service accepts
+const (
+	auEncodingMulaw = 1
+	auEncodingL16   = 3
+	auEncodingAlaw  = 27
+)
+
+// detectContentType returns the content type of audio from its first bytes, and the size of the header to remove
+// before sending it
+func detectContentType(header []byte) (contentType string, headerSize int, ok bool) {
+	switch {
+	case len(header) >= 12 && bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WAVE")):
+		return "audio/wav", 0, true
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return "audio/flac", 0, true
+	case bytes.HasPrefix(header, []byte("OggS")):
+		return "audio/ogg" + codecsOf(header, "OpusHead", "\x01vorbis"), 0, true
+	case bytes.HasPrefix(header, []byte("\x1a\x45\xdf\xa3")):
+		// An EBML document, of which WebM is a profile
+		if !bytes.Contains(header, []byte("webm")) {
+			return "", 0, false
+		}
+		return "audio/webm" + codecsOf(header, "A_OPUS", "A_VORBIS"), 0, true
+	case bytes.HasPrefix(header, []byte("ID3")):
+		return detectTaggedContentType(header)
+	case isMP3Frame(header):
+		return "audio/mp3", 0, true
+	case bytes.HasPrefix(header, []byte(".snd")):
+		return detectAuContentType(header)
+	}
+	return "", 0, false
+}
+
+// codecsOf returns the codecs parameter of a container, from the marker of the Opus or Vorbis codec in its header
+func codecsOf(header []byte, opusMarker string, vorbisMarker string) string {
+	switch {
+	case bytes.Contains(header, []byte(opusMarker)):
+		return ";codecs=opus"
+	case bytes.Contains(header, []byte(vorbisMarker)):
+		return ";codecs=vorbis"
+	}
+	return ""
+}
+
+// isMP3Frame reports whether the audio starts with the header of an MPEG Layer III frame
+func isMP3Frame(header []byte) bool {
+	return len(header) >= 2 && header[0] == 0xff && header[1]&0xe0 == 0xe0 && (header[1]>>1)&0x03 == 0x01
+}
+
+// detectTaggedContentType detects the format of audio that starts with an ID3v2 tag. The tag usually precedes MP3
+// audio, sometimes FLAC audio.
+func detectTaggedContentType(header []byte) (string, int, bool) {
+	if len(header) >= 10 {
+		// The size of the tag is a 28-bit integer stored in 7 bits per byte
+		size := 10 + (int(header[6]&0x7f)<<21 | int(header[7]&0x7f)<<14 | int(header[8]&0x7f)<<7 | int(header[9]&0x7f))
+		if size < len(header) && bytes.HasPrefix(header[size:], []byte("fLaC")) {
+			return "audio/flac", 0, true
+		}
+	}
+	return "audio/mp3", 0, true
+}
+
+// detectAuContentType describes the raw audio of a Sun .au file from its header, which is removed
+func detectAuContentType(header []byte) (string, int, bool) {
+	if len(header) < 24 {
+		return "", 0, false
+	}
+	dataOffset := int(binary.BigEndian.Uint32(header[4:8]))
+	encoding := binary.BigEndian.Uint32(header[12:16])
+	rate := binary.BigEndian.Uint32(header[16:20])
+	channels := binary.BigEndian.Uint32(header[20:24])
+	if dataOffset < 24 || dataOffset > len(header) || rate == 0 || channels == 0 {
+		return "", 0, false
+	}
+
+	switch {
+	case encoding == auEncodingL16:
+		return fmt.Sprintf("audio/l16;rate=%d;channels=%d;endianness=big-endian", rate, channels), dataOffset, true
+	case encoding == auEncodingMulaw && channels == 1:
+		return fmt.Sprintf("audio/mulaw;rate=%d", rate), dataOffset, true
+	case encoding == auEncodingAlaw && channels == 1:
+		return fmt.Sprintf("audio/alaw;rate=%d", rate), dataOffset, true
+	}
+	return "", 0, false
+}
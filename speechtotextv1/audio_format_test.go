@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// auHeader returns the header of a Sun .au file
+func auHeader(encoding uint32, rate uint32, channels uint32) []byte {
+	header := []byte(".snd")
+	for _, value := range []uint32{28, 0xffffffff, encoding, rate, channels, 0} {
+		header = append(header, make([]byte, 4)...)
+		binary.BigEndian.PutUint32(header[len(header)-4:], value)
+	}
+	return header
+}
+
+// closeRecorder records whether the audio was closed
+type closeRecorder struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (audio *closeRecorder) Close() error {
+	audio.closed = true
+	return nil
+}
+
+var _ = Describe(`SniffContentType(audio io.ReadCloser)`, func() {
+	id3Tag := []byte("ID3\x04\x00\x00\x00\x00\x00\x05tags.")
+	largeAudio := bytes.Repeat([]byte{0}, 3*speechtotextv1.AUDIO_SNIFF_SIZE)
+
+	detectedFormats := []struct {
+		name        string
+		audio       []byte
+		contentType string
+	}{
+		{`WAV`, []byte("RIFF\x24\x00\x00\x00WAVEfmt "), "audio/wav"},
+		{`FLAC`, []byte("fLaC\x00\x00\x00\x22"), "audio/flac"},
+		{`Ogg Opus`, []byte("OggS\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x13OpusHead"), "audio/ogg;codecs=opus"},
+		{`Ogg Vorbis`, []byte("OggS\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x1e\x01vorbis"), "audio/ogg;codecs=vorbis"},
+		{`Ogg with another codec`, []byte("OggS\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x13\x7fFLAC"), "audio/ogg"},
+		{`WebM Opus`, []byte("\x1a\x45\xdf\xa3\x9f\x42\x82\x84webm\x42\x87\x81\x04\x86\x86A_OPUS"), "audio/webm;codecs=opus"},
+		{`WebM Vorbis`, []byte("\x1a\x45\xdf\xa3\x9f\x42\x82\x84webm\x42\x87\x81\x04\x86\x88A_VORBIS"), "audio/webm;codecs=vorbis"},
+		{`MP3 frame`, []byte("\xff\xfb\x90\x64\x00\x00"), "audio/mp3"},
+		{`MP3 with an ID3 tag`, append(append([]byte{}, id3Tag...), "\xff\xfb\x90\x64"...), "audio/mp3"},
+		{`FLAC with an ID3 tag`, append(append([]byte{}, id3Tag...), "fLaC\x00\x00\x00\x22"...), "audio/flac"},
+		{`Large WAV`, append([]byte("RIFF\x24\x00\x00\x00WAVEfmt "), largeAudio...), "audio/wav"},
+	}
+	for _, format := range detectedFormats {
+		audio, expectedContentType := format.audio, format.contentType
+		It(`Detects and replays `+format.name+` audio`, func() {
+			original := &closeRecorder{Reader: bytes.NewReader(audio)}
+			contentType, sniffed, err := speechtotextv1.SniffContentType(original)
+			Expect(err).To(BeNil())
+			Expect(contentType).To(Equal(expectedContentType))
+
+			replayed, err := ioutil.ReadAll(sniffed)
+			Expect(err).To(BeNil())
+			Expect(replayed).To(Equal(audio))
+			Expect(sniffed.Close()).To(Succeed())
+			Expect(original.closed).To(BeTrue())
+		})
+	}
+
+	auFormats := []struct {
+		name        string
+		header      []byte
+		contentType string
+	}{
+		{`linear PCM`, auHeader(3, 16000, 2), "audio/l16;rate=16000;channels=2;endianness=big-endian"},
+		{`mu-law`, auHeader(1, 8000, 1), "audio/mulaw;rate=8000"},
+		{`A-law`, auHeader(27, 8000, 1), "audio/alaw;rate=8000"},
+	}
+	for _, format := range auFormats {
+		header, expectedContentType := format.header, format.contentType
+		It(`Describes raw `+format.name+` .au audio and removes the header`, func() {
+			audio := append(append([]byte{}, header...), "samples"...)
+			contentType, sniffed, err := speechtotextv1.SniffContentType(ioutil.NopCloser(bytes.NewReader(audio)))
+			Expect(err).To(BeNil())
+			Expect(contentType).To(Equal(expectedContentType))
+
+			replayed, err := ioutil.ReadAll(sniffed)
+			Expect(err).To(BeNil())
+			Expect(string(replayed)).To(Equal("samples"))
+		})
+	}
+
+	unknownFormats := map[string][]byte{
+		`raw samples`:          []byte("\x00\x01\x00\x02\x00\x03"),
+		`empty audio`:          {},
+		`Matroska video`:       []byte("\x1a\x45\xdf\xa3\x9f\x42\x82\x88matroska"),
+		`floating point .au`:   append(auHeader(6, 8000, 1), "samples"...),
+		`truncated .au header`: []byte(".snd\x00\x00\x00\x1c"),
+	}
+	for name, audio := range unknownFormats {
+		audio := audio
+		It(`Returns ErrUnknownAudioFormat and the whole audio for `+name, func() {
+			contentType, sniffed, err := speechtotextv1.SniffContentType(ioutil.NopCloser(bytes.NewReader(audio)))
+			Expect(err).To(Equal(speechtotextv1.ErrUnknownAudioFormat))
+			Expect(contentType).To(BeEmpty())
+
+			replayed, err := ioutil.ReadAll(sniffed)
+			Expect(err).To(BeNil())
+			Expect(replayed).To(Equal(audio))
+		})
+	}
+})
+
+var _ = Describe(`Automatic content type`, func() {
+	flacAudio := "fLaC\x00\x00\x00\x22 some audio"
+
+	It(`Is detected by Recognize when it is omitted`, func() {
+		var contentType, body string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			contentType = req.Header.Get("Content-Type")
+			content, _ := ioutil.ReadAll(req.Body)
+			body = string(content)
+			res.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(res, `{"results": []}`)
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		options := testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader(flacAudio)))
+		_, _, err := testService.Recognize(options)
+		Expect(err).To(BeNil())
+		Expect(contentType).To(Equal("audio/flac"))
+		Expect(body).To(Equal(flacAudio))
+		Expect(options.ContentType).To(BeNil())
+
+		options = testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader(flacAudio))).SetContentType("audio/ogg")
+		_, _, err = testService.Recognize(options)
+		Expect(err).To(BeNil())
+		Expect(contentType).To(Equal("audio/ogg"))
+	})
+	It(`Is detected for websocket recognitions when it is empty`, func() {
+		recognition := recognitionServer(func(audio []byte) []string {
+			Expect(string(audio)).To(Equal(flacAudio))
+			return []string{`{"results": [{"final": true, "alternatives": [{"transcript": "some audio"}]}], "result_index": 0}`}
+		})
+		defer recognition.Close()
+		var contentType string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			contentType = req.Header.Get("Content-Type")
+			recognition.Config.Handler.ServeHTTP(res, req)
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		options := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(strings.NewReader(flacAudio)), "")
+		Expect(options.ContentType).To(BeNil())
+		callback := new(recordingCallback)
+		Expect(testService.RecognizeUsingWebsocket(options, callback)).To(Succeed())
+		Expect(callback.errors).To(BeEmpty())
+		Expect(contentType).To(Equal("audio/flac"))
+
+		options = testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(strings.NewReader(flacAudio)), "")
+		events, err := testService.RecognizeUsingWebsocketStream(context.Background(), options)
+		Expect(err).To(BeNil())
+		for range events {
+		}
+		Expect(contentType).To(Equal("audio/flac"))
+	})
+	It(`Lets reconnecting recognitions use raw .au audio`, func() {
+		header := auHeader(3, 16000, 1)
+		recognition := recognitionServer(func(audio []byte) []string {
+			Expect(string(audio)).To(Equal("some audio"))
+			return []string{`{"results": [{"final": true, "alternatives": [{"transcript": "some audio", "timestamps": [["some", 0, 0.1]]}]}], "result_index": 0}`}
+		})
+		defer recognition.Close()
+
+		testService := newWebsocketTestService(recognition.URL)
+		audio := ioutil.NopCloser(bytes.NewReader(append(header, "some audio"...)))
+		options := testService.NewRecognizeUsingWebsocketOptions(audio, "")
+		callback := new(eventCallback)
+		err := testService.RecognizeUsingWebsocketWithReconnect(context.Background(), options, callback, nil)
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(BeEmpty())
+		Expect(core.StringNilMapper(options.ContentType)).To(BeEmpty())
+	})
+})
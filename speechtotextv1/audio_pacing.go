@@ -0,0 +1,259 @@
+package speechtotextv1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// Constants associated with the RecognizeUsingWebsocketOptions.Pacing property.
+// How fast the audio is sent over the websocket connection.
+const (
+	// Sends a chunk every 10 milliseconds, the historical behavior
+	RecognizeUsingWebsocketOptions_Pacing_FixedDelay = "fixed_delay"
+
+	// Sends the chunks without waiting, for the batch transcription of files
+	RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible = "as_fast_as_possible"
+
+	// Sends the audio at the rate at which it plays, derived from the content type, as a live source would
+	RecognizeUsingWebsocketOptions_Pacing_RealTime = "real_time"
+
+	// Sends the audio at the rate given by PacingBytesPerSecond
+	RecognizeUsingWebsocketOptions_Pacing_CustomRate = "custom_rate"
+)
+
+// DEFAULT_CHUNK_SIZE is the number of bytes of audio sent in each websocket message, unless ChunkSize is set
+const DEFAULT_CHUNK_SIZE = ONE_KB * 2
+
+// WAV_HEADER_SIZE is the number of bytes of a WAV file read to find the layout of the audio
+const WAV_HEADER_SIZE = 4 * ONE_KB
+
+// SetChunkSize : Allow user to set ChunkSize
+func (recognizeWSOptions *RecognizeUsingWebsocketOptions) SetChunkSize(chunkSize int) *RecognizeUsingWebsocketOptions {
+	recognizeWSOptions.ChunkSize = &chunkSize
+	return recognizeWSOptions
+}
+
+// SetPacing : Allow user to set Pacing
+func (recognizeWSOptions *RecognizeUsingWebsocketOptions) SetPacing(pacing string) *RecognizeUsingWebsocketOptions {
+	recognizeWSOptions.Pacing = &pacing
+	return recognizeWSOptions
+}
+
+// SetPacingBytesPerSecond : Allow user to set PacingBytesPerSecond, and the custom_rate pacing
+func (recognizeWSOptions *RecognizeUsingWebsocketOptions) SetPacingBytesPerSecond(bytesPerSecond float64) *RecognizeUsingWebsocketOptions {
+	recognizeWSOptions.Pacing = core.StringPtr(RecognizeUsingWebsocketOptions_Pacing_CustomRate)
+	recognizeWSOptions.PacingBytesPerSecond = &bytesPerSecond
+	return recognizeWSOptions
+}
+
+// validatePacing checks the chunk size and pacing of the options, before the connection is opened
+func (recognizeWSOptions *RecognizeUsingWebsocketOptions) validatePacing() error {
+	if recognizeWSOptions.ChunkSize != nil && *recognizeWSOptions.ChunkSize <= 0 {
+		return fmt.Errorf("The chunk size must be positive")
+	}
+	if recognizeWSOptions.Pacing == nil {
+		return nil
+	}
+
+	switch *recognizeWSOptions.Pacing {
+	case RecognizeUsingWebsocketOptions_Pacing_FixedDelay, RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible:
+		return nil
+	case RecognizeUsingWebsocketOptions_Pacing_RealTime:
+		if recognizeWSOptions.ContentType == nil {
+			return fmt.Errorf("The real_time pacing requires a content type")
+		}
+		mediaType, _, err := mime.ParseMediaType(*recognizeWSOptions.ContentType)
+		if err != nil {
+			return err
+		}
+		if mediaType == "audio/wav" {
+			// The byte rate is read from the header of the audio once sending starts
+			return nil
+		}
+		_, err = describeAudio(*recognizeWSOptions.ContentType, nil)
+		return err
+	case RecognizeUsingWebsocketOptions_Pacing_CustomRate:
+		if recognizeWSOptions.PacingBytesPerSecond == nil || *recognizeWSOptions.PacingBytesPerSecond <= 0 {
+			return fmt.Errorf("The custom_rate pacing requires a positive PacingBytesPerSecond")
+		}
+		return nil
+	default:
+		return fmt.Errorf("Unknown pacing '%s'", *recognizeWSOptions.Pacing)
+	}
+}
+
+// audioFormat describes the layout of uncompressed audio
+type audioFormat struct {
+	// The number of bytes per second of audio.
+	bytesPerSecond float64
+
+	// The number of bytes of a sample of every channel. Positions in the audio are multiples of it.
+	frameSize int
+
+	// The number of bytes before the samples, such as a WAV header.
+	headerSize int
+
+	// The number of channels of the audio.
+	channels int
+
+	// The number of bytes of a sample of one channel.
+	sampleSize int
+
+	// If true, the samples are linear PCM, rather than companded or compressed.
+	linearPCM bool
+
+	// If true, the bytes of a linear PCM sample are in big-endian order.
+	bigEndian bool
+}
+
+// sampleRate returns the number of samples per second of each channel
+func (format audioFormat) sampleRate() int {
+	return int(format.bytesPerSecond) / format.frameSize
+}
+
+// l16ContentType returns the content type of 16-bit linear PCM audio with the rate and byte order of the format
+func (format audioFormat) l16ContentType(channels int) string {
+	endianness := "little-endian"
+	if format.bigEndian {
+		endianness = "big-endian"
+	}
+	return fmt.Sprintf("audio/l16;rate=%d;channels=%d;endianness=%s", format.sampleRate(), channels, endianness)
+}
+
+// describeAudio returns the layout of uncompressed audio of the given content type. The header is the beginning of
+// the audio, which is only needed for WAV files.
+func describeAudio(contentType string, header []byte) (audioFormat, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return audioFormat{}, err
+	}
+
+	channels := 1
+	if value, ok := params["channels"]; ok {
+		if channels, err = strconv.Atoi(value); err != nil || channels <= 0 {
+			return audioFormat{}, fmt.Errorf("Invalid number of channels '%s'", value)
+		}
+	}
+	withRate := func(sampleSize int) (audioFormat, error) {
+		value, ok := params["rate"]
+		if !ok {
+			return audioFormat{}, fmt.Errorf("The rate of %s audio is required", mediaType)
+		}
+		sampleRate, err := strconv.Atoi(value)
+		if err != nil || sampleRate <= 0 {
+			return audioFormat{}, fmt.Errorf("Invalid rate '%s'", value)
+		}
+		frameSize := sampleSize * channels
+		return audioFormat{bytesPerSecond: float64(sampleRate * frameSize), frameSize: frameSize, channels: channels,
+			sampleSize: sampleSize}, nil
+	}
+
+	switch mediaType {
+	case "audio/l16":
+		format, err := withRate(2)
+		format.linearPCM = true
+		format.bigEndian = params["endianness"] == "big-endian"
+		return format, err
+	case "audio/mulaw", "audio/alaw":
+		return withRate(1)
+	case "audio/basic":
+		return audioFormat{bytesPerSecond: 8000, frameSize: 1, channels: 1, sampleSize: 1}, nil
+	case "audio/wav":
+		return parseWavHeader(header)
+	default:
+		return audioFormat{}, fmt.Errorf("The byte rate of %s audio is unknown, use the custom_rate pacing", strings.TrimPrefix(mediaType, "audio/"))
+	}
+}
+
+// parseWavHeader reads the layout of a WAV file from its first bytes, which must include the header
+func parseWavHeader(header []byte) (audioFormat, error) {
+	if len(header) < 12 || !bytes.Equal(header[0:4], []byte("RIFF")) || !bytes.Equal(header[8:12], []byte("WAVE")) {
+		return audioFormat{}, fmt.Errorf("The audio does not start with a WAV header")
+	}
+
+	format := audioFormat{}
+	for offset := 12; offset+8 <= len(header); {
+		chunkID := string(header[offset : offset+4])
+		chunkSize := int(binary.LittleEndian.Uint32(header[offset+4 : offset+8]))
+		switch {
+		case chunkID == "fmt " && offset+8+16 <= len(header):
+			// PCM, or WAVE_FORMAT_EXTENSIBLE, which describes PCM in most files
+			formatTag := binary.LittleEndian.Uint16(header[offset+8 : offset+10])
+			format.linearPCM = formatTag == 1 || formatTag == 0xfffe
+			format.channels = int(binary.LittleEndian.Uint16(header[offset+10 : offset+12]))
+			format.bytesPerSecond = float64(binary.LittleEndian.Uint32(header[offset+16 : offset+20]))
+			format.frameSize = int(binary.LittleEndian.Uint16(header[offset+20 : offset+22]))
+			format.sampleSize = int(binary.LittleEndian.Uint16(header[offset+22:offset+24])+7) / 8
+		case chunkID == "data":
+			if format.bytesPerSecond == 0 || format.frameSize == 0 {
+				return audioFormat{}, fmt.Errorf("The WAV header has no byte rate")
+			}
+			format.headerSize = offset + 8
+			return format, nil
+		}
+		// Chunks are padded to an even size
+		offset += 8 + chunkSize + chunkSize%2
+	}
+	return audioFormat{}, fmt.Errorf("The WAV header is incomplete")
+}
+
+// audioPacer spaces the chunks of audio sent over a websocket connection
+type audioPacer struct {
+	chunkSize      int
+	pacing         string
+	bytesPerSecond float64
+
+	start time.Time
+	sent  int64
+}
+
+func newAudioPacer(recognizeWSOptions *RecognizeUsingWebsocketOptions) *audioPacer {
+	pacer := &audioPacer{chunkSize: DEFAULT_CHUNK_SIZE, pacing: RecognizeUsingWebsocketOptions_Pacing_FixedDelay}
+	if recognizeWSOptions.ChunkSize != nil {
+		pacer.chunkSize = *recognizeWSOptions.ChunkSize
+	}
+	if recognizeWSOptions.Pacing != nil {
+		pacer.pacing = *recognizeWSOptions.Pacing
+	}
+	if recognizeWSOptions.PacingBytesPerSecond != nil {
+		pacer.bytesPerSecond = *recognizeWSOptions.PacingBytesPerSecond
+	}
+	return pacer
+}
+
+// needsHeader reports whether the byte rate is read from the beginning of the audio
+func (pacer *audioPacer) needsHeader() bool {
+	return pacer.pacing == RecognizeUsingWebsocketOptions_Pacing_RealTime
+}
+
+// setContentType computes the byte rate of the real_time pacing
+func (pacer *audioPacer) setContentType(contentType string, header []byte) error {
+	format, err := describeAudio(contentType, header)
+	pacer.bytesPerSecond = format.bytesPerSecond
+	return err
+}
+
+// delay returns how long to wait after sending a chunk of n bytes
+func (pacer *audioPacer) delay(n int) time.Duration {
+	switch pacer.pacing {
+	case RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible:
+		return 0
+	case RecognizeUsingWebsocketOptions_Pacing_RealTime, RecognizeUsingWebsocketOptions_Pacing_CustomRate:
+		// The delay is computed from the start, so that the time spent writing does not accumulate
+		if pacer.start.IsZero() {
+			pacer.start = time.Now()
+		}
+		pacer.sent += int64(n)
+		due := pacer.start.Add(time.Duration(float64(pacer.sent) / pacer.bytesPerSecond * float64(time.Second)))
+		return time.Until(due)
+	default:
+		return TEN_MILLISECONDS
+	}
+}
@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// wavHeader builds the header of a WAV file of 16 bit mono audio
+func wavHeader(sampleRate uint32, dataSize uint32) []byte {
+	header := new(bytes.Buffer)
+	header.WriteString("RIFF")
+	_ = binary.Write(header, binary.LittleEndian, 36+dataSize)
+	header.WriteString("WAVEfmt ")
+	for _, value := range []interface{}{uint32(16), uint16(1), uint16(1), sampleRate, sampleRate * 2, uint16(2), uint16(16)} {
+		_ = binary.Write(header, binary.LittleEndian, value)
+	}
+	header.WriteString("data")
+	_ = binary.Write(header, binary.LittleEndian, dataSize)
+	return header.Bytes()
+}
+
+var _ = Describe(`RecognizeUsingWebsocketOptions pacing`, func() {
+	recognize := func(recognizeWSOptions *speechtotextv1.RecognizeUsingWebsocketOptions, testService *speechtotextv1.SpeechToTextV1) time.Duration {
+		callback := new(recordingCallback)
+		start := time.Now()
+		err := testService.RecognizeUsingWebsocket(recognizeWSOptions, callback)
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(BeEmpty())
+		return time.Since(start)
+	}
+
+	It(`Sends the audio in chunks of the configured size`, func() {
+		var chunkSizes []int
+		testServer := chunkedRecognitionServer(func(chunks [][]byte) []string {
+			for _, chunk := range chunks {
+				chunkSizes = append(chunkSizes, len(chunk))
+			}
+			return nil
+		})
+		defer testServer.Close()
+		testService := newWebsocketTestService(testServer.URL)
+
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(make([]byte, 64*1024+10))), "audio/l16; rate=16000").
+			SetChunkSize(1024).
+			SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible)
+		Expect(recognize(recognizeWSOptions, testService)).To(BeNumerically("<", 500*time.Millisecond))
+		Expect(chunkSizes).To(HaveLen(65))
+		Expect(chunkSizes[0]).To(Equal(1024))
+		Expect(chunkSizes[64]).To(Equal(10))
+	})
+	It(`Sends the audio in real time`, func() {
+		var lengths []int
+		testServer := recognitionServer(func(audio []byte) []string {
+			lengths = append(lengths, len(audio))
+			return nil
+		})
+		defer testServer.Close()
+		testService := newWebsocketTestService(testServer.URL)
+
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(make([]byte, 4000))), "audio/l16; rate=8000").
+			SetChunkSize(400).
+			SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_RealTime)
+		Expect(recognize(recognizeWSOptions, testService)).To(BeNumerically(">=", 200*time.Millisecond))
+
+		wav := append(wavHeader(10000, 4000), make([]byte, 4000)...)
+		recognizeWSOptions = testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(wav)), "audio/wav").
+			SetChunkSize(500).
+			SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_RealTime)
+		Expect(recognize(recognizeWSOptions, testService)).To(BeNumerically(">=", 150*time.Millisecond))
+		Expect(lengths).To(Equal([]int{4000, 4044}))
+	})
+	It(`Sends the audio at a custom rate`, func() {
+		testServer := recognitionServer(func(audio []byte) []string {
+			return nil
+		})
+		defer testServer.Close()
+		testService := newWebsocketTestService(testServer.URL)
+
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(make([]byte, 8000))), "audio/mp3").
+			SetChunkSize(1000).
+			SetPacingBytesPerSecond(40000)
+		Expect(recognize(recognizeWSOptions, testService)).To(BeNumerically(">=", 150*time.Millisecond))
+	})
+	It(`Rejects invalid pacing settings`, func() {
+		testService := newWebsocketTestService("http://localhost")
+		callback := new(recordingCallback)
+		audio := ioutil.NopCloser(bytes.NewReader(nil))
+
+		err := testService.RecognizeUsingWebsocket(testService.NewRecognizeUsingWebsocketOptions(audio, "audio/mp3").SetPacing("unknown"), callback)
+		Expect(err).ToNot(BeNil())
+
+		err = testService.RecognizeUsingWebsocket(testService.NewRecognizeUsingWebsocketOptions(audio, "audio/mp3").SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_RealTime), callback)
+		Expect(err).To(MatchError(ContainSubstring("custom_rate")))
+
+		err = testService.RecognizeUsingWebsocket(testService.NewRecognizeUsingWebsocketOptions(audio, "audio/l16").SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_RealTime), callback)
+		Expect(err).To(MatchError(ContainSubstring("rate")))
+
+		err = testService.RecognizeUsingWebsocket(testService.NewRecognizeUsingWebsocketOptions(audio, "audio/mp3").SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_CustomRate), callback)
+		Expect(err).ToNot(BeNil())
+
+		err = testService.RecognizeUsingWebsocket(testService.NewRecognizeUsingWebsocketOptions(audio, "audio/mp3").SetChunkSize(0), callback)
+		Expect(err).ToNot(BeNil())
+		Expect(callback.opened).To(BeFalse())
+	})
+})
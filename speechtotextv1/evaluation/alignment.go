@@ -0,0 +1,132 @@
+package evaluation
+
+// Constants associated with the AlignmentOperation.Type property.
+const (
+	AlignmentOperation_Type_Correct      = "correct"
+	AlignmentOperation_Type_Substitution = "substitution"
+	AlignmentOperation_Type_Deletion     = "deletion"
+	AlignmentOperation_Type_Insertion    = "insertion"
+)
+
+// AlignmentOperation : A step of the alignment of a hypothesis with its reference
+type AlignmentOperation struct {
+	// The type of the operation, one of the AlignmentOperation_Type_* constants.
+	Type string `json:"type"`
+
+	// The word of the reference, empty for an insertion.
+	Reference string `json:"reference,omitempty"`
+
+	// The word of the hypothesis, empty for a deletion.
+	Hypothesis string `json:"hypothesis,omitempty"`
+}
+
+// Score : The error counts of a hypothesis against its reference
+type Score struct {
+	// The number of words of the reference.
+	ReferenceWords int `json:"reference_words"`
+
+	// The number of words of the hypothesis.
+	HypothesisWords int `json:"hypothesis_words"`
+
+	// The number of words of the reference that the hypothesis matches.
+	Correct int `json:"correct"`
+
+	// The number of words of the reference that the hypothesis replaces with another word.
+	Substitutions int `json:"substitutions"`
+
+	// The number of words of the reference that the hypothesis misses.
+	Deletions int `json:"deletions"`
+
+	// The number of words of the hypothesis that are not in the reference.
+	Insertions int `json:"insertions"`
+}
+
+// Errors : The total number of substitutions, deletions and insertions
+func (score Score) Errors() int {
+	return score.Substitutions + score.Deletions + score.Insertions
+}
+
+// WordErrorRate : The number of errors divided by the number of words of the reference. It can be greater than 1 when
+// the hypothesis has many insertions. When the reference is empty, it is 0 for an empty hypothesis and 1 otherwise.
+func (score Score) WordErrorRate() float64 {
+	if score.ReferenceWords == 0 {
+		if score.Errors() == 0 {
+			return 0
+		}
+		return 1
+	}
+	return float64(score.Errors()) / float64(score.ReferenceWords)
+}
+
+// Add : Adds the counts of another score
+func (score *Score) Add(other Score) {
+	score.ReferenceWords += other.ReferenceWords
+	score.HypothesisWords += other.HypothesisWords
+	score.Correct += other.Correct
+	score.Substitutions += other.Substitutions
+	score.Deletions += other.Deletions
+	score.Insertions += other.Insertions
+}
+
+// Align : Aligns the words of a hypothesis with the words of its reference with the minimum number of errors, and
+// scores the hypothesis. Among the alignments with the fewest errors, substitutions are preferred over a deletion and
+// an insertion.
+func Align(reference []string, hypothesis []string) ([]AlignmentOperation, Score) {
+	// distances[i][j] is the number of errors of the best alignment of reference[:i] with hypothesis[:j]
+	distances := make([][]int, len(reference)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(hypothesis)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+	for i := 1; i <= len(reference); i++ {
+		for j := 1; j <= len(hypothesis); j++ {
+			distance := distances[i-1][j-1]
+			if reference[i-1] != hypothesis[j-1] {
+				distance++
+			}
+			if deletion := distances[i-1][j] + 1; deletion < distance {
+				distance = deletion
+			}
+			if insertion := distances[i][j-1] + 1; insertion < distance {
+				distance = insertion
+			}
+			distances[i][j] = distance
+		}
+	}
+
+	score := Score{ReferenceWords: len(reference), HypothesisWords: len(hypothesis)}
+	operations := make([]AlignmentOperation, 0, len(reference)+len(hypothesis))
+	i, j := len(reference), len(hypothesis)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && reference[i-1] == hypothesis[j-1] && distances[i][j] == distances[i-1][j-1]:
+			score.Correct++
+			operations = append(operations, AlignmentOperation{Type: AlignmentOperation_Type_Correct,
+				Reference: reference[i-1], Hypothesis: hypothesis[j-1]})
+			i, j = i-1, j-1
+		case i > 0 && j > 0 && distances[i][j] == distances[i-1][j-1]+1:
+			score.Substitutions++
+			operations = append(operations, AlignmentOperation{Type: AlignmentOperation_Type_Substitution,
+				Reference: reference[i-1], Hypothesis: hypothesis[j-1]})
+			i, j = i-1, j-1
+		case i > 0 && distances[i][j] == distances[i-1][j]+1:
+			score.Deletions++
+			operations = append(operations, AlignmentOperation{Type: AlignmentOperation_Type_Deletion,
+				Reference: reference[i-1]})
+			i--
+		default:
+			score.Insertions++
+			operations = append(operations, AlignmentOperation{Type: AlignmentOperation_Type_Insertion,
+				Hypothesis: hypothesis[j-1]})
+			j--
+		}
+	}
+
+	for left, right := 0, len(operations)-1; left < right; left, right = left+1, right-1 {
+		operations[left], operations[right] = operations[right], operations[left]
+	}
+	return operations, score
+}
@@ -0,0 +1,52 @@
+package evaluation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlign(t *testing.T) {
+	operations, score := Align(strings.Fields("the cat sat on the mat"), strings.Fields("the cat sat at the big mat"))
+	assert.Equal(t, Score{ReferenceWords: 6, HypothesisWords: 7, Correct: 5, Substitutions: 1, Insertions: 1}, score)
+	assert.Equal(t, []AlignmentOperation{
+		{Type: AlignmentOperation_Type_Correct, Reference: "the", Hypothesis: "the"},
+		{Type: AlignmentOperation_Type_Correct, Reference: "cat", Hypothesis: "cat"},
+		{Type: AlignmentOperation_Type_Correct, Reference: "sat", Hypothesis: "sat"},
+		{Type: AlignmentOperation_Type_Substitution, Reference: "on", Hypothesis: "at"},
+		{Type: AlignmentOperation_Type_Correct, Reference: "the", Hypothesis: "the"},
+		{Type: AlignmentOperation_Type_Insertion, Hypothesis: "big"},
+		{Type: AlignmentOperation_Type_Correct, Reference: "mat", Hypothesis: "mat"},
+	}, operations)
+	assert.Equal(t, 2, score.Errors())
+	assert.InDelta(t, 2.0/6, score.WordErrorRate(), 1e-9)
+
+	operations, score = Align(strings.Fields("a b c"), strings.Fields("b"))
+	assert.Equal(t, Score{ReferenceWords: 3, HypothesisWords: 1, Correct: 1, Deletions: 2}, score)
+	assert.Equal(t, AlignmentOperation_Type_Deletion, operations[0].Type)
+	assert.Equal(t, AlignmentOperation_Type_Correct, operations[1].Type)
+	assert.Equal(t, AlignmentOperation_Type_Deletion, operations[2].Type)
+}
+
+func TestAlignEmpty(t *testing.T) {
+	operations, score := Align(nil, nil)
+	assert.Empty(t, operations)
+	assert.Equal(t, 0.0, score.WordErrorRate())
+
+	_, score = Align(nil, []string{"noise"})
+	assert.Equal(t, 1, score.Insertions)
+	assert.Equal(t, 1.0, score.WordErrorRate())
+
+	_, score = Align([]string{"a", "b"}, nil)
+	assert.Equal(t, 2, score.Deletions)
+	assert.Equal(t, 1.0, score.WordErrorRate())
+}
+
+func TestScoreAdd(t *testing.T) {
+	total := Score{}
+	total.Add(Score{ReferenceWords: 4, HypothesisWords: 4, Correct: 3, Substitutions: 1})
+	total.Add(Score{ReferenceWords: 6, HypothesisWords: 5, Correct: 4, Deletions: 2, Insertions: 1})
+	assert.Equal(t, Score{ReferenceWords: 10, HypothesisWords: 9, Correct: 7, Substitutions: 1, Deletions: 2, Insertions: 1}, total)
+	assert.InDelta(t, 0.4, total.WordErrorRate(), 1e-9)
+}
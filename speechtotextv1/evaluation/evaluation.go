@@ -0,0 +1,331 @@
+// Package evaluation : Measures the accuracy of speech recognition against reference transcripts
+// The audio files of a manifest are transcribed with Recognize, and each transcript is normalized, aligned with its
+// reference and scored. Running an evaluation for each base model, customization weight or custom model of interest
+// gives reports that can be compared.
+package evaluation
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// ManifestEntry : An audio file and its reference transcript
+type ManifestEntry struct {
+	// The path of the audio file.
+	Audio string `json:"audio"`
+
+	// The content type of the audio file, or an empty string to let the service detect it.
+	ContentType string `json:"content_type,omitempty"`
+
+	// The reference transcript.
+	Reference string `json:"reference,omitempty"`
+
+	// The path of a file that contains the reference transcript, used when Reference is empty.
+	ReferenceFile string `json:"reference_file,omitempty"`
+}
+
+// ReadManifest : Reads a manifest in JSON, either as an array of entries or as one entry per line. The relative paths
+// of the entries are resolved against the directory of the manifest.
+func ReadManifest(path string) ([]ManifestEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []ManifestEntry
+	decoder := json.NewDecoder(file)
+	for {
+		var value json.RawMessage
+		if err = decoder.Decode(&value); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("Invalid manifest %s: %s", path, err.Error())
+		}
+
+		var decoded []ManifestEntry
+		if strings.HasPrefix(strings.TrimSpace(string(value)), "[") {
+			err = json.Unmarshal(value, &decoded)
+		} else {
+			decoded = make([]ManifestEntry, 1)
+			err = json.Unmarshal(value, &decoded[0])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Invalid manifest %s: %s", path, err.Error())
+		}
+		entries = append(entries, decoded...)
+	}
+
+	directory := filepath.Dir(path)
+	for i := range entries {
+		if entries[i].Audio == "" {
+			return nil, fmt.Errorf("Invalid manifest %s: entry %d has no audio", path, i+1)
+		}
+		entries[i].Audio = resolvePath(directory, entries[i].Audio)
+		if entries[i].ReferenceFile != "" {
+			entries[i].ReferenceFile = resolvePath(directory, entries[i].ReferenceFile)
+		}
+	}
+	return entries, nil
+}
+
+func resolvePath(directory string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(directory, path)
+}
+
+// EvaluateOptions : The options of an evaluation
+type EvaluateOptions struct {
+	// The name of the evaluated configuration, such as the model and customization, copied to the report.
+	Name string
+
+	// The audio files to transcribe and their reference transcripts.
+	Entries []ManifestEntry
+
+	// The options of the recognition of every audio file, which select the model, the custom models, the
+	// `CustomizationWeight` and `SmartFormatting`. The Audio and ContentType are set for each file.
+	RecognizeOptions *speechtotextv1.RecognizeOptions
+
+	// The normalization of the transcripts. All the normalizations are applied if it is nil.
+	NormalizeOptions *NormalizeOptions
+}
+
+// NewEvaluateOptions : Instantiate EvaluateOptions
+func NewEvaluateOptions(entries []ManifestEntry) *EvaluateOptions {
+	return &EvaluateOptions{
+		Entries: entries,
+	}
+}
+
+// SetName : Allow user to set Name
+func (options *EvaluateOptions) SetName(name string) *EvaluateOptions {
+	options.Name = name
+	return options
+}
+
+// SetEntries : Allow user to set Entries
+func (options *EvaluateOptions) SetEntries(entries []ManifestEntry) *EvaluateOptions {
+	options.Entries = entries
+	return options
+}
+
+// SetRecognizeOptions : Allow user to set RecognizeOptions
+func (options *EvaluateOptions) SetRecognizeOptions(recognizeOptions *speechtotextv1.RecognizeOptions) *EvaluateOptions {
+	options.RecognizeOptions = recognizeOptions
+	return options
+}
+
+// SetNormalizeOptions : Allow user to set NormalizeOptions
+func (options *EvaluateOptions) SetNormalizeOptions(normalizeOptions *NormalizeOptions) *EvaluateOptions {
+	options.NormalizeOptions = normalizeOptions
+	return options
+}
+
+// FileReport : The evaluation of an audio file
+type FileReport struct {
+	// The path of the audio file.
+	Audio string `json:"audio"`
+
+	// The normalized reference transcript.
+	Reference string `json:"reference"`
+
+	// The normalized transcript of the service.
+	Hypothesis string `json:"hypothesis"`
+
+	// The error counts of the transcript.
+	Score Score `json:"score"`
+
+	// The word error rate of the transcript.
+	WordErrorRate float64 `json:"word_error_rate"`
+
+	// The error that prevented the evaluation of the file, if any. Such a file is left out of the totals.
+	Error string `json:"error,omitempty"`
+}
+
+// Report : The evaluation of a configuration on all the audio files of a manifest
+type Report struct {
+	// The name of the evaluated configuration.
+	Name string `json:"name,omitempty"`
+
+	// The number of audio files that were evaluated.
+	EvaluatedFiles int `json:"evaluated_files"`
+
+	// The number of audio files that could not be evaluated.
+	FailedFiles int `json:"failed_files"`
+
+	// The sum of the error counts of the evaluated files.
+	Total Score `json:"total"`
+
+	// The word error rate over all the evaluated files.
+	WordErrorRate float64 `json:"word_error_rate"`
+
+	// The proportion of evaluated files whose transcript has at least one error.
+	SentenceErrorRate float64 `json:"sentence_error_rate"`
+
+	// The evaluation of each audio file, in the order of the manifest.
+	Files []*FileReport `json:"files"`
+}
+
+// Evaluate : Transcribes each audio file of the options and scores the transcripts against their references. A file
+// that cannot be read or transcribed is reported with its error and left out of the totals. The evaluation stops
+// with the report of the files evaluated so far when the context is done.
+func Evaluate(ctx context.Context, service speechtotextv1.SpeechToTextV1API, evaluateOptions *EvaluateOptions) (*Report, error) {
+	if evaluateOptions == nil {
+		return nil, errors.New("EvaluateOptions cannot be nil")
+	}
+	if len(evaluateOptions.Entries) == 0 {
+		return nil, errors.New("The evaluation has no entries")
+	}
+
+	report := &Report{Name: evaluateOptions.Name, Files: []*FileReport{}}
+	sentenceErrors := 0
+	for _, entry := range evaluateOptions.Entries {
+		file, err := evaluateFile(ctx, service, evaluateOptions, entry)
+		if err != nil {
+			if ctx.Err() != nil {
+				report.summarize(sentenceErrors)
+				return report, ctx.Err()
+			}
+			file.Error = err.Error()
+			report.FailedFiles++
+		} else {
+			report.EvaluatedFiles++
+			report.Total.Add(file.Score)
+			if file.Score.Errors() > 0 {
+				sentenceErrors++
+			}
+		}
+		report.Files = append(report.Files, file)
+	}
+	report.summarize(sentenceErrors)
+	return report, nil
+}
+
+// summarize computes the error rates of the report from its totals
+func (report *Report) summarize(sentenceErrors int) {
+	report.WordErrorRate = report.Total.WordErrorRate()
+	report.SentenceErrorRate = 0
+	if report.EvaluatedFiles > 0 {
+		report.SentenceErrorRate = float64(sentenceErrors) / float64(report.EvaluatedFiles)
+	}
+}
+
+// evaluateFile transcribes and scores an audio file
+func evaluateFile(ctx context.Context, service speechtotextv1.SpeechToTextV1API, evaluateOptions *EvaluateOptions, entry ManifestEntry) (*FileReport, error) {
+	file := &FileReport{Audio: entry.Audio}
+
+	reference := entry.Reference
+	if reference == "" && entry.ReferenceFile != "" {
+		content, err := ioutil.ReadFile(entry.ReferenceFile)
+		if err != nil {
+			return file, err
+		}
+		reference = string(content)
+	}
+
+	audio, err := os.Open(entry.Audio)
+	if err != nil {
+		return file, err
+	}
+	defer audio.Close()
+
+	recognizeOptions := &speechtotextv1.RecognizeOptions{}
+	if evaluateOptions.RecognizeOptions != nil {
+		template := *evaluateOptions.RecognizeOptions
+		recognizeOptions = &template
+	}
+	recognizeOptions.Audio = audio
+	if entry.ContentType != "" {
+		recognizeOptions.ContentType = &entry.ContentType
+	}
+	results, _, err := service.RecognizeWithContext(ctx, recognizeOptions)
+	if err != nil {
+		return file, err
+	}
+
+	referenceWords := Normalize(reference, evaluateOptions.NormalizeOptions)
+	hypothesisWords := Normalize(transcriptOf(results), evaluateOptions.NormalizeOptions)
+	_, file.Score = Align(referenceWords, hypothesisWords)
+	file.Reference = strings.Join(referenceWords, " ")
+	file.Hypothesis = strings.Join(hypothesisWords, " ")
+	file.WordErrorRate = file.Score.WordErrorRate()
+	return file, nil
+}
+
+// transcriptOf returns the best transcript of the final results
+func transcriptOf(results *speechtotextv1.SpeechRecognitionResults) string {
+	if results == nil {
+		return ""
+	}
+	var transcripts []string
+	for _, result := range results.Results {
+		if (result.Final != nil && !*result.Final) || len(result.Alternatives) == 0 || result.Alternatives[0].Transcript == nil {
+			continue
+		}
+		transcripts = append(transcripts, *result.Alternatives[0].Transcript)
+	}
+	return strings.Join(transcripts, " ")
+}
+
+// WriteJSON : Writes the report in JSON
+func (report *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteCSV : Writes the evaluation of each audio file in CSV, with a header line
+func (report *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"audio", "reference_words", "hypothesis_words", "correct", "substitutions", "deletions",
+		"insertions", "word_error_rate", "reference", "hypothesis", "error"})
+	for _, file := range report.Files {
+		writer.Write(append([]string{file.Audio}, append(scoreColumns(file.Score, file.WordErrorRate),
+			file.Reference, file.Hypothesis, file.Error)...))
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteSummaryCSV : Writes the totals of reports in CSV, with a header line and one line per report, to compare the
+// configurations they evaluated
+func WriteSummaryCSV(w io.Writer, reports ...*Report) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"name", "evaluated_files", "failed_files", "reference_words", "hypothesis_words", "correct",
+		"substitutions", "deletions", "insertions", "word_error_rate", "sentence_error_rate"})
+	for _, report := range reports {
+		writer.Write(append([]string{report.Name, strconv.Itoa(report.EvaluatedFiles), strconv.Itoa(report.FailedFiles)},
+			append(scoreColumns(report.Total, report.WordErrorRate), formatRate(report.SentenceErrorRate))...))
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func scoreColumns(score Score, wordErrorRate float64) []string {
+	return []string{
+		strconv.Itoa(score.ReferenceWords),
+		strconv.Itoa(score.HypothesisWords),
+		strconv.Itoa(score.Correct),
+		strconv.Itoa(score.Substitutions),
+		strconv.Itoa(score.Deletions),
+		strconv.Itoa(score.Insertions),
+		formatRate(wordErrorRate),
+	}
+}
+
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', 4, 64)
+}
@@ -0,0 +1,171 @@
+package evaluation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// newTranscriber returns a mock service that transcribes each audio file as its content, split in two results
+func newTranscriber() *speechtotextv1.MockSpeechToTextV1 {
+	return &speechtotextv1.MockSpeechToTextV1{
+		RecognizeFunc: func(ctx context.Context, recognizeOptions *speechtotextv1.RecognizeOptions) (*speechtotextv1.SpeechRecognitionResults, *core.DetailedResponse, error) {
+			content, _ := ioutil.ReadAll(recognizeOptions.Audio)
+			if bytes.Equal(content, []byte("unsupported")) {
+				return nil, nil, errors.New("unsupported audio")
+			}
+			words := bytes.Fields(content)
+			half := len(words) / 2
+			return &speechtotextv1.SpeechRecognitionResults{Results: []speechtotextv1.SpeechRecognitionResult{
+				newResult(string(bytes.Join(words[:half], []byte(" ")))),
+				newResult(string(bytes.Join(words[half:], []byte(" ")))),
+			}}, nil, nil
+		},
+	}
+}
+
+func newResult(transcript string) speechtotextv1.SpeechRecognitionResult {
+	return speechtotextv1.SpeechRecognitionResult{
+		Final:        core.BoolPtr(true),
+		Alternatives: []speechtotextv1.SpeechRecognitionAlternative{{Transcript: core.StringPtr(transcript)}},
+	}
+}
+
+func writeFiles(t *testing.T, directory string, files map[string]string) {
+	for name, content := range files {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(directory, name), []byte(content), 0600))
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	directory, err := ioutil.TempDir("", "evaluation")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+
+	writeFiles(t, directory, map[string]string{
+		"manifest.json":  `[{"audio": "a.wav", "reference": "hello"}, {"audio": "/data/b.flac", "content_type": "audio/flac", "reference_file": "b.txt"}]`,
+		"manifest.jsonl": "{\"audio\": \"a.wav\", \"reference\": \"hello\"}\n{\"audio\": \"c.wav\"}\n",
+		"invalid.json":   `[{"audio": "a.wav"}, {"reference": "no audio"}]`,
+	})
+
+	entries, err := ReadManifest(filepath.Join(directory, "manifest.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, []ManifestEntry{
+		{Audio: filepath.Join(directory, "a.wav"), Reference: "hello"},
+		{Audio: "/data/b.flac", ContentType: "audio/flac", ReferenceFile: filepath.Join(directory, "b.txt")},
+	}, entries)
+
+	entries, err = ReadManifest(filepath.Join(directory, "manifest.jsonl"))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, filepath.Join(directory, "c.wav"), entries[1].Audio)
+
+	_, err = ReadManifest(filepath.Join(directory, "invalid.json"))
+	assert.NotNil(t, err)
+	_, err = ReadManifest(filepath.Join(directory, "missing.json"))
+	assert.NotNil(t, err)
+}
+
+func TestEvaluate(t *testing.T) {
+	directory, err := ioutil.TempDir("", "evaluation")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+
+	writeFiles(t, directory, map[string]string{
+		"exact.wav":     "It costs $25, %HESITATION right?",
+		"errors.wav":    "the cat sat at the big mat",
+		"failed.wav":    "unsupported",
+		"reference.txt": "The cat sat on the mat.",
+	})
+	entries := []ManifestEntry{
+		{Audio: filepath.Join(directory, "exact.wav"), ContentType: "audio/wav", Reference: "it costs twenty-five dollars right"},
+		{Audio: filepath.Join(directory, "errors.wav"), ReferenceFile: filepath.Join(directory, "reference.txt")},
+		{Audio: filepath.Join(directory, "failed.wav"), Reference: "anything"},
+		{Audio: filepath.Join(directory, "missing.wav"), Reference: "anything"},
+	}
+
+	service := newTranscriber()
+	options := NewEvaluateOptions(entries).
+		SetName("broadband").
+		SetRecognizeOptions(&speechtotextv1.RecognizeOptions{Model: core.StringPtr("en-US_BroadbandModel"), SmartFormatting: core.BoolPtr(true)})
+	report, err := Evaluate(context.Background(), service, options)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "broadband", report.Name)
+	assert.Equal(t, 2, report.EvaluatedFiles)
+	assert.Equal(t, 2, report.FailedFiles)
+	assert.Equal(t, Score{ReferenceWords: 12, HypothesisWords: 13, Correct: 11, Substitutions: 1, Insertions: 1}, report.Total)
+	assert.InDelta(t, 2.0/12, report.WordErrorRate, 1e-9)
+	assert.Equal(t, 0.5, report.SentenceErrorRate)
+
+	assert.Len(t, report.Files, 4)
+	assert.Equal(t, "it costs twenty five dollars right", report.Files[0].Reference)
+	assert.Equal(t, "it costs twenty five dollars right", report.Files[0].Hypothesis)
+	assert.Equal(t, 0.0, report.Files[0].WordErrorRate)
+	assert.Equal(t, "the cat sat at the big mat", report.Files[1].Hypothesis)
+	assert.InDelta(t, 2.0/6, report.Files[1].WordErrorRate, 1e-9)
+	assert.Equal(t, "unsupported audio", report.Files[2].Error)
+	assert.NotEmpty(t, report.Files[3].Error)
+
+	calls := service.CallsTo("Recognize")
+	assert.Len(t, calls, 3)
+	first := calls[0].Args[0].(*speechtotextv1.RecognizeOptions)
+	assert.Equal(t, "audio/wav", *first.ContentType)
+	assert.Equal(t, "en-US_BroadbandModel", *first.Model)
+	assert.Nil(t, calls[1].Args[0].(*speechtotextv1.RecognizeOptions).ContentType)
+	assert.Nil(t, options.RecognizeOptions.Audio)
+
+	var output bytes.Buffer
+	assert.Nil(t, report.WriteCSV(&output))
+	lines := bytes.Split(bytes.TrimSpace(output.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 5)
+	assert.Equal(t, "audio,reference_words,hypothesis_words,correct,substitutions,deletions,insertions,word_error_rate,reference,hypothesis,error", string(lines[0]))
+	assert.Equal(t, filepath.Join(directory, "errors.wav")+",6,7,5,1,0,1,0.3333,the cat sat on the mat,the cat sat at the big mat,", string(lines[2]))
+
+	output.Reset()
+	assert.Nil(t, WriteSummaryCSV(&output, report, &Report{Name: "narrowband"}))
+	assert.Equal(t, "name,evaluated_files,failed_files,reference_words,hypothesis_words,correct,substitutions,deletions,insertions,word_error_rate,sentence_error_rate\n"+
+		"broadband,2,2,12,13,11,1,0,1,0.1667,0.5000\n"+
+		"narrowband,0,0,0,0,0,0,0,0,0.0000,0.0000\n", output.String())
+
+	output.Reset()
+	assert.Nil(t, report.WriteJSON(&output))
+	assert.Contains(t, output.String(), `"sentence_error_rate": 0.5`)
+	assert.Contains(t, output.String(), `"error": "unsupported audio"`)
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	_, err := Evaluate(context.Background(), newTranscriber(), nil)
+	assert.NotNil(t, err)
+	_, err = Evaluate(context.Background(), newTranscriber(), NewEvaluateOptions(nil))
+	assert.NotNil(t, err)
+
+	directory, err := ioutil.TempDir("", "evaluation")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+	writeFiles(t, directory, map[string]string{"a.wav": "hello"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	service := newTranscriber()
+	recognize := service.RecognizeFunc
+	service.RecognizeFunc = func(ctx context.Context, recognizeOptions *speechtotextv1.RecognizeOptions) (*speechtotextv1.SpeechRecognitionResults, *core.DetailedResponse, error) {
+		if len(service.CallsTo("Recognize")) > 1 {
+			cancel()
+			return nil, nil, ctx.Err()
+		}
+		return recognize(ctx, recognizeOptions)
+	}
+	entry := ManifestEntry{Audio: filepath.Join(directory, "a.wav"), Reference: "hello"}
+	report, err := Evaluate(ctx, service, NewEvaluateOptions([]ManifestEntry{entry, entry, entry}))
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, report.EvaluatedFiles)
+	assert.Len(t, report.Files, 1)
+}
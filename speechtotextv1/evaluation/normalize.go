@@ -0,0 +1,261 @@
+package evaluation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// MAX_SPELLED_OUT_DIGITS is the length above which a number is spelled out digit by digit, as for account numbers
+const MAX_SPELLED_OUT_DIGITS = 15
+
+// numberPattern matches the numbers that smart formatting writes with digits: an optional minus sign, an optional
+// currency sign, an integer with optional thousands separators, an optional decimal part, then an optional percent
+// sign or ordinal suffix
+var numberPattern = regexp.MustCompile(`^(-?)(\$?)(\d{1,3}(?:,\d{3})+|\d+)(?:\.(\d+))?(%|st|nd|rd|th)?$`)
+
+// NormalizeOptions : Configures how the reference and hypothesis transcripts are normalized before they are compared.
+// All the normalizations are enabled by default.
+type NormalizeOptions struct {
+	// If true, the transcripts are lowercased.
+	Lowercase *bool
+
+	// If true, punctuation is removed. Hyphens separate words, and apostrophes within a word are kept.
+	RemovePunctuation *bool
+
+	// If true, numbers written with digits are spelled out, so that a transcript produced with `SmartFormatting`, such
+	// as "25% of $3", compares equal to "twenty five percent of three dollars". A leading minus sign is read "minus",
+	// amounts with cents are read as dollars then cents, such as "five dollars fifty" for "$5.50", and other decimals
+	// are read digit by digit after "point".
+	SpellOutNumbers *bool
+
+	// If true, the hesitation markers of the service are removed.
+	RemoveHesitations *bool
+}
+
+// NewNormalizeOptions : Instantiate NormalizeOptions
+func NewNormalizeOptions() *NormalizeOptions {
+	return &NormalizeOptions{}
+}
+
+// SetLowercase : Allow user to set Lowercase
+func (options *NormalizeOptions) SetLowercase(lowercase bool) *NormalizeOptions {
+	options.Lowercase = &lowercase
+	return options
+}
+
+// SetRemovePunctuation : Allow user to set RemovePunctuation
+func (options *NormalizeOptions) SetRemovePunctuation(removePunctuation bool) *NormalizeOptions {
+	options.RemovePunctuation = &removePunctuation
+	return options
+}
+
+// SetSpellOutNumbers : Allow user to set SpellOutNumbers
+func (options *NormalizeOptions) SetSpellOutNumbers(spellOutNumbers bool) *NormalizeOptions {
+	options.SpellOutNumbers = &spellOutNumbers
+	return options
+}
+
+// SetRemoveHesitations : Allow user to set RemoveHesitations
+func (options *NormalizeOptions) SetRemoveHesitations(removeHesitations bool) *NormalizeOptions {
+	options.RemoveHesitations = &removeHesitations
+	return options
+}
+
+// enabled returns the value of an option that defaults to true
+func enabled(option *bool) bool {
+	return option == nil || *option
+}
+
+// Normalize : Splits a transcript into normalized words. The options can be nil to apply all the normalizations.
+func Normalize(text string, options *NormalizeOptions) []string {
+	if options == nil {
+		options = NewNormalizeOptions()
+	}
+
+	words := []string{}
+	for _, field := range strings.Fields(text) {
+		if enabled(options.RemoveHesitations) && field == speechtotextv1.HESITATION_MARKER {
+			continue
+		}
+		if enabled(options.Lowercase) {
+			field = strings.ToLower(field)
+		}
+		if enabled(options.SpellOutNumbers) {
+			if spelled := spellOutNumber(strings.TrimRight(field, ".,;:!?")); spelled != nil {
+				words = append(words, spelled...)
+				continue
+			}
+		}
+		if enabled(options.RemovePunctuation) {
+			words = append(words, removePunctuation(field)...)
+			continue
+		}
+		words = append(words, field)
+	}
+	return words
+}
+
+// removePunctuation splits a field into its words, without punctuation
+func removePunctuation(field string) []string {
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' || unicode.Is(unicode.Mn, r) {
+			return r
+		}
+		return ' '
+	}, field)
+
+	var words []string
+	for _, word := range strings.Fields(cleaned) {
+		if word = strings.Trim(word, "'"); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+var (
+	smallNumbers = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	tens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scales = []string{"", "thousand", "million", "billion", "trillion"}
+
+	irregularOrdinals = map[string]string{"one": "first", "two": "second", "three": "third", "five": "fifth",
+		"eight": "eighth", "nine": "ninth", "twelve": "twelfth"}
+)
+
+// spellOutNumber returns the words of a number written with digits, or nil if the field is not a number
+func spellOutNumber(field string) []string {
+	match := numberPattern.FindStringSubmatch(field)
+	if match == nil {
+		return nil
+	}
+	minus, currency, integer, decimals, suffix := match[1], match[2], strings.Replace(match[3], ",", "", -1), match[4], match[5]
+	if suffix != "" && suffix != "%" && (currency != "" || decimals != "") {
+		return nil
+	}
+
+	var words []string
+	if minus != "" {
+		words = append(words, "minus")
+	}
+	if currency != "" {
+		return append(words, spellOutAmount(integer, decimals)...)
+	}
+	words = append(words, spellOutInteger(integer)...)
+	if decimals != "" {
+		words = append(words, "point")
+		words = append(words, spellOutDigits(decimals)...)
+	}
+	switch suffix {
+	case "":
+	case "%":
+		words = append(words, "percent")
+	default:
+		last := words[len(words)-1]
+		if ordinal, ok := irregularOrdinals[last]; ok {
+			last = ordinal
+		} else if strings.HasSuffix(last, "y") {
+			last = strings.TrimSuffix(last, "y") + "ieth"
+		} else {
+			last += "th"
+		}
+		words[len(words)-1] = last
+	}
+	return words
+}
+
+// spellOutAmount returns the words of an amount of dollars. Cents are read after the dollars, as in "five dollars
+// fifty", or alone with "cents" when there are no dollars. Decimals other than cents are read after "point".
+func spellOutAmount(integer string, decimals string) []string {
+	dollars := "dollars"
+	if integer == "1" && (decimals == "" || len(decimals) == 2) {
+		dollars = "dollar"
+	}
+	if len(decimals) != 2 {
+		words := spellOutInteger(integer)
+		if decimals != "" {
+			words = append(words, "point")
+			words = append(words, spellOutDigits(decimals)...)
+		}
+		return append(words, dollars)
+	}
+
+	cents := "cents"
+	if decimals == "01" {
+		cents = "cent"
+	}
+	switch {
+	case strings.Trim(integer, "0") == "":
+		return append(spellOutInteger(strings.TrimPrefix(decimals, "0")), cents)
+	case decimals == "00":
+		return append(spellOutInteger(integer), dollars)
+	}
+	words := append(spellOutInteger(integer), dollars)
+	return append(words, spellOutInteger(strings.TrimPrefix(decimals, "0"))...)
+}
+
+// spellOutInteger returns the words of a string of digits
+func spellOutInteger(digits string) []string {
+	if (len(digits) > 1 && digits[0] == '0') || len(digits) > MAX_SPELLED_OUT_DIGITS {
+		return spellOutDigits(digits)
+	}
+	if strings.Trim(digits, "0") == "" {
+		return []string{smallNumbers[0]}
+	}
+
+	// The groups of three digits, from the least significant
+	var groups []int
+	for end := len(digits); end > 0; end -= 3 {
+		start := end - 3
+		if start < 0 {
+			start = 0
+		}
+		group, _ := strconv.Atoi(digits[start:end])
+		groups = append(groups, group)
+	}
+
+	var words []string
+	for scale := len(groups) - 1; scale >= 0; scale-- {
+		if groups[scale] == 0 {
+			continue
+		}
+		words = append(words, spellOutHundreds(groups[scale])...)
+		if scales[scale] != "" {
+			words = append(words, scales[scale])
+		}
+	}
+	return words
+}
+
+// spellOutHundreds returns the words of a number between 1 and 999
+func spellOutHundreds(number int) []string {
+	var words []string
+	if number >= 100 {
+		words = append(words, smallNumbers[number/100], "hundred")
+		number %= 100
+	}
+	switch {
+	case number == 0:
+	case number < 20:
+		words = append(words, smallNumbers[number])
+	default:
+		words = append(words, tens[number/10])
+		if number%10 != 0 {
+			words = append(words, smallNumbers[number%10])
+		}
+	}
+	return words
+}
+
+// spellOutDigits returns the words of each digit of a string of digits
+func spellOutDigits(digits string) []string {
+	words := make([]string, 0, len(digits))
+	for _, digit := range digits {
+		words = append(words, smallNumbers[digit-'0'])
+	}
+	return words
+}
@@ -0,0 +1,51 @@
+package evaluation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, []string{"hello", "world", "it's", "a", "well", "known", "fact"},
+		Normalize(`Hello, world! "It's" a well-known %HESITATION fact.`, nil))
+	assert.Equal(t, []string{}, Normalize(" %HESITATION ", nil))
+
+	options := NewNormalizeOptions().SetLowercase(false).SetRemovePunctuation(false).SetRemoveHesitations(false)
+	assert.Equal(t, []string{"Hello,", "%HESITATION", "World."}, Normalize("Hello, %HESITATION World.", options))
+}
+
+func TestNormalizeNumbers(t *testing.T) {
+	tests := map[string]string{
+		"0":                 "zero",
+		"7":                 "seven",
+		"25%":               "twenty five percent",
+		"$1":                "one dollar",
+		"$3.50":             "three dollars fifty",
+		"$1.05":             "one dollar five",
+		"$0.01":             "one cent",
+		"$0.50":             "fifty cents",
+		"$2.00":             "two dollars",
+		"$1.5":              "one point five dollars",
+		"-4":                "minus four",
+		"-$12.25":           "minus twelve dollars twenty five",
+		"-0.5%":             "minus zero point five percent",
+		"3.14":              "three point one four",
+		"1,005":             "one thousand five",
+		"1200000":           "one million two hundred thousand",
+		"987654321012":      "nine hundred eighty seven billion six hundred fifty four million three hundred twenty one thousand twelve",
+		"007":               "zero zero seven",
+		"1st 2nd 3rd 5th":   "first second third fifth",
+		"12th 20th 21st":    "twelfth twentieth twenty first",
+		"99th":              "ninety ninth",
+		"1000000000000000 ": "one zero zero zero zero zero zero zero zero zero zero zero zero zero zero zero",
+		"at 10.":            "at ten",
+		"2b":                "2b",
+	}
+	for text, expected := range tests {
+		assert.Equal(t, expected, strings.Join(Normalize(text, nil), " "), text)
+	}
+
+	assert.Equal(t, []string{"25"}, Normalize("25%", NewNormalizeOptions().SetSpellOutNumbers(false)))
+}
@@ -0,0 +1,479 @@
+package grammar
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// bareTokenPattern matches the tokens written without quotes in the ABNF form
+var bareTokenPattern = regexp.MustCompile(`^[^\s;|/(){}\[\]<>$"=!]+$`)
+
+// abnfReserved are the characters that end a bare token
+const abnfReserved = ";|/(){}[]<>$\"=!"
+
+// The precedence of the expansions, which decides where the ABNF form needs parentheses
+const (
+	precedenceAlternatives = iota
+	precedenceSequence
+	precedenceItem
+)
+
+// SyntaxError : An error in the source of a grammar
+type SyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("Grammar syntax error at line %d, column %d: %s", err.Line, err.Column, err.Message)
+}
+
+// ABNF : Writes the grammar in the ABNF form, once validated
+func (grammar *Grammar) ABNF() (string, error) {
+	if err := grammar.Validate(); err != nil {
+		return "", err
+	}
+
+	var abnf strings.Builder
+	abnf.WriteString("#ABNF 1.0 UTF-8;\n")
+	fmt.Fprintf(&abnf, "language %s;\n", grammar.Language)
+	abnf.WriteString("mode voice;\n")
+	fmt.Fprintf(&abnf, "root $%s;\n", grammar.Root)
+	if grammar.TagFormat != "" {
+		fmt.Fprintf(&abnf, "tag-format <%s>;\n", grammar.TagFormat)
+	}
+	for _, rule := range grammar.Rules {
+		abnf.WriteString("\n")
+		if rule.Public {
+			abnf.WriteString("public ")
+		}
+		fmt.Fprintf(&abnf, "$%s = %s;\n", rule.Name, formatABNF(rule.Expansion, precedenceAlternatives))
+	}
+	return abnf.String(), nil
+}
+
+// formatABNF writes an expansion, in parentheses if it binds less tightly than its context requires
+func formatABNF(expansion Expansion, precedence int) string {
+	group := func(text string, own int) string {
+		if own < precedence {
+			return "(" + text + ")"
+		}
+		return text
+	}
+
+	switch expansion := expansion.(type) {
+	case *Token:
+		if bareTokenPattern.MatchString(expansion.Text) {
+			return expansion.Text
+		}
+		return `"` + expansion.Text + `"`
+	case *Sequence:
+		items := make([]string, len(expansion.Items))
+		for i, item := range expansion.Items {
+			items[i] = formatABNF(item, precedenceItem)
+		}
+		return group(strings.Join(items, " "), precedenceSequence)
+	case *Alternatives:
+		choices := make([]string, len(expansion.Choices))
+		for i, choice := range expansion.Choices {
+			choices[i] = formatABNF(choice, precedenceSequence)
+		}
+		return group(strings.Join(choices, " | "), precedenceAlternatives)
+	case *Weighted:
+		return group(fmt.Sprintf("/%s/ %s", formatNumber(expansion.Weight), formatABNF(expansion.Expansion, precedenceSequence)), precedenceSequence)
+	case *Repeat:
+		if expansion.Min == 0 && expansion.Max == 1 && expansion.Probability < 0 {
+			return "[" + formatABNF(expansion.Expansion, precedenceAlternatives) + "]"
+		}
+		probability := ""
+		if expansion.Probability >= 0 {
+			probability = fmt.Sprintf(" /%s/", formatNumber(expansion.Probability))
+		}
+		return fmt.Sprintf("%s<%s%s>", formatABNF(expansion.Expansion, precedenceItem), repeatRange(expansion), probability)
+	case *RuleRef:
+		return "$" + expansion.Name
+	case *Tag:
+		if strings.Contains(expansion.Content, "}") {
+			return "{!{" + expansion.Content + "}!}"
+		}
+		return "{" + expansion.Content + "}"
+	}
+	return ""
+}
+
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'g', -1, 64)
+}
+
+// ParseABNF : Reads a grammar in the ABNF form. Only the syntax is checked; call Validate on the grammar to check
+// its rules.
+func ParseABNF(source string) (*Grammar, error) {
+	parser := &abnfParser{source: []rune(source)}
+	grammar, err := parser.parseGrammar()
+	if err != nil {
+		return nil, err
+	}
+	return grammar, nil
+}
+
+type abnfParser struct {
+	source   []rune
+	position int
+}
+
+// fail returns a SyntaxError at the current position
+func (parser *abnfParser) fail(format string, args ...interface{}) error {
+	line, column := 1, 1
+	for _, char := range parser.source[:parser.position] {
+		if char == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return &SyntaxError{Line: line, Column: column, Message: fmt.Sprintf(format, args...)}
+}
+
+// peek skips the spaces and comments, and returns the next character or 0 at the end
+func (parser *abnfParser) peek() rune {
+	for parser.position < len(parser.source) {
+		rest := string(parser.source[parser.position:min(parser.position+2, len(parser.source))])
+		switch {
+		case unicode.IsSpace(parser.source[parser.position]):
+			parser.position++
+		case rest == "//":
+			for parser.position < len(parser.source) && parser.source[parser.position] != '\n' {
+				parser.position++
+			}
+		case rest == "/*":
+			end := strings.Index(string(parser.source[parser.position+2:]), "*/")
+			if end < 0 {
+				parser.position = len(parser.source)
+			} else {
+				parser.position += 2 + len([]rune(string(parser.source[parser.position+2:])[:end])) + 2
+			}
+		default:
+			return parser.source[parser.position]
+		}
+	}
+	return 0
+}
+
+func (parser *abnfParser) expect(char rune) error {
+	if parser.peek() != char {
+		return parser.fail("expected '%c'", char)
+	}
+	parser.position++
+	return nil
+}
+
+// word reads the characters up to a space or a reserved character
+func (parser *abnfParser) word() string {
+	parser.peek()
+	start := parser.position
+	for parser.position < len(parser.source) {
+		char := parser.source[parser.position]
+		if unicode.IsSpace(char) || strings.ContainsRune(abnfReserved, char) {
+			break
+		}
+		parser.position++
+	}
+	return string(parser.source[start:parser.position])
+}
+
+// until reads the characters up to the given delimiter, which is skipped
+func (parser *abnfParser) until(delimiter string, what string) (string, error) {
+	rest := string(parser.source[parser.position:])
+	end := strings.Index(rest, delimiter)
+	if end < 0 {
+		return "", parser.fail("unterminated %s", what)
+	}
+	parser.position += len([]rune(rest[:end+len(delimiter)]))
+	return rest[:end], nil
+}
+
+func (parser *abnfParser) parseGrammar() (*Grammar, error) {
+	grammar := &Grammar{}
+	if parser.peek() != '#' {
+		return nil, parser.fail("the grammar must start with '#ABNF 1.0'")
+	}
+	header, err := parser.until(";", "header")
+	if err != nil {
+		return nil, err
+	}
+	if fields := strings.Fields(header); len(fields) < 2 || fields[0] != "#ABNF" || fields[1] != "1.0" {
+		return nil, parser.fail("the grammar must start with '#ABNF 1.0'")
+	}
+
+	for parser.peek() != 0 {
+		if parser.peek() == '$' {
+			if err := parser.parseRule(grammar, false); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if parser.peek() == '{' {
+			// A tag declaration, which applies to the whole grammar
+			if _, err := parser.parseTag(); err != nil {
+				return nil, err
+			}
+			if err := parser.expect(';'); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		keyword := parser.word()
+		switch keyword {
+		case "public", "private":
+			if err := parser.parseRule(grammar, keyword == "public"); err != nil {
+				return nil, err
+			}
+			continue
+		case "language":
+			grammar.Language = parser.word()
+		case "mode":
+			if mode := parser.word(); mode != "voice" {
+				return nil, parser.fail("the mode '%s' is not supported, only voice is", mode)
+			}
+		case "root":
+			if err := parser.expect('$'); err != nil {
+				return nil, err
+			}
+			grammar.Root = parser.word()
+		case "tag-format":
+			if err := parser.expect('<'); err != nil {
+				return nil, err
+			}
+			if grammar.TagFormat, err = parser.until(">", "tag format"); err != nil {
+				return nil, err
+			}
+		case "base", "lexicon", "meta", "http-equiv":
+			if _, err := parser.until(";", keyword+" declaration"); err != nil {
+				return nil, err
+			}
+			continue
+		case "":
+			return nil, parser.fail("unexpected '%c'", parser.peek())
+		default:
+			return nil, parser.fail("unknown declaration '%s'", keyword)
+		}
+		if err := parser.expect(';'); err != nil {
+			return nil, err
+		}
+	}
+	return grammar, nil
+}
+
+func (parser *abnfParser) parseRule(grammar *Grammar, public bool) error {
+	if err := parser.expect('$'); err != nil {
+		return err
+	}
+	name := parser.word()
+	if name == "" {
+		return parser.fail("the rule name is missing")
+	}
+	if err := parser.expect('='); err != nil {
+		return err
+	}
+	expansion, err := parser.parseAlternatives()
+	if err != nil {
+		return err
+	}
+	if err := parser.expect(';'); err != nil {
+		return err
+	}
+	grammar.Rules = append(grammar.Rules, &Rule{Name: name, Public: public, Expansion: expansion})
+	return nil
+}
+
+func (parser *abnfParser) parseAlternatives() (Expansion, error) {
+	var choices []Expansion
+	weighted := false
+	for {
+		choice, isWeighted, err := parser.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		choices = append(choices, choice)
+		weighted = weighted || isWeighted
+		if parser.peek() != '|' {
+			break
+		}
+		parser.position++
+	}
+	if len(choices) == 1 && !weighted {
+		return choices[0], nil
+	}
+	return &Alternatives{Choices: choices}, nil
+}
+
+func (parser *abnfParser) parseSequence() (Expansion, bool, error) {
+	var weight float64
+	weighted := parser.peek() == '/'
+	if weighted {
+		parser.position++
+		text, err := parser.until("/", "weight")
+		if err != nil {
+			return nil, false, err
+		}
+		if weight, err = strconv.ParseFloat(strings.TrimSpace(text), 64); err != nil {
+			return nil, false, parser.fail("invalid weight '%s'", text)
+		}
+	}
+
+	var items []Expansion
+	for {
+		char := parser.peek()
+		if char == 0 || char == ';' || char == '|' || char == ')' || char == ']' {
+			break
+		}
+		item, err := parser.parseItem()
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, false, parser.fail("expected a token, a rule reference, a group or a tag")
+	}
+
+	if weighted {
+		return &Weighted{Weight: weight, Expansion: Seq(items...)}, true, nil
+	}
+	return Seq(items...), false, nil
+}
+
+func (parser *abnfParser) parseItem() (Expansion, error) {
+	var item Expansion
+	switch parser.peek() {
+	case '"':
+		parser.position++
+		text, err := parser.until(`"`, "quoted token")
+		if err != nil {
+			return nil, err
+		}
+		item = &Token{Text: text}
+	case '$':
+		parser.position++
+		if parser.peek() == '<' {
+			return nil, parser.fail("references to external grammars are not supported")
+		}
+		name := parser.word()
+		if name == "" {
+			return nil, parser.fail("the rule name is missing")
+		}
+		item = &RuleRef{Name: name}
+	case '(':
+		parser.position++
+		group, err := parser.parseAlternatives()
+		if err != nil {
+			return nil, err
+		}
+		if err := parser.expect(')'); err != nil {
+			return nil, err
+		}
+		item = group
+	case '[':
+		parser.position++
+		group, err := parser.parseAlternatives()
+		if err != nil {
+			return nil, err
+		}
+		if err := parser.expect(']'); err != nil {
+			return nil, err
+		}
+		item = RepeatOf(group, 0, 1)
+	case '{':
+		tag, err := parser.parseTag()
+		if err != nil {
+			return nil, err
+		}
+		return tag, nil
+	default:
+		text := parser.word()
+		if text == "" {
+			return nil, parser.fail("unexpected '%c'", parser.peek())
+		}
+		item = &Token{Text: text}
+	}
+
+	if parser.position < len(parser.source) && parser.source[parser.position] == '!' {
+		return nil, parser.fail("language attachments are not supported")
+	}
+	for parser.peek() == '<' {
+		parser.position++
+		repeat, err := parser.parseRepeat(item)
+		if err != nil {
+			return nil, err
+		}
+		item = repeat
+	}
+	return item, nil
+}
+
+// parseRepeat reads the bounds and probability of a repeat, after the '<'
+func (parser *abnfParser) parseRepeat(item Expansion) (Expansion, error) {
+	text, err := parser.until(">", "repeat")
+	if err != nil {
+		return nil, err
+	}
+	repeat := &Repeat{Expansion: item, Probability: -1}
+	bounds := text
+	if slash := strings.Index(text, "/"); slash >= 0 {
+		bounds = text[:slash]
+		probability := strings.Trim(strings.TrimSpace(text[slash:]), "/")
+		if repeat.Probability, err = strconv.ParseFloat(strings.TrimSpace(probability), 64); err != nil {
+			return nil, parser.fail("invalid repeat probability '%s'", probability)
+		}
+	}
+
+	bounds = strings.TrimSpace(bounds)
+	parts := strings.SplitN(bounds, "-", 2)
+	if repeat.Min, err = strconv.Atoi(strings.TrimSpace(parts[0])); err != nil {
+		return nil, parser.fail("invalid repeat '%s'", bounds)
+	}
+	switch {
+	case len(parts) == 1:
+		repeat.Max = repeat.Min
+	case strings.TrimSpace(parts[1]) == "":
+		repeat.Max = UNBOUNDED
+	default:
+		if repeat.Max, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil {
+			return nil, parser.fail("invalid repeat '%s'", bounds)
+		}
+	}
+	return repeat, nil
+}
+
+// parseTag reads a tag, in braces or in the {!{ }!} form
+func (parser *abnfParser) parseTag() (Expansion, error) {
+	if err := parser.expect('{'); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(string(parser.source[parser.position:]), "!{") {
+		parser.position += 2
+		content, err := parser.until("}!}", "tag")
+		if err != nil {
+			return nil, err
+		}
+		return &Tag{Content: content}, nil
+	}
+	content, err := parser.until("}", "tag")
+	if err != nil {
+		return nil, err
+	}
+	return &Tag{Content: content}, nil
+}
+
+func min(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
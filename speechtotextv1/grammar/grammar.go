@@ -0,0 +1,450 @@
+// Package grammar : Builds, formats and validates the SRGS grammars of custom language models
+// A grammar is built in Go from rules and expansions, written in the ABNF or XML form that AddGrammar accepts, and
+// parsed back from either form, so that its errors are caught before it is uploaded.
+package grammar
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The content types of the forms of a grammar, as expected by AddGrammar
+const (
+	CONTENT_TYPE_ABNF = "application/srgs"
+	CONTENT_TYPE_XML  = "application/srgs+xml"
+)
+
+// The special rules, which can be referenced but not defined
+const (
+	// Matches without consuming any speech
+	SPECIAL_NULL = "NULL"
+
+	// Never matches
+	SPECIAL_VOID = "VOID"
+
+	// Matches any speech
+	SPECIAL_GARBAGE = "GARBAGE"
+)
+
+// UNBOUNDED is the maximum of a repeat without upper bound
+const UNBOUNDED = -1
+
+// ruleNamePattern matches the names of rules that both forms accept
+var ruleNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.\-]*$`)
+
+// Grammar : An SRGS grammar
+type Grammar struct {
+	// The language of the grammar, such as `en-US`, which must match the language of the custom model.
+	Language string
+
+	// The name of the rule that the whole speech must match.
+	Root string
+
+	// The format of the tags, such as `semantics/1.0`, or an empty string.
+	TagFormat string
+
+	// The rules, in the order in which they are written.
+	Rules []*Rule
+}
+
+// Rule : A named expansion
+type Rule struct {
+	Name string
+
+	// Whether the rule can be referenced from other grammars. The root rule is usually public.
+	Public bool
+
+	Expansion Expansion
+}
+
+// Expansion : The speech that a rule matches. It is one of *Token, *Sequence, *Alternatives, *Weighted, *Repeat,
+// *RuleRef and *Tag.
+type Expansion interface {
+	expansion()
+}
+
+// Token : A word, or words that are recognized together such as "New York"
+type Token struct {
+	Text string
+}
+
+// Sequence : Expansions matched one after the other
+type Sequence struct {
+	Items []Expansion
+}
+
+// Alternatives : Expansions of which one is matched. A choice can be *Weighted.
+type Alternatives struct {
+	Choices []Expansion
+}
+
+// Weighted : A choice of alternatives with its weight relative to the other choices
+type Weighted struct {
+	Weight    float64
+	Expansion Expansion
+}
+
+// Repeat : An expansion matched a number of times
+type Repeat struct {
+	Expansion Expansion
+	Min       int
+
+	// The maximum number of times, or UNBOUNDED.
+	Max int
+
+	// The probability of repeating the expansion once more, between 0 and 1, or a negative number when not set.
+	Probability float64
+}
+
+// RuleRef : A reference to a rule of the grammar or to a special rule
+type RuleRef struct {
+	Name string
+}
+
+// Tag : Semantic content attached to the speech that matches
+type Tag struct {
+	Content string
+}
+
+func (*Token) expansion()        {}
+func (*Sequence) expansion()     {}
+func (*Alternatives) expansion() {}
+func (*Weighted) expansion()     {}
+func (*Repeat) expansion()       {}
+func (*RuleRef) expansion()      {}
+func (*Tag) expansion()          {}
+
+// New : Instantiate Grammar
+func New(language string, root string) *Grammar {
+	return &Grammar{Language: language, Root: root}
+}
+
+// SetTagFormat : Allow user to set TagFormat
+func (grammar *Grammar) SetTagFormat(tagFormat string) *Grammar {
+	grammar.TagFormat = tagFormat
+	return grammar
+}
+
+// PublicRule : Adds a public rule that matches the items in sequence
+func (grammar *Grammar) PublicRule(name string, items ...Expansion) *Grammar {
+	grammar.Rules = append(grammar.Rules, &Rule{Name: name, Public: true, Expansion: Seq(items...)})
+	return grammar
+}
+
+// PrivateRule : Adds a private rule that matches the items in sequence
+func (grammar *Grammar) PrivateRule(name string, items ...Expansion) *Grammar {
+	grammar.Rules = append(grammar.Rules, &Rule{Name: name, Expansion: Seq(items...)})
+	return grammar
+}
+
+// Rule : Returns the rule of the given name, or nil
+func (grammar *Grammar) Rule(name string) *Rule {
+	for _, rule := range grammar.Rules {
+		if rule.Name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Word : A token
+func Word(text string) Expansion {
+	return &Token{Text: text}
+}
+
+// Words : A sequence of the tokens of a text separated by spaces
+func Words(text string) Expansion {
+	var items []Expansion
+	for _, word := range strings.Fields(text) {
+		items = append(items, Word(word))
+	}
+	return Seq(items...)
+}
+
+// Seq : A sequence of items. A single item is returned as it is.
+func Seq(items ...Expansion) Expansion {
+	if len(items) == 1 {
+		return items[0]
+	}
+	return &Sequence{Items: items}
+}
+
+// OneOf : Alternatives between the choices
+func OneOf(choices ...Expansion) Expansion {
+	return &Alternatives{Choices: choices}
+}
+
+// WithWeight : A choice of alternatives with a weight
+func WithWeight(weight float64, choice Expansion) Expansion {
+	return &Weighted{Weight: weight, Expansion: choice}
+}
+
+// Optional : An expansion matched once or not at all
+func Optional(items ...Expansion) Expansion {
+	return RepeatOf(Seq(items...), 0, 1)
+}
+
+// RepeatOf : An expansion matched between min and max times; max can be UNBOUNDED
+func RepeatOf(expansion Expansion, min int, max int) Expansion {
+	return &Repeat{Expansion: expansion, Min: min, Max: max, Probability: -1}
+}
+
+// Ref : A reference to a rule
+func Ref(name string) Expansion {
+	return &RuleRef{Name: name}
+}
+
+// TagOf : A semantic tag
+func TagOf(content string) Expansion {
+	return &Tag{Content: content}
+}
+
+// ValidationError : The problems found in a grammar
+type ValidationError struct {
+	Problems []string
+}
+
+func (err *ValidationError) Error() string {
+	return "Invalid grammar: " + strings.Join(err.Problems, "; ")
+}
+
+// Validate : Checks that the grammar can be written and would be accepted by the service: the root rule and all
+// referenced rules are defined once, names and tokens are well formed, repeats and weights are in range, and no rule
+// is left recursive. The problems are returned in a *ValidationError.
+func (grammar *Grammar) Validate() error {
+	validator := &validator{grammar: grammar, rules: map[string]*Rule{}}
+	validator.validate()
+	if len(validator.problems) > 0 {
+		return &ValidationError{Problems: validator.problems}
+	}
+	return nil
+}
+
+type validator struct {
+	grammar  *Grammar
+	rules    map[string]*Rule
+	problems []string
+}
+
+func (validator *validator) report(format string, args ...interface{}) {
+	validator.problems = append(validator.problems, fmt.Sprintf(format, args...))
+}
+
+func (validator *validator) validate() {
+	grammar := validator.grammar
+	if grammar.Language == "" {
+		validator.report("the language is missing")
+	}
+	for _, rule := range grammar.Rules {
+		switch {
+		case !ruleNamePattern.MatchString(rule.Name):
+			validator.report("the rule name '%s' is invalid", rule.Name)
+		case isSpecial(rule.Name):
+			validator.report("the special rule $%s cannot be defined", rule.Name)
+		case validator.rules[rule.Name] != nil:
+			validator.report("the rule $%s is defined more than once", rule.Name)
+		}
+		validator.rules[rule.Name] = rule
+	}
+	if grammar.Root == "" {
+		validator.report("the root rule is missing")
+	} else if validator.rules[grammar.Root] == nil {
+		validator.report("the root rule $%s is not defined", grammar.Root)
+	}
+
+	for _, rule := range grammar.Rules {
+		if rule.Expansion == nil {
+			validator.report("rule $%s: the rule is empty", rule.Name)
+			continue
+		}
+		validator.validateExpansion(rule, rule.Expansion, false)
+	}
+	validator.validateRecursion()
+}
+
+func (validator *validator) validateExpansion(rule *Rule, expansion Expansion, inAlternatives bool) {
+	switch expansion := expansion.(type) {
+	case *Token:
+		if strings.TrimSpace(expansion.Text) == "" {
+			validator.report("rule $%s: a token is empty", rule.Name)
+		} else if strings.ContainsAny(expansion.Text, "\"\n\r") {
+			validator.report("rule $%s: the token '%s' contains a quote or a line break", rule.Name, expansion.Text)
+		}
+	case *Sequence:
+		if len(expansion.Items) == 0 {
+			validator.report("rule $%s: a sequence is empty", rule.Name)
+		}
+		for _, item := range expansion.Items {
+			validator.validateExpansion(rule, item, false)
+		}
+	case *Alternatives:
+		if len(expansion.Choices) == 0 {
+			validator.report("rule $%s: alternatives have no choice", rule.Name)
+		}
+		for _, choice := range expansion.Choices {
+			validator.validateExpansion(rule, choice, true)
+		}
+	case *Weighted:
+		if !inAlternatives {
+			validator.report("rule $%s: a weight is only allowed on a choice of alternatives", rule.Name)
+		}
+		if expansion.Weight <= 0 {
+			validator.report("rule $%s: the weight %g is not positive", rule.Name, expansion.Weight)
+		}
+		validator.validateExpansion(rule, expansion.Expansion, false)
+	case *Repeat:
+		if expansion.Min < 0 || (expansion.Max != UNBOUNDED && (expansion.Max < expansion.Min || expansion.Max == 0)) {
+			validator.report("rule $%s: the repeat %s is invalid", rule.Name, repeatRange(expansion))
+		}
+		if expansion.Probability > 1 {
+			validator.report("rule $%s: the repeat probability %g is greater than 1", rule.Name, expansion.Probability)
+		}
+		validator.validateExpansion(rule, expansion.Expansion, false)
+	case *RuleRef:
+		if !isSpecial(expansion.Name) && validator.rules[expansion.Name] == nil {
+			validator.report("rule $%s: the referenced rule $%s is not defined", rule.Name, expansion.Name)
+		}
+	case *Tag:
+		if strings.Contains(expansion.Content, "}!}") {
+			validator.report("rule $%s: a tag contains '}!}'", rule.Name)
+		}
+	case nil:
+		validator.report("rule $%s: an expansion is nil", rule.Name)
+	default:
+		validator.report("rule $%s: unknown expansion %T", rule.Name, expansion)
+	}
+}
+
+// validateRecursion reports the rules that can reference themselves before matching any speech, which SRGS forbids
+func (validator *validator) validateRecursion() {
+	leftRefs := map[string][]string{}
+	for _, rule := range validator.grammar.Rules {
+		if rule.Expansion != nil {
+			leftRefs[rule.Name] = validator.leftRefs(rule.Expansion, nil)
+		}
+	}
+
+	names := make([]string, 0, len(leftRefs))
+	for name := range leftRefs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		visited := map[string]bool{}
+		pending := append([]string{}, leftRefs[name]...)
+		for len(pending) > 0 {
+			current := pending[0]
+			pending = pending[1:]
+			if current == name {
+				validator.report("rule $%s: the rule is left recursive", name)
+				break
+			}
+			if !visited[current] {
+				visited[current] = true
+				pending = append(pending, leftRefs[current]...)
+			}
+		}
+	}
+}
+
+// leftRefs appends the rules that an expansion can reference before matching any speech
+func (validator *validator) leftRefs(expansion Expansion, refs []string) []string {
+	switch expansion := expansion.(type) {
+	case *Sequence:
+		for _, item := range expansion.Items {
+			refs = validator.leftRefs(item, refs)
+			if !validator.nullable(item, map[string]bool{}) {
+				break
+			}
+		}
+	case *Alternatives:
+		for _, choice := range expansion.Choices {
+			refs = validator.leftRefs(choice, refs)
+		}
+	case *Weighted:
+		refs = validator.leftRefs(expansion.Expansion, refs)
+	case *Repeat:
+		refs = validator.leftRefs(expansion.Expansion, refs)
+	case *RuleRef:
+		if validator.rules[expansion.Name] != nil {
+			refs = append(refs, expansion.Name)
+		}
+	}
+	return refs
+}
+
+// nullable reports whether an expansion can match without any speech
+func (validator *validator) nullable(expansion Expansion, visiting map[string]bool) bool {
+	switch expansion := expansion.(type) {
+	case *Token:
+		return false
+	case *Sequence:
+		for _, item := range expansion.Items {
+			if !validator.nullable(item, visiting) {
+				return false
+			}
+		}
+		return true
+	case *Alternatives:
+		for _, choice := range expansion.Choices {
+			if validator.nullable(choice, visiting) {
+				return true
+			}
+		}
+		return false
+	case *Weighted:
+		return validator.nullable(expansion.Expansion, visiting)
+	case *Repeat:
+		return expansion.Min == 0 || validator.nullable(expansion.Expansion, visiting)
+	case *RuleRef:
+		if expansion.Name == SPECIAL_NULL {
+			return true
+		}
+		rule := validator.rules[expansion.Name]
+		if rule == nil || rule.Expansion == nil || visiting[expansion.Name] {
+			return false
+		}
+		visiting[expansion.Name] = true
+		defer delete(visiting, expansion.Name)
+		return validator.nullable(rule.Expansion, visiting)
+	case *Tag:
+		return true
+	}
+	return false
+}
+
+// Validate : Parses a grammar in the form of the content type and validates it
+func Validate(contentType string, source string) error {
+	var grammar *Grammar
+	var err error
+	switch contentType {
+	case CONTENT_TYPE_ABNF:
+		grammar, err = ParseABNF(source)
+	case CONTENT_TYPE_XML:
+		grammar, err = ParseXML(source)
+	default:
+		return fmt.Errorf("Unknown grammar content type '%s'", contentType)
+	}
+	if err != nil {
+		return err
+	}
+	return grammar.Validate()
+}
+
+func isSpecial(name string) bool {
+	return name == SPECIAL_NULL || name == SPECIAL_VOID || name == SPECIAL_GARBAGE
+}
+
+// repeatRange formats the bounds of a repeat as in both forms, such as 1-3, 2- or 4
+func repeatRange(repeat *Repeat) string {
+	switch {
+	case repeat.Max == UNBOUNDED:
+		return fmt.Sprintf("%d-", repeat.Min)
+	case repeat.Min == repeat.Max:
+		return fmt.Sprintf("%d", repeat.Min)
+	default:
+		return fmt.Sprintf("%d-%d", repeat.Min, repeat.Max)
+	}
+}
@@ -0,0 +1,207 @@
+package grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newPizzaGrammar() *Grammar {
+	return New("en-US", "order").
+		SetTagFormat("semantics/1.0").
+		PublicRule("order",
+			Optional(Words("i would like")),
+			RepeatOf(Ref("size"), 1, 1),
+			Ref("topping"),
+			Word("pizza"),
+			TagOf("out.kind='pizza';")).
+		PrivateRule("size", OneOf(WithWeight(2, Word("large")), Word("small"), Word("extra large"))).
+		PrivateRule("topping", RepeatOf(OneOf(Word("cheese"), Words("bell pepper")), 1, UNBOUNDED))
+}
+
+const pizzaABNF = `#ABNF 1.0 UTF-8;
+language en-US;
+mode voice;
+root $order;
+tag-format <semantics/1.0>;
+
+public $order = [i would like] $size<1> $topping pizza {out.kind='pizza';};
+
+$size = /2/ large | small | "extra large";
+
+$topping = (cheese | bell pepper)<1->;
+`
+
+const pizzaXML = `<?xml version="1.0" encoding="UTF-8"?>
+<grammar xmlns="http://www.w3.org/2001/06/grammar" version="1.0" xml:lang="en-US" mode="voice" root="order" tag-format="semantics/1.0">
+  <rule id="order" scope="public"><item repeat="0-1">i would like</item> <item repeat="1"><ruleref uri="#size"/></item> <ruleref uri="#topping"/> pizza <tag>out.kind=&#39;pizza&#39;;</tag></rule>
+  <rule id="size" scope="private"><one-of><item weight="2">large</item><item>small</item><item><token>extra large</token></item></one-of></rule>
+  <rule id="topping" scope="private"><item repeat="1-"><one-of><item>cheese</item><item>bell pepper</item></one-of></item></rule>
+</grammar>
+`
+
+func TestGrammarABNF(t *testing.T) {
+	abnf, err := newPizzaGrammar().ABNF()
+	assert.Nil(t, err)
+	assert.Equal(t, pizzaABNF, abnf)
+
+	parsed, err := ParseABNF(abnf)
+	assert.Nil(t, err)
+	assert.Equal(t, newPizzaGrammar(), parsed)
+	assert.Nil(t, Validate(CONTENT_TYPE_ABNF, abnf))
+}
+
+func TestGrammarXML(t *testing.T) {
+	document, err := newPizzaGrammar().XML()
+	assert.Nil(t, err)
+	assert.Equal(t, pizzaXML, document)
+
+	parsed, err := ParseXML(document)
+	assert.Nil(t, err)
+	assert.Equal(t, newPizzaGrammar(), parsed)
+	assert.Nil(t, Validate(CONTENT_TYPE_XML, document))
+}
+
+func TestParseABNF(t *testing.T) {
+	grammar, err := ParseABNF(`#ABNF 1.0;
+// A comment
+language en-US; mode voice; root $main;
+/* A block
+   comment */
+public $main = /0.3/ yes {!{ out = {answer: true}; }!} | /0.7/ (no | nope)<0-2 /0.5/> $NULL;
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, &Grammar{
+		Language: "en-US",
+		Root:     "main",
+		Rules: []*Rule{{Name: "main", Public: true, Expansion: &Alternatives{Choices: []Expansion{
+			&Weighted{Weight: 0.3, Expansion: Seq(Word("yes"), TagOf(" out = {answer: true}; "))},
+			&Weighted{Weight: 0.7, Expansion: Seq(
+				&Repeat{Expansion: OneOf(Word("no"), Word("nope")), Min: 0, Max: 2, Probability: 0.5},
+				Ref(SPECIAL_NULL))},
+		}}}},
+	}, grammar)
+	assert.Nil(t, grammar.Validate())
+}
+
+func TestParseABNFErrors(t *testing.T) {
+	tests := map[string]string{
+		"missing header":       "language en-US;",
+		"unterminated rule":    "#ABNF 1.0;\nroot $a;\n$a = hello",
+		"unbalanced group":     "#ABNF 1.0;\nroot $a;\n$a = (hello | hi;",
+		"empty alternative":    "#ABNF 1.0;\nroot $a;\n$a = hello | ;",
+		"invalid repeat":       "#ABNF 1.0;\nroot $a;\n$a = hello<x>;",
+		"language attachment":  "#ABNF 1.0;\nroot $a;\n$a = hello!en-US;",
+		"external reference":   "#ABNF 1.0;\nroot $a;\n$a = $<other.abnf#b>;",
+		"unsupported mode":     "#ABNF 1.0;\nmode dtmf;",
+		"unknown declaration":  "#ABNF 1.0;\nlanguages en-US;",
+		"unterminated comment": "#ABNF 1.0;\nroot $a;\n$a = hello /* ;",
+	}
+	for name, source := range tests {
+		_, err := ParseABNF(source)
+		assert.IsType(t, &SyntaxError{}, err, name)
+	}
+
+	_, err := ParseABNF("#ABNF 1.0;\nroot $a;\n\n$a = (hello | hi;")
+	assert.EqualError(t, err, "Grammar syntax error at line 4, column 17: expected ')'")
+}
+
+func TestParseXML(t *testing.T) {
+	grammar, err := ParseXML(`<?xml version="1.0"?>
+<grammar xmlns="http://www.w3.org/2001/06/grammar" version="1.0" xml:lang="en-GB" root="main">
+  <meta name="author" content="someone"/>
+  <rule id="main" scope="public">
+    <example>call home</example>
+    call <ruleref uri="#place"/> <ruleref special="GARBAGE"/>
+  </rule>
+  <rule id="place">
+    <one-of>
+      <item>home</item>
+      <item><token>the   office</token></item>
+    </one-of>
+  </rule>
+</grammar>`)
+	assert.Nil(t, err)
+	assert.Equal(t, &Grammar{
+		Language: "en-GB",
+		Root:     "main",
+		Rules: []*Rule{
+			{Name: "main", Public: true, Expansion: Seq(Word("call"), Ref("place"), Ref(SPECIAL_GARBAGE))},
+			{Name: "place", Expansion: OneOf(Word("home"), Word("the office"))},
+		},
+	}, grammar)
+	assert.Nil(t, grammar.Validate())
+}
+
+func TestParseXMLErrors(t *testing.T) {
+	tests := map[string]string{
+		"malformed":         `<grammar version="1.0"><rule id="a">hello</grammar>`,
+		"root element":      `<rules version="1.0"/>`,
+		"version":           `<grammar version="2.0"/>`,
+		"rule id":           `<grammar version="1.0"><rule>hello</rule></grammar>`,
+		"unknown element":   `<grammar version="1.0"><rule id="a"><say>hello</say></rule></grammar>`,
+		"one-of content":    `<grammar version="1.0"><rule id="a"><one-of>hello</one-of></rule></grammar>`,
+		"external ruleref":  `<grammar version="1.0"><rule id="a"><ruleref uri="other.xml#b"/></rule></grammar>`,
+		"invalid repeat":    `<grammar version="1.0"><rule id="a"><item repeat="1-x">hello</item></rule></grammar>`,
+		"misplaced weight":  `<grammar version="1.0"><rule id="a"><item weight="2">hello</item></rule></grammar>`,
+		"foreign namespace": `<grammar xmlns="http://example.com" version="1.0"/>`,
+	}
+	for name, source := range tests {
+		_, err := ParseXML(source)
+		assert.IsType(t, &SyntaxError{}, err, name)
+	}
+
+	_, err := ParseXML("<grammar version=\"1.0\">\n  <rule id=\"a\">\n    <say>hello</say>\n  </rule>\n</grammar>")
+	assert.EqualError(t, err, "Grammar syntax error at line 3, column 5: unexpected element say")
+}
+
+func TestValidate(t *testing.T) {
+	grammar := &Grammar{
+		Root: "missing",
+		Rules: []*Rule{
+			{Name: "a", Expansion: Seq(Optional(Word("well")), Ref("b"), Word("end"))},
+			{Name: "b", Expansion: OneOf(Ref("a"), Word("x"))},
+			{Name: "e", Expansion: Word("y")},
+			{Name: "e", Expansion: Word("y")},
+			{Name: "NULL", Expansion: Word("z")},
+			{Name: "bad name", Expansion: Word("z")},
+			{Name: "c", Expansion: Seq(
+				Ref("undefined"),
+				RepeatOf(Word("r"), 3, 2),
+				Word(""),
+				WithWeight(1, Word("w")),
+				OneOf(WithWeight(0, Word("v"))),
+				&Sequence{})},
+		},
+	}
+	err := grammar.Validate()
+	assert.IsType(t, &ValidationError{}, err)
+	assert.Equal(t, []string{
+		"the language is missing",
+		"the rule $e is defined more than once",
+		"the special rule $NULL cannot be defined",
+		"the rule name 'bad name' is invalid",
+		"the root rule $missing is not defined",
+		"rule $c: the referenced rule $undefined is not defined",
+		"rule $c: the repeat 3-2 is invalid",
+		"rule $c: a token is empty",
+		"rule $c: a weight is only allowed on a choice of alternatives",
+		"rule $c: the weight 0 is not positive",
+		"rule $c: a sequence is empty",
+		"rule $a: the rule is left recursive",
+		"rule $b: the rule is left recursive",
+	}, err.(*ValidationError).Problems)
+
+	_, err = grammar.ABNF()
+	assert.NotNil(t, err)
+	_, err = grammar.XML()
+	assert.NotNil(t, err)
+
+	// Recursion after speech is allowed
+	assert.Nil(t, New("en-US", "list").
+		PublicRule("list", Word("item"), Optional(Word("and"), Ref("list"))).
+		Validate())
+
+	assert.NotNil(t, Validate("text/plain", "hello"))
+	assert.IsType(t, &ValidationError{}, Validate(CONTENT_TYPE_ABNF, "#ABNF 1.0;\nlanguage en-US;\nroot $a;\n$a = $b;"))
+}
@@ -0,0 +1,365 @@
+package grammar
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SRGS_NAMESPACE is the namespace of the elements of the XML form
+const SRGS_NAMESPACE = "http://www.w3.org/2001/06/grammar"
+
+// xmlLangNamespace is the namespace of the xml:lang attribute, as reported by the decoder
+const xmlLangNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// XML : Writes the grammar in the XML form, once validated
+func (grammar *Grammar) XML() (string, error) {
+	if err := grammar.Validate(); err != nil {
+		return "", err
+	}
+
+	var document strings.Builder
+	document.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	fmt.Fprintf(&document, `<grammar xmlns="%s" version="1.0" xml:lang="%s" mode="voice" root="%s"`,
+		SRGS_NAMESPACE, escapeXML(grammar.Language), escapeXML(grammar.Root))
+	if grammar.TagFormat != "" {
+		fmt.Fprintf(&document, ` tag-format="%s"`, escapeXML(grammar.TagFormat))
+	}
+	document.WriteString(">\n")
+	for _, rule := range grammar.Rules {
+		scope := "private"
+		if rule.Public {
+			scope = "public"
+		}
+		fmt.Fprintf(&document, `  <rule id="%s" scope="%s">%s</rule>`+"\n", escapeXML(rule.Name), scope, formatXML(rule.Expansion))
+	}
+	document.WriteString("</grammar>\n")
+	return document.String(), nil
+}
+
+// formatXML writes an expansion as the content of an element
+func formatXML(expansion Expansion) string {
+	switch expansion := expansion.(type) {
+	case *Token:
+		if strings.ContainsAny(expansion.Text, " \t") {
+			return "<token>" + escapeXML(expansion.Text) + "</token>"
+		}
+		return escapeXML(expansion.Text)
+	case *Sequence:
+		items := make([]string, len(expansion.Items))
+		for i, item := range expansion.Items {
+			items[i] = formatXML(item)
+		}
+		return strings.Join(items, " ")
+	case *Alternatives:
+		var oneOf strings.Builder
+		oneOf.WriteString("<one-of>")
+		for _, choice := range expansion.Choices {
+			if weighted, ok := choice.(*Weighted); ok {
+				fmt.Fprintf(&oneOf, `<item weight="%s">%s</item>`, formatNumber(weighted.Weight), formatXML(weighted.Expansion))
+			} else {
+				fmt.Fprintf(&oneOf, "<item>%s</item>", formatXML(choice))
+			}
+		}
+		oneOf.WriteString("</one-of>")
+		return oneOf.String()
+	case *Repeat:
+		probability := ""
+		if expansion.Probability >= 0 {
+			probability = fmt.Sprintf(` repeat-prob="%s"`, formatNumber(expansion.Probability))
+		}
+		return fmt.Sprintf(`<item repeat="%s"%s>%s</item>`, repeatRange(expansion), probability, formatXML(expansion.Expansion))
+	case *RuleRef:
+		if isSpecial(expansion.Name) {
+			return fmt.Sprintf(`<ruleref special="%s"/>`, expansion.Name)
+		}
+		return fmt.Sprintf(`<ruleref uri="#%s"/>`, escapeXML(expansion.Name))
+	case *Tag:
+		return "<tag>" + escapeXML(expansion.Content) + "</tag>"
+	}
+	return ""
+}
+
+func escapeXML(text string) string {
+	var escaped bytes.Buffer
+	_ = xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
+
+// xmlElement is an element of the XML form, with its content in order
+type xmlElement struct {
+	name    string
+	attrs   map[string]string
+	content []interface{}
+	offset  int64
+}
+
+// ParseXML : Reads a grammar in the XML form. Only the syntax is checked; call Validate on the grammar to check its
+// rules.
+func ParseXML(source string) (*Grammar, error) {
+	parser := &xmlParser{source: source, decoder: xml.NewDecoder(strings.NewReader(source))}
+	root, err := parser.readDocument()
+	if err != nil {
+		return nil, err
+	}
+	return parser.parseGrammar(root)
+}
+
+type xmlParser struct {
+	source  string
+	decoder *xml.Decoder
+}
+
+// fail returns a SyntaxError at the offset of an element
+func (parser *xmlParser) fail(element *xmlElement, format string, args ...interface{}) error {
+	before := parser.source[:element.offset]
+	line := strings.Count(before, "\n") + 1
+	column := len([]rune(before[strings.LastIndex(before, "\n")+1:])) + 1
+	return &SyntaxError{Line: line, Column: column, Message: fmt.Sprintf(format, args...)}
+}
+
+// readDocument reads the tree of elements of the document
+func (parser *xmlParser) readDocument() (*xmlElement, error) {
+	for {
+		offset := parser.decoder.InputOffset()
+		token, err := parser.decoder.Token()
+		if err == io.EOF {
+			return nil, &SyntaxError{Line: 1, Column: 1, Message: "the document has no grammar element"}
+		}
+		if err != nil {
+			return nil, parser.syntaxError(err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return parser.readElement(start, offset)
+		}
+	}
+}
+
+func (parser *xmlParser) readElement(start xml.StartElement, offset int64) (*xmlElement, error) {
+	if start.Name.Space != "" && start.Name.Space != SRGS_NAMESPACE {
+		return nil, parser.fail(&xmlElement{offset: offset}, "the element %s is not in the SRGS namespace", start.Name.Local)
+	}
+	element := &xmlElement{name: start.Name.Local, attrs: map[string]string{}, offset: offset}
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == xmlLangNamespace || attr.Name.Space == "xml":
+			element.attrs["xml:"+attr.Name.Local] = attr.Value
+		case attr.Name.Space == "" || attr.Name.Space == SRGS_NAMESPACE:
+			element.attrs[attr.Name.Local] = attr.Value
+		}
+	}
+
+	for {
+		childOffset := parser.decoder.InputOffset()
+		token, err := parser.decoder.Token()
+		if err != nil {
+			return nil, parser.syntaxError(err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			child, err := parser.readElement(token, childOffset)
+			if err != nil {
+				return nil, err
+			}
+			element.content = append(element.content, child)
+		case xml.CharData:
+			element.content = append(element.content, string(token))
+		case xml.EndElement:
+			return element, nil
+		}
+	}
+}
+
+// syntaxError converts an error of the decoder
+func (parser *xmlParser) syntaxError(err error) error {
+	if syntaxError, ok := err.(*xml.SyntaxError); ok {
+		return &SyntaxError{Line: syntaxError.Line, Column: 1, Message: syntaxError.Msg}
+	}
+	return err
+}
+
+func (parser *xmlParser) parseGrammar(root *xmlElement) (*Grammar, error) {
+	if root.name != "grammar" {
+		return nil, parser.fail(root, "the root element must be grammar, not %s", root.name)
+	}
+	if version := root.attrs["version"]; version != "1.0" {
+		return nil, parser.fail(root, "the grammar version must be 1.0")
+	}
+	if mode, ok := root.attrs["mode"]; ok && mode != "voice" {
+		return nil, parser.fail(root, "the mode '%s' is not supported, only voice is", mode)
+	}
+	grammar := &Grammar{
+		Language:  root.attrs["xml:lang"],
+		Root:      root.attrs["root"],
+		TagFormat: root.attrs["tag-format"],
+	}
+
+	for _, content := range root.content {
+		switch content := content.(type) {
+		case string:
+			if strings.TrimSpace(content) != "" {
+				return nil, parser.fail(root, "unexpected text '%s' outside of the rules", strings.TrimSpace(content))
+			}
+		case *xmlElement:
+			switch content.name {
+			case "rule":
+				rule, err := parser.parseRule(content)
+				if err != nil {
+					return nil, err
+				}
+				grammar.Rules = append(grammar.Rules, rule)
+			case "meta", "metadata", "lexicon", "tag":
+			default:
+				return nil, parser.fail(content, "unexpected element %s in the grammar", content.name)
+			}
+		}
+	}
+	return grammar, nil
+}
+
+func (parser *xmlParser) parseRule(element *xmlElement) (*Rule, error) {
+	name, ok := element.attrs["id"]
+	if !ok {
+		return nil, parser.fail(element, "the rule has no id")
+	}
+	scope := element.attrs["scope"]
+	if scope != "" && scope != "public" && scope != "private" {
+		return nil, parser.fail(element, "the scope '%s' of the rule %s is invalid", scope, name)
+	}
+	expansion, err := parser.parseContent(element)
+	if err != nil {
+		return nil, err
+	}
+	return &Rule{Name: name, Public: scope == "public", Expansion: expansion}, nil
+}
+
+// parseContent reads the content of a rule or an item as a sequence
+func (parser *xmlParser) parseContent(element *xmlElement) (Expansion, error) {
+	var items []Expansion
+	for _, content := range element.content {
+		switch content := content.(type) {
+		case string:
+			for _, word := range strings.Fields(content) {
+				items = append(items, &Token{Text: word})
+			}
+		case *xmlElement:
+			item, err := parser.parseElement(content)
+			if err != nil {
+				return nil, err
+			}
+			if item != nil {
+				items = append(items, item)
+			}
+		}
+	}
+	if len(items) == 0 {
+		return &Sequence{}, nil
+	}
+	return Seq(items...), nil
+}
+
+// parseElement reads an element of the content of a rule or an item. Examples are skipped.
+func (parser *xmlParser) parseElement(element *xmlElement) (Expansion, error) {
+	switch element.name {
+	case "token":
+		return &Token{Text: strings.Join(strings.Fields(textOf(element)), " ")}, nil
+	case "tag":
+		return &Tag{Content: textOf(element)}, nil
+	case "example":
+		return nil, nil
+	case "ruleref":
+		if special, ok := element.attrs["special"]; ok {
+			return &RuleRef{Name: special}, nil
+		}
+		uri := element.attrs["uri"]
+		if !strings.HasPrefix(uri, "#") {
+			return nil, parser.fail(element, "the reference '%s' is not local, references to external grammars are not supported", uri)
+		}
+		return &RuleRef{Name: strings.TrimPrefix(uri, "#")}, nil
+	case "one-of":
+		alternatives := &Alternatives{}
+		for _, content := range element.content {
+			switch content := content.(type) {
+			case string:
+				if strings.TrimSpace(content) != "" {
+					return nil, parser.fail(element, "unexpected text '%s' in one-of", strings.TrimSpace(content))
+				}
+			case *xmlElement:
+				if content.name != "item" {
+					return nil, parser.fail(content, "one-of can only contain item elements, not %s", content.name)
+				}
+				choice, err := parser.parseItem(content)
+				if err != nil {
+					return nil, err
+				}
+				if weight, ok := content.attrs["weight"]; ok {
+					value, err := strconv.ParseFloat(weight, 64)
+					if err != nil {
+						return nil, parser.fail(content, "invalid weight '%s'", weight)
+					}
+					choice = &Weighted{Weight: value, Expansion: choice}
+				}
+				alternatives.Choices = append(alternatives.Choices, choice)
+			}
+		}
+		return alternatives, nil
+	case "item":
+		if _, ok := element.attrs["weight"]; ok {
+			return nil, parser.fail(element, "a weight is only allowed on an item of one-of")
+		}
+		return parser.parseItem(element)
+	default:
+		return nil, parser.fail(element, "unexpected element %s", element.name)
+	}
+}
+
+// parseItem reads an item and its repeat
+func (parser *xmlParser) parseItem(element *xmlElement) (Expansion, error) {
+	expansion, err := parser.parseContent(element)
+	if err != nil {
+		return nil, err
+	}
+	bounds, ok := element.attrs["repeat"]
+	if !ok {
+		if _, ok := element.attrs["repeat-prob"]; ok {
+			return nil, parser.fail(element, "repeat-prob requires repeat")
+		}
+		return expansion, nil
+	}
+
+	repeat := &Repeat{Expansion: expansion, Probability: -1}
+	parts := strings.SplitN(bounds, "-", 2)
+	if repeat.Min, err = strconv.Atoi(parts[0]); err != nil {
+		return nil, parser.fail(element, "invalid repeat '%s'", bounds)
+	}
+	switch {
+	case len(parts) == 1:
+		repeat.Max = repeat.Min
+	case parts[1] == "":
+		repeat.Max = UNBOUNDED
+	default:
+		if repeat.Max, err = strconv.Atoi(parts[1]); err != nil {
+			return nil, parser.fail(element, "invalid repeat '%s'", bounds)
+		}
+	}
+	if probability, ok := element.attrs["repeat-prob"]; ok {
+		if repeat.Probability, err = strconv.ParseFloat(probability, 64); err != nil {
+			return nil, parser.fail(element, "invalid repeat-prob '%s'", probability)
+		}
+	}
+	return repeat, nil
+}
+
+// textOf returns the text of an element
+func textOf(element *xmlElement) string {
+	var text strings.Builder
+	for _, content := range element.content {
+		if content, ok := content.(string); ok {
+			text.WriteString(content)
+		}
+	}
+	return text.String()
+}
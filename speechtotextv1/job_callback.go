@@ -0,0 +1,157 @@
+package speechtotextv1
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// CALLBACK_SIGNATURE_HEADER is the header in which the service signs the requests sent to a callback URL
+const CALLBACK_SIGNATURE_HEADER = "X-Callback-Signature"
+
+// DEFAULT_MAX_NOTIFICATION_SIZE is the maximum size, in bytes, of the body of a notification accepted by a
+// JobCallbackHandler, unless SetMaxNotificationSize is called. It leaves room for the results of long recordings.
+const DEFAULT_MAX_NOTIFICATION_SIZE = 32 * ONE_KB * ONE_KB
+
+// JobNotification : A notification of the status of an asynchronous job, sent to its callback URL
+type JobNotification struct {
+	// The event that triggered the notification, one of the CreateJobOptions_Events_* constants.
+	Event string
+
+	// The job, with its ID, its user token and, for the `recognitions.completed_with_results` event, its results.
+	// Its status is derived from the event.
+	Job *RecognitionJob
+}
+
+// JobCallbackHandler : An http.Handler that receives the requests that the service sends to a callback URL
+// It answers the challenge of RegisterCallback, which allowlists the URL, and passes the notifications of the jobs
+// created with the URL to a function. When a secret was registered with the URL, the handler rejects the requests
+// whose X-Callback-Signature header is not the HMAC-SHA1 signature of their challenge or body with that secret.
+//
+// The body of a notification is read before its signature can be checked, so the handler rejects the bodies larger
+// than DEFAULT_MAX_NOTIFICATION_SIZE with status 413, or than the size set with SetMaxNotificationSize.
+type JobCallbackHandler struct {
+	secret              []byte
+	notify              func(notification *JobNotification) error
+	maxNotificationSize int64
+}
+
+// NewJobCallbackHandler : Instantiate JobCallbackHandler
+// The userSecret is the secret passed to RegisterCallback, or an empty string if none was. The notify function is
+// called for each notification; when it returns an error, the request is answered with status 500.
+func NewJobCallbackHandler(userSecret string, notify func(notification *JobNotification) error) (*JobCallbackHandler, error) {
+	if notify == nil {
+		return nil, fmt.Errorf("notify cannot be nil")
+	}
+	return &JobCallbackHandler{secret: []byte(userSecret), notify: notify, maxNotificationSize: DEFAULT_MAX_NOTIFICATION_SIZE}, nil
+}
+
+// SetMaxNotificationSize : Allow user to set the maximum size, in bytes, of the body of a notification
+func (handler *JobCallbackHandler) SetMaxNotificationSize(maxNotificationSize int64) *JobCallbackHandler {
+	handler.maxNotificationSize = maxNotificationSize
+	return handler
+}
+
+// ServeHTTP : Answer a challenge or handle a notification
+func (handler *JobCallbackHandler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		handler.serveChallenge(res, req)
+	case http.MethodPost:
+		handler.serveNotification(res, req)
+	default:
+		res.Header().Set("Allow", "GET, POST")
+		http.Error(res, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// serveChallenge echoes the challenge that the service sends to allowlist the callback URL
+func (handler *JobCallbackHandler) serveChallenge(res http.ResponseWriter, req *http.Request) {
+	challenge := req.URL.Query().Get("challenge_string")
+	if challenge == "" {
+		http.Error(res, "The challenge_string parameter is missing", http.StatusBadRequest)
+		return
+	}
+	if !handler.verify(req, []byte(challenge)) {
+		http.Error(res, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+	res.Header().Set("Content-Type", "text/plain")
+	res.WriteHeader(http.StatusOK)
+	_, _ = res.Write([]byte(challenge))
+}
+
+// serveNotification decodes a notification and passes it to the notify function
+func (handler *JobCallbackHandler) serveNotification(res http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(res, req.Body, handler.maxNotificationSize))
+	if err != nil && int64(len(body)) >= handler.maxNotificationSize {
+		http.Error(res, "The notification is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(res, "Unable to read the notification", http.StatusBadRequest)
+		return
+	}
+	if !handler.verify(req, body) {
+		http.Error(res, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	notification, err := parseJobNotification(body)
+	if err != nil {
+		http.Error(res, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := handler.notify(notification); err != nil {
+		http.Error(res, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	res.WriteHeader(http.StatusOK)
+}
+
+// verify checks the signature of a request, unless no secret was registered
+func (handler *JobCallbackHandler) verify(req *http.Request, payload []byte) bool {
+	if len(handler.secret) == 0 {
+		return true
+	}
+	signature, err := base64.StdEncoding.DecodeString(req.Header.Get(CALLBACK_SIGNATURE_HEADER))
+	if err != nil || len(signature) == 0 {
+		return false
+	}
+	mac := hmac.New(sha1.New, handler.secret)
+	mac.Write(payload)
+	return hmac.Equal(signature, mac.Sum(nil))
+}
+
+// parseJobNotification decodes the body of a notification
+func parseJobNotification(body []byte) (*JobNotification, error) {
+	var message struct {
+		RecognitionJob
+		Event *string `json:"event"`
+	}
+	if err := json.Unmarshal(body, &message); err != nil {
+		return nil, fmt.Errorf("Unable to decode the notification: %s", err.Error())
+	}
+	if message.ID == nil || message.Event == nil {
+		return nil, fmt.Errorf("The notification has no id or event")
+	}
+
+	job := message.RecognitionJob
+	switch *message.Event {
+	case CreateJobOptions_Events_RecognitionsStarted:
+		job.Status = core.StringPtr(RecognitionJob_Status_Processing)
+	case CreateJobOptions_Events_RecognitionsCompleted, CreateJobOptions_Events_RecognitionsCompletedWithResults:
+		job.Status = core.StringPtr(RecognitionJob_Status_Completed)
+	case CreateJobOptions_Events_RecognitionsFailed:
+		job.Status = core.StringPtr(RecognitionJob_Status_Failed)
+	default:
+		return nil, fmt.Errorf("Unknown event '%s'", *message.Event)
+	}
+	return &JobNotification{Event: *message.Event, Job: &job}, nil
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+func callbackSignature(secret string, payload string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+var _ = Describe(`JobCallbackHandler`, func() {
+	var notifications []*speechtotextv1.JobNotification
+	var handler *speechtotextv1.JobCallbackHandler
+
+	BeforeEach(func() {
+		notifications = nil
+		var err error
+		handler, err = speechtotextv1.NewJobCallbackHandler("secret", func(notification *speechtotextv1.JobNotification) error {
+			notifications = append(notifications, notification)
+			if *notification.Job.ID == "broken" {
+				return fmt.Errorf("unable to store the results")
+			}
+			return nil
+		})
+		Expect(err).To(BeNil())
+	})
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder
+	}
+	notify := func(body string, signature string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/callback", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(speechtotextv1.CALLBACK_SIGNATURE_HEADER, signature)
+		return serve(req)
+	}
+
+	It(`Answers the allowlisting challenge`, func() {
+		req := httptest.NewRequest("GET", "/callback?challenge_string="+url.QueryEscape("a+b/c"), nil)
+		req.Header.Set(speechtotextv1.CALLBACK_SIGNATURE_HEADER, callbackSignature("secret", "a+b/c"))
+		res := serve(req)
+		Expect(res.Code).To(Equal(200))
+		Expect(res.Body.String()).To(Equal("a+b/c"))
+
+		req = httptest.NewRequest("GET", "/callback?challenge_string=abc", nil)
+		req.Header.Set(speechtotextv1.CALLBACK_SIGNATURE_HEADER, callbackSignature("other", "abc"))
+		Expect(serve(req).Code).To(Equal(401))
+
+		Expect(serve(httptest.NewRequest("GET", "/callback", nil)).Code).To(Equal(400))
+	})
+	It(`Passes signed notifications to the function`, func() {
+		body := `{"id": "job", "event": "recognitions.completed_with_results", "user_token": "token", "results": [{"results": [{"final": true, "alternatives": [{"transcript": "some audio"}]}], "result_index": 0}]}`
+		res := notify(body, callbackSignature("secret", body))
+		Expect(res.Code).To(Equal(200))
+		Expect(notifications).To(HaveLen(1))
+		Expect(notifications[0].Event).To(Equal(speechtotextv1.CreateJobOptions_Events_RecognitionsCompletedWithResults))
+		job := notifications[0].Job
+		Expect(*job.ID).To(Equal("job"))
+		Expect(*job.UserToken).To(Equal("token"))
+		Expect(*job.Status).To(Equal(speechtotextv1.RecognitionJob_Status_Completed))
+		Expect(*job.Results[0].Results[0].Alternatives[0].Transcript).To(Equal("some audio"))
+
+		body = `{"id": "job", "event": "recognitions.started"}`
+		Expect(notify(body, callbackSignature("secret", body)).Code).To(Equal(200))
+		Expect(*notifications[1].Job.Status).To(Equal(speechtotextv1.RecognitionJob_Status_Processing))
+	})
+	It(`Rejects unsigned, tampered or malformed notifications`, func() {
+		body := `{"id": "job", "event": "recognitions.failed"}`
+		Expect(notify(body, "").Code).To(Equal(401))
+		Expect(notify(body, callbackSignature("secret", `{"id": "other", "event": "recognitions.failed"}`)).Code).To(Equal(401))
+
+		body = `{"id": "job", "event": "recognitions.unknown"}`
+		Expect(notify(body, callbackSignature("secret", body)).Code).To(Equal(400))
+		Expect(notifications).To(BeEmpty())
+
+		Expect(serve(httptest.NewRequest("PUT", "/callback", nil)).Code).To(Equal(405))
+	})
+	It(`Rejects notifications larger than the maximum size`, func() {
+		body := `{"id": "job", "event": "recognitions.failed"}`
+		handler.SetMaxNotificationSize(int64(len(body)))
+		Expect(notify(body, callbackSignature("secret", body)).Code).To(Equal(200))
+
+		body = `{"id": "job", "event": "recognitions.failed" }`
+		Expect(notify(body, callbackSignature("secret", body)).Code).To(Equal(413))
+		Expect(notifications).To(HaveLen(1))
+	})
+	It(`Answers with an error when the function fails`, func() {
+		body := `{"id": "broken", "event": "recognitions.completed"}`
+		Expect(notify(body, callbackSignature("secret", body)).Code).To(Equal(500))
+	})
+	It(`Accepts unsigned requests when no secret was registered`, func() {
+		handler, err := speechtotextv1.NewJobCallbackHandler("", func(notification *speechtotextv1.JobNotification) error {
+			notifications = append(notifications, notification)
+			return nil
+		})
+		Expect(err).To(BeNil())
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("POST", "/callback", strings.NewReader(`{"id": "job", "event": "recognitions.failed"}`)))
+		Expect(recorder.Code).To(Equal(200))
+		Expect(*notifications[0].Job.Status).To(Equal(speechtotextv1.RecognitionJob_Status_Failed))
+
+		_, err = speechtotextv1.NewJobCallbackHandler("", nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
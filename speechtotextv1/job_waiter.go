@@ -0,0 +1,128 @@
+package speechtotextv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+const (
+	DEFAULT_JOB_POLLING_INTERVAL     = time.Second
+	DEFAULT_MAX_JOB_POLLING_INTERVAL = 30 * time.Second
+	DEFAULT_JOB_POLLING_MULTIPLIER   = 2.0
+)
+
+// ErrJobFailed is returned by WaitForJob, along with the job, when the status of the job is failed
+var ErrJobFailed = errors.New("The recognition job failed")
+
+// JobPollingPolicy : How WaitForJob polls the status of a job
+type JobPollingPolicy struct {
+	// The time waited before checking the job again, the first time.
+	Interval time.Duration
+
+	// The maximum time waited between two checks.
+	MaxInterval time.Duration
+
+	// The factor applied to the time waited after each check.
+	Multiplier float64
+
+	// If `true`, the job is deleted once it is completed or failed.
+	DeleteJob bool
+}
+
+// NewJobPollingPolicy : Instantiate JobPollingPolicy
+func NewJobPollingPolicy() *JobPollingPolicy {
+	return &JobPollingPolicy{
+		Interval:    DEFAULT_JOB_POLLING_INTERVAL,
+		MaxInterval: DEFAULT_MAX_JOB_POLLING_INTERVAL,
+		Multiplier:  DEFAULT_JOB_POLLING_MULTIPLIER,
+	}
+}
+
+// SetInterval : Allow user to set Interval
+func (policy *JobPollingPolicy) SetInterval(interval time.Duration) *JobPollingPolicy {
+	policy.Interval = interval
+	return policy
+}
+
+// SetMaxInterval : Allow user to set MaxInterval
+func (policy *JobPollingPolicy) SetMaxInterval(maxInterval time.Duration) *JobPollingPolicy {
+	policy.MaxInterval = maxInterval
+	return policy
+}
+
+// SetMultiplier : Allow user to set Multiplier
+func (policy *JobPollingPolicy) SetMultiplier(multiplier float64) *JobPollingPolicy {
+	policy.Multiplier = multiplier
+	return policy
+}
+
+// SetDeleteJob : Allow user to set DeleteJob
+func (policy *JobPollingPolicy) SetDeleteJob(deleteJob bool) *JobPollingPolicy {
+	policy.DeleteJob = deleteJob
+	return policy
+}
+
+// WaitForJob : Wait for an asynchronous job to end
+// Checks the status of a job created without a callback URL until it is `completed` or `failed`, waiting longer
+// between checks as set by the policy. The job is returned with its results and the response of the last check.
+//
+// When the job failed, it is returned with ErrJobFailed. When the policy deletes the job and the deletion fails, the
+// job is returned with the error of the deletion. When the context is done, the last status of the job is returned
+// with the error of the context. If policy is nil, the job is checked after 1 second, then after twice as long each
+// time, up to 30 seconds, and is not deleted.
+func (speechToText *SpeechToTextV1) WaitForJob(ctx context.Context, jobID string, policy *JobPollingPolicy) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	if jobID == "" {
+		err = fmt.Errorf("jobID cannot be empty")
+		return
+	}
+	if policy == nil {
+		policy = NewJobPollingPolicy()
+	}
+	if policy.Interval <= 0 || policy.MaxInterval < policy.Interval || policy.Multiplier < 1 {
+		err = fmt.Errorf("The polling policy requires a positive interval, a larger maximum interval and a multiplier of at least 1")
+		return
+	}
+
+	interval := policy.Interval
+	for {
+		var job *RecognitionJob
+		job, response, err = speechToText.CheckJobWithContext(ctx, speechToText.NewCheckJobOptions(jobID))
+		if err != nil {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			return
+		}
+		result = job
+
+		if result.Status != nil && (*result.Status == RecognitionJob_Status_Completed || *result.Status == RecognitionJob_Status_Failed) {
+			if *result.Status == RecognitionJob_Status_Failed {
+				err = ErrJobFailed
+			}
+			if policy.DeleteJob {
+				if _, deleteErr := speechToText.DeleteJobWithContext(ctx, speechToText.NewDeleteJobOptions(jobID)); deleteErr != nil {
+					err = deleteErr
+				}
+			}
+			return
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = ctx.Err()
+			return
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * policy.Multiplier)
+		if interval > policy.MaxInterval {
+			interval = policy.MaxInterval
+		}
+	}
+}
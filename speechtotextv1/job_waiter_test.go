@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// jobServer answers the checks of a job with the given statuses, repeating the last one, and records the deletions
+type jobServer struct {
+	*httptest.Server
+	lock     sync.Mutex
+	checks   int
+	deletes  int
+	statuses []string
+}
+
+func newJobServer(statuses ...string) *jobServer {
+	server := &jobServer{statuses: statuses}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		server.lock.Lock()
+		defer server.lock.Unlock()
+		Expect(req.URL.Path).To(Equal("/v1/recognitions/job"))
+		if req.Method == "DELETE" {
+			server.deletes++
+			res.WriteHeader(204)
+			return
+		}
+		Expect(req.Method).To(Equal("GET"))
+		status := server.statuses[len(server.statuses)-1]
+		if server.checks < len(server.statuses) {
+			status = server.statuses[server.checks]
+		}
+		server.checks++
+
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		results := ""
+		if status == "completed" {
+			results = `, "results": [{"results": [{"final": true, "alternatives": [{"transcript": "some audio"}]}], "result_index": 0}]`
+		}
+		fmt.Fprintf(res, `{"id": "job", "status": "%s", "created": "2020-01-01T00:00:00Z"%s}`, status, results)
+	}))
+	return server
+}
+
+var _ = Describe(`WaitForJob(ctx context.Context, jobID string, policy *JobPollingPolicy)`, func() {
+	policy := func() *speechtotextv1.JobPollingPolicy {
+		return speechtotextv1.NewJobPollingPolicy().SetInterval(time.Millisecond).SetMaxInterval(5 * time.Millisecond)
+	}
+
+	It(`Polls until the job is completed`, func() {
+		testServer := newJobServer("waiting", "processing", "processing", "completed")
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		job, response, err := testService.WaitForJob(context.Background(), "job", policy())
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*job.Status).To(Equal(speechtotextv1.RecognitionJob_Status_Completed))
+		Expect(*job.Results[0].Results[0].Alternatives[0].Transcript).To(Equal("some audio"))
+		Expect(testServer.checks).To(Equal(4))
+		Expect(testServer.deletes).To(Equal(0))
+	})
+	It(`Deletes the job when the policy asks for it`, func() {
+		testServer := newJobServer("completed")
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		job, _, err := testService.WaitForJob(context.Background(), "job", policy().SetDeleteJob(true))
+		Expect(err).To(BeNil())
+		Expect(job.Results).To(HaveLen(1))
+		Expect(testServer.deletes).To(Equal(1))
+	})
+	It(`Returns a failed job with ErrJobFailed`, func() {
+		testServer := newJobServer("processing", "failed")
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		job, _, err := testService.WaitForJob(context.Background(), "job", policy().SetDeleteJob(true))
+		Expect(err).To(Equal(speechtotextv1.ErrJobFailed))
+		Expect(*job.Status).To(Equal(speechtotextv1.RecognitionJob_Status_Failed))
+		Expect(testServer.deletes).To(Equal(1))
+	})
+	It(`Stops when the context is done`, func() {
+		testServer := newJobServer("processing")
+		defer testServer.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		testService := newWebsocketTestService(testServer.URL)
+		job, _, err := testService.WaitForJob(ctx, "job", policy())
+		Expect(err).To(Equal(context.DeadlineExceeded))
+		Expect(*job.Status).To(Equal(speechtotextv1.RecognitionJob_Status_Processing))
+		Expect(testServer.deletes).To(Equal(0))
+	})
+	It(`Returns an error for invalid arguments`, func() {
+		testService := newWebsocketTestService("http://localhost")
+		_, _, err := testService.WaitForJob(context.Background(), "", nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = testService.WaitForJob(context.Background(), "job", policy().SetMultiplier(0.5))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,411 @@
+package speechtotextv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+const (
+	DEFAULT_LANGUAGE_MODEL_POLLING_INTERVAL = 10 * time.Second
+	DEFAULT_LANGUAGE_MODEL_ANALYSIS_TIMEOUT = 15 * time.Minute
+	DEFAULT_LANGUAGE_MODEL_TRAINING_TIMEOUT = time.Hour
+)
+
+// CORPUS_FILE_EXTENSION is the extension of the files of a corpora directory added as corpora
+const CORPUS_FILE_EXTENSION = ".txt"
+
+// BuildLanguageModelOptions : The BuildLanguageModel options.
+type BuildLanguageModelOptions struct {
+	// The options of the custom language model to create. Either CreateLanguageModelOptions or CustomizationID is
+	// required.
+	CreateLanguageModelOptions *CreateLanguageModelOptions
+
+	// The customization ID (GUID) of an existing custom language model to add the corpora and words to.
+	CustomizationID *string
+
+	// A directory whose .txt files are added as corpora, each named after its file without the extension.
+	CorporaDirectory *string
+
+	// A JSON file of custom words, either a list of words or an object with a `words` list, as sent by AddWords.
+	WordsFile *string
+
+	// If `true`, the corpora replace the corpora of the same name of an existing custom language model.
+	AllowOverwrite *bool
+
+	// The type of words from the custom model's words resource on which to train the model, one of the
+	// TrainLanguageModelOptions_WordTypeToAdd_* constants.
+	WordTypeToAdd *string
+
+	// The customization weight of the trained model.
+	CustomizationWeight *float64
+
+	// The time waited between two checks of the status of a corpus or of the model.
+	PollingInterval *time.Duration
+
+	// The maximum time waited for a corpus to be analyzed, or for the model to be ready once the words are added.
+	AnalysisTimeout *time.Duration
+
+	// The maximum time waited for the training to end.
+	TrainingTimeout *time.Duration
+}
+
+// NewBuildLanguageModelOptions : Instantiate BuildLanguageModelOptions
+func (speechToText *SpeechToTextV1) NewBuildLanguageModelOptions() *BuildLanguageModelOptions {
+	return &BuildLanguageModelOptions{}
+}
+
+// SetCreateLanguageModelOptions : Allow user to set CreateLanguageModelOptions
+func (options *BuildLanguageModelOptions) SetCreateLanguageModelOptions(createLanguageModelOptions *CreateLanguageModelOptions) *BuildLanguageModelOptions {
+	options.CreateLanguageModelOptions = createLanguageModelOptions
+	return options
+}
+
+// SetCustomizationID : Allow user to set CustomizationID
+func (options *BuildLanguageModelOptions) SetCustomizationID(customizationID string) *BuildLanguageModelOptions {
+	options.CustomizationID = core.StringPtr(customizationID)
+	return options
+}
+
+// SetCorporaDirectory : Allow user to set CorporaDirectory
+func (options *BuildLanguageModelOptions) SetCorporaDirectory(corporaDirectory string) *BuildLanguageModelOptions {
+	options.CorporaDirectory = core.StringPtr(corporaDirectory)
+	return options
+}
+
+// SetWordsFile : Allow user to set WordsFile
+func (options *BuildLanguageModelOptions) SetWordsFile(wordsFile string) *BuildLanguageModelOptions {
+	options.WordsFile = core.StringPtr(wordsFile)
+	return options
+}
+
+// SetAllowOverwrite : Allow user to set AllowOverwrite
+func (options *BuildLanguageModelOptions) SetAllowOverwrite(allowOverwrite bool) *BuildLanguageModelOptions {
+	options.AllowOverwrite = core.BoolPtr(allowOverwrite)
+	return options
+}
+
+// SetWordTypeToAdd : Allow user to set WordTypeToAdd
+func (options *BuildLanguageModelOptions) SetWordTypeToAdd(wordTypeToAdd string) *BuildLanguageModelOptions {
+	options.WordTypeToAdd = core.StringPtr(wordTypeToAdd)
+	return options
+}
+
+// SetCustomizationWeight : Allow user to set CustomizationWeight
+func (options *BuildLanguageModelOptions) SetCustomizationWeight(customizationWeight float64) *BuildLanguageModelOptions {
+	options.CustomizationWeight = core.Float64Ptr(customizationWeight)
+	return options
+}
+
+// SetPollingInterval : Allow user to set PollingInterval
+func (options *BuildLanguageModelOptions) SetPollingInterval(pollingInterval time.Duration) *BuildLanguageModelOptions {
+	options.PollingInterval = &pollingInterval
+	return options
+}
+
+// SetAnalysisTimeout : Allow user to set AnalysisTimeout
+func (options *BuildLanguageModelOptions) SetAnalysisTimeout(analysisTimeout time.Duration) *BuildLanguageModelOptions {
+	options.AnalysisTimeout = &analysisTimeout
+	return options
+}
+
+// SetTrainingTimeout : Allow user to set TrainingTimeout
+func (options *BuildLanguageModelOptions) SetTrainingTimeout(trainingTimeout time.Duration) *BuildLanguageModelOptions {
+	options.TrainingTimeout = &trainingTimeout
+	return options
+}
+
+// LanguageModelBuild : The outcome of BuildLanguageModel
+type LanguageModelBuild struct {
+	// The customization ID (GUID) of the custom language model.
+	CustomizationID string
+
+	// The custom language model, as it was last checked.
+	LanguageModel *LanguageModel
+
+	// The corpora added to the model, once analyzed.
+	Corpora []Corpus
+
+	// The out-of-vocabulary words that the corpora added to the model.
+	OutOfVocabularyWords []Word
+
+	// The words of the model that the service found invalid, which are not used for training.
+	InvalidWords []Word
+
+	// The warnings of the training.
+	TrainingWarnings []TrainingWarning
+}
+
+// BuildLanguageModel : Build a custom language model from local files
+// Creates a custom language model, unless the options name an existing one, then adds the corpora of a directory
+// one at a time, waiting for the service to analyze each of them, adds the words of a file, waits for the model to
+// be ready, trains it and waits for it to be available. Each wait fails after the timeout of the options.
+//
+// The build reports the out-of-vocabulary words found in the corpora, the invalid words and the training warnings.
+// When a step fails, the build is returned along with the error, so that the model created can be inspected or
+// deleted.
+func (speechToText *SpeechToTextV1) BuildLanguageModel(ctx context.Context, buildLanguageModelOptions *BuildLanguageModelOptions) (*LanguageModelBuild, error) {
+	if err := core.ValidateNotNil(buildLanguageModelOptions, "buildLanguageModelOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	options := buildLanguageModelOptions
+	if (options.CreateLanguageModelOptions == nil) == (options.CustomizationID == nil) {
+		return nil, fmt.Errorf("Either CreateLanguageModelOptions or CustomizationID is required")
+	}
+	if options.CreateLanguageModelOptions != nil && options.CorporaDirectory == nil && options.WordsFile == nil {
+		return nil, fmt.Errorf("A new custom language model requires a corpora directory or a words file")
+	}
+
+	// Read the local files first, so that a missing file does not leave a model behind
+	var corpusFiles []string
+	if options.CorporaDirectory != nil {
+		var err error
+		if corpusFiles, err = listCorpusFiles(*options.CorporaDirectory); err != nil {
+			return nil, err
+		}
+	}
+	var words []CustomWord
+	if options.WordsFile != nil {
+		var err error
+		if words, err = readCustomWords(*options.WordsFile); err != nil {
+			return nil, err
+		}
+	}
+
+	build := &LanguageModelBuild{}
+	if options.CustomizationID != nil {
+		build.CustomizationID = *options.CustomizationID
+	} else {
+		languageModel, _, err := speechToText.CreateLanguageModelWithContext(ctx, options.CreateLanguageModelOptions)
+		if err != nil {
+			return nil, err
+		}
+		build.CustomizationID = *languageModel.CustomizationID
+		build.LanguageModel = languageModel
+	}
+
+	for _, corpusFile := range corpusFiles {
+		corpus, err := speechToText.addCorpusFile(ctx, options, build.CustomizationID, corpusFile)
+		if corpus != nil {
+			build.Corpora = append(build.Corpora, *corpus)
+		}
+		if err != nil {
+			return build, err
+		}
+	}
+
+	if len(words) > 0 {
+		if _, err := speechToText.AddWordsWithContext(ctx, speechToText.NewAddWordsOptions(build.CustomizationID, words)); err != nil {
+			return build, err
+		}
+	}
+
+	err := speechToText.waitForLanguageModel(ctx, options, build, options.analysisTimeout(), LanguageModel_Status_Ready, LanguageModel_Status_Available)
+	if err != nil {
+		return build, err
+	}
+
+	modelWords, _, err := speechToText.ListWordsWithContext(ctx, speechToText.NewListWordsOptions(build.CustomizationID))
+	if err != nil {
+		return build, err
+	}
+	for _, word := range modelWords.Words {
+		if len(word.Error) > 0 {
+			build.InvalidWords = append(build.InvalidWords, word)
+		}
+	}
+	listCorpusWordsOptions := speechToText.NewListWordsOptions(build.CustomizationID).SetWordType(ListWordsOptions_WordType_Corpora)
+	corpusWords, _, err := speechToText.ListWordsWithContext(ctx, listCorpusWordsOptions)
+	if err != nil {
+		return build, err
+	}
+	for _, word := range corpusWords.Words {
+		if len(word.Error) == 0 {
+			build.OutOfVocabularyWords = append(build.OutOfVocabularyWords, word)
+		}
+	}
+
+	trainOptions := speechToText.NewTrainLanguageModelOptions(build.CustomizationID)
+	trainOptions.WordTypeToAdd = options.WordTypeToAdd
+	trainOptions.CustomizationWeight = options.CustomizationWeight
+	training, _, err := speechToText.TrainLanguageModelWithContext(ctx, trainOptions)
+	if err != nil {
+		return build, err
+	}
+	build.TrainingWarnings = training.Warnings
+
+	err = speechToText.waitForLanguageModel(ctx, options, build, options.trainingTimeout(), LanguageModel_Status_Available)
+	return build, err
+}
+
+// addCorpusFile adds a corpus and waits for it to be analyzed
+func (speechToText *SpeechToTextV1) addCorpusFile(ctx context.Context, options *BuildLanguageModelOptions, customizationID string, corpusFile string) (*Corpus, error) {
+	file, err := os.Open(corpusFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	corpusName := strings.TrimSuffix(filepath.Base(corpusFile), filepath.Ext(corpusFile))
+	addCorpusOptions := speechToText.NewAddCorpusOptions(customizationID, corpusName, file)
+	addCorpusOptions.AllowOverwrite = options.AllowOverwrite
+	if _, err := speechToText.AddCorpusWithContext(ctx, addCorpusOptions); err != nil {
+		return nil, err
+	}
+
+	var corpus *Corpus
+	err = pollStatus(ctx, options.pollingInterval(), options.analysisTimeout(), func() (bool, error) {
+		var err error
+		if corpus, _, err = speechToText.GetCorpusWithContext(ctx, speechToText.NewGetCorpusOptions(customizationID, corpusName)); err != nil {
+			return false, err
+		}
+		// A corpus without a status is not analyzed yet
+		switch core.StringNilMapper(corpus.Status) {
+		case Corpus_Status_Analyzed:
+			return true, nil
+		case Corpus_Status_Undetermined:
+			return false, fmt.Errorf("The corpus %s could not be analyzed: %s", corpusName, core.StringNilMapper(corpus.Error))
+		}
+		return false, nil
+	})
+	if err == errPollTimeout {
+		err = fmt.Errorf("The corpus %s was not analyzed within %s", corpusName, options.analysisTimeout())
+	}
+	return corpus, err
+}
+
+// waitForLanguageModel waits for the custom language model to reach one of the given statuses
+func (speechToText *SpeechToTextV1) waitForLanguageModel(ctx context.Context, options *BuildLanguageModelOptions, build *LanguageModelBuild, timeout time.Duration, statuses ...string) error {
+	err := pollStatus(ctx, options.pollingInterval(), timeout, func() (bool, error) {
+		languageModel, _, err := speechToText.GetLanguageModelWithContext(ctx, speechToText.NewGetLanguageModelOptions(build.CustomizationID))
+		if err != nil {
+			return false, err
+		}
+		build.LanguageModel = languageModel
+		if languageModel.Status == nil {
+			return false, nil
+		}
+		if *languageModel.Status == LanguageModel_Status_Failed {
+			return false, fmt.Errorf("The custom language model %s failed: %s", build.CustomizationID, core.StringNilMapper(languageModel.Error))
+		}
+		for _, status := range statuses {
+			if *languageModel.Status == status {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err == errPollTimeout {
+		err = fmt.Errorf("The custom language model %s was not %s within %s", build.CustomizationID, statuses[0], timeout)
+	}
+	return err
+}
+
+// errPollTimeout is returned by pollStatus when its timeout expires, as opposed to the deadline of its context
+var errPollTimeout = errors.New("The status did not change within the timeout")
+
+// pollStatus calls check until it is done or fails, waiting the interval between calls. It returns errPollTimeout
+// when the timeout expires, and the error of ctx when it is done.
+func pollStatus(ctx context.Context, interval time.Duration, timeout time.Duration, check func() (bool, error)) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			if err != nil && ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-deadline.C:
+			timer.Stop()
+			return errPollTimeout
+		case <-timer.C:
+		}
+	}
+}
+
+func (options *BuildLanguageModelOptions) pollingInterval() time.Duration {
+	if options.PollingInterval != nil {
+		return *options.PollingInterval
+	}
+	return DEFAULT_LANGUAGE_MODEL_POLLING_INTERVAL
+}
+
+func (options *BuildLanguageModelOptions) analysisTimeout() time.Duration {
+	if options.AnalysisTimeout != nil {
+		return *options.AnalysisTimeout
+	}
+	return DEFAULT_LANGUAGE_MODEL_ANALYSIS_TIMEOUT
+}
+
+func (options *BuildLanguageModelOptions) trainingTimeout() time.Duration {
+	if options.TrainingTimeout != nil {
+		return *options.TrainingTimeout
+	}
+	return DEFAULT_LANGUAGE_MODEL_TRAINING_TIMEOUT
+}
+
+// listCorpusFiles returns the corpus files of a directory, sorted by name
+func listCorpusFiles(directory string) ([]string, error) {
+	entries, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.Mode().IsRegular() && strings.EqualFold(filepath.Ext(entry.Name()), CORPUS_FILE_EXTENSION) {
+			files = append(files, filepath.Join(directory, entry.Name()))
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("The directory %s has no %s files", directory, CORPUS_FILE_EXTENSION)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// readCustomWords reads a JSON file of custom words
+func readCustomWords(wordsFile string) ([]CustomWord, error) {
+	content, err := ioutil.ReadFile(wordsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var words []CustomWord
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(content, &words)
+	} else {
+		var wordsObject struct {
+			Words []CustomWord `json:"words"`
+		}
+		err = json.Unmarshal(content, &wordsObject)
+		words = wordsObject.Words
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the words of %s: %s", wordsFile, err.Error())
+	}
+	for i, word := range words {
+		if word.Word == nil || *word.Word == "" {
+			return nil, fmt.Errorf("The word %d of %s is empty", i, wordsFile)
+		}
+	}
+	return words, nil
+}
@@ -0,0 +1,252 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// customizationServer plays the service side of the customization of a language model. Corpora and the model take
+// two checks to change status.
+type customizationServer struct {
+	*httptest.Server
+	lock        sync.Mutex
+	corpora     map[string]string
+	corpusViews map[string]int
+	words       []speechtotextv1.CustomWord
+	modelViews  int
+	trained     bool
+	failTrain   bool
+
+	// If true, the first check of each corpus and of the model omits the status.
+	omitStatus bool
+}
+
+func newCustomizationServer() *customizationServer {
+	server := &customizationServer{corpora: map[string]string{}, corpusViews: map[string]int{}}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	return server
+}
+
+func (server *customizationServer) serve(res http.ResponseWriter, req *http.Request) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	res.Header().Set("Content-type", "application/json")
+	path := strings.TrimPrefix(req.URL.Path, "/v1/customizations")
+
+	switch {
+	case req.Method == "POST" && path == "":
+		res.WriteHeader(201)
+		fmt.Fprint(res, `{"customization_id": "cust"}`)
+	case req.Method == "POST" && strings.HasPrefix(path, "/cust/corpora/"):
+		file, _, err := req.FormFile("corpus_file")
+		Expect(err).To(BeNil())
+		content, _ := ioutil.ReadAll(file)
+		server.corpora[strings.TrimPrefix(path, "/cust/corpora/")] = string(content)
+		res.WriteHeader(201)
+		fmt.Fprint(res, `{}`)
+	case req.Method == "GET" && strings.HasPrefix(path, "/cust/corpora/"):
+		name := strings.TrimPrefix(path, "/cust/corpora/")
+		server.corpusViews[name]++
+		status := "being_processed"
+		if server.corpusViews[name] > 1 {
+			status = "analyzed"
+			if strings.Contains(server.corpora[name], "garbage") {
+				status = "undetermined"
+			}
+		}
+		if server.omitStatus && server.corpusViews[name] == 1 {
+			fmt.Fprintf(res, `{"name": "%s"}`, name)
+			return
+		}
+		fmt.Fprintf(res, `{"name": "%s", "total_words": 10, "out_of_vocabulary_words": 1, "status": "%s", "error": "unreadable"}`, name, status)
+	case req.Method == "POST" && path == "/cust/words":
+		var body struct {
+			Words []speechtotextv1.CustomWord `json:"words"`
+		}
+		Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+		server.words = body.Words
+		res.WriteHeader(201)
+		fmt.Fprint(res, `{}`)
+	case req.Method == "GET" && path == "/cust":
+		server.modelViews++
+		status := "pending"
+		if server.trained {
+			status = "training"
+			if server.modelViews > 1 {
+				status = "available"
+				if server.failTrain {
+					status = "failed"
+				}
+			}
+		} else if server.modelViews > 1 {
+			status = "ready"
+		}
+		if server.omitStatus && server.modelViews == 1 {
+			fmt.Fprint(res, `{"customization_id": "cust"}`)
+			return
+		}
+		fmt.Fprintf(res, `{"customization_id": "cust", "status": "%s", "error": "training failed"}`, status)
+	case req.Method == "GET" && path == "/cust/words":
+		if req.URL.Query().Get("word_type") == "corpora" {
+			fmt.Fprint(res, `{"words": [{"word": "kubernetes", "sounds_like": [], "display_as": "Kubernetes", "count": 3, "source": ["a"]}]}`)
+		} else {
+			fmt.Fprint(res, `{"words": [{"word": "kubernetes", "sounds_like": [], "display_as": "Kubernetes", "count": 3, "source": ["a"]},
+				{"word": "gRPC", "sounds_like": ["g r p c", "1"], "display_as": "gRPC", "count": 0, "source": ["user"], "error": [{"element": "sounds_like"}]}]}`)
+		}
+	case req.Method == "POST" && path == "/cust/train":
+		server.trained = true
+		server.modelViews = 0
+		fmt.Fprint(res, `{"warnings": [{"code": "invalid_audio_files", "message": "some words are invalid"}]}`)
+	default:
+		Fail(fmt.Sprintf("unexpected request %s %s", req.Method, req.URL.Path))
+	}
+}
+
+var _ = Describe(`BuildLanguageModel(ctx context.Context, buildLanguageModelOptions *BuildLanguageModelOptions)`, func() {
+	var directory string
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "corpora")
+		Expect(err).To(BeNil())
+		Expect(ioutil.WriteFile(filepath.Join(directory, "b.txt"), []byte("deploy to kubernetes"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(directory, "a.txt"), []byte("kubernetes pods"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(directory, "notes.md"), []byte("not a corpus"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(directory, "words.json"), []byte(`{"words": [{"word": "gRPC", "sounds_like": ["g r p c"]}]}`), 0600)).To(Succeed())
+	})
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	buildOptions := func(testService *speechtotextv1.SpeechToTextV1) *speechtotextv1.BuildLanguageModelOptions {
+		return testService.NewBuildLanguageModelOptions().
+			SetCreateLanguageModelOptions(testService.NewCreateLanguageModelOptions("model", "en-US_BroadbandModel")).
+			SetCorporaDirectory(directory).
+			SetWordsFile(filepath.Join(directory, "words.json")).
+			SetPollingInterval(time.Millisecond)
+	}
+
+	It(`Adds the corpora and words, then trains the model`, func() {
+		testServer := newCustomizationServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		build, err := testService.BuildLanguageModel(context.Background(), buildOptions(testService))
+		Expect(err).To(BeNil())
+		Expect(build.CustomizationID).To(Equal("cust"))
+		Expect(*build.LanguageModel.Status).To(Equal(speechtotextv1.LanguageModel_Status_Available))
+		Expect(build.Corpora).To(HaveLen(2))
+		Expect(*build.Corpora[0].Name).To(Equal("a"))
+		Expect(*build.Corpora[1].Name).To(Equal("b"))
+		Expect(testServer.corpora).To(Equal(map[string]string{"a": "kubernetes pods", "b": "deploy to kubernetes"}))
+		Expect(testServer.words).To(HaveLen(1))
+		Expect(*testServer.words[0].Word).To(Equal("gRPC"))
+
+		Expect(build.OutOfVocabularyWords).To(HaveLen(1))
+		Expect(*build.OutOfVocabularyWords[0].Word).To(Equal("kubernetes"))
+		Expect(build.InvalidWords).To(HaveLen(1))
+		Expect(*build.InvalidWords[0].Word).To(Equal("gRPC"))
+		Expect(build.TrainingWarnings).To(HaveLen(1))
+		Expect(*build.TrainingWarnings[0].Code).To(Equal("invalid_audio_files"))
+	})
+	It(`Reports a corpus that cannot be analyzed`, func() {
+		Expect(ioutil.WriteFile(filepath.Join(directory, "c.txt"), []byte("garbage"), 0600)).To(Succeed())
+		testServer := newCustomizationServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		build, err := testService.BuildLanguageModel(context.Background(), buildOptions(testService))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unreadable"))
+		Expect(build.CustomizationID).To(Equal("cust"))
+		Expect(build.Corpora).To(HaveLen(3))
+		Expect(testServer.words).To(BeNil())
+	})
+	It(`Reports a failed training`, func() {
+		testServer := newCustomizationServer()
+		testServer.failTrain = true
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		build, err := testService.BuildLanguageModel(context.Background(), buildOptions(testService))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("training failed"))
+		Expect(*build.LanguageModel.Status).To(Equal(speechtotextv1.LanguageModel_Status_Failed))
+	})
+	It(`Times out while waiting on a state`, func() {
+		testServer := newCustomizationServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		options := buildOptions(testService).SetPollingInterval(50 * time.Millisecond).SetAnalysisTimeout(10 * time.Millisecond)
+		build, err := testService.BuildLanguageModel(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("was not analyzed within"))
+		Expect(build.CustomizationID).To(Equal("cust"))
+	})
+	It(`Keeps waiting when a check omits the status`, func() {
+		testServer := newCustomizationServer()
+		testServer.omitStatus = true
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		build, err := testService.BuildLanguageModel(context.Background(), buildOptions(testService))
+		Expect(err).To(BeNil())
+		Expect(*build.LanguageModel.Status).To(Equal(speechtotextv1.LanguageModel_Status_Available))
+	})
+	It(`Returns the error of the context when its deadline expires`, func() {
+		testServer := newCustomizationServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		options := buildOptions(testService).SetPollingInterval(time.Second)
+		_, err := testService.BuildLanguageModel(ctx, options)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+	})
+	It(`Returns an error for invalid options before creating a model`, func() {
+		testService := newWebsocketTestService("http://localhost:0")
+		_, err := testService.BuildLanguageModel(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = testService.BuildLanguageModel(context.Background(), testService.NewBuildLanguageModelOptions())
+		Expect(err).ToNot(BeNil())
+
+		_, err = testService.BuildLanguageModel(context.Background(), buildOptions(testService).SetCorporaDirectory(filepath.Join(directory, "missing")))
+		Expect(err).ToNot(BeNil())
+
+		Expect(ioutil.WriteFile(filepath.Join(directory, "words.json"), []byte(`[{"sounds_like": ["x"]}]`), 0600)).To(Succeed())
+		_, err = testService.BuildLanguageModel(context.Background(), buildOptions(testService))
+		Expect(err).ToNot(BeNil())
+	})
+})
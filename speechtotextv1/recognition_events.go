@@ -0,0 +1,183 @@
+package speechtotextv1
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// RecognitionEvent : An event of a websocket recognition. It is one of *InterimResultEvent, *FinalResultEvent,
+// *SpeakerLabelsEvent, *ProcessingMetricsEvent, *AudioMetricsEvent, *WarningsEvent, *StateEvent, *ServiceErrorEvent
+// and, on the channel of RecognizeUsingWebsocketStream, *RecognitionErrorEvent.
+type RecognitionEvent interface {
+	recognitionEvent()
+}
+
+// InterimResultEvent : A hypothesis for a part of the audio, which later events may revise
+type InterimResultEvent struct {
+	// The index of the result, which identifies the part of the audio it transcribes.
+	ResultIndex int64
+
+	Result SpeechRecognitionResult
+}
+
+// FinalResultEvent : The final transcription of a part of the audio
+type FinalResultEvent struct {
+	// The index of the result, which identifies the part of the audio it transcribes.
+	ResultIndex int64
+
+	Result SpeechRecognitionResult
+}
+
+// SpeakerLabelsEvent : The speakers identified for the words recognized so far
+type SpeakerLabelsEvent struct {
+	SpeakerLabels []SpeakerLabelsResult
+}
+
+// ProcessingMetricsEvent : Metrics about the processing of the audio by the service
+type ProcessingMetricsEvent struct {
+	ProcessingMetrics ProcessingMetrics
+}
+
+// AudioMetricsEvent : Metrics about the signal characteristics of the audio
+type AudioMetricsEvent struct {
+	AudioMetrics AudioMetrics
+}
+
+// WarningsEvent : Warnings about the request, for example about unknown parameters
+type WarningsEvent struct {
+	Warnings []string
+}
+
+// StateEvent : A change of the state of the recognition. The service sends the "listening" state when it is ready
+// to receive the audio of an utterance, and again once it has sent the final results of the utterance.
+type StateEvent struct {
+	State string
+}
+
+// ServiceErrorEvent : An error sent by the service, after which it closes the connection
+type ServiceErrorEvent struct {
+	Message string
+}
+
+// Error returns the message sent by the service
+func (event *ServiceErrorEvent) Error() string {
+	return event.Message
+}
+
+func (*InterimResultEvent) recognitionEvent()     {}
+func (*FinalResultEvent) recognitionEvent()       {}
+func (*SpeakerLabelsEvent) recognitionEvent()     {}
+func (*ProcessingMetricsEvent) recognitionEvent() {}
+func (*AudioMetricsEvent) recognitionEvent()      {}
+func (*WarningsEvent) recognitionEvent()          {}
+func (*StateEvent) recognitionEvent()             {}
+func (*ServiceErrorEvent) recognitionEvent()      {}
+
+// websocketMessage is the union of the messages sent by the service over a recognition connection
+type websocketMessage struct {
+	WebsocketRecognitionResults
+
+	Error string `json:"error,omitempty"`
+}
+
+// ParseRecognitionEvents : Parses a message received over a websocket recognition into the events it carries, in
+// the order in which the service reports them
+func ParseRecognitionEvents(message []byte) ([]RecognitionEvent, error) {
+	var websocketResponse websocketMessage
+	if err := json.Unmarshal(message, &websocketResponse); err != nil {
+		return nil, err
+	}
+
+	var events []RecognitionEvent
+	if websocketResponse.Error != "" {
+		events = append(events, &ServiceErrorEvent{Message: websocketResponse.Error})
+	}
+	if len(websocketResponse.Warnings) > 0 {
+		events = append(events, &WarningsEvent{Warnings: websocketResponse.Warnings})
+	}
+	if websocketResponse.State != "" {
+		events = append(events, &StateEvent{State: websocketResponse.State})
+	}
+
+	var resultIndex int64
+	if websocketResponse.ResultIndex != nil {
+		resultIndex = *websocketResponse.ResultIndex
+	}
+	for i, result := range websocketResponse.Results {
+		if result.Final != nil && *result.Final {
+			events = append(events, &FinalResultEvent{ResultIndex: resultIndex + int64(i), Result: result})
+		} else {
+			events = append(events, &InterimResultEvent{ResultIndex: resultIndex + int64(i), Result: result})
+		}
+	}
+
+	if len(websocketResponse.SpeakerLabels) > 0 {
+		events = append(events, &SpeakerLabelsEvent{SpeakerLabels: websocketResponse.SpeakerLabels})
+	}
+	if websocketResponse.ProcessingMetrics != nil {
+		events = append(events, &ProcessingMetricsEvent{ProcessingMetrics: *websocketResponse.ProcessingMetrics})
+	}
+	if websocketResponse.AudioMetrics != nil {
+		events = append(events, &AudioMetricsEvent{AudioMetrics: *websocketResponse.AudioMetrics})
+	}
+	return events, nil
+}
+
+// RecognizeEventCallback : Receives the events of a websocket recognition already parsed, as an alternative to the
+// raw messages received by a RecognizeCallbackWrapper. Use NewRecognizeEventCallbackWrapper to pass it to
+// RecognizeUsingWebsocket or OpenRecognizeSession.
+type RecognizeEventCallback interface {
+	OnOpen()
+	OnClose()
+	OnEvent(RecognitionEvent)
+	OnError(error)
+}
+
+// recognizeStateCallback is implemented by the callbacks that are notified of the states sent by the service, which
+// are not passed to RecognizeCallbackWrapper.OnData
+type recognizeStateCallback interface {
+	onState(state string)
+}
+
+// recognizeEventCallbackWrapper parses the messages received by a RecognizeCallbackWrapper into events
+type recognizeEventCallbackWrapper struct {
+	callback RecognizeEventCallback
+}
+
+// NewRecognizeEventCallbackWrapper : Adapts a RecognizeEventCallback to the RecognizeCallbackWrapper interface
+func NewRecognizeEventCallbackWrapper(callback RecognizeEventCallback) RecognizeCallbackWrapper {
+	return &recognizeEventCallbackWrapper{callback: callback}
+}
+
+func (wrapper *recognizeEventCallbackWrapper) OnOpen() {
+	wrapper.callback.OnOpen()
+}
+
+func (wrapper *recognizeEventCallbackWrapper) OnClose() {
+	wrapper.callback.OnClose()
+}
+
+func (wrapper *recognizeEventCallbackWrapper) OnData(resp *core.DetailedResponse) {
+	message, _ := resp.GetResult().([]byte)
+	events, err := ParseRecognitionEvents(message)
+	if err != nil {
+		wrapper.callback.OnError(err)
+		return
+	}
+	for _, event := range events {
+		// States are delivered by onState, which is also called for the states not passed to OnData
+		if _, ok := event.(*StateEvent); ok {
+			continue
+		}
+		wrapper.callback.OnEvent(event)
+	}
+}
+
+func (wrapper *recognizeEventCallbackWrapper) OnError(err error) {
+	wrapper.callback.OnError(err)
+}
+
+func (wrapper *recognizeEventCallbackWrapper) onState(state string) {
+	wrapper.callback.OnEvent(&StateEvent{State: state})
+}
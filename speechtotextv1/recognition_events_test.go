@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// eventCallback collects the events of a recognition
+type eventCallback struct {
+	lock   sync.Mutex
+	events []speechtotextv1.RecognitionEvent
+	errors []error
+	closed bool
+}
+
+func (callback *eventCallback) OnOpen() {}
+
+func (callback *eventCallback) OnClose() {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	callback.closed = true
+}
+
+func (callback *eventCallback) OnEvent(event speechtotextv1.RecognitionEvent) {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	callback.events = append(callback.events, event)
+}
+
+func (callback *eventCallback) OnError(err error) {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	callback.errors = append(callback.errors, err)
+}
+
+var _ = Describe(`ParseRecognitionEvents(message []byte)`, func() {
+	It(`Splits a message into typed events`, func() {
+		events, err := speechtotextv1.ParseRecognitionEvents([]byte(`{
+			"result_index": 2,
+			"results": [
+				{"final": true, "alternatives": [{"transcript": "hello"}]},
+				{"final": false, "alternatives": [{"transcript": "wor"}]}
+			],
+			"speaker_labels": [{"from": 0.5, "to": 1.0, "speaker": 1, "confidence": 0.8, "final": false}],
+			"processing_metrics": {"wall_clock_since_first_byte_received": 1.5, "periodic": true}
+		}`))
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(4))
+
+		final, ok := events[0].(*speechtotextv1.FinalResultEvent)
+		Expect(ok).To(BeTrue())
+		Expect(final.ResultIndex).To(Equal(int64(2)))
+		Expect(*final.Result.Alternatives[0].Transcript).To(Equal("hello"))
+
+		interim, ok := events[1].(*speechtotextv1.InterimResultEvent)
+		Expect(ok).To(BeTrue())
+		Expect(interim.ResultIndex).To(Equal(int64(3)))
+
+		speakerLabels, ok := events[2].(*speechtotextv1.SpeakerLabelsEvent)
+		Expect(ok).To(BeTrue())
+		Expect(*speakerLabels.SpeakerLabels[0].Speaker).To(Equal(int64(1)))
+
+		metrics, ok := events[3].(*speechtotextv1.ProcessingMetricsEvent)
+		Expect(ok).To(BeTrue())
+		Expect(*metrics.ProcessingMetrics.Periodic).To(BeTrue())
+	})
+	It(`Parses states, warnings, audio metrics and errors`, func() {
+		events, err := speechtotextv1.ParseRecognitionEvents([]byte(`{"state": "listening"}`))
+		Expect(err).To(BeNil())
+		Expect(events).To(Equal([]speechtotextv1.RecognitionEvent{&speechtotextv1.StateEvent{State: "listening"}}))
+
+		events, err = speechtotextv1.ParseRecognitionEvents([]byte(`{"warnings": ["Unknown arguments: foo."]}`))
+		Expect(err).To(BeNil())
+		Expect(events).To(Equal([]speechtotextv1.RecognitionEvent{&speechtotextv1.WarningsEvent{Warnings: []string{"Unknown arguments: foo."}}}))
+
+		events, err = speechtotextv1.ParseRecognitionEvents([]byte(`{"audio_metrics": {"sampling_interval": 0.1}}`))
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0]).To(BeAssignableToTypeOf(&speechtotextv1.AudioMetricsEvent{}))
+
+		events, err = speechtotextv1.ParseRecognitionEvents([]byte(`{"error": "Session timed out."}`))
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].(error)).To(MatchError("Session timed out."))
+
+		_, err = speechtotextv1.ParseRecognitionEvents([]byte(`not json`))
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe(`NewRecognizeEventCallbackWrapper(callback RecognizeEventCallback)`, func() {
+	It(`Delivers typed events, including states, from a recognition`, func() {
+		testServer := recognitionServer(func(audio []byte) []string {
+			return []string{`{"results": [{"final": true, "alternatives": [{"transcript": "some audio"}]}], "result_index": 0}`}
+		})
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		callback := new(eventCallback)
+		err := testService.RecognizeUsingWebsocket(newWebsocketTestOptions(testService, "some audio"), speechtotextv1.NewRecognizeEventCallbackWrapper(callback))
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(BeEmpty())
+		Expect(callback.closed).To(BeTrue())
+		Expect(callback.events).To(HaveLen(3))
+		Expect(callback.events[0]).To(Equal(&speechtotextv1.StateEvent{State: "listening"}))
+		Expect(callback.events[1]).To(BeAssignableToTypeOf(&speechtotextv1.FinalResultEvent{}))
+		Expect(callback.events[2]).To(Equal(&speechtotextv1.StateEvent{State: "listening"}))
+	})
+})
@@ -0,0 +1,401 @@
+package speechtotextv1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"sort"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// Constants associated with the RecognizeMultichannelOptions.Transport property.
+// How the audio of each channel is sent to the service.
+const (
+	// Recognizes each channel with a Recognize request
+	RecognizeMultichannelOptions_Transport_Http = "http"
+
+	// Recognizes each channel over a websocket connection, with the websocket options of the recognition
+	RecognizeMultichannelOptions_Transport_Websocket = "websocket"
+)
+
+// DEINTERLEAVE_FRAMES is the number of frames of multichannel audio read at a time to separate its channels
+const DEINTERLEAVE_FRAMES = ONE_KB
+
+// CHANNEL_BUFFER_SIZE is the number of bytes of the audio of a channel that are separated ahead of its recognition
+const CHANNEL_BUFFER_SIZE = 64 * ONE_KB
+
+// errChannelStopped is returned to the writes of the audio of a channel whose recognition is over
+var errChannelStopped = errors.New("The recognition of the channel is over")
+
+// RecognizeMultichannelOptions : The RecognizeMultichannel options.
+type RecognizeMultichannelOptions struct {
+	RecognizeUsingWebsocketOptions
+
+	// How the channels are recognized, see the RecognizeMultichannelOptions_Transport constants. The websocket
+	// options, such as the pacing, only apply to the websocket transport. Defaults to http.
+	Transport *string
+}
+
+// NewRecognizeMultichannelOptions : Instantiate RecognizeMultichannelOptions. The content type must be audio/l16,
+// audio/mulaw, audio/alaw or audio/wav with 16-bit linear PCM samples, with the number of channels of the audio. If
+// it is empty, it is detected from the first bytes of the audio.
+func (speechToText *SpeechToTextV1) NewRecognizeMultichannelOptions(audio io.ReadCloser, contentType string) *RecognizeMultichannelOptions {
+	return &RecognizeMultichannelOptions{
+		RecognizeUsingWebsocketOptions: *speechToText.NewRecognizeUsingWebsocketOptions(audio, contentType),
+	}
+}
+
+// SetTransport : Allow user to set Transport
+func (options *RecognizeMultichannelOptions) SetTransport(transport string) *RecognizeMultichannelOptions {
+	options.Transport = core.StringPtr(transport)
+	return options
+}
+
+// ChannelResult : A final result of the recognition of a channel of the audio
+type ChannelResult struct {
+	// The channel, from 0, in the order in which the channels are interleaved.
+	Channel int
+
+	// The start time of the first word of the result, in seconds. A result without word timestamps starts and ends at
+	// the end time of the previous result of its channel, so that it keeps its place in the timeline.
+	StartTime float64
+
+	// The end time of the last word of the result, in seconds.
+	EndTime float64
+
+	// The final result, without word timestamps unless the options request them.
+	Result SpeechRecognitionResult
+}
+
+// MultichannelRecognitionResults : The results of the recognition of the channels of multichannel audio
+type MultichannelRecognitionResults struct {
+	// The final results of all the channels, in the order of their start times. Results that start at the same time
+	// are in the order of their channels.
+	Results []ChannelResult
+
+	// The results of the recognition of each channel, by channel.
+	Channels []*SpeechRecognitionResults
+}
+
+// RecognizeMultichannel : Recognize each channel of multichannel audio separately, for recordings in which each
+// speaker has a channel, such as the agent and the customer of a call. The channels are separated into mono streams
+// as the audio is read, and recognized concurrently with the options of the recognition, over HTTP or websocket
+// connections. The final results of the channels are merged into a single timeline, in which each result is tagged
+// with its channel.
+//
+// The separation of the channels waits for the recognitions that read their audio. The audio of a channel whose
+// recognition has not started reading, for example because the transport of the service allows fewer connections
+// per host than there are channels, is held in memory until the recognition starts, so that the other channels are
+// not blocked.
+//
+// The timestamps of the words are requested to order the results, and are removed from them unless the options
+// request them. The first failure of a channel cancels the others and is returned.
+func (speechToText *SpeechToTextV1) RecognizeMultichannel(ctx context.Context, recognizeMultichannelOptions *RecognizeMultichannelOptions) (*MultichannelRecognitionResults, error) {
+	if err := core.ValidateNotNil(recognizeMultichannelOptions, "recognizeMultichannelOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(recognizeMultichannelOptions, "recognizeMultichannelOptions"); err != nil {
+		return nil, err
+	}
+	transport := RecognizeMultichannelOptions_Transport_Http
+	if recognizeMultichannelOptions.Transport != nil {
+		transport = *recognizeMultichannelOptions.Transport
+	}
+	if transport != RecognizeMultichannelOptions_Transport_Http && transport != RecognizeMultichannelOptions_Transport_Websocket {
+		return nil, fmt.Errorf("Unknown transport '%s'", transport)
+	}
+	recognizeWSOptions, err := recognizeMultichannelOptions.RecognizeUsingWebsocketOptions.withDetectedContentType()
+	if err != nil {
+		return nil, err
+	}
+	defer recognizeWSOptions.Audio.Close()
+	if recognizeWSOptions.ContentType == nil {
+		return nil, fmt.Errorf("The content type of the audio is required to separate its channels")
+	}
+
+	reader := bufio.NewReaderSize(recognizeWSOptions.Audio, WAV_HEADER_SIZE)
+	header, _ := reader.Peek(WAV_HEADER_SIZE)
+	format, err := describeAudio(*recognizeWSOptions.ContentType, header)
+	if err != nil {
+		return nil, err
+	}
+	channelContentType, err := monoContentType(*recognizeWSOptions.ContentType, format)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := reader.Discard(format.headerSize); err != nil {
+		return nil, err
+	}
+
+	channelOptions := *recognizeWSOptions
+	channelOptions.ContentType = core.StringPtr(channelContentType)
+	keepTimestamps := recognizeWSOptions.Timestamps != nil && *recognizeWSOptions.Timestamps
+	channelOptions.SetTimestamps(true)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var lock sync.Mutex
+	var firstErr error
+	fail := func(err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	channels := make([]*SpeechRecognitionResults, format.channels)
+	writers := make([]*channelAudio, format.channels)
+	var recognitions sync.WaitGroup
+	for channel := range channels {
+		audio := newChannelAudio()
+		writers[channel] = audio
+		recognitions.Add(1)
+		go func(channel int, audio *channelAudio) {
+			defer recognitions.Done()
+			// The separation of the channels stops writing the audio of a channel once its recognition is over
+			defer audio.Close()
+			options := channelOptions
+			options.Audio = audio
+			results, err := speechToText.recognizeChannel(ctx, &options, transport)
+			if err != nil && ctx.Err() != nil {
+				fail(ctx.Err())
+				return
+			}
+			if err != nil {
+				fail(fmt.Errorf("The recognition of channel %d failed: %s", channel, err.Error()))
+				return
+			}
+			channels[channel] = results
+		}(channel, audio)
+	}
+
+	err = deinterleave(ctx, reader, format, writers)
+	if err != nil && ctx.Err() == nil {
+		fail(err)
+	}
+	for _, writer := range writers {
+		writer.CloseWithError(err)
+	}
+	recognitions.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return mergeChannelResults(channels, keepTimestamps), nil
+}
+
+// monoContentType returns the content type of a channel of audio of the given content type and format
+func monoContentType(contentType string, format audioFormat) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case format.linearPCM && format.sampleSize == 2:
+		return format.l16ContentType(1), nil
+	case mediaType == "audio/mulaw" || mediaType == "audio/alaw":
+		return fmt.Sprintf("%s;rate=%d", mediaType, format.sampleRate()), nil
+	}
+	return "", fmt.Errorf("Only 16-bit linear PCM, mu-law and A-law audio can be separated into channels, not %s", contentType)
+}
+
+// deinterleave writes the samples of each channel of the audio to the writer of the channel, until the end of the
+// audio. The writers of the channels whose recognition is over are skipped. An incomplete frame at the end of the
+// audio is dropped.
+func deinterleave(ctx context.Context, audio io.Reader, format audioFormat, writers []*channelAudio) error {
+	buffer := make([]byte, DEINTERLEAVE_FRAMES*format.frameSize)
+	samples := make([]byte, DEINTERLEAVE_FRAMES*format.sampleSize)
+	stopped := make([]bool, len(writers))
+	for ctx.Err() == nil {
+		n, err := io.ReadFull(audio, buffer)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+
+		frames := n / format.frameSize
+		for channel, writer := range writers {
+			if stopped[channel] || frames == 0 {
+				continue
+			}
+			for frame := 0; frame < frames; frame++ {
+				offset := frame*format.frameSize + channel*format.sampleSize
+				copy(samples[frame*format.sampleSize:], buffer[offset:offset+format.sampleSize])
+			}
+			if _, err := writer.Write(samples[:frames*format.sampleSize]); err != nil {
+				stopped[channel] = true
+			}
+		}
+		if last {
+			return nil
+		}
+	}
+	return ctx.Err()
+}
+
+// channelAudio is the audio of a channel, written by the separation of the channels and read by the recognition of
+// the channel. Writes wait while CHANNEL_BUFFER_SIZE bytes are unread, but only once the recognition has started
+// reading, so that the audio of a channel whose recognition waits for a connection does not block the others.
+type channelAudio struct {
+	lock    sync.Mutex
+	changed *sync.Cond
+	buffer  bytes.Buffer
+	reading bool
+
+	// The error returned by the reads at the end of the audio, set once the separation of the channels is over.
+	err error
+
+	// True once the recognition of the channel is over.
+	stopped bool
+}
+
+func newChannelAudio() *channelAudio {
+	audio := &channelAudio{}
+	audio.changed = sync.NewCond(&audio.lock)
+	return audio
+}
+
+// Write adds separated audio, or fails with errChannelStopped once the recognition of the channel is over
+func (audio *channelAudio) Write(data []byte) (int, error) {
+	audio.lock.Lock()
+	defer audio.lock.Unlock()
+	for !audio.stopped && audio.reading && audio.buffer.Len() >= CHANNEL_BUFFER_SIZE {
+		audio.changed.Wait()
+	}
+	if audio.stopped {
+		return 0, errChannelStopped
+	}
+	audio.buffer.Write(data)
+	audio.changed.Broadcast()
+	return len(data), nil
+}
+
+// CloseWithError ends the audio: the reads return err once the audio is read, or io.EOF if err is nil
+func (audio *channelAudio) CloseWithError(err error) {
+	audio.lock.Lock()
+	defer audio.lock.Unlock()
+	if err == nil {
+		err = io.EOF
+	}
+	if audio.err == nil {
+		audio.err = err
+	}
+	audio.changed.Broadcast()
+}
+
+// Read returns the separated audio, waiting for it to be written
+func (audio *channelAudio) Read(data []byte) (int, error) {
+	audio.lock.Lock()
+	defer audio.lock.Unlock()
+	audio.reading = true
+	for audio.buffer.Len() == 0 && audio.err == nil && !audio.stopped {
+		audio.changed.Wait()
+	}
+	switch {
+	case audio.stopped:
+		return 0, errChannelStopped
+	case audio.buffer.Len() == 0:
+		return 0, audio.err
+	}
+	n, _ := audio.buffer.Read(data)
+	audio.changed.Broadcast()
+	return n, nil
+}
+
+// Close stops the audio once the recognition of the channel is over, releasing the audio that was not read
+func (audio *channelAudio) Close() error {
+	audio.lock.Lock()
+	defer audio.lock.Unlock()
+	audio.stopped = true
+	audio.buffer = bytes.Buffer{}
+	audio.changed.Broadcast()
+	return nil
+}
+
+// recognizeChannel recognizes the audio of a channel with the transport
+func (speechToText *SpeechToTextV1) recognizeChannel(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, transport string) (*SpeechRecognitionResults, error) {
+	if transport == RecognizeMultichannelOptions_Transport_Http {
+		results, _, err := speechToText.RecognizeWithContext(ctx, &recognizeWSOptions.RecognizeOptions)
+		return results, err
+	}
+
+	events, err := speechToText.RecognizeUsingWebsocketStream(ctx, recognizeWSOptions)
+	if err != nil {
+		return nil, err
+	}
+	results := &SpeechRecognitionResults{ResultIndex: core.Int64Ptr(0)}
+	finalResults := map[int64]SpeechRecognitionResult{}
+	for event := range events {
+		switch event := event.(type) {
+		case *FinalResultEvent:
+			finalResults[event.ResultIndex] = event.Result
+		case *SpeakerLabelsEvent:
+			results.SpeakerLabels = append(results.SpeakerLabels, event.SpeakerLabels...)
+		case *ProcessingMetricsEvent:
+			results.ProcessingMetrics = &event.ProcessingMetrics
+		case *AudioMetricsEvent:
+			results.AudioMetrics = &event.AudioMetrics
+		case *WarningsEvent:
+			results.Warnings = append(results.Warnings, event.Warnings...)
+		case *RecognitionErrorEvent:
+			err = event.Err
+		}
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]int64, 0, len(finalResults))
+	for index := range finalResults {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	results.Results = make([]SpeechRecognitionResult, len(indexes))
+	for i, index := range indexes {
+		results.Results[i] = finalResults[index]
+	}
+	return results, nil
+}
+
+// mergeChannelResults orders the final results of the channels by their start times
+func mergeChannelResults(channels []*SpeechRecognitionResults, keepTimestamps bool) *MultichannelRecognitionResults {
+	merged := &MultichannelRecognitionResults{Results: []ChannelResult{}, Channels: channels}
+	for channel, results := range channels {
+		previousEnd := 0.0
+		for i := range results.Results {
+			result := &results.Results[i]
+			channelResult := ChannelResult{Channel: channel, StartTime: previousEnd, EndTime: previousEnd}
+			if len(result.Alternatives) > 0 {
+				if timestamps, err := result.Alternatives[0].WordTimestamps(); err == nil && len(timestamps) > 0 {
+					channelResult.StartTime = timestamps[0].StartTime
+					channelResult.EndTime = timestamps[len(timestamps)-1].EndTime
+				}
+			}
+			previousEnd = channelResult.EndTime
+			if !keepTimestamps {
+				removeTimestamps(result)
+			}
+			if result.Final != nil && !*result.Final {
+				continue
+			}
+			channelResult.Result = *result
+			merged.Results = append(merged.Results, channelResult)
+		}
+	}
+	// The results are in the order of their channels, which the stable sort keeps for equal start times
+	sort.SliceStable(merged.Results, func(i, j int) bool {
+		return merged.Results[i].StartTime < merged.Results[j].StartTime
+	})
+	return merged
+}
@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/common"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// interleave returns the stereo audio of two channels of 16-bit mono audio
+func interleave(left []byte, right []byte) []byte {
+	stereo := make([]byte, 0, 2*len(left))
+	for i := 0; i+1 < len(left); i += 2 {
+		stereo = append(stereo, left[i:i+2]...)
+		stereo = append(stereo, right[i:i+2]...)
+	}
+	return stereo
+}
+
+// stereoWavHeader returns the header of a 16-bit stereo WAV file
+func stereoWavHeader(sampleRate uint32, dataSize uint32) []byte {
+	header := wavHeader(sampleRate, dataSize)
+	binary.LittleEndian.PutUint16(header[22:], 2)
+	binary.LittleEndian.PutUint32(header[28:], sampleRate*4)
+	binary.LittleEndian.PutUint16(header[32:], 4)
+	return header
+}
+
+// channelTimeline returns the channel and transcript of each merged result
+func channelTimeline(results *speechtotextv1.MultichannelRecognitionResults) ([]int, []string) {
+	var channels []int
+	var transcripts []string
+	for _, result := range results.Results {
+		channels = append(channels, result.Channel)
+		transcripts = append(transcripts, strings.TrimSpace(*result.Result.Alternatives[0].Transcript))
+	}
+	return channels, transcripts
+}
+
+var _ = Describe(`RecognizeMultichannel(ctx context.Context, recognizeMultichannelOptions *RecognizeMultichannelOptions)`, func() {
+	const rate = 1000
+	agent := speechAudio(rate, 2, spokenWord{0.1, 0.2, 1000}, spokenWord{1, 0.3, 3000})
+	customer := speechAudio(rate, 2, spokenWord{0.5, 0.2, 2000}, spokenWord{1, 0.4, 4000})
+	stereo := interleave(agent, customer)
+
+	It(`Recognizes each channel of WAV audio with a Recognize request`, func() {
+		testServer := newSegmentServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		audio := append(stereoWavHeader(rate, uint32(len(stereo))), stereo...)
+		options := testService.NewRecognizeMultichannelOptions(ioutil.NopCloser(bytes.NewReader(audio)), "")
+		results, err := testService.RecognizeMultichannel(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		Expect(testServer.contentTypes).To(ConsistOf(
+			"audio/l16;rate=1000;channels=1;endianness=little-endian",
+			"audio/l16;rate=1000;channels=1;endianness=little-endian"))
+		Expect(testServer.durations).To(Equal([]float64{2, 2}))
+		channels, transcripts := channelTimeline(results)
+		Expect(channels).To(Equal([]int{0, 1}))
+		Expect(transcripts).To(Equal([]string{"w1 w3", "w2 w4"}))
+		Expect(results.Results[0].StartTime).To(BeNumerically("~", 0.1, 1e-9))
+		Expect(results.Results[0].EndTime).To(BeNumerically("~", 1.3, 1e-9))
+		Expect(results.Results[1].StartTime).To(BeNumerically("~", 0.5, 1e-9))
+		Expect(results.Results[0].Result.Alternatives[0].Timestamps).To(BeNil())
+
+		Expect(results.Channels).To(HaveLen(2))
+		Expect(results.Channels[1].Warnings).To(Equal([]string{"Unknown arguments: foo."}))
+		Expect(results.Channels[1].Results[0].Alternatives[0].Timestamps).To(BeNil())
+	})
+	It(`Recognizes each channel over a websocket connection`, func() {
+		recognition := recognitionServer(func(audio []byte) []string {
+			words, timestamps, _ := transcribeBursts(audio, rate)
+			var responses []string
+			for i, word := range words {
+				response, err := json.Marshal(map[string]interface{}{"result_index": i, "results": []interface{}{
+					map[string]interface{}{"final": true, "alternatives": []interface{}{
+						map[string]interface{}{"transcript": word + " ", "timestamps": []interface{}{timestamps[i]}}}}}})
+				Expect(err).To(BeNil())
+				responses = append(responses, string(response))
+			}
+			return responses
+		})
+		defer recognition.Close()
+
+		testService := newWebsocketTestService(recognition.URL)
+		options := testService.NewRecognizeMultichannelOptions(ioutil.NopCloser(bytes.NewReader(stereo)), "audio/l16; rate=1000; channels=2").
+			SetTransport(speechtotextv1.RecognizeMultichannelOptions_Transport_Websocket)
+		options.SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible)
+		options.SetTimestamps(true)
+		results, err := testService.RecognizeMultichannel(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		channels, transcripts := channelTimeline(results)
+		Expect(channels).To(Equal([]int{0, 1, 0, 1}))
+		Expect(transcripts).To(Equal([]string{"w1", "w2", "w3", "w4"}))
+		Expect(results.Results[3].StartTime).To(BeNumerically("~", 1, 1e-9))
+		Expect(results.Results[3].EndTime).To(BeNumerically("~", 1.4, 1e-9))
+		Expect(results.Results[3].Result.Alternatives[0].Timestamps).To(HaveLen(1))
+		Expect(results.Channels[0].Results).To(HaveLen(2))
+	})
+	It(`Keeps the place of a result without word timestamps`, func() {
+		recognition := recognitionServer(func(audio []byte) []string {
+			words, timestamps, _ := transcribeBursts(audio, rate)
+			var responses []string
+			for i, word := range words {
+				alternative := map[string]interface{}{"transcript": word + " ", "timestamps": []interface{}{timestamps[i]}}
+				if word == "w4" {
+					delete(alternative, "timestamps")
+				}
+				response, err := json.Marshal(map[string]interface{}{"result_index": i, "results": []interface{}{
+					map[string]interface{}{"final": true, "alternatives": []interface{}{alternative}}}})
+				Expect(err).To(BeNil())
+				responses = append(responses, string(response))
+			}
+			return responses
+		})
+		defer recognition.Close()
+
+		testService := newWebsocketTestService(recognition.URL)
+		options := testService.NewRecognizeMultichannelOptions(ioutil.NopCloser(bytes.NewReader(stereo)), "audio/l16; rate=1000; channels=2").
+			SetTransport(speechtotextv1.RecognizeMultichannelOptions_Transport_Websocket)
+		options.SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible)
+		results, err := testService.RecognizeMultichannel(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		channels, transcripts := channelTimeline(results)
+		Expect(channels).To(Equal([]int{0, 1, 1, 0}))
+		Expect(transcripts).To(Equal([]string{"w1", "w2", "w4", "w3"}))
+		Expect(results.Results[2].StartTime).To(BeNumerically("~", 0.7, 1e-9))
+		Expect(results.Results[2].EndTime).To(BeNumerically("~", 0.7, 1e-9))
+	})
+	It(`Recognizes the channels one at a time when the transport allows a single connection`, func() {
+		testServer := newSegmentServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		testService.ConfigureTransport(&common.TransportConfig{MaxConnsPerHost: 1})
+		// Longer audio than the buffer of a channel, so that the separation waits for the recognition reading it
+		long := bytes.Repeat(stereo, 1+speechtotextv1.CHANNEL_BUFFER_SIZE/len(stereo))
+		options := testService.NewRecognizeMultichannelOptions(ioutil.NopCloser(bytes.NewReader(long)), "audio/l16; rate=1000; channels=2")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		results, err := testService.RecognizeMultichannel(ctx, options)
+		Expect(err).To(BeNil())
+		Expect(results.Channels).To(HaveLen(2))
+		Expect(testServer.durations).To(HaveLen(2))
+		Expect(testServer.durations[0]).To(Equal(testServer.durations[1]))
+		Expect(testServer.durations[0]).To(BeNumerically("~", float64(len(long)/4)/rate, 1e-9))
+	})
+	It(`Returns the first failure of a channel`, func() {
+		testServer := newSegmentServer()
+		testServer.failAfter = 0
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		options := testService.NewRecognizeMultichannelOptions(ioutil.NopCloser(bytes.NewReader(stereo)), "audio/l16; rate=1000; channels=2")
+		_, err := testService.RecognizeMultichannel(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("The recognition of channel"))
+	})
+	It(`Returns an error for invalid options`, func() {
+		testService := newWebsocketTestService("http://localhost:0")
+		newOptions := func(contentType string) *speechtotextv1.RecognizeMultichannelOptions {
+			return testService.NewRecognizeMultichannelOptions(ioutil.NopCloser(strings.NewReader("audio")), contentType)
+		}
+
+		_, err := testService.RecognizeMultichannel(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeMultichannel(context.Background(), newOptions(""))
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeMultichannel(context.Background(), newOptions("audio/flac"))
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeMultichannel(context.Background(), newOptions("audio/l16; rate=8000").SetTransport("carrier pigeon"))
+		Expect(err).ToNot(BeNil())
+	})
+})
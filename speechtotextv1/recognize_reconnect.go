@@ -0,0 +1,509 @@
+package speechtotextv1
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+const (
+	DEFAULT_MAX_RECONNECTS    = 3
+	DEFAULT_RECONNECT_BACKOFF = time.Second
+	DEFAULT_MAX_JOURNAL_SIZE  = 64 * ONE_KB * ONE_KB
+)
+
+// errAudioDiscarded is returned to a sender that reads audio which was already acknowledged by the service
+var errAudioDiscarded = errors.New("The audio was already acknowledged")
+
+// ReconnectPolicy : How RecognizeUsingWebsocketWithReconnect reconnects when the connection drops
+type ReconnectPolicy struct {
+	// The maximum number of consecutive reconnections that do not make progress, after which the recognition fails.
+	// The count is reset whenever the service acknowledges more audio.
+	MaxReconnects int
+
+	// The time waited before reconnecting.
+	Backoff time.Duration
+
+	// The maximum number of bytes of audio kept until the service acknowledges them, to be sent again after a
+	// reconnection. Older audio is discarded once it is exceeded, and a reconnection that would need it fails.
+	// Defaults to DEFAULT_MAX_JOURNAL_SIZE when it is not positive.
+	MaxJournalSize int
+}
+
+// NewReconnectPolicy : Instantiate ReconnectPolicy
+func NewReconnectPolicy(maxReconnects int) *ReconnectPolicy {
+	return &ReconnectPolicy{
+		MaxReconnects:  maxReconnects,
+		Backoff:        DEFAULT_RECONNECT_BACKOFF,
+		MaxJournalSize: DEFAULT_MAX_JOURNAL_SIZE,
+	}
+}
+
+// SetMaxReconnects : Allow user to set MaxReconnects
+func (policy *ReconnectPolicy) SetMaxReconnects(maxReconnects int) *ReconnectPolicy {
+	policy.MaxReconnects = maxReconnects
+	return policy
+}
+
+// SetBackoff : Allow user to set Backoff
+func (policy *ReconnectPolicy) SetBackoff(backoff time.Duration) *ReconnectPolicy {
+	policy.Backoff = backoff
+	return policy
+}
+
+// SetMaxJournalSize : Allow user to set MaxJournalSize
+func (policy *ReconnectPolicy) SetMaxJournalSize(maxJournalSize int) *ReconnectPolicy {
+	policy.MaxJournalSize = maxJournalSize
+	return policy
+}
+
+// RecognizeUsingWebsocketWithReconnect : Recognize audio over websocket connections, reconnecting when a connection
+// drops, for example because the recording exceeds the session or inactivity limits of the service. After a
+// reconnection, the audio is sent again from the end of the last final result, and the times and indexes of the
+// events are offset so that the callback receives one continuous transcript.
+//
+// The position of the audio is derived from its byte rate, so the content type must be audio/l16, audio/mulaw,
+// audio/alaw, audio/basic or audio/wav. Timestamps are requested from the service to track the position; they are
+// removed from the results unless the options request them. The callback is notified of the listening state once
+// at the start and once at the end of the recognition.
+//
+// The call returns once the recognition is over. Failures to set up the first connection are returned, while the
+// failure that ends the recognition after the policy is exhausted is delivered to the OnError method of the callback.
+// If policy is nil, the recognition reconnects up to 3 times, waiting 1 second.
+func (speechToText *SpeechToTextV1) RecognizeUsingWebsocketWithReconnect(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeEventCallback, policy *ReconnectPolicy) error {
+	if err := core.ValidateNotNil(recognizeWSOptions, "recognizeOptions cannot be nil"); err != nil {
+		return err
+	}
+	if err := core.ValidateStruct(recognizeWSOptions, "recognizeOptions"); err != nil {
+		return err
+	}
+	if callback == nil {
+		return fmt.Errorf("callback cannot be nil")
+	}
+	recognizeWSOptions, err := recognizeWSOptions.withDetectedContentType()
+	if err != nil {
+		return err
+	}
+	if err := recognizeWSOptions.validatePacing(); err != nil {
+		return err
+	}
+	if recognizeWSOptions.ContentType == nil {
+		return fmt.Errorf("Reconnecting requires the content type of the audio")
+	}
+	if policy == nil {
+		policy = NewReconnectPolicy(DEFAULT_MAX_RECONNECTS)
+	}
+
+	source := bufio.NewReaderSize(recognizeWSOptions.Audio, WAV_HEADER_SIZE)
+	header, _ := source.Peek(WAV_HEADER_SIZE)
+	format, err := describeAudio(*recognizeWSOptions.ContentType, header)
+	if err != nil {
+		return err
+	}
+	journal := &audioJournal{header: append([]byte(nil), header[:format.headerSize]...), source: source, maxSize: policy.MaxJournalSize}
+	if journal.maxSize <= 0 {
+		journal.maxSize = DEFAULT_MAX_JOURNAL_SIZE
+	}
+	if _, err := source.Discard(format.headerSize); err != nil {
+		return err
+	}
+
+	recognition := &resilientRecognition{
+		callback:   callback,
+		format:     format,
+		journal:    journal,
+		timestamps: recognizeWSOptions.Timestamps != nil && *recognizeWSOptions.Timestamps,
+	}
+
+	attempts := 0
+	var connectionErr error
+	for connection := 0; ; connection++ {
+		if connection > 0 && !journal.holds(recognition.resumePosition()) {
+			callback.OnError(fmt.Errorf("The recognition cannot resume, because the audio not acknowledged by the service exceeded the maximum journal size of %d bytes", journal.maxSize))
+			return nil
+		}
+		dialURL, param, headers, err := speechToText.recognizeHandshake(recognizeWSOptions)
+		if err != nil && connection == 0 {
+			return err
+		}
+		if err == nil {
+			conn, dialErr := speechToText.dialRecognize(ctx, dialURL, param, headers)
+			if dialErr != nil && connection == 0 {
+				return dialErr
+			}
+			err = dialErr
+			if err == nil {
+				if connection == 0 {
+					callback.OnOpen()
+					defer callback.OnClose()
+				}
+
+				connectionCallback := recognition.newConnection(connection == 0)
+				connectionOptions := *recognizeWSOptions
+				connectionOptions.Audio = &journalReader{journal: journal, header: journal.header, position: connectionCallback.resumePosition}
+				connectionOptions.SetTimestamps(true)
+				runRecognizeListener(ctx, conn, NewRecognizeEventCallbackWrapper(connectionCallback), &connectionOptions)
+
+				if connectionCallback.isFinished() {
+					return nil
+				}
+				if connectionCallback.madeProgress() {
+					attempts = 0
+				}
+				err = connectionCallback.failure()
+			}
+		}
+
+		if ctx.Err() != nil {
+			callback.OnError(ctx.Err())
+			return nil
+		}
+		if err != nil {
+			connectionErr = err
+		}
+		attempts++
+		if attempts > policy.MaxReconnects {
+			if connectionErr == nil {
+				connectionErr = fmt.Errorf("The connection was closed")
+			}
+			callback.OnError(connectionErr)
+			return nil
+		}
+
+		timer := time.NewTimer(policy.Backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			callback.OnError(ctx.Err())
+			return nil
+		}
+	}
+}
+
+// resilientRecognition holds the progress of a recognition across its connections
+type resilientRecognition struct {
+	callback   RecognizeEventCallback
+	format     audioFormat
+	journal    *audioJournal
+	timestamps bool
+
+	lock sync.Mutex
+
+	// The time, from the start of the audio, up to which the service sent final results.
+	acknowledged float64
+
+	// The index of the next final result.
+	nextIndex int64
+}
+
+// newConnection returns the callback of a new connection, which resumes at the acknowledged position
+func (recognition *resilientRecognition) newConnection(first bool) *connectionCallback {
+	resumePosition := recognition.resumePosition()
+
+	recognition.lock.Lock()
+	defer recognition.lock.Unlock()
+	return &connectionCallback{
+		recognition:    recognition,
+		first:          first,
+		resumePosition: resumePosition,
+		offset:         float64(resumePosition) / recognition.format.bytesPerSecond,
+		indexOffset:    recognition.nextIndex,
+	}
+}
+
+// resumePosition returns the position of the audio from which a new connection resumes
+func (recognition *resilientRecognition) resumePosition() int64 {
+	recognition.lock.Lock()
+	defer recognition.lock.Unlock()
+
+	frameSize := int64(recognition.format.frameSize)
+	return int64(recognition.acknowledged*recognition.format.bytesPerSecond) / frameSize * frameSize
+}
+
+// connectionCallback receives the events of one of the connections of a resilient recognition, and forwards them
+// with the times and indexes offset by the audio recognized by the previous connections
+type connectionCallback struct {
+	recognition    *resilientRecognition
+	first          bool
+	resumePosition int64
+	offset         float64
+	indexOffset    int64
+
+	lock       sync.Mutex
+	listening  int
+	finished   bool
+	progressed bool
+	err        error
+}
+
+func (callback *connectionCallback) OnOpen() {}
+
+func (callback *connectionCallback) OnClose() {}
+
+func (callback *connectionCallback) OnError(err error) {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	if callback.err == nil && !callback.finished {
+		callback.err = err
+	}
+}
+
+func (callback *connectionCallback) OnEvent(event RecognitionEvent) {
+	recognition := callback.recognition
+	switch event := event.(type) {
+	case *StateEvent:
+		callback.lock.Lock()
+		callback.listening++
+		forward := callback.listening > 1 || callback.first
+		callback.finished = callback.listening > 1
+		callback.lock.Unlock()
+		if !forward {
+			return
+		}
+	case *ServiceErrorEvent:
+		// The service closes the connection after an error, which triggers a reconnection
+		callback.OnError(event)
+		return
+	case *FinalResultEvent:
+		event.ResultIndex += callback.indexOffset
+		end := offsetResult(&event.Result, callback.offset)
+		recognition.acknowledge(event.ResultIndex, end, callback)
+		if !recognition.timestamps {
+			removeTimestamps(&event.Result)
+		}
+	case *InterimResultEvent:
+		event.ResultIndex += callback.indexOffset
+		offsetResult(&event.Result, callback.offset)
+		if !recognition.timestamps {
+			removeTimestamps(&event.Result)
+		}
+	case *SpeakerLabelsEvent:
+		for i := range event.SpeakerLabels {
+			offsetFloat32(event.SpeakerLabels[i].From, callback.offset)
+			offsetFloat32(event.SpeakerLabels[i].To, callback.offset)
+		}
+	case *ProcessingMetricsEvent:
+		if processedAudio := event.ProcessingMetrics.ProcessedAudio; processedAudio != nil {
+			offsetFloat32(processedAudio.Received, callback.offset)
+			offsetFloat32(processedAudio.SeenByEngine, callback.offset)
+			offsetFloat32(processedAudio.Transcription, callback.offset)
+			offsetFloat32(processedAudio.SpeakerLabels, callback.offset)
+		}
+	}
+	recognition.callback.OnEvent(event)
+}
+
+func (callback *connectionCallback) isFinished() bool {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	return callback.finished
+}
+
+func (callback *connectionCallback) madeProgress() bool {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	return callback.progressed
+}
+
+func (callback *connectionCallback) failure() error {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	return callback.err
+}
+
+// acknowledge records that the service sent the final result of the given index, which ends at the given time
+func (recognition *resilientRecognition) acknowledge(resultIndex int64, end float64, callback *connectionCallback) {
+	recognition.lock.Lock()
+	if resultIndex >= recognition.nextIndex {
+		recognition.nextIndex = resultIndex + 1
+	}
+	progressed := end > recognition.acknowledged
+	if progressed {
+		recognition.acknowledged = end
+	}
+	acknowledged := recognition.acknowledged
+	recognition.lock.Unlock()
+
+	if progressed {
+		callback.lock.Lock()
+		callback.progressed = true
+		callback.lock.Unlock()
+
+		frameSize := int64(recognition.format.frameSize)
+		recognition.journal.acknowledge(int64(acknowledged*recognition.format.bytesPerSecond) / frameSize * frameSize)
+	}
+}
+
+// offsetResult shifts the times of a result, and returns the end time of its words
+func offsetResult(result *SpeechRecognitionResult, offset float64) float64 {
+	end := 0.0
+	for i := range result.Alternatives {
+		timestamps, err := result.Alternatives[i].WordTimestamps()
+		if err != nil || len(timestamps) == 0 {
+			continue
+		}
+		for j := range timestamps {
+			timestamps[j].StartTime += offset
+			timestamps[j].EndTime += offset
+			end = math.Max(end, timestamps[j].EndTime)
+		}
+		result.Alternatives[i].setWordTimestamps(timestamps)
+	}
+	for _, keywords := range result.KeywordsResult {
+		for i := range keywords {
+			offsetFloat64(keywords[i].StartTime, offset)
+			offsetFloat64(keywords[i].EndTime, offset)
+		}
+	}
+	for i := range result.WordAlternatives {
+		offsetFloat64(result.WordAlternatives[i].StartTime, offset)
+		offsetFloat64(result.WordAlternatives[i].EndTime, offset)
+	}
+	return end
+}
+
+// removeTimestamps removes the timestamps that were requested only to track the position of the recognition
+func removeTimestamps(result *SpeechRecognitionResult) {
+	for i := range result.Alternatives {
+		result.Alternatives[i].Timestamps = nil
+	}
+}
+
+func offsetFloat64(value *float64, offset float64) {
+	if value != nil {
+		*value += offset
+	}
+}
+
+func offsetFloat32(value *float32, offset float64) {
+	if value != nil {
+		*value += float32(offset)
+	}
+}
+
+// audioJournal keeps the audio read from the source that the service did not acknowledge yet, so that it can be sent
+// again over a new connection. It holds at most maxSize bytes, plus the last read of the source.
+type audioJournal struct {
+	// The header of the audio, such as a WAV header, sent at the start of every connection.
+	header []byte
+
+	// Serializes the reads of the source, which may still be read by the sender of a dropped connection.
+	sourceLock sync.Mutex
+	source     io.Reader
+
+	lock sync.Mutex
+
+	// The position, in the audio following the header, of the first byte of data.
+	base    int64
+	data    []byte
+	maxSize int
+
+	// The error that ended the source, io.EOF at its end.
+	err error
+}
+
+// readAt reads the audio at the given position, reading the source when the journal does not hold it yet
+func (journal *audioJournal) readAt(p []byte, position int64) (int, error) {
+	for {
+		journal.lock.Lock()
+		if position < journal.base {
+			journal.lock.Unlock()
+			return 0, errAudioDiscarded
+		}
+		if available := journal.base + int64(len(journal.data)) - position; available > 0 {
+			n := copy(p, journal.data[position-journal.base:])
+			journal.lock.Unlock()
+			return n, nil
+		}
+		if journal.err != nil {
+			err := journal.err
+			journal.lock.Unlock()
+			return 0, err
+		}
+		journal.lock.Unlock()
+
+		journal.fill(len(p))
+	}
+}
+
+// fill appends the next bytes of the source to the journal
+func (journal *audioJournal) fill(size int) {
+	journal.sourceLock.Lock()
+	defer journal.sourceLock.Unlock()
+
+	buffer := make([]byte, size)
+	n, err := journal.source.Read(buffer)
+
+	journal.lock.Lock()
+	defer journal.lock.Unlock()
+	// The oldest audio is discarded beyond the maximum size, but not the audio just read, which is still to be sent
+	if excess := len(journal.data) + n - journal.maxSize; excess > 0 {
+		if excess > len(journal.data) {
+			excess = len(journal.data)
+		}
+		journal.discard(int64(excess))
+	}
+	journal.data = append(journal.data, buffer[:n]...)
+	if err != nil {
+		journal.err = err
+	}
+}
+
+// acknowledge discards the audio before the given position
+func (journal *audioJournal) acknowledge(position int64) {
+	journal.lock.Lock()
+	defer journal.lock.Unlock()
+
+	discarded := position - journal.base
+	if discarded <= 0 {
+		return
+	}
+	if discarded > int64(len(journal.data)) {
+		discarded = int64(len(journal.data))
+	}
+	journal.discard(discarded)
+}
+
+// discard drops the first n bytes of data. The slice is shortened rather than copied, and the array is released
+// when appending reallocates it.
+func (journal *audioJournal) discard(n int64) {
+	journal.data = journal.data[n:]
+	journal.base += n
+}
+
+// holds reports whether the audio from the given position can still be read
+func (journal *audioJournal) holds(position int64) bool {
+	journal.lock.Lock()
+	defer journal.lock.Unlock()
+	return position >= journal.base
+}
+
+// journalReader reads the header of the audio, then the journal from a position
+type journalReader struct {
+	journal  *audioJournal
+	header   []byte
+	position int64
+}
+
+func (reader *journalReader) Read(p []byte) (int, error) {
+	if len(reader.header) > 0 {
+		n := copy(p, reader.header)
+		reader.header = reader.header[n:]
+		return n, nil
+	}
+	n, err := reader.journal.readAt(p, reader.position)
+	reader.position += int64(n)
+	return n, err
+}
+
+func (reader *journalReader) Close() error {
+	return nil
+}
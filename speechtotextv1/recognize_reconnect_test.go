@@ -0,0 +1,200 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// droppingRecognitionServer plays a service that drops the first connection once it has received dropAfter bytes of
+// audio, after sending a final result for the first second. The second connection is recognized normally.
+func droppingRecognitionServer(dropAfter int, resumedAudio *[]byte) *httptest.Server {
+	var lock sync.Mutex
+	connections := 0
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		connections++
+		connection := connections
+		lock.Unlock()
+
+		conn, err := upgrader.Upgrade(res, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var audio []byte
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if messageType == websocket.BinaryMessage {
+				audio = append(audio, message...)
+				if connection == 1 && len(audio) >= dropAfter {
+					_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"result_index": 0, "results": [{"final": true, "alternatives": [{"transcript": "hello ", "timestamps": [["hello", 0.0, 1.0]]}]}]}`))
+					return
+				}
+				continue
+			}
+
+			var action struct {
+				Action     string `json:"action"`
+				Timestamps bool   `json:"timestamps"`
+			}
+			Expect(json.Unmarshal(message, &action)).To(Succeed())
+			switch action.Action {
+			case "start":
+				Expect(action.Timestamps).To(BeTrue())
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))
+			case "stop":
+				lock.Lock()
+				*resumedAudio = audio
+				lock.Unlock()
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"result_index": 0, "results": [{"final": true, "alternatives": [{"transcript": "world ", "timestamps": [["world", 0.5, 1.5]]}]}], "speaker_labels": [{"from": 0.5, "to": 1.5, "speaker": 0, "confidence": 0.9, "final": true}]}`))
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))
+			}
+		}
+	}))
+}
+
+var _ = Describe(`RecognizeUsingWebsocketWithReconnect(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeEventCallback, policy *ReconnectPolicy)`, func() {
+	// 1000 samples of 16 bits per second
+	const byteRate = 2000
+
+	audio := make([]byte, 3*byteRate)
+	for i := range audio {
+		audio[i] = byte(i / byteRate)
+	}
+
+	It(`Resumes after the last final result and offsets the events`, func() {
+		var resumedAudio []byte
+		testServer := droppingRecognitionServer(2*byteRate, &resumedAudio)
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(audio)), "audio/l16; rate=1000").
+			SetChunkSize(500).
+			SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible)
+		recognizeWSOptions.SetSpeakerLabels(true)
+
+		callback := new(eventCallback)
+		err := testService.RecognizeUsingWebsocketWithReconnect(context.Background(), recognizeWSOptions, callback, speechtotextv1.NewReconnectPolicy(1).SetBackoff(time.Millisecond))
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(BeEmpty())
+		Expect(callback.closed).To(BeTrue())
+
+		// The audio is sent again from the end of the first result
+		Expect(resumedAudio).To(Equal(audio[byteRate:]))
+
+		Expect(callback.events).To(HaveLen(5))
+		Expect(callback.events[0]).To(Equal(&speechtotextv1.StateEvent{State: "listening"}))
+
+		first := callback.events[1].(*speechtotextv1.FinalResultEvent)
+		Expect(first.ResultIndex).To(Equal(int64(0)))
+		Expect(*first.Result.Alternatives[0].Transcript).To(Equal("hello "))
+		Expect(first.Result.Alternatives[0].Timestamps).To(BeNil())
+
+		second := callback.events[2].(*speechtotextv1.FinalResultEvent)
+		Expect(second.ResultIndex).To(Equal(int64(1)))
+		Expect(*second.Result.Alternatives[0].Transcript).To(Equal("world "))
+
+		speakerLabels := callback.events[3].(*speechtotextv1.SpeakerLabelsEvent)
+		Expect(*speakerLabels.SpeakerLabels[0].From).To(BeNumerically("~", 1.5, 0.001))
+		Expect(*speakerLabels.SpeakerLabels[0].To).To(BeNumerically("~", 2.5, 0.001))
+
+		Expect(callback.events[4]).To(Equal(&speechtotextv1.StateEvent{State: "listening"}))
+	})
+	It(`Keeps the timestamps requested by the options`, func() {
+		var resumedAudio []byte
+		testServer := droppingRecognitionServer(2*byteRate, &resumedAudio)
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(audio)), "audio/l16; rate=1000").
+			SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible)
+		recognizeWSOptions.SetTimestamps(true)
+
+		callback := new(eventCallback)
+		err := testService.RecognizeUsingWebsocketWithReconnect(context.Background(), recognizeWSOptions, callback, speechtotextv1.NewReconnectPolicy(1).SetBackoff(time.Millisecond))
+		Expect(err).To(BeNil())
+
+		second := callback.events[2].(*speechtotextv1.FinalResultEvent)
+		Expect(second.Result.Alternatives[0].Timestamps).To(Equal([]interface{}{[]interface{}{"world", 1.5, 2.5}}))
+	})
+	It(`Gives up once the policy is exhausted`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err != nil {
+				return
+			}
+			_, _, _ = conn.ReadMessage()
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"error": "Session timed out."}`))
+			conn.Close()
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(audio)), "audio/l16; rate=1000")
+
+		callback := new(eventCallback)
+		err := testService.RecognizeUsingWebsocketWithReconnect(context.Background(), recognizeWSOptions, callback, speechtotextv1.NewReconnectPolicy(2).SetBackoff(time.Millisecond))
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(HaveLen(1))
+		Expect(callback.errors[0]).To(MatchError("Session timed out."))
+		Expect(callback.closed).To(BeTrue())
+	})
+	It(`Fails to resume once the unacknowledged audio exceeds the journal`, func() {
+		var resumedAudio []byte
+		testServer := droppingRecognitionServer(2*byteRate, &resumedAudio)
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(audio)), "audio/l16; rate=1000").
+			SetChunkSize(500).
+			SetPacing(speechtotextv1.RecognizeUsingWebsocketOptions_Pacing_AsFastAsPossible)
+
+		callback := new(eventCallback)
+		policy := speechtotextv1.NewReconnectPolicy(1).SetBackoff(time.Millisecond).SetMaxJournalSize(byteRate / 2)
+		err := testService.RecognizeUsingWebsocketWithReconnect(context.Background(), recognizeWSOptions, callback, policy)
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(HaveLen(1))
+		Expect(callback.errors[0].Error()).To(ContainSubstring("maximum journal size of 1000 bytes"))
+		Expect(resumedAudio).To(BeNil())
+		Expect(callback.closed).To(BeTrue())
+	})
+	It(`Requires audio of a known byte rate`, func() {
+		testService := newWebsocketTestService("http://localhost")
+		recognizeWSOptions := testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(bytes.NewReader(audio)), "audio/mp3")
+		err := testService.RecognizeUsingWebsocketWithReconnect(context.Background(), recognizeWSOptions, new(eventCallback), nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
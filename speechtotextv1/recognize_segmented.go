@@ -0,0 +1,534 @@
+package speechtotextv1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// Constants associated with the RecognizeSegmentedOptions.SplitMode property.
+// Where the audio is split into segments.
+const (
+	// Splits the audio in the middle of the last silence of the second half of each segment. A segment that has no
+	// silence is split as a fixed window, overlapping the next segment.
+	RecognizeSegmentedOptions_SplitMode_Silence = "silence"
+
+	// Splits the audio into segments of the maximum duration that overlap, so that words cut at the end of a segment
+	// are transcribed whole at the start of the next one
+	RecognizeSegmentedOptions_SplitMode_FixedWindow = "fixed_window"
+)
+
+const (
+	DEFAULT_SEGMENT_DURATION      = 5 * time.Minute
+	DEFAULT_SEGMENT_OVERLAP       = 2 * time.Second
+	DEFAULT_SILENCE_THRESHOLD     = 0.01
+	DEFAULT_MIN_SILENCE_DURATION  = 300 * time.Millisecond
+	DEFAULT_SEGMENT_CONCURRENCY   = 4
+	SILENCE_DETECTION_WINDOW_SIZE = 10 * time.Millisecond
+)
+
+// RecognizeSegmentedOptions : The RecognizeSegmented options.
+type RecognizeSegmentedOptions struct {
+	RecognizeOptions
+
+	// Where the audio is split, see the RecognizeSegmentedOptions_SplitMode constants. Defaults to silence.
+	SplitMode *string
+
+	// The maximum duration of a segment. Defaults to DEFAULT_SEGMENT_DURATION.
+	SegmentDuration *time.Duration
+
+	// How much consecutive segments overlap with the fixed_window split mode, or in the silence split mode after a
+	// segment that has no silence. It must be less than half of the SegmentDuration. Defaults to
+	// DEFAULT_SEGMENT_OVERLAP, or to a quarter of the SegmentDuration if the default is too long.
+	Overlap *time.Duration
+
+	// The root mean square amplitude, relative to the full scale, under which audio is silent. Defaults to
+	// DEFAULT_SILENCE_THRESHOLD.
+	SilenceThreshold *float64
+
+	// The minimum duration of a silence at which the audio can be split. Defaults to DEFAULT_MIN_SILENCE_DURATION.
+	MinSilenceDuration *time.Duration
+
+	// The maximum number of segments recognized at the same time. Defaults to DEFAULT_SEGMENT_CONCURRENCY.
+	Concurrency *int
+}
+
+// NewRecognizeSegmentedOptions : Instantiate RecognizeSegmentedOptions. The content type must be audio/wav with
+// 16-bit linear PCM samples or audio/l16. If it is empty, it is detected from the first bytes of the audio.
+func (speechToText *SpeechToTextV1) NewRecognizeSegmentedOptions(audio io.ReadCloser, contentType string) *RecognizeSegmentedOptions {
+	recognizeOptions := speechToText.NewRecognizeOptions(audio)
+	if contentType != "" {
+		recognizeOptions.SetContentType(contentType)
+	}
+	return &RecognizeSegmentedOptions{RecognizeOptions: *recognizeOptions}
+}
+
+// SetSplitMode : Allow user to set SplitMode
+func (options *RecognizeSegmentedOptions) SetSplitMode(splitMode string) *RecognizeSegmentedOptions {
+	options.SplitMode = core.StringPtr(splitMode)
+	return options
+}
+
+// SetSegmentDuration : Allow user to set SegmentDuration
+func (options *RecognizeSegmentedOptions) SetSegmentDuration(segmentDuration time.Duration) *RecognizeSegmentedOptions {
+	options.SegmentDuration = &segmentDuration
+	return options
+}
+
+// SetOverlap : Allow user to set Overlap
+func (options *RecognizeSegmentedOptions) SetOverlap(overlap time.Duration) *RecognizeSegmentedOptions {
+	options.Overlap = &overlap
+	return options
+}
+
+// SetSilenceThreshold : Allow user to set SilenceThreshold
+func (options *RecognizeSegmentedOptions) SetSilenceThreshold(silenceThreshold float64) *RecognizeSegmentedOptions {
+	options.SilenceThreshold = core.Float64Ptr(silenceThreshold)
+	return options
+}
+
+// SetMinSilenceDuration : Allow user to set MinSilenceDuration
+func (options *RecognizeSegmentedOptions) SetMinSilenceDuration(minSilenceDuration time.Duration) *RecognizeSegmentedOptions {
+	options.MinSilenceDuration = &minSilenceDuration
+	return options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (options *RecognizeSegmentedOptions) SetConcurrency(concurrency int) *RecognizeSegmentedOptions {
+	options.Concurrency = &concurrency
+	return options
+}
+
+// RecognizeSegmented : Recognize audio that is too large or too long for a single Recognize request. The audio is
+// split into segments, at silences or into overlapping windows, which are recognized concurrently with the options
+// of the recognition. The results of the segments are merged in order into a single SpeechRecognitionResults: the
+// times of words, keywords and word alternatives are relative to the start of the whole audio, and the words that
+// overlapping segments both transcribe are kept once. Processing and audio metrics are not returned.
+//
+// Speaker labels are not supported, because the service identifies the speakers of each segment separately.
+//
+// The audio is read as the segments are sent, so that at most Concurrency+1 segments are held in memory: those being
+// recognized and the next one. The first failure of a segment cancels the others and is returned.
+func (speechToText *SpeechToTextV1) RecognizeSegmented(ctx context.Context, recognizeSegmentedOptions *RecognizeSegmentedOptions) (*SpeechRecognitionResults, error) {
+	if err := core.ValidateNotNil(recognizeSegmentedOptions, "recognizeSegmentedOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(recognizeSegmentedOptions, "recognizeSegmentedOptions"); err != nil {
+		return nil, err
+	}
+	recognizeOptions, err := recognizeSegmentedOptions.RecognizeOptions.withDetectedContentType()
+	if err != nil {
+		return nil, err
+	}
+	defer recognizeOptions.Audio.Close()
+	splitter, err := recognizeSegmentedOptions.newAudioSplitter(recognizeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Overlapping segments are trimmed by the times of their words. Segments that do not overlap are kept whole.
+	segmentOptions := *recognizeOptions
+	segmentOptions.ContentType = core.StringPtr(splitter.contentType)
+	keepTimestamps := recognizeOptions.Timestamps != nil && *recognizeOptions.Timestamps
+	if splitter.overlap > 0 {
+		segmentOptions.SetTimestamps(true)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var lock sync.Mutex
+	var firstErr error
+	fail := func(err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	var segments []*audioSegment
+	pending := make(chan *audioSegment)
+	var workers sync.WaitGroup
+	for i := 0; i < recognizeSegmentedOptions.concurrency(); i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for segment := range pending {
+				options := segmentOptions
+				options.Audio = ioutil.NopCloser(bytes.NewReader(segment.audio))
+				result, _, err := speechToText.RecognizeWithContext(ctx, &options)
+				if err != nil && ctx.Err() != nil {
+					fail(ctx.Err())
+					continue
+				}
+				if err != nil {
+					fail(fmt.Errorf("The recognition of the segment starting at %.3fs failed: %s", segment.start, err.Error()))
+					continue
+				}
+				// The audio is released once the segment is recognized
+				segment.audio = nil
+				segment.results = result
+			}
+		}()
+	}
+
+	for ctx.Err() == nil {
+		segment, err := splitter.next()
+		if err != nil {
+			fail(err)
+			break
+		}
+		if segment == nil {
+			break
+		}
+		segments = append(segments, segment)
+		select {
+		case pending <- segment:
+		case <-ctx.Done():
+		}
+	}
+	close(pending)
+	workers.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return mergeSegmentResults(segments, keepTimestamps), nil
+}
+
+func (options *RecognizeSegmentedOptions) concurrency() int {
+	if options.Concurrency != nil {
+		return *options.Concurrency
+	}
+	return DEFAULT_SEGMENT_CONCURRENCY
+}
+
+// audioSegment is a part of the audio recognized with a single request
+type audioSegment struct {
+	audio []byte
+
+	// The start time of the segment in the audio, in seconds.
+	start float64
+
+	// The words starting between keepFrom and keepTo are kept, the others are transcribed by an overlapping segment.
+	keepFrom float64
+	keepTo   float64
+
+	results *SpeechRecognitionResults
+}
+
+// audioSplitter reads the segments of linear PCM audio
+type audioSplitter struct {
+	reader      *bufio.Reader
+	format      audioFormat
+	contentType string
+
+	splitMode          string
+	segmentSize        int
+	overlap            int
+	silenceThreshold   float64
+	minSilenceDuration time.Duration
+
+	// The audio read after the end of the last segment, and its position in bytes.
+	carry    []byte
+	position int
+	keepFrom float64
+	done     bool
+}
+
+// newAudioSplitter checks the options and reads the format of the audio
+func (options *RecognizeSegmentedOptions) newAudioSplitter(recognizeOptions *RecognizeOptions) (*audioSplitter, error) {
+	splitter := &audioSplitter{
+		reader:             bufio.NewReaderSize(recognizeOptions.Audio, WAV_HEADER_SIZE),
+		splitMode:          RecognizeSegmentedOptions_SplitMode_Silence,
+		silenceThreshold:   DEFAULT_SILENCE_THRESHOLD,
+		minSilenceDuration: DEFAULT_MIN_SILENCE_DURATION,
+		keepFrom:           math.Inf(-1),
+	}
+	if options.SplitMode != nil {
+		splitter.splitMode = *options.SplitMode
+	}
+	if options.SilenceThreshold != nil {
+		splitter.silenceThreshold = *options.SilenceThreshold
+	}
+	if options.MinSilenceDuration != nil {
+		splitter.minSilenceDuration = *options.MinSilenceDuration
+	}
+	segmentDuration := DEFAULT_SEGMENT_DURATION
+	if options.SegmentDuration != nil {
+		segmentDuration = *options.SegmentDuration
+	}
+	overlap := DEFAULT_SEGMENT_OVERLAP
+	if 2*overlap >= segmentDuration {
+		overlap = segmentDuration / 4
+	}
+	if options.Overlap != nil {
+		overlap = *options.Overlap
+	}
+
+	switch {
+	case splitter.splitMode != RecognizeSegmentedOptions_SplitMode_Silence && splitter.splitMode != RecognizeSegmentedOptions_SplitMode_FixedWindow:
+		return nil, fmt.Errorf("Unknown split mode '%s'", splitter.splitMode)
+	case segmentDuration <= 0:
+		return nil, fmt.Errorf("The segment duration must be positive")
+	case overlap < 0 || 2*overlap >= segmentDuration:
+		return nil, fmt.Errorf("The overlap must not be negative and must be less than half of the segment duration")
+	case splitter.silenceThreshold <= 0 || splitter.silenceThreshold > 1:
+		return nil, fmt.Errorf("The silence threshold must be between 0 and 1")
+	case options.concurrency() <= 0:
+		return nil, fmt.Errorf("The concurrency must be positive")
+	case recognizeOptions.SpeakerLabels != nil && *recognizeOptions.SpeakerLabels:
+		return nil, fmt.Errorf("Speaker labels are not supported, because the speakers of each segment are identified separately")
+	case recognizeOptions.ContentType == nil:
+		return nil, fmt.Errorf("The content type of the audio is required to split it")
+	}
+
+	header, _ := splitter.reader.Peek(WAV_HEADER_SIZE)
+	format, err := describeAudio(*recognizeOptions.ContentType, header)
+	if err != nil {
+		return nil, err
+	}
+	if !format.linearPCM || format.sampleSize != 2 {
+		return nil, fmt.Errorf("Only 16-bit linear PCM audio can be split, not %s", *recognizeOptions.ContentType)
+	}
+	if _, err := splitter.reader.Discard(format.headerSize); err != nil {
+		return nil, err
+	}
+	splitter.format = format
+
+	splitter.contentType = format.l16ContentType(format.channels)
+	splitter.segmentSize = splitter.bytesOf(segmentDuration)
+	splitter.overlap = splitter.bytesOf(overlap)
+	return splitter, nil
+}
+
+// bytesOf returns the number of bytes of a duration of audio, rounded down to whole frames and at least one frame
+func (splitter *audioSplitter) bytesOf(duration time.Duration) int {
+	if duration == 0 {
+		return 0
+	}
+	frameSize := splitter.format.frameSize
+	size := int(duration.Seconds()*splitter.format.bytesPerSecond) / frameSize * frameSize
+	if size < frameSize {
+		return frameSize
+	}
+	return size
+}
+
+// next reads the next segment, or returns nil at the end of the audio
+func (splitter *audioSplitter) next() (*audioSegment, error) {
+	if splitter.done {
+		return nil, nil
+	}
+
+	buffer := make([]byte, splitter.segmentSize)
+	carried := copy(buffer, splitter.carry)
+	n, err := io.ReadFull(splitter.reader, buffer[carried:])
+	buffer = buffer[:carried+n]
+	last := err == io.EOF || err == io.ErrUnexpectedEOF
+	if err != nil && !last {
+		return nil, err
+	}
+	if !last {
+		if _, err := splitter.reader.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	// An incomplete frame at the end of the audio is dropped
+	buffer = buffer[:len(buffer)/splitter.format.frameSize*splitter.format.frameSize]
+	if len(buffer) == 0 {
+		splitter.done = true
+		return nil, nil
+	}
+
+	segment := &audioSegment{
+		start:    splitter.seconds(splitter.position),
+		keepFrom: splitter.keepFrom,
+		keepTo:   math.Inf(1),
+	}
+	if last {
+		splitter.done = true
+		segment.audio = buffer
+		return segment, nil
+	}
+
+	cut, next := len(buffer), len(buffer)
+	silence, found := 0, false
+	if splitter.splitMode == RecognizeSegmentedOptions_SplitMode_Silence {
+		silence, found = splitter.findSilence(buffer)
+	}
+	if found {
+		cut, next = silence, silence
+		splitter.keepFrom = math.Inf(-1)
+	} else {
+		next = len(buffer) - splitter.overlap
+		splitter.keepFrom = math.Inf(-1)
+		if splitter.overlap > 0 {
+			// The words are taken from the segment that transcribes them furthest from its edges
+			segment.keepTo = splitter.seconds(splitter.position + len(buffer) - splitter.overlap/2)
+			splitter.keepFrom = segment.keepTo
+		}
+	}
+	segment.audio = buffer[:cut]
+	splitter.carry = append([]byte(nil), buffer[next:]...)
+	splitter.position += next
+	return segment, nil
+}
+
+func (splitter *audioSplitter) seconds(position int) float64 {
+	return float64(position) / splitter.format.bytesPerSecond
+}
+
+// findSilence returns the position of the middle of the last silence of the second half of the buffer, and false if
+// it has no silence
+func (splitter *audioSplitter) findSilence(buffer []byte) (int, bool) {
+	frameSize := splitter.format.frameSize
+	windowSize := splitter.bytesOf(SILENCE_DETECTION_WINDOW_SIZE)
+	minWindows := int(math.Ceil(float64(splitter.minSilenceDuration) / float64(SILENCE_DETECTION_WINDOW_SIZE)))
+	if minWindows < 1 {
+		minWindows = 1
+	}
+
+	cut, found := len(buffer), false
+	runStart, runLength := 0, 0
+	for offset := len(buffer) / 2 / windowSize * windowSize; offset+windowSize <= len(buffer); offset += windowSize {
+		if splitter.rms(buffer[offset:offset+windowSize]) >= splitter.silenceThreshold {
+			runLength = 0
+			continue
+		}
+		if runLength == 0 {
+			runStart = offset
+		}
+		runLength++
+		if runLength >= minWindows {
+			cut, found = (runStart+(offset+windowSize-runStart)/2)/frameSize*frameSize, true
+		}
+	}
+	return cut, found
+}
+
+// rms returns the root mean square amplitude of 16-bit samples, relative to the full scale
+func (splitter *audioSplitter) rms(samples []byte) float64 {
+	var order binary.ByteOrder = binary.LittleEndian
+	if splitter.format.bigEndian {
+		order = binary.BigEndian
+	}
+	sum := 0.0
+	count := len(samples) / 2
+	for i := 0; i < count; i++ {
+		sample := float64(int16(order.Uint16(samples[2*i:]))) / 32768
+		sum += sample * sample
+	}
+	return math.Sqrt(sum / float64(count))
+}
+
+// mergeSegmentResults joins the results of the segments, shifting their times by the start of their segment
+func mergeSegmentResults(segments []*audioSegment, keepTimestamps bool) *SpeechRecognitionResults {
+	merged := &SpeechRecognitionResults{Results: []SpeechRecognitionResult{}, ResultIndex: core.Int64Ptr(0)}
+	warnings := map[string]bool{}
+	for _, segment := range segments {
+		if segment.results == nil {
+			continue
+		}
+		for _, result := range segment.results.Results {
+			offsetResult(&result, segment.start)
+			if trimmed, ok := trimResult(result, segment.keepFrom, segment.keepTo); ok {
+				if !keepTimestamps {
+					removeTimestamps(&trimmed)
+				}
+				merged.Results = append(merged.Results, trimmed)
+			}
+		}
+		for _, warning := range segment.results.Warnings {
+			if !warnings[warning] {
+				warnings[warning] = true
+				merged.Warnings = append(merged.Warnings, warning)
+			}
+		}
+	}
+	return merged
+}
+
+func inWindow(time float64, from float64, to float64) bool {
+	return time >= from && time < to
+}
+
+// trimResult keeps the words of a result that start between from and to. A result that is partly kept is reduced to
+// its best alternative, whose transcript is rebuilt from the kept words. It returns false if no word is kept.
+func trimResult(result SpeechRecognitionResult, from float64, to float64) (SpeechRecognitionResult, bool) {
+	if math.IsInf(from, -1) && math.IsInf(to, 1) {
+		return result, true
+	}
+	if len(result.Alternatives) == 0 {
+		return result, false
+	}
+	best := result.Alternatives[0]
+	timestamps, err := best.WordTimestamps()
+	if err != nil || len(timestamps) == 0 {
+		return result, false
+	}
+
+	var kept []int
+	for i, timestamp := range timestamps {
+		if inWindow(timestamp.StartTime, from, to) {
+			kept = append(kept, i)
+		}
+	}
+	switch len(kept) {
+	case 0:
+		return result, false
+	case len(timestamps):
+		return result, true
+	}
+
+	words := make([]string, len(kept))
+	keptTimestamps := make([]interface{}, len(kept))
+	var keptConfidences []interface{}
+	for i, index := range kept {
+		words[i] = timestamps[index].Word
+		keptTimestamps[i] = best.Timestamps[index]
+		if len(best.WordConfidence) == len(timestamps) {
+			keptConfidences = append(keptConfidences, best.WordConfidence[index])
+		}
+	}
+	best.Transcript = core.StringPtr(strings.Join(words, " ") + " ")
+	best.Timestamps = keptTimestamps
+	best.WordConfidence = keptConfidences
+	result.Alternatives = []SpeechRecognitionAlternative{best}
+
+	if result.KeywordsResult != nil {
+		keywordsResult := map[string][]KeywordResult{}
+		for keyword, matches := range result.KeywordsResult {
+			for _, match := range matches {
+				if match.StartTime != nil && inWindow(*match.StartTime, from, to) {
+					keywordsResult[keyword] = append(keywordsResult[keyword], match)
+				}
+			}
+		}
+		result.KeywordsResult = keywordsResult
+	}
+	var wordAlternatives []WordAlternativeResults
+	for _, alternatives := range result.WordAlternatives {
+		if alternatives.StartTime != nil && inWindow(*alternatives.StartTime, from, to) {
+			wordAlternatives = append(wordAlternatives, alternatives)
+		}
+	}
+	result.WordAlternatives = wordAlternatives
+	return result, true
+}
@@ -0,0 +1,311 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// spokenWord is a burst of constant samples, transcribed as "w" followed by the amplitude in thousands
+type spokenWord struct {
+	start     float64
+	duration  float64
+	amplitude int16
+}
+
+// speechAudio returns little-endian 16-bit mono audio at the given rate, with the words over silence
+func speechAudio(rate int, duration float64, words ...spokenWord) []byte {
+	audio := make([]byte, 2*int(duration*float64(rate)))
+	for _, word := range words {
+		for frame := int(word.start * float64(rate)); frame < int((word.start+word.duration)*float64(rate)); frame++ {
+			binary.LittleEndian.PutUint16(audio[2*frame:], uint16(word.amplitude))
+		}
+	}
+	return audio
+}
+
+// transcribeBursts returns the words of 16-bit mono audio, with their timestamps and confidences
+func transcribeBursts(audio []byte, rate int) (words []string, timestamps []interface{}, confidences []interface{}) {
+	frames := len(audio) / 2
+	current, start := int16(0), 0
+	for frame := 0; frame <= frames; frame++ {
+		sample := int16(0)
+		if frame < frames {
+			sample = int16(binary.LittleEndian.Uint16(audio[2*frame:]))
+		}
+		if sample == current {
+			continue
+		}
+		if current != 0 {
+			word := fmt.Sprintf("w%d", current/1000)
+			words = append(words, word)
+			timestamps = append(timestamps, []interface{}{word, float64(start) / float64(rate), float64(frame) / float64(rate)})
+			confidences = append(confidences, []interface{}{word, 0.9})
+		}
+		current, start = sample, frame
+	}
+	return words, timestamps, confidences
+}
+
+// segmentServer plays the service side of Recognize for 16-bit mono audio, transcribing the bursts of samples
+type segmentServer struct {
+	*httptest.Server
+	lock         sync.Mutex
+	contentTypes []string
+	durations    []float64
+	failAfter    float64
+}
+
+func newSegmentServer() *segmentServer {
+	server := &segmentServer{failAfter: -1}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	return server
+}
+
+func (server *segmentServer) serve(res http.ResponseWriter, req *http.Request) {
+	Expect(req.URL.Path).To(Equal("/v1/recognize"))
+	_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	Expect(err).To(BeNil())
+	rate, _ := strconv.Atoi(params["rate"])
+	audio, _ := ioutil.ReadAll(req.Body)
+	duration := float64(len(audio)/2) / float64(rate)
+
+	server.lock.Lock()
+	server.contentTypes = append(server.contentTypes, req.Header.Get("Content-Type"))
+	server.durations = append(server.durations, duration)
+	fail := server.failAfter >= 0 && len(server.durations) > int(server.failAfter)
+	server.lock.Unlock()
+	if fail {
+		res.WriteHeader(500)
+		fmt.Fprint(res, `{"error": "segment failed", "code": 500}`)
+		return
+	}
+
+	words, timestamps, confidences := transcribeBursts(audio, rate)
+	alternative := map[string]interface{}{"transcript": strings.Join(words, " ") + " ", "confidence": 0.9}
+	if req.URL.Query().Get("timestamps") == "true" {
+		alternative["timestamps"] = timestamps
+	}
+	alternative["word_confidence"] = confidences
+	results := []interface{}{}
+	if len(words) > 0 {
+		results = append(results, map[string]interface{}{"final": true, "alternatives": []interface{}{alternative},
+			"word_alternatives": []interface{}{map[string]interface{}{"start_time": timestamps[0].([]interface{})[1],
+				"end_time": timestamps[0].([]interface{})[2], "alternatives": []interface{}{map[string]interface{}{"word": words[0], "confidence": 0.9}}}}})
+	}
+	res.Header().Set("Content-Type", "application/json")
+	Expect(json.NewEncoder(res).Encode(map[string]interface{}{"results": results, "result_index": 0,
+		"warnings": []string{"Unknown arguments: foo."}})).To(Succeed())
+}
+
+// transcribedWords returns the words of merged results with their start times
+func transcribedWords(results *speechtotextv1.SpeechRecognitionResults) ([]string, []float64) {
+	var words []string
+	var starts []float64
+	for _, result := range results.Results {
+		Expect(result.Alternatives).ToNot(BeEmpty())
+		timestamps, err := result.Alternatives[0].WordTimestamps()
+		Expect(err).To(BeNil())
+		for _, timestamp := range timestamps {
+			words = append(words, timestamp.Word)
+			starts = append(starts, timestamp.StartTime)
+		}
+		Expect(strings.Fields(*result.Alternatives[0].Transcript)).To(HaveLen(len(timestamps)))
+	}
+	return words, starts
+}
+
+var _ = Describe(`RecognizeSegmented(ctx context.Context, recognizeSegmentedOptions *RecognizeSegmentedOptions)`, func() {
+	const rate = 1000
+	words := []spokenWord{
+		{0.2, 0.4, 1000}, {0.9, 0.3, 2000},
+		{1.5, 0.4, 3000}, {2.3, 0.5, 4000},
+		{3.1, 0.6, 5000}, {3.9, 0.3, 6000},
+		{4.6, 0.5, 7000}, {5.6, 0.2, 8000},
+	}
+	expectedWords := []string{"w1", "w2", "w3", "w4", "w5", "w6", "w7", "w8"}
+
+	It(`Splits WAV audio at silences and merges the results`, func() {
+		testServer := newSegmentServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		samples := speechAudio(rate, 6, words...)
+		audio := append(wavHeader(rate, uint32(len(samples))), samples...)
+		options := testService.NewRecognizeSegmentedOptions(ioutil.NopCloser(strings.NewReader(string(audio))), "").
+			SetSegmentDuration(2 * time.Second).
+			SetMinSilenceDuration(150 * time.Millisecond)
+		options.SetTimestamps(true)
+		results, err := testService.RecognizeSegmented(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		transcript, starts := transcribedWords(results)
+		Expect(transcript).To(Equal(expectedWords))
+		for i, word := range words {
+			Expect(starts[i]).To(BeNumerically("~", word.start, 0.002))
+		}
+		Expect(*results.ResultIndex).To(Equal(int64(0)))
+		Expect(results.Warnings).To(Equal([]string{"Unknown arguments: foo."}))
+		Expect(*results.Results[1].WordAlternatives[0].StartTime).To(BeNumerically(">", 1))
+
+		Expect(len(testServer.durations)).To(BeNumerically(">=", 4))
+		total := 0.0
+		for _, duration := range testServer.durations {
+			Expect(duration).To(BeNumerically("<=", 2))
+			total += duration
+		}
+		Expect(total).To(BeNumerically("~", 6, 0.002))
+		Expect(testServer.contentTypes[0]).To(Equal("audio/l16;rate=1000;channels=1;endianness=little-endian"))
+	})
+	It(`Splits a segment without silence as an overlapping window`, func() {
+		testServer := newSegmentServer()
+		defer testServer.Close()
+
+		// Words of 0.3 seconds without pauses, whose amplitudes differ from one word to the next
+		var speech []spokenWord
+		var speechWords []string
+		for i := 0; i < 13; i++ {
+			amplitude := int16(1000 * (1 + i%9))
+			speech = append(speech, spokenWord{0.3 * float64(i), 0.3, amplitude})
+			speechWords = append(speechWords, fmt.Sprintf("w%d", amplitude/1000))
+		}
+		testService := newWebsocketTestService(testServer.URL)
+		audio := speechAudio(rate, 3.9, speech...)
+		options := testService.NewRecognizeSegmentedOptions(ioutil.NopCloser(strings.NewReader(string(audio))), "audio/l16; rate=1000").
+			SetSegmentDuration(2 * time.Second).
+			SetOverlap(800 * time.Millisecond).
+			SetConcurrency(1)
+		options.SetTimestamps(true)
+		results, err := testService.RecognizeSegmented(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		transcript, starts := transcribedWords(results)
+		Expect(transcript).To(Equal(speechWords))
+		for i, word := range speech {
+			Expect(starts[i]).To(BeNumerically("~", word.start, 0.002))
+		}
+		Expect(testServer.durations).To(Equal([]float64{2, 2, 1.5}))
+	})
+	It(`Splits audio into overlapping windows and keeps the words once`, func() {
+		testServer := newSegmentServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		audio := speechAudio(rate, 6, words...)
+		options := testService.NewRecognizeSegmentedOptions(ioutil.NopCloser(strings.NewReader(string(audio))), "audio/l16; rate=1000").
+			SetSplitMode(speechtotextv1.RecognizeSegmentedOptions_SplitMode_FixedWindow).
+			SetSegmentDuration(2 * time.Second).
+			SetOverlap(800 * time.Millisecond).
+			SetConcurrency(2)
+		results, err := testService.RecognizeSegmented(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		var transcript []string
+		for _, result := range results.Results {
+			Expect(result.Alternatives[0].Timestamps).To(BeNil())
+			transcript = append(transcript, strings.Fields(*result.Alternatives[0].Transcript)...)
+		}
+		Expect(transcript).To(Equal(expectedWords))
+		Expect(testServer.durations).To(Equal([]float64{2, 2, 2, 2, 1.2}))
+	})
+	It(`Keeps the whole results of windows that do not overlap`, func() {
+		testServer := newSegmentServer()
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		audio := speechAudio(rate, 6, words...)
+		options := testService.NewRecognizeSegmentedOptions(ioutil.NopCloser(strings.NewReader(string(audio))), "audio/l16; rate=1000").
+			SetSplitMode(speechtotextv1.RecognizeSegmentedOptions_SplitMode_FixedWindow).
+			SetSegmentDuration(3 * time.Second).
+			SetOverlap(0)
+		results, err := testService.RecognizeSegmented(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		var transcript []string
+		for _, result := range results.Results {
+			Expect(result.Alternatives[0].Timestamps).To(BeNil())
+			transcript = append(transcript, strings.Fields(*result.Alternatives[0].Transcript)...)
+		}
+		Expect(transcript).To(Equal(expectedWords))
+		Expect(testServer.durations).To(Equal([]float64{3, 3}))
+	})
+	It(`Returns the first failure of a segment`, func() {
+		testServer := newSegmentServer()
+		testServer.failAfter = 1
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		audio := speechAudio(rate, 6, words...)
+		options := testService.NewRecognizeSegmentedOptions(ioutil.NopCloser(strings.NewReader(string(audio))), "audio/l16; rate=1000").
+			SetSplitMode(speechtotextv1.RecognizeSegmentedOptions_SplitMode_FixedWindow).
+			SetSegmentDuration(time.Second).
+			SetOverlap(0).
+			SetConcurrency(1)
+		_, err := testService.RecognizeSegmented(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("segment starting at 1.000s"))
+		Expect(testServer.durations).To(HaveLen(2))
+	})
+	It(`Returns empty results for empty audio`, func() {
+		testService := newWebsocketTestService("http://localhost:0")
+		options := testService.NewRecognizeSegmentedOptions(ioutil.NopCloser(strings.NewReader("")), "audio/l16; rate=1000")
+		results, err := testService.RecognizeSegmented(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(results.Results).To(BeEmpty())
+	})
+	It(`Returns an error for invalid options`, func() {
+		testService := newWebsocketTestService("http://localhost:0")
+		newOptions := func(contentType string) *speechtotextv1.RecognizeSegmentedOptions {
+			return testService.NewRecognizeSegmentedOptions(ioutil.NopCloser(strings.NewReader("audio")), contentType)
+		}
+
+		_, err := testService.RecognizeSegmented(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeSegmented(context.Background(), newOptions(""))
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeSegmented(context.Background(), newOptions("audio/mulaw; rate=8000"))
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeSegmented(context.Background(), newOptions("audio/l16; rate=8000").SetSplitMode("words"))
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeSegmented(context.Background(), newOptions("audio/l16; rate=8000").
+			SetSplitMode(speechtotextv1.RecognizeSegmentedOptions_SplitMode_FixedWindow).
+			SetSegmentDuration(time.Second).
+			SetOverlap(time.Second))
+		Expect(err).ToNot(BeNil())
+		_, err = testService.RecognizeSegmented(context.Background(), newOptions("audio/l16; rate=8000").SetConcurrency(0))
+		Expect(err).ToNot(BeNil())
+		speakerLabelsOptions := newOptions("audio/l16; rate=8000")
+		speakerLabelsOptions.SetSpeakerLabels(true)
+		_, err = testService.RecognizeSegmented(context.Background(), speakerLabelsOptions)
+		Expect(err).ToNot(BeNil())
+	})
+})
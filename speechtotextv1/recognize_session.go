@@ -0,0 +1,257 @@
+package speechtotextv1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/gorilla/websocket"
+)
+
+// ErrSessionClosed is returned by the methods of a RecognizeSession whose connection is closed
+var ErrSessionClosed = errors.New("The recognize session is closed")
+
+// RecognizeSession : A websocket connection to which audio is pushed as it becomes available, for example from a
+// live call. The connection can recognize several utterances: each one starts with Start, which OpenRecognizeSession
+// calls for the first one, and ends with Stop.
+type RecognizeSession struct {
+	conn     *websocket.Conn
+	options  *RecognizeUsingWebsocketOptions
+	callback RecognizeCallbackWrapper
+
+	// Serializes the writes to the connection
+	writeLock sync.Mutex
+
+	// Number of listening states sent by the service, and number of listening states expected once the pending start
+	// and stop messages are acknowledged. The listening channel is closed and replaced every time a listening state is
+	// received.
+	stateLock sync.Mutex
+	listened  int
+	expected  int
+	listening chan struct{}
+
+	ctx       context.Context
+	closeOnce sync.Once
+	closing   chan struct{}
+	done      chan struct{}
+}
+
+// OpenRecognizeSession : Opens a websocket connection and starts the recognition of a first utterance. The Audio of
+// the options is ignored: the audio is sent with the Write method of the session. Results and failures occurring
+// once the connection is open are delivered to the callback.
+func (speechToText *SpeechToTextV1) OpenRecognizeSession(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) (*RecognizeSession, error) {
+	return speechToText.OpenRecognizeSessionWithContext(context.Background(), recognizeWSOptions, callback)
+}
+
+// OpenRecognizeSessionWithContext : Opens a websocket connection and starts the recognition of a first utterance.
+// The connection is closed when ctx is done.
+func (speechToText *SpeechToTextV1) OpenRecognizeSessionWithContext(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) (*RecognizeSession, error) {
+	if err := core.ValidateNotNil(recognizeWSOptions, "recognizeOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	// The audio is sent with Write rather than read from the options
+	validatedOptions := *recognizeWSOptions
+	validatedOptions.Audio = http.NoBody
+	if err := core.ValidateStruct(&validatedOptions, "recognizeOptions"); err != nil {
+		return nil, err
+	}
+	if callback == nil {
+		return nil, fmt.Errorf("callback cannot be nil")
+	}
+
+	dialURL, param, headers, err := speechToText.recognizeHandshake(recognizeWSOptions)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := speechToText.dialRecognize(ctx, dialURL, param, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &RecognizeSession{
+		conn:      conn,
+		options:   recognizeWSOptions,
+		callback:  callback,
+		listening: make(chan struct{}),
+		ctx:       ctx,
+		closing:   make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	callback.OnOpen()
+	go session.read()
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-session.done:
+		}
+	}()
+
+	if err := session.Start(); err != nil {
+		session.Close()
+		return nil, err
+	}
+	return session, nil
+}
+
+// Start : Starts the recognition of a new utterance, with the parameters of the options the session was opened with
+func (session *RecognizeSession) Start() error {
+	startOptions := *session.options
+	startOptions.Action = core.StringPtr("start")
+	startMsgBytes, err := json.Marshal(startOptions)
+	if err != nil {
+		return err
+	}
+	return session.writeState(websocket.TextMessage, startMsgBytes)
+}
+
+// Write : Sends audio of the current utterance to the service
+func (session *RecognizeSession) Write(audio []byte) (int, error) {
+	if len(audio) == 0 {
+		return 0, nil
+	}
+	if err := session.write(websocket.BinaryMessage, audio); err != nil {
+		return 0, err
+	}
+	return len(audio), nil
+}
+
+// Stop : Ends the current utterance. The call returns once the service has sent the final results of the utterance
+// to the callback, after which Start can be called to recognize another utterance over the same connection. It waits
+// as long as the session is open; use StopWithContext to bound the wait.
+func (session *RecognizeSession) Stop() error {
+	return session.StopWithContext(context.Background())
+}
+
+// StopWithContext : Ends the current utterance, waiting for its final results until ctx is done. In that case
+// ctx.Err() is returned, and the session stays open: the results may still be delivered to the callback, and the
+// next Stop also waits for them.
+func (session *RecognizeSession) StopWithContext(ctx context.Context) error {
+	stopMsgBytes, _ := json.Marshal(RecognizeUsingWebsocketOptions{Action: core.StringPtr("stop")})
+	if err := session.writeState(websocket.TextMessage, stopMsgBytes); err != nil {
+		return err
+	}
+
+	for {
+		session.stateLock.Lock()
+		acknowledged := session.listened >= session.expected
+		listening := session.listening
+		session.stateLock.Unlock()
+		if acknowledged {
+			return nil
+		}
+
+		select {
+		case <-listening:
+		case <-session.done:
+			return ErrSessionClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Close : Closes the connection, without waiting for the results of an utterance that was not stopped. The call
+// returns once the callback has been notified of the closing.
+func (session *RecognizeSession) Close() error {
+	session.closeOnce.Do(func() {
+		close(session.closing)
+		session.writeLock.Lock()
+		_ = session.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		session.writeLock.Unlock()
+		session.conn.Close()
+	})
+	<-session.done
+	return nil
+}
+
+// Done : Returns a channel closed once the connection is closed
+func (session *RecognizeSession) Done() <-chan struct{} {
+	return session.done
+}
+
+// writeState sends a start or stop message, which the service acknowledges with a listening state
+func (session *RecognizeSession) writeState(messageType int, data []byte) error {
+	session.stateLock.Lock()
+	session.expected++
+	session.stateLock.Unlock()
+
+	err := session.write(messageType, data)
+	if err != nil {
+		session.stateLock.Lock()
+		session.expected--
+		session.stateLock.Unlock()
+	}
+	return err
+}
+
+func (session *RecognizeSession) write(messageType int, data []byte) error {
+	select {
+	case <-session.done:
+		return ErrSessionClosed
+	default:
+	}
+
+	session.writeLock.Lock()
+	defer session.writeLock.Unlock()
+	return session.conn.WriteMessage(messageType, data)
+}
+
+// read delivers the messages of the service to the callback until the connection is closed
+func (session *RecognizeSession) read() {
+	defer func() {
+		session.conn.Close()
+		session.callback.OnClose()
+		close(session.done)
+	}()
+
+	for {
+		_, result, err := session.conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-session.closing:
+			default:
+				if session.ctx.Err() != nil {
+					err = session.ctx.Err()
+				}
+				session.callback.OnError(err)
+			}
+			return
+		}
+
+		var websocketResponse websocketMessage
+		if err = json.Unmarshal(result, &websocketResponse); err != nil {
+			session.callback.OnError(err)
+			continue
+		}
+		if websocketResponse.Error != "" {
+			// The service closes the connection after an error
+			session.callback.OnError(&ServiceErrorEvent{Message: websocketResponse.Error})
+			continue
+		}
+
+		if websocketResponse.State != "" {
+			if stateCallback, ok := session.callback.(recognizeStateCallback); ok {
+				stateCallback.onState(websocketResponse.State)
+			}
+		}
+		if websocketResponse.State == "listening" {
+			session.stateLock.Lock()
+			session.listened++
+			close(session.listening)
+			session.listening = make(chan struct{})
+			session.stateLock.Unlock()
+			continue
+		}
+
+		detailResp := core.DetailedResponse{}
+		detailResp.Result = result
+		detailResp.StatusCode = SUCCESS
+		session.callback.OnData(&detailResp)
+	}
+}
@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+var _ = Describe(`OpenRecognizeSession(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper)`, func() {
+	It(`Recognizes several utterances over the same connection`, func() {
+		testServer := recognitionServer(func(audio []byte) []string {
+			return []string{fmt.Sprintf(`{"results": [{"final": true, "alternatives": [{"transcript": "%s"}]}], "result_index": 0}`, audio)}
+		})
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		callback := new(recordingCallback)
+		session, err := testService.OpenRecognizeSession(testService.NewRecognizeUsingWebsocketOptions(nil, "audio/l16; rate=16000"), callback)
+		Expect(err).To(BeNil())
+		Expect(callback.opened).To(BeTrue())
+
+		_, err = session.Write([]byte("first "))
+		Expect(err).To(BeNil())
+		_, err = session.Write([]byte("utterance"))
+		Expect(err).To(BeNil())
+		Expect(session.Stop()).To(Succeed())
+		Expect(callback.results).To(HaveLen(1))
+		Expect(callback.results[0]).To(ContainSubstring("first utterance"))
+
+		Expect(session.Start()).To(Succeed())
+		_, err = session.Write([]byte("second utterance"))
+		Expect(err).To(BeNil())
+		Expect(session.Stop()).To(Succeed())
+		Expect(callback.results).To(HaveLen(2))
+		Expect(callback.results[1]).To(ContainSubstring("second utterance"))
+
+		Expect(session.Close()).To(Succeed())
+		Eventually(session.Done()).Should(BeClosed())
+		Expect(callback.closed).To(BeTrue())
+		Expect(callback.errors).To(BeEmpty())
+
+		_, err = session.Write([]byte("too late"))
+		Expect(err).To(Equal(speechtotextv1.ErrSessionClosed))
+	})
+	It(`Returns an error when the connection ends before the utterance is stopped`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err != nil {
+				return
+			}
+			_, _, _ = conn.ReadMessage()
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))
+			conn.Close()
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		callback := new(recordingCallback)
+		session, err := testService.OpenRecognizeSession(testService.NewRecognizeUsingWebsocketOptions(nil, "audio/l16; rate=16000"), callback)
+		Expect(err).To(BeNil())
+
+		Expect(session.Stop()).ToNot(Succeed())
+		Eventually(session.Done()).Should(BeClosed())
+		Expect(callback.errors).ToNot(BeEmpty())
+	})
+	It(`Stops waiting for the final results when the context is done`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			_, _, _ = conn.ReadMessage()
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		session, err := testService.OpenRecognizeSession(testService.NewRecognizeUsingWebsocketOptions(nil, "audio/l16; rate=16000"), new(recordingCallback))
+		Expect(err).To(BeNil())
+		defer session.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		Expect(session.StopWithContext(ctx)).To(Equal(context.DeadlineExceeded))
+	})
+	It(`Delivers the errors sent by the service to OnError`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			_, _, _ = conn.ReadMessage()
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))
+			_, _, _ = conn.ReadMessage()
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"error": "No speech detected for 30s."}`))
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		callback := new(recordingCallback)
+		session, err := testService.OpenRecognizeSession(testService.NewRecognizeUsingWebsocketOptions(nil, "audio/l16; rate=16000"), callback)
+		Expect(err).To(BeNil())
+
+		_, err = session.Write([]byte("audio"))
+		Expect(err).To(BeNil())
+		Eventually(session.Done()).Should(BeClosed())
+		Expect(callback.results).To(BeEmpty())
+		Expect(callback.errors).ToNot(BeEmpty())
+		Expect(callback.errors[0]).To(Equal(&speechtotextv1.ServiceErrorEvent{Message: "No speech detected for 30s."}))
+	})
+	It(`Returns an error without options`, func() {
+		testService := newWebsocketTestService("http://localhost")
+		_, err := testService.OpenRecognizeSession(nil, new(recordingCallback))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,114 @@
+package speechtotextv1
+
+import (
+	"context"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// RECOGNITION_EVENTS_BUFFER is the capacity of the channel returned by RecognizeUsingWebsocketStream
+const RECOGNITION_EVENTS_BUFFER = 16
+
+// RecognitionErrorEvent : The last event of a stream of recognition events, when the recognition failed. Err is the
+// error sent by the service, as a *ServiceErrorEvent, or else the failure of the connection.
+type RecognitionErrorEvent struct {
+	Err error
+}
+
+// Error returns the description of the failure
+func (event *RecognitionErrorEvent) Error() string {
+	return event.Err.Error()
+}
+
+// Unwrap returns the failure
+func (event *RecognitionErrorEvent) Unwrap() error {
+	return event.Err
+}
+
+func (*RecognitionErrorEvent) recognitionEvent() {}
+
+// RecognizeUsingWebsocketStream : Recognize audio over websocket connection, delivering the events of the recognition
+// on a channel. The channel is closed once the service has sent the final results, or when the connection ends.
+// Failures to set up the connection are returned.
+//
+// If the recognition fails, the last event before the channel is closed is a *RecognitionErrorEvent. It is the only
+// report of the failure, so the channel must be read until it is closed to receive it. When ctx is done, the channel
+// is closed without an error event, and ctx.Err() reports why the recognition stopped. A consumer that stops reading
+// before the channel is closed must cancel ctx, which ends the recognition.
+func (speechToText *SpeechToTextV1) RecognizeUsingWebsocketStream(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions) (<-chan RecognitionEvent, error) {
+	if err := core.ValidateNotNil(recognizeWSOptions, "recognizeOptions cannot be nil"); err != nil {
+		return nil, err
+	}
+	if err := core.ValidateStruct(recognizeWSOptions, "recognizeOptions"); err != nil {
+		return nil, err
+	}
+	recognizeWSOptions, err := recognizeWSOptions.withDetectedContentType()
+	if err != nil {
+		return nil, err
+	}
+	if err := recognizeWSOptions.validatePacing(); err != nil {
+		return nil, err
+	}
+
+	dialURL, param, headers, err := speechToText.recognizeHandshake(recognizeWSOptions)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := speechToText.dialRecognize(ctx, dialURL, param, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	callback := &recognizeStreamCallback{ctx: ctx, events: make(chan RecognitionEvent, RECOGNITION_EVENTS_BUFFER)}
+	go func() {
+		defer close(callback.events)
+		runRecognizeListener(ctx, conn, NewRecognizeEventCallbackWrapper(callback), recognizeWSOptions)
+		if err := callback.terminalError(); err != nil && ctx.Err() == nil {
+			callback.send(&RecognitionErrorEvent{Err: err})
+		}
+	}()
+	return callback.events, nil
+}
+
+// recognizeStreamCallback forwards the events of a recognition to a channel, and keeps the first error
+type recognizeStreamCallback struct {
+	ctx    context.Context
+	events chan RecognitionEvent
+
+	lock sync.Mutex
+	err  error
+}
+
+func (callback *recognizeStreamCallback) OnOpen() {}
+
+func (callback *recognizeStreamCallback) OnClose() {}
+
+func (callback *recognizeStreamCallback) OnEvent(event RecognitionEvent) {
+	if serviceError, ok := event.(*ServiceErrorEvent); ok {
+		callback.OnError(serviceError)
+	}
+	callback.send(event)
+}
+
+func (callback *recognizeStreamCallback) OnError(err error) {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	if callback.err == nil {
+		callback.err = err
+	}
+}
+
+func (callback *recognizeStreamCallback) terminalError() error {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	return callback.err
+}
+
+// send delivers an event, unless the context is done, in which case the receiver may have stopped reading
+func (callback *recognizeStreamCallback) send(event RecognitionEvent) {
+	select {
+	case callback.events <- event:
+	case <-callback.ctx.Done():
+	}
+}
@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+func collectEvents(events <-chan speechtotextv1.RecognitionEvent) []speechtotextv1.RecognitionEvent {
+	var collected []speechtotextv1.RecognitionEvent
+	for event := range events {
+		collected = append(collected, event)
+	}
+	return collected
+}
+
+var _ = Describe(`RecognizeUsingWebsocketStream(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions)`, func() {
+	It(`Delivers the events and closes the channel after the final results`, func() {
+		testServer := recognitionServer(func(audio []byte) []string {
+			return []string{
+				`{"results": [{"final": false, "alternatives": [{"transcript": "some"}]}], "result_index": 0}`,
+				`{"results": [{"final": true, "alternatives": [{"transcript": "some audio"}]}], "result_index": 0}`,
+			}
+		})
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		events, err := testService.RecognizeUsingWebsocketStream(context.Background(), newWebsocketTestOptions(testService, "some audio"))
+		Expect(err).To(BeNil())
+
+		collected := collectEvents(events)
+		Expect(collected).To(HaveLen(4))
+		Expect(collected[0]).To(Equal(&speechtotextv1.StateEvent{State: "listening"}))
+		Expect(collected[1]).To(BeAssignableToTypeOf(&speechtotextv1.InterimResultEvent{}))
+		Expect(collected[2]).To(BeAssignableToTypeOf(&speechtotextv1.FinalResultEvent{}))
+		Expect(collected[3]).To(Equal(&speechtotextv1.StateEvent{State: "listening"}))
+	})
+	It(`Ends with the error sent by the service`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err != nil {
+				return
+			}
+			_, _, _ = conn.ReadMessage()
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"error": "Model en-XX_BroadbandModel not found"}`))
+			conn.Close()
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		events, err := testService.RecognizeUsingWebsocketStream(context.Background(), newWebsocketTestOptions(testService, "some audio"))
+		Expect(err).To(BeNil())
+
+		collected := collectEvents(events)
+		Expect(collected).ToNot(BeEmpty())
+		errorEvent, ok := collected[len(collected)-1].(*speechtotextv1.RecognitionErrorEvent)
+		Expect(ok).To(BeTrue())
+		var serviceError *speechtotextv1.ServiceErrorEvent
+		Expect(errors.As(errorEvent, &serviceError)).To(BeTrue())
+		Expect(serviceError.Message).To(Equal("Model en-XX_BroadbandModel not found"))
+	})
+	It(`Closes the channel when the context is cancelled`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}))
+		defer testServer.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		testService := newWebsocketTestService(testServer.URL)
+		events, err := testService.RecognizeUsingWebsocketStream(ctx, newWebsocketTestOptions(testService, "some audio"))
+		Expect(err).To(BeNil())
+		cancel()
+		Eventually(events).Should(BeClosed())
+	})
+	It(`Returns setup errors`, func() {
+		testService := newWebsocketTestService("http://localhost")
+		_, err := testService.RecognizeUsingWebsocketStream(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,273 @@
+package speechtotextv1
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// UNKNOWN_SPEAKER is the speaker of the words that no speaker label covers
+const UNKNOWN_SPEAKER = -1
+
+// speakerLabelTolerance is the difference, in seconds, under which a speaker label and a word start at the same time
+const speakerLabelTolerance = 0.001
+
+// SpeakerTurn : Consecutive words of a transcript spoken by the same speaker
+type SpeakerTurn struct {
+	// The speaker, as identified by the speaker labels, or UNKNOWN_SPEAKER.
+	Speaker int64
+
+	// The start time of the first word, in seconds.
+	StartTime float64
+
+	// The end time of the last word, in seconds.
+	EndTime float64
+
+	// The words, separated by spaces.
+	Text string
+
+	// The average confidence score of the words, or the confidence of the transcripts when the word confidences are
+	// not available.
+	Confidence float64
+
+	// The average confidence score of the speaker labels of the words.
+	SpeakerConfidence float64
+
+	// Whether the speaker labels of all the words are final. Interim labels may still change while a websocket
+	// recognition goes on.
+	Final bool
+}
+
+// transcriptWord is a word of a final result, with its confidence
+type transcriptWord struct {
+	WordTimestamp
+	confidence float64
+
+	// Whether the word is the last of a result that the service ended at the end of an utterance.
+	endOfUtterance bool
+}
+
+// speakerWord is a word attributed to a speaker
+type speakerWord struct {
+	transcriptWord
+	speaker           int64
+	speakerConfidence float64
+	final             bool
+}
+
+// SpeakerTranscriptBuilder : Merges the words of final results with speaker labels into speaker turns. Results and
+// labels can be added in any order, for example as the events of a websocket recognition arrive: a later label
+// replaces an interim label for the same word, and a later final result replaces a result of the same index. It is
+// safe for concurrent use.
+type SpeakerTranscriptBuilder struct {
+	lock sync.Mutex
+
+	// The words of the final results, by result index.
+	results map[int64][]transcriptWord
+
+	// The speaker labels, by start time in milliseconds.
+	labels map[int64]SpeakerLabelsResult
+}
+
+// NewSpeakerTranscriptBuilder : Instantiate SpeakerTranscriptBuilder
+func NewSpeakerTranscriptBuilder() *SpeakerTranscriptBuilder {
+	return &SpeakerTranscriptBuilder{
+		results: make(map[int64][]transcriptWord),
+		labels:  make(map[int64]SpeakerLabelsResult),
+	}
+}
+
+// SpeakerTurns : Merges the results of a recognition, made with speaker labels and timestamps, into speaker turns
+func SpeakerTurns(results *SpeechRecognitionResults) ([]SpeakerTurn, error) {
+	builder := NewSpeakerTranscriptBuilder()
+	if err := builder.AddResults(results); err != nil {
+		return nil, err
+	}
+	return builder.Turns(), nil
+}
+
+// AddResults : Adds the final results and the speaker labels of a response of Recognize or CheckJob
+func (builder *SpeakerTranscriptBuilder) AddResults(results *SpeechRecognitionResults) error {
+	var resultIndex int64
+	if results.ResultIndex != nil {
+		resultIndex = *results.ResultIndex
+	}
+	for i := range results.Results {
+		if err := builder.AddResult(resultIndex+int64(i), &results.Results[i]); err != nil {
+			return err
+		}
+	}
+	builder.AddSpeakerLabels(results.SpeakerLabels)
+	return nil
+}
+
+// AddEvent : Adds the final result or the speaker labels carried by an event of a websocket recognition. Other events
+// are ignored.
+func (builder *SpeakerTranscriptBuilder) AddEvent(event RecognitionEvent) error {
+	switch event := event.(type) {
+	case *FinalResultEvent:
+		return builder.AddResult(event.ResultIndex, &event.Result)
+	case *SpeakerLabelsEvent:
+		builder.AddSpeakerLabels(event.SpeakerLabels)
+	}
+	return nil
+}
+
+// AddResult : Adds a result of the given index, which replaces any result previously added with the same index.
+// Results that are not final are ignored.
+func (builder *SpeakerTranscriptBuilder) AddResult(resultIndex int64, result *SpeechRecognitionResult) error {
+	if result.Final == nil || !*result.Final || len(result.Alternatives) == 0 {
+		return nil
+	}
+
+	alternative := &result.Alternatives[0]
+	timestamps, err := alternative.WordTimestamps()
+	if err != nil {
+		return err
+	}
+	confidences, err := alternative.WordConfidences()
+	if err != nil {
+		return err
+	}
+
+	words := make([]transcriptWord, len(timestamps))
+	for i, timestamp := range timestamps {
+		words[i].WordTimestamp = timestamp
+		if len(confidences) == len(timestamps) {
+			words[i].confidence = confidences[i].Confidence
+		} else if alternative.Confidence != nil {
+			words[i].confidence = *alternative.Confidence
+		}
+	}
+	if len(words) > 0 && result.EndOfUtterance != nil {
+		words[len(words)-1].endOfUtterance = true
+	}
+
+	builder.lock.Lock()
+	defer builder.lock.Unlock()
+	builder.results[resultIndex] = words
+	return nil
+}
+
+// AddSpeakerLabels : Adds speaker labels. A label replaces the label previously added for the same start time, unless
+// that one is final and the new one is not.
+func (builder *SpeakerTranscriptBuilder) AddSpeakerLabels(labels []SpeakerLabelsResult) {
+	builder.lock.Lock()
+	defer builder.lock.Unlock()
+	for _, label := range labels {
+		if label.From == nil || label.To == nil || label.Speaker == nil {
+			continue
+		}
+		key := int64(math.Round(float64(*label.From) * 1000))
+		if previous, ok := builder.labels[key]; ok && isFinalLabel(previous) && !isFinalLabel(label) {
+			continue
+		}
+		builder.labels[key] = label
+	}
+}
+
+func isFinalLabel(label SpeakerLabelsResult) bool {
+	return label.Final != nil && *label.Final
+}
+
+// Turns : Returns the speaker turns of the words added so far, in chronological order
+func (builder *SpeakerTranscriptBuilder) Turns() []SpeakerTurn {
+	var turns []SpeakerTurn
+	var text []string
+	for _, word := range builder.speakerWords() {
+		if len(turns) == 0 || turns[len(turns)-1].Speaker != word.speaker {
+			if len(turns) > 0 {
+				closeTurn(&turns[len(turns)-1], text)
+			}
+			turns = append(turns, SpeakerTurn{Speaker: word.speaker, StartTime: word.StartTime, Final: true})
+			text = nil
+		}
+
+		turn := &turns[len(turns)-1]
+		turn.EndTime = math.Max(turn.EndTime, word.EndTime)
+		turn.Confidence += word.confidence
+		turn.SpeakerConfidence += word.speakerConfidence
+		turn.Final = turn.Final && word.final
+		text = append(text, word.Word)
+	}
+	if len(turns) > 0 {
+		closeTurn(&turns[len(turns)-1], text)
+	}
+	return turns
+}
+
+// speakerWords returns the words added so far in chronological order, with their speakers
+func (builder *SpeakerTranscriptBuilder) speakerWords() []speakerWord {
+	builder.lock.Lock()
+	defer builder.lock.Unlock()
+
+	// The words are collected in the order of their results, which the stable sort keeps for equal start times
+	indexes := make([]int64, 0, len(builder.results))
+	for index := range builder.results {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	var words []speakerWord
+	for _, index := range indexes {
+		for _, word := range builder.results[index] {
+			words = append(words, speakerWord{transcriptWord: word, speaker: UNKNOWN_SPEAKER})
+		}
+	}
+	sort.SliceStable(words, func(i, j int) bool {
+		return words[i].StartTime < words[j].StartTime
+	})
+
+	labels := make([]SpeakerLabelsResult, 0, len(builder.labels))
+	for _, label := range builder.labels {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return *labels[i].From < *labels[j].From
+	})
+
+	for i := range words {
+		label, found := speakerLabelOf(words[i].WordTimestamp, labels)
+		if !found {
+			continue
+		}
+		words[i].speaker = *label.Speaker
+		words[i].final = isFinalLabel(label)
+		if label.Confidence != nil {
+			words[i].speakerConfidence = float64(*label.Confidence)
+		}
+	}
+	return words
+}
+
+// closeTurn sets the text of a turn and turns the sums of its confidences into averages
+func closeTurn(turn *SpeakerTurn, text []string) {
+	turn.Text = strings.Join(text, " ")
+	turn.Confidence /= float64(len(text))
+	turn.SpeakerConfidence /= float64(len(text))
+}
+
+// speakerLabelOf finds the label of a word: the label starting with the word, or else the label that overlaps the
+// word the most
+func speakerLabelOf(word WordTimestamp, labels []SpeakerLabelsResult) (SpeakerLabelsResult, bool) {
+	index := sort.Search(len(labels), func(i int) bool {
+		return float64(*labels[i].From) >= word.StartTime-speakerLabelTolerance
+	})
+	if index < len(labels) && math.Abs(float64(*labels[index].From)-word.StartTime) <= speakerLabelTolerance {
+		return labels[index], true
+	}
+
+	best := -1
+	bestOverlap := 0.0
+	for i, label := range labels {
+		overlap := math.Min(float64(*label.To), word.EndTime) - math.Max(float64(*label.From), word.StartTime)
+		if overlap > bestOverlap {
+			best = i
+			bestOverlap = overlap
+		}
+	}
+	if best < 0 {
+		return SpeakerLabelsResult{}, false
+	}
+	return labels[best], true
+}
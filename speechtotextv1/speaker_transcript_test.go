@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+const speakerResultsJSON = `{
+	"result_index": 0,
+	"results": [
+		{"final": true, "alternatives": [{"transcript": "hello there how are you", "confidence": 0.8,
+			"timestamps": [["hello", 0.0, 0.4], ["there", 0.4, 0.8], ["how", 1.0, 1.2], ["are", 1.2, 1.4], ["you", 1.4, 1.6]],
+			"word_confidence": [["hello", 1.0], ["there", 0.8], ["how", 0.9], ["are", 0.7], ["you", 0.8]]}]},
+		{"final": true, "alternatives": [{"transcript": "fine thanks", "confidence": 0.6,
+			"timestamps": [["fine", 2.0, 2.3], ["thanks", 2.3, 2.7]]}]}
+	],
+	"speaker_labels": [
+		{"from": 0.0, "to": 0.4, "speaker": 0, "confidence": 0.5, "final": true},
+		{"from": 0.4, "to": 0.8, "speaker": 0, "confidence": 0.7, "final": true},
+		{"from": 1.0, "to": 1.2, "speaker": 1, "confidence": 0.6, "final": true},
+		{"from": 1.2, "to": 1.4, "speaker": 1, "confidence": 0.6, "final": true},
+		{"from": 1.4, "to": 1.6, "speaker": 1, "confidence": 0.6, "final": true},
+		{"from": 2.0, "to": 2.3, "speaker": 0, "confidence": 0.9, "final": true},
+		{"from": 2.3, "to": 2.7, "speaker": 0, "confidence": 0.9, "final": true}
+	]
+}`
+
+func parseRecognitionEvents(message string) []speechtotextv1.RecognitionEvent {
+	events, err := speechtotextv1.ParseRecognitionEvents([]byte(message))
+	Expect(err).To(BeNil())
+	return events
+}
+
+var _ = Describe(`SpeakerTranscriptBuilder`, func() {
+	It(`Merges the results and the speaker labels into turns`, func() {
+		var results speechtotextv1.SpeechRecognitionResults
+		Expect(json.Unmarshal([]byte(speakerResultsJSON), &results)).To(Succeed())
+
+		turns, err := speechtotextv1.SpeakerTurns(&results)
+		Expect(err).To(BeNil())
+		Expect(turns).To(HaveLen(3))
+
+		Expect(turns[0].Speaker).To(Equal(int64(0)))
+		Expect(turns[0].Text).To(Equal("hello there"))
+		Expect(turns[0].StartTime).To(Equal(0.0))
+		Expect(turns[0].EndTime).To(Equal(0.8))
+		Expect(turns[0].Confidence).To(BeNumerically("~", 0.9, 1e-6))
+		Expect(turns[0].SpeakerConfidence).To(BeNumerically("~", 0.6, 1e-6))
+		Expect(turns[0].Final).To(BeTrue())
+
+		Expect(turns[1].Speaker).To(Equal(int64(1)))
+		Expect(turns[1].Text).To(Equal("how are you"))
+		Expect(turns[1].StartTime).To(Equal(1.0))
+		Expect(turns[1].EndTime).To(Equal(1.6))
+
+		// Without word confidences, the confidence of the transcript is used
+		Expect(turns[2].Speaker).To(Equal(int64(0)))
+		Expect(turns[2].Text).To(Equal("fine thanks"))
+		Expect(turns[2].Confidence).To(BeNumerically("~", 0.6, 1e-6))
+	})
+	It(`Replaces interim labels with final ones as websocket events arrive`, func() {
+		builder := speechtotextv1.NewSpeakerTranscriptBuilder()
+		messages := []string{
+			`{"result_index": 0, "results": [{"final": false, "alternatives": [{"transcript": "hello", "timestamps": [["hello", 0.0, 0.4]]}]}]}`,
+			`{"result_index": 0, "results": [{"final": true, "alternatives": [{"transcript": "hello there", "confidence": 0.9, "timestamps": [["hello", 0.0, 0.4], ["there", 0.4, 0.8]]}]}]}`,
+			`{"speaker_labels": [{"from": 0.0, "to": 0.4, "speaker": 0, "confidence": 0.5, "final": false}, {"from": 0.4, "to": 0.8, "speaker": 0, "confidence": 0.5, "final": false}]}`,
+		}
+		for _, message := range messages {
+			for _, event := range parseRecognitionEvents(message) {
+				Expect(builder.AddEvent(event)).To(Succeed())
+			}
+		}
+
+		turns := builder.Turns()
+		Expect(turns).To(HaveLen(1))
+		Expect(turns[0].Text).To(Equal("hello there"))
+		Expect(turns[0].Final).To(BeFalse())
+
+		for _, event := range parseRecognitionEvents(`{"speaker_labels": [{"from": 0.0, "to": 0.4, "speaker": 0, "confidence": 0.8, "final": true}, {"from": 0.4, "to": 0.8, "speaker": 1, "confidence": 0.8, "final": true}]}`) {
+			Expect(builder.AddEvent(event)).To(Succeed())
+		}
+		// A late interim label does not replace a final one
+		builder.AddSpeakerLabels(parseSpeakerLabels(`[{"from": 0.4, "to": 0.8, "speaker": 0, "confidence": 0.1, "final": false}]`))
+
+		turns = builder.Turns()
+		Expect(turns).To(HaveLen(2))
+		Expect(turns[0].Text).To(Equal("hello"))
+		Expect(turns[0].Final).To(BeTrue())
+		Expect(turns[1].Speaker).To(Equal(int64(1)))
+		Expect(turns[1].Text).To(Equal("there"))
+		Expect(turns[1].SpeakerConfidence).To(BeNumerically("~", 0.8, 1e-6))
+		Expect(turns[1].Final).To(BeTrue())
+	})
+	It(`Attributes words without a matching label`, func() {
+		builder := speechtotextv1.NewSpeakerTranscriptBuilder()
+		for _, event := range parseRecognitionEvents(`{"result_index": 0, "results": [{"final": true, "alternatives": [{"transcript": "one two three", "timestamps": [["one", 0.0, 0.3], ["two", 0.31, 0.6], ["three", 5.0, 5.5]]}]}]}`) {
+			Expect(builder.AddEvent(event)).To(Succeed())
+		}
+		builder.AddSpeakerLabels(parseSpeakerLabels(`[{"from": 0.0, "to": 0.65, "speaker": 2, "confidence": 0.5, "final": true}]`))
+
+		turns := builder.Turns()
+		Expect(turns).To(HaveLen(2))
+		Expect(turns[0].Speaker).To(Equal(int64(2)))
+		Expect(turns[0].Text).To(Equal("one two"))
+		Expect(turns[1].Speaker).To(Equal(int64(speechtotextv1.UNKNOWN_SPEAKER)))
+		Expect(turns[1].Text).To(Equal("three"))
+		Expect(turns[1].Final).To(BeFalse())
+	})
+	It(`Orders the words that start at the same time by result`, func() {
+		builder := speechtotextv1.NewSpeakerTranscriptBuilder()
+		var expected []string
+		for i := 9; i >= 0; i-- {
+			event := fmt.Sprintf(`{"result_index": %d, "results": [{"final": true, "alternatives": [{"transcript": "w%d", "timestamps": [["w%d", 1.0, 1.5]]}]}]}`, i, i, i)
+			for _, event := range parseRecognitionEvents(event) {
+				Expect(builder.AddEvent(event)).To(Succeed())
+			}
+		}
+		for i := 0; i < 10; i++ {
+			expected = append(expected, fmt.Sprintf("w%d", i))
+		}
+
+		turns := builder.Turns()
+		Expect(turns).To(HaveLen(1))
+		Expect(turns[0].Text).To(Equal(strings.Join(expected, " ")))
+	})
+	It(`Returns an error for malformed timestamps`, func() {
+		var results speechtotextv1.SpeechRecognitionResults
+		Expect(json.Unmarshal([]byte(`{"results": [{"final": true, "alternatives": [{"transcript": "x", "timestamps": [["x", "start", 1]]}]}]}`), &results)).To(Succeed())
+		_, err := speechtotextv1.SpeakerTurns(&results)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+func parseSpeakerLabels(labels string) []speechtotextv1.SpeakerLabelsResult {
+	var result []speechtotextv1.SpeakerLabelsResult
+	Expect(json.Unmarshal([]byte(labels), &result)).To(Succeed())
+	return result
+}
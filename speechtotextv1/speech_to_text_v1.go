@@ -18,10 +18,12 @@
 package speechtotextv1
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/core"
 	common "github.com/watson-developer-cloud/go-sdk/common"
 	"io"
+	"net/http"
 	"strings"
 )
 
@@ -58,9 +60,12 @@ const DefaultServiceName = "speech_to_text"
 
 // SpeechToTextV1Options : Service options
 type SpeechToTextV1Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewSpeechToTextV1 : constructs an instance of SpeechToTextV1 with passed in options.
@@ -98,6 +103,16 @@ func NewSpeechToTextV1(options *SpeechToTextV1Options) (service *SpeechToTextV1,
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &SpeechToTextV1{
 		Service: baseService,
 	}
@@ -112,7 +127,27 @@ func (speechToText *SpeechToTextV1) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (speechToText *SpeechToTextV1) DisableSSLVerification() {
-	speechToText.Service.DisableSSLVerification()
+	common.DisableSSLVerification(speechToText.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (speechToText *SpeechToTextV1) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(speechToText.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (speechToText *SpeechToTextV1) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(speechToText.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (speechToText *SpeechToTextV1) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(speechToText.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (speechToText *SpeechToTextV1) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(speechToText.Service, interceptor)
 }
 
 // ListModels : List models
@@ -122,6 +157,11 @@ func (speechToText *SpeechToTextV1) DisableSSLVerification() {
 //
 // **See also:** [Languages and models](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-models#models).
 func (speechToText *SpeechToTextV1) ListModels(listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error) {
+	return speechToText.ListModelsWithContext(context.Background(), listModelsOptions)
+}
+
+// ListModelsWithContext is an alternate form of the ListModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listModelsOptions, "listModelsOptions")
 	if err != nil {
 		return
@@ -152,7 +192,10 @@ func (speechToText *SpeechToTextV1) ListModels(listModelsOptions *ListModelsOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(SpeechModels))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SpeechModels)
@@ -170,6 +213,11 @@ func (speechToText *SpeechToTextV1) ListModels(listModelsOptions *ListModelsOpti
 //
 // **See also:** [Languages and models](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-models#models).
 func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error) {
+	return speechToText.GetModelWithContext(context.Background(), getModelOptions)
+}
+
+// GetModelWithContext is an alternate form of the GetModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetModelWithContext(ctx context.Context, getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getModelOptions, "getModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -204,7 +252,10 @@ func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(SpeechModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SpeechModel)
@@ -290,6 +341,11 @@ func (speechToText *SpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (
 // **See also:** [Making a multipart HTTP
 // request](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-http#HTTP-multi).
 func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	return speechToText.RecognizeWithContext(context.Background(), recognizeOptions)
+}
+
+// RecognizeWithContext is an alternate form of the Recognize method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RecognizeWithContext(ctx context.Context, recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(recognizeOptions, "recognizeOptions cannot be nil")
 	if err != nil {
 		return
@@ -298,6 +354,10 @@ func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions
 	if err != nil {
 		return
 	}
+	recognizeOptions, err = recognizeOptions.withDetectedContentType()
+	if err != nil {
+		return
+	}
 
 	pathSegments := []string{"v1/recognize"}
 	pathParameters := []string{}
@@ -402,7 +462,10 @@ func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(SpeechRecognitionResults))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*SpeechRecognitionResults)
@@ -443,6 +506,11 @@ func (speechToText *SpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions
 // **See also:** [Registering a callback
 // URL](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-async#register).
 func (speechToText *SpeechToTextV1) RegisterCallback(registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error) {
+	return speechToText.RegisterCallbackWithContext(context.Background(), registerCallbackOptions)
+}
+
+// RegisterCallbackWithContext is an alternate form of the RegisterCallback method which supports a Context parameter
+func (speechToText *SpeechToTextV1) RegisterCallbackWithContext(ctx context.Context, registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(registerCallbackOptions, "registerCallbackOptions cannot be nil")
 	if err != nil {
 		return
@@ -482,7 +550,10 @@ func (speechToText *SpeechToTextV1) RegisterCallback(registerCallbackOptions *Re
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(RegisterStatus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RegisterStatus)
@@ -501,6 +572,11 @@ func (speechToText *SpeechToTextV1) RegisterCallback(registerCallbackOptions *Re
 // **See also:** [Unregistering a callback
 // URL](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-async#unregister).
 func (speechToText *SpeechToTextV1) UnregisterCallback(unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.UnregisterCallbackWithContext(context.Background(), unregisterCallbackOptions)
+}
+
+// UnregisterCallbackWithContext is an alternate form of the UnregisterCallback method which supports a Context parameter
+func (speechToText *SpeechToTextV1) UnregisterCallbackWithContext(ctx context.Context, unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(unregisterCallbackOptions, "unregisterCallbackOptions cannot be nil")
 	if err != nil {
 		return
@@ -535,7 +611,10 @@ func (speechToText *SpeechToTextV1) UnregisterCallback(unregisterCallbackOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -620,6 +699,11 @@ func (speechToText *SpeechToTextV1) UnregisterCallback(unregisterCallbackOptions
 //  **See also:** [Audio
 // formats](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-audio-formats#audio-formats).
 func (speechToText *SpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	return speechToText.CreateJobWithContext(context.Background(), createJobOptions)
+}
+
+// CreateJobWithContext is an alternate form of the CreateJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createJobOptions, "createJobOptions cannot be nil")
 	if err != nil {
 		return
@@ -750,7 +834,10 @@ func (speechToText *SpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(RecognitionJob))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RecognitionJob)
@@ -772,6 +859,11 @@ func (speechToText *SpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions
 // **See also:** [Checking the status of the latest
 // jobs](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-async#jobs).
 func (speechToText *SpeechToTextV1) CheckJobs(checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error) {
+	return speechToText.CheckJobsWithContext(context.Background(), checkJobsOptions)
+}
+
+// CheckJobsWithContext is an alternate form of the CheckJobs method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CheckJobsWithContext(ctx context.Context, checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(checkJobsOptions, "checkJobsOptions")
 	if err != nil {
 		return
@@ -802,7 +894,10 @@ func (speechToText *SpeechToTextV1) CheckJobs(checkJobsOptions *CheckJobsOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(RecognitionJobs))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RecognitionJobs)
@@ -827,6 +922,11 @@ func (speechToText *SpeechToTextV1) CheckJobs(checkJobsOptions *CheckJobsOptions
 // **See also:** [Checking the status and retrieving the results of a
 // job](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-async#job).
 func (speechToText *SpeechToTextV1) CheckJob(checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	return speechToText.CheckJobWithContext(context.Background(), checkJobOptions)
+}
+
+// CheckJobWithContext is an alternate form of the CheckJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CheckJobWithContext(ctx context.Context, checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(checkJobOptions, "checkJobOptions cannot be nil")
 	if err != nil {
 		return
@@ -861,7 +961,10 @@ func (speechToText *SpeechToTextV1) CheckJob(checkJobOptions *CheckJobOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(RecognitionJob))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*RecognitionJob)
@@ -880,6 +983,11 @@ func (speechToText *SpeechToTextV1) CheckJob(checkJobOptions *CheckJobOptions) (
 //
 // **See also:** [Deleting a job](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-async#delete-async).
 func (speechToText *SpeechToTextV1) DeleteJob(deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteJobWithContext(context.Background(), deleteJobOptions)
+}
+
+// DeleteJobWithContext is an alternate form of the DeleteJob method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteJobOptions, "deleteJobOptions cannot be nil")
 	if err != nil {
 		return
@@ -912,7 +1020,10 @@ func (speechToText *SpeechToTextV1) DeleteJob(deleteJobOptions *DeleteJobOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -929,6 +1040,11 @@ func (speechToText *SpeechToTextV1) DeleteJob(deleteJobOptions *DeleteJobOptions
 // **See also:** [Create a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-languageCreate#createModel-language).
 func (speechToText *SpeechToTextV1) CreateLanguageModel(createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	return speechToText.CreateLanguageModelWithContext(context.Background(), createLanguageModelOptions)
+}
+
+// CreateLanguageModelWithContext is an alternate form of the CreateLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateLanguageModelWithContext(ctx context.Context, createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createLanguageModelOptions, "createLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -982,7 +1098,10 @@ func (speechToText *SpeechToTextV1) CreateLanguageModel(createLanguageModelOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(LanguageModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LanguageModel)
@@ -1003,6 +1122,11 @@ func (speechToText *SpeechToTextV1) CreateLanguageModel(createLanguageModelOptio
 // **See also:** [Listing custom language
 // models](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageLanguageModels#listModels-language).
 func (speechToText *SpeechToTextV1) ListLanguageModels(listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error) {
+	return speechToText.ListLanguageModelsWithContext(context.Background(), listLanguageModelsOptions)
+}
+
+// ListLanguageModelsWithContext is an alternate form of the ListLanguageModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListLanguageModelsWithContext(ctx context.Context, listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listLanguageModelsOptions, "listLanguageModelsOptions")
 	if err != nil {
 		return
@@ -1037,7 +1161,10 @@ func (speechToText *SpeechToTextV1) ListLanguageModels(listLanguageModelsOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(LanguageModels))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LanguageModels)
@@ -1056,6 +1183,11 @@ func (speechToText *SpeechToTextV1) ListLanguageModels(listLanguageModelsOptions
 // **See also:** [Listing custom language
 // models](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageLanguageModels#listModels-language).
 func (speechToText *SpeechToTextV1) GetLanguageModel(getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	return speechToText.GetLanguageModelWithContext(context.Background(), getLanguageModelOptions)
+}
+
+// GetLanguageModelWithContext is an alternate form of the GetLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetLanguageModelWithContext(ctx context.Context, getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getLanguageModelOptions, "getLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1090,7 +1222,10 @@ func (speechToText *SpeechToTextV1) GetLanguageModel(getLanguageModelOptions *Ge
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(LanguageModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*LanguageModel)
@@ -1110,6 +1245,11 @@ func (speechToText *SpeechToTextV1) GetLanguageModel(getLanguageModelOptions *Ge
 // **See also:** [Deleting a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageLanguageModels#deleteModel-language).
 func (speechToText *SpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteLanguageModelWithContext(context.Background(), deleteLanguageModelOptions)
+}
+
+// DeleteLanguageModelWithContext is an alternate form of the DeleteLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteLanguageModelWithContext(ctx context.Context, deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteLanguageModelOptions, "deleteLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1144,7 +1284,10 @@ func (speechToText *SpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1180,6 +1323,11 @@ func (speechToText *SpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptio
 // exclude the invalid resources from the training. The model must contain at least one valid resource for training to
 // succeed.
 func (speechToText *SpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	return speechToText.TrainLanguageModelWithContext(context.Background(), trainLanguageModelOptions)
+}
+
+// TrainLanguageModelWithContext is an alternate form of the TrainLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) TrainLanguageModelWithContext(ctx context.Context, trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(trainLanguageModelOptions, "trainLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1221,7 +1369,10 @@ func (speechToText *SpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(TrainingResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingResponse)
@@ -1242,6 +1393,11 @@ func (speechToText *SpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions
 // **See also:** [Resetting a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageLanguageModels#resetModel-language).
 func (speechToText *SpeechToTextV1) ResetLanguageModel(resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.ResetLanguageModelWithContext(context.Background(), resetLanguageModelOptions)
+}
+
+// ResetLanguageModelWithContext is an alternate form of the ResetLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ResetLanguageModelWithContext(ctx context.Context, resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(resetLanguageModelOptions, "resetLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1276,7 +1432,10 @@ func (speechToText *SpeechToTextV1) ResetLanguageModel(resetLanguageModelOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1297,6 +1456,11 @@ func (speechToText *SpeechToTextV1) ResetLanguageModel(resetLanguageModelOptions
 // **See also:** [Upgrading a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-customUpgrade#upgradeLanguage).
 func (speechToText *SpeechToTextV1) UpgradeLanguageModel(upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.UpgradeLanguageModelWithContext(context.Background(), upgradeLanguageModelOptions)
+}
+
+// UpgradeLanguageModelWithContext is an alternate form of the UpgradeLanguageModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) UpgradeLanguageModelWithContext(ctx context.Context, upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(upgradeLanguageModelOptions, "upgradeLanguageModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -1331,7 +1495,10 @@ func (speechToText *SpeechToTextV1) UpgradeLanguageModel(upgradeLanguageModelOpt
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1344,6 +1511,11 @@ func (speechToText *SpeechToTextV1) UpgradeLanguageModel(upgradeLanguageModelOpt
 // **See also:** [Listing corpora for a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageCorpora#listCorpora).
 func (speechToText *SpeechToTextV1) ListCorpora(listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error) {
+	return speechToText.ListCorporaWithContext(context.Background(), listCorporaOptions)
+}
+
+// ListCorporaWithContext is an alternate form of the ListCorpora method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListCorporaWithContext(ctx context.Context, listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listCorporaOptions, "listCorporaOptions cannot be nil")
 	if err != nil {
 		return
@@ -1378,7 +1550,10 @@ func (speechToText *SpeechToTextV1) ListCorpora(listCorporaOptions *ListCorporaO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(Corpora))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Corpora)
@@ -1430,6 +1605,11 @@ func (speechToText *SpeechToTextV1) ListCorpora(listCorporaOptions *ListCorporaO
 // * [Validating a words
 // resource](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-corporaWords#validateModel).
 func (speechToText *SpeechToTextV1) AddCorpus(addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddCorpusWithContext(context.Background(), addCorpusOptions)
+}
+
+// AddCorpusWithContext is an alternate form of the AddCorpus method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddCorpusWithContext(ctx context.Context, addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addCorpusOptions, "addCorpusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1471,7 +1651,10 @@ func (speechToText *SpeechToTextV1) AddCorpus(addCorpusOptions *AddCorpusOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1484,6 +1667,11 @@ func (speechToText *SpeechToTextV1) AddCorpus(addCorpusOptions *AddCorpusOptions
 // **See also:** [Listing corpora for a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageCorpora#listCorpora).
 func (speechToText *SpeechToTextV1) GetCorpus(getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error) {
+	return speechToText.GetCorpusWithContext(context.Background(), getCorpusOptions)
+}
+
+// GetCorpusWithContext is an alternate form of the GetCorpus method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetCorpusWithContext(ctx context.Context, getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCorpusOptions, "getCorpusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1518,7 +1706,10 @@ func (speechToText *SpeechToTextV1) GetCorpus(getCorpusOptions *GetCorpusOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(Corpus))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Corpus)
@@ -1540,6 +1731,11 @@ func (speechToText *SpeechToTextV1) GetCorpus(getCorpusOptions *GetCorpusOptions
 // **See also:** [Deleting a corpus from a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageCorpora#deleteCorpus).
 func (speechToText *SpeechToTextV1) DeleteCorpus(deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteCorpusWithContext(context.Background(), deleteCorpusOptions)
+}
+
+// DeleteCorpusWithContext is an alternate form of the DeleteCorpus method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteCorpusWithContext(ctx context.Context, deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteCorpusOptions, "deleteCorpusOptions cannot be nil")
 	if err != nil {
 		return
@@ -1574,7 +1770,10 @@ func (speechToText *SpeechToTextV1) DeleteCorpus(deleteCorpusOptions *DeleteCorp
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1589,6 +1788,11 @@ func (speechToText *SpeechToTextV1) DeleteCorpus(deleteCorpusOptions *DeleteCorp
 // **See also:** [Listing words from a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageWords#listWords).
 func (speechToText *SpeechToTextV1) ListWords(listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
+	return speechToText.ListWordsWithContext(context.Background(), listWordsOptions)
+}
+
+// ListWordsWithContext is an alternate form of the ListWords method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListWordsWithContext(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listWordsOptions, "listWordsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1630,7 +1834,10 @@ func (speechToText *SpeechToTextV1) ListWords(listWordsOptions *ListWordsOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(Words))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Words)
@@ -1691,6 +1898,11 @@ func (speechToText *SpeechToTextV1) ListWords(listWordsOptions *ListWordsOptions
 // * [Validating a words
 // resource](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-corporaWords#validateModel).
 func (speechToText *SpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddWordsWithContext(context.Background(), addWordsOptions)
+}
+
+// AddWordsWithContext is an alternate form of the AddWords method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddWordsWithContext(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addWordsOptions, "addWordsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1735,7 +1947,10 @@ func (speechToText *SpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1775,6 +1990,11 @@ func (speechToText *SpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (
 // * [Validating a words
 // resource](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-corporaWords#validateModel).
 func (speechToText *SpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddWordWithContext(context.Background(), addWordOptions)
+}
+
+// AddWordWithContext is an alternate form of the AddWord method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddWordWithContext(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addWordOptions, "addWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -1825,7 +2045,10 @@ func (speechToText *SpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (res
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1837,6 +2060,11 @@ func (speechToText *SpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (res
 // **See also:** [Listing words from a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageWords#listWords).
 func (speechToText *SpeechToTextV1) GetWord(getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error) {
+	return speechToText.GetWordWithContext(context.Background(), getWordOptions)
+}
+
+// GetWordWithContext is an alternate form of the GetWord method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetWordWithContext(ctx context.Context, getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getWordOptions, "getWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -1871,7 +2099,10 @@ func (speechToText *SpeechToTextV1) GetWord(getWordOptions *GetWordOptions) (res
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(Word))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Word)
@@ -1893,6 +2124,11 @@ func (speechToText *SpeechToTextV1) GetWord(getWordOptions *GetWordOptions) (res
 // **See also:** [Deleting a word from a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageWords#deleteWord).
 func (speechToText *SpeechToTextV1) DeleteWord(deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteWordWithContext(context.Background(), deleteWordOptions)
+}
+
+// DeleteWordWithContext is an alternate form of the DeleteWord method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteWordWithContext(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteWordOptions, "deleteWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -1927,7 +2163,10 @@ func (speechToText *SpeechToTextV1) DeleteWord(deleteWordOptions *DeleteWordOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1940,6 +2179,11 @@ func (speechToText *SpeechToTextV1) DeleteWord(deleteWordOptions *DeleteWordOpti
 // **See also:** [Listing grammars from a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageGrammars#listGrammars).
 func (speechToText *SpeechToTextV1) ListGrammars(listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error) {
+	return speechToText.ListGrammarsWithContext(context.Background(), listGrammarsOptions)
+}
+
+// ListGrammarsWithContext is an alternate form of the ListGrammars method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListGrammarsWithContext(ctx context.Context, listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listGrammarsOptions, "listGrammarsOptions cannot be nil")
 	if err != nil {
 		return
@@ -1974,7 +2218,10 @@ func (speechToText *SpeechToTextV1) ListGrammars(listGrammarsOptions *ListGramma
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(Grammars))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Grammars)
@@ -2020,6 +2267,11 @@ func (speechToText *SpeechToTextV1) ListGrammars(listGrammarsOptions *ListGramma
 // * [Add a grammar to the custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-grammarAdd#addGrammar).
 func (speechToText *SpeechToTextV1) AddGrammar(addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddGrammarWithContext(context.Background(), addGrammarOptions)
+}
+
+// AddGrammarWithContext is an alternate form of the AddGrammar method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddGrammarWithContext(ctx context.Context, addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addGrammarOptions, "addGrammarOptions cannot be nil")
 	if err != nil {
 		return
@@ -2066,7 +2318,10 @@ func (speechToText *SpeechToTextV1) AddGrammar(addGrammarOptions *AddGrammarOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2079,6 +2334,11 @@ func (speechToText *SpeechToTextV1) AddGrammar(addGrammarOptions *AddGrammarOpti
 // **See also:** [Listing grammars from a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageGrammars#listGrammars).
 func (speechToText *SpeechToTextV1) GetGrammar(getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error) {
+	return speechToText.GetGrammarWithContext(context.Background(), getGrammarOptions)
+}
+
+// GetGrammarWithContext is an alternate form of the GetGrammar method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetGrammarWithContext(ctx context.Context, getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getGrammarOptions, "getGrammarOptions cannot be nil")
 	if err != nil {
 		return
@@ -2113,7 +2373,10 @@ func (speechToText *SpeechToTextV1) GetGrammar(getGrammarOptions *GetGrammarOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(Grammar))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Grammar)
@@ -2135,6 +2398,11 @@ func (speechToText *SpeechToTextV1) GetGrammar(getGrammarOptions *GetGrammarOpti
 // **See also:** [Deleting a grammar from a custom language
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageGrammars#deleteGrammar).
 func (speechToText *SpeechToTextV1) DeleteGrammar(deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteGrammarWithContext(context.Background(), deleteGrammarOptions)
+}
+
+// DeleteGrammarWithContext is an alternate form of the DeleteGrammar method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteGrammarWithContext(ctx context.Context, deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteGrammarOptions, "deleteGrammarOptions cannot be nil")
 	if err != nil {
 		return
@@ -2169,7 +2437,10 @@ func (speechToText *SpeechToTextV1) DeleteGrammar(deleteGrammarOptions *DeleteGr
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2186,6 +2457,11 @@ func (speechToText *SpeechToTextV1) DeleteGrammar(deleteGrammarOptions *DeleteGr
 // **See also:** [Create a custom acoustic
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-acoustic#createModel-acoustic).
 func (speechToText *SpeechToTextV1) CreateAcousticModel(createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	return speechToText.CreateAcousticModelWithContext(context.Background(), createAcousticModelOptions)
+}
+
+// CreateAcousticModelWithContext is an alternate form of the CreateAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) CreateAcousticModelWithContext(ctx context.Context, createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createAcousticModelOptions, "createAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2236,7 +2512,10 @@ func (speechToText *SpeechToTextV1) CreateAcousticModel(createAcousticModelOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(AcousticModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AcousticModel)
@@ -2257,6 +2536,11 @@ func (speechToText *SpeechToTextV1) CreateAcousticModel(createAcousticModelOptio
 // **See also:** [Listing custom acoustic
 // models](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageAcousticModels#listModels-acoustic).
 func (speechToText *SpeechToTextV1) ListAcousticModels(listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error) {
+	return speechToText.ListAcousticModelsWithContext(context.Background(), listAcousticModelsOptions)
+}
+
+// ListAcousticModelsWithContext is an alternate form of the ListAcousticModels method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListAcousticModelsWithContext(ctx context.Context, listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listAcousticModelsOptions, "listAcousticModelsOptions")
 	if err != nil {
 		return
@@ -2291,7 +2575,10 @@ func (speechToText *SpeechToTextV1) ListAcousticModels(listAcousticModelsOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(AcousticModels))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AcousticModels)
@@ -2310,6 +2597,11 @@ func (speechToText *SpeechToTextV1) ListAcousticModels(listAcousticModelsOptions
 // **See also:** [Listing custom acoustic
 // models](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageAcousticModels#listModels-acoustic).
 func (speechToText *SpeechToTextV1) GetAcousticModel(getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	return speechToText.GetAcousticModelWithContext(context.Background(), getAcousticModelOptions)
+}
+
+// GetAcousticModelWithContext is an alternate form of the GetAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetAcousticModelWithContext(ctx context.Context, getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getAcousticModelOptions, "getAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2344,7 +2636,10 @@ func (speechToText *SpeechToTextV1) GetAcousticModel(getAcousticModelOptions *Ge
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(AcousticModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AcousticModel)
@@ -2364,6 +2659,11 @@ func (speechToText *SpeechToTextV1) GetAcousticModel(getAcousticModelOptions *Ge
 // **See also:** [Deleting a custom acoustic
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageAcousticModels#deleteModel-acoustic).
 func (speechToText *SpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteAcousticModelWithContext(context.Background(), deleteAcousticModelOptions)
+}
+
+// DeleteAcousticModelWithContext is an alternate form of the DeleteAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteAcousticModelWithContext(ctx context.Context, deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteAcousticModelOptions, "deleteAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2398,7 +2698,10 @@ func (speechToText *SpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2448,6 +2751,11 @@ func (speechToText *SpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptio
 // the `strict` parameter to `false` to exclude the invalid resources from the training. The model must contain at least
 // one valid resource for training to succeed.
 func (speechToText *SpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	return speechToText.TrainAcousticModelWithContext(context.Background(), trainAcousticModelOptions)
+}
+
+// TrainAcousticModelWithContext is an alternate form of the TrainAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) TrainAcousticModelWithContext(ctx context.Context, trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(trainAcousticModelOptions, "trainAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2486,7 +2794,10 @@ func (speechToText *SpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(TrainingResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingResponse)
@@ -2509,6 +2820,11 @@ func (speechToText *SpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions
 // **See also:** [Resetting a custom acoustic
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageAcousticModels#resetModel-acoustic).
 func (speechToText *SpeechToTextV1) ResetAcousticModel(resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.ResetAcousticModelWithContext(context.Background(), resetAcousticModelOptions)
+}
+
+// ResetAcousticModelWithContext is an alternate form of the ResetAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ResetAcousticModelWithContext(ctx context.Context, resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(resetAcousticModelOptions, "resetAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2543,7 +2859,10 @@ func (speechToText *SpeechToTextV1) ResetAcousticModel(resetAcousticModelOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2571,6 +2890,11 @@ func (speechToText *SpeechToTextV1) ResetAcousticModel(resetAcousticModelOptions
 // **See also:** [Upgrading a custom acoustic
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-customUpgrade#upgradeAcoustic).
 func (speechToText *SpeechToTextV1) UpgradeAcousticModel(upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.UpgradeAcousticModelWithContext(context.Background(), upgradeAcousticModelOptions)
+}
+
+// UpgradeAcousticModelWithContext is an alternate form of the UpgradeAcousticModel method which supports a Context parameter
+func (speechToText *SpeechToTextV1) UpgradeAcousticModelWithContext(ctx context.Context, upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(upgradeAcousticModelOptions, "upgradeAcousticModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -2612,7 +2936,10 @@ func (speechToText *SpeechToTextV1) UpgradeAcousticModel(upgradeAcousticModelOpt
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2627,6 +2954,11 @@ func (speechToText *SpeechToTextV1) UpgradeAcousticModel(upgradeAcousticModelOpt
 // **See also:** [Listing audio resources for a custom acoustic
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageAudio#listAudio).
 func (speechToText *SpeechToTextV1) ListAudio(listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error) {
+	return speechToText.ListAudioWithContext(context.Background(), listAudioOptions)
+}
+
+// ListAudioWithContext is an alternate form of the ListAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) ListAudioWithContext(ctx context.Context, listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listAudioOptions, "listAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2661,7 +2993,10 @@ func (speechToText *SpeechToTextV1) ListAudio(listAudioOptions *ListAudioOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(AudioResources))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AudioResources)
@@ -2760,6 +3095,11 @@ func (speechToText *SpeechToTextV1) ListAudio(listAudioOptions *ListAudioOptions
 //  The name of an audio file that is contained in an archive-type resource can include a maximum of 128 characters.
 // This includes the file extension and all elements of the name (for example, slashes).
 func (speechToText *SpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.AddAudioWithContext(context.Background(), addAudioOptions)
+}
+
+// AddAudioWithContext is an alternate form of the AddAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) AddAudioWithContext(ctx context.Context, addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addAudioOptions, "addAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2809,7 +3149,10 @@ func (speechToText *SpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2834,6 +3177,11 @@ func (speechToText *SpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (
 // **See also:** [Listing audio resources for a custom acoustic
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageAudio#listAudio).
 func (speechToText *SpeechToTextV1) GetAudio(getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error) {
+	return speechToText.GetAudioWithContext(context.Background(), getAudioOptions)
+}
+
+// GetAudioWithContext is an alternate form of the GetAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) GetAudioWithContext(ctx context.Context, getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getAudioOptions, "getAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2868,7 +3216,10 @@ func (speechToText *SpeechToTextV1) GetAudio(getAudioOptions *GetAudioOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, new(AudioListing))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AudioListing)
@@ -2892,6 +3243,11 @@ func (speechToText *SpeechToTextV1) GetAudio(getAudioOptions *GetAudioOptions) (
 // **See also:** [Deleting an audio resource from a custom acoustic
 // model](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-manageAudio#deleteAudio).
 func (speechToText *SpeechToTextV1) DeleteAudio(deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteAudioWithContext(context.Background(), deleteAudioOptions)
+}
+
+// DeleteAudioWithContext is an alternate form of the DeleteAudio method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteAudioWithContext(ctx context.Context, deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteAudioOptions, "deleteAudioOptions cannot be nil")
 	if err != nil {
 		return
@@ -2926,7 +3282,10 @@ func (speechToText *SpeechToTextV1) DeleteAudio(deleteAudioOptions *DeleteAudioO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -2946,6 +3305,11 @@ func (speechToText *SpeechToTextV1) DeleteAudio(deleteAudioOptions *DeleteAudioO
 // **See also:** [Information
 // security](https://cloud.ibm.com/docs/speech-to-text?topic=speech-to-text-information-security#information-security).
 func (speechToText *SpeechToTextV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return speechToText.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (speechToText *SpeechToTextV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -2980,7 +3344,10 @@ func (speechToText *SpeechToTextV1) DeleteUserData(deleteUserDataOptions *Delete
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = speechToText.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
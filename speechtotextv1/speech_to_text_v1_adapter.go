@@ -1,14 +1,15 @@
 package speechtotextv1
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
 
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 )
 
@@ -45,6 +46,16 @@ type RecognizeUsingWebsocketOptions struct {
 	// instead of at periodic intervals, set the value to a large number. If the value is larger than the duration of the
 	// audio, the service returns processing metrics only for transcription events.
 	ProcessingMetricsInterval *float32 `json:"processing_metrics_interval,omitempty"`
+
+	// The number of bytes of audio sent in each websocket message. Defaults to 2 KB.
+	ChunkSize *int `json:"-"`
+
+	// How fast the audio is sent, see the RecognizeUsingWebsocketOptions_Pacing constants. Defaults to a chunk every 10
+	// milliseconds.
+	Pacing *string `json:"-"`
+
+	// The number of bytes of audio sent per second with the custom_rate pacing.
+	PacingBytesPerSecond *float64 `json:"-"`
 }
 
 // SetAction: Allows user to set the Action
@@ -71,11 +82,14 @@ func (recognizeWSOptions *RecognizeUsingWebsocketOptions) SetProcessingMetricsIn
 	return recognizeWSOptions
 }
 
-// NewRecognizeUsingWebsocketOptions: Instantiate RecognizeOptions to enable websocket support
+// NewRecognizeUsingWebsocketOptions: Instantiate RecognizeOptions to enable websocket support. If contentType is
+// empty, it is detected from the first bytes of the audio when the recognition starts.
 func (speechToText *SpeechToTextV1) NewRecognizeUsingWebsocketOptions(audio io.ReadCloser, contentType string) *RecognizeUsingWebsocketOptions {
 	recognizeOptions := speechToText.NewRecognizeOptions(audio)
-	recognizeOptions.SetContentType(contentType)
-	recognizeWSOptions := &RecognizeUsingWebsocketOptions{*recognizeOptions, nil, nil, nil, nil}
+	if contentType != "" {
+		recognizeOptions.SetContentType(contentType)
+	}
+	recognizeWSOptions := &RecognizeUsingWebsocketOptions{RecognizeOptions: *recognizeOptions}
 	return recognizeWSOptions
 }
 
@@ -94,32 +108,66 @@ type RecognizeCallbackWrapper interface {
 	OnError(error)
 }
 
-// RecognizeUsingWebsocket: Recognize audio over websocket connection
-func (speechToText *SpeechToTextV1) RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) {
+// RecognizeUsingWebsocket: Recognize audio over websocket connection. The call returns once the recognition is over.
+// Failures to set up the connection are returned, while failures occurring once it is open are delivered to the
+// OnError method of the callback.
+func (speechToText *SpeechToTextV1) RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) error {
+	return speechToText.RecognizeUsingWebsocketWithContext(context.Background(), recognizeWSOptions, callback)
+}
+
+// RecognizeUsingWebsocketWithContext: Recognize audio over websocket connection, closing the connection when ctx is
+// done
+func (speechToText *SpeechToTextV1) RecognizeUsingWebsocketWithContext(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) error {
 	if err := core.ValidateNotNil(recognizeWSOptions, "recognizeOptions cannot be nil"); err != nil {
-		panic(err)
+		return err
 	}
 	if err := core.ValidateStruct(recognizeWSOptions, "recognizeOptions"); err != nil {
-		panic(err)
+		return err
+	}
+	if callback == nil {
+		return fmt.Errorf("callback cannot be nil")
+	}
+	recognizeWSOptions, err := recognizeWSOptions.withDetectedContentType()
+	if err != nil {
+		return err
+	}
+	if err := recognizeWSOptions.validatePacing(); err != nil {
+		return err
 	}
 
+	dialURL, param, headers, err := speechToText.recognizeHandshake(recognizeWSOptions)
+	if err != nil {
+		return err
+	}
+
+	return speechToText.newRecognizeListener(ctx, callback, recognizeWSOptions, dialURL, param, headers)
+}
+
+// recognizeHandshake builds the URL, query parameters and authenticated headers of the websocket handshake of a
+// recognition
+func (speechToText *SpeechToTextV1) recognizeHandshake(recognizeWSOptions *RecognizeUsingWebsocketOptions) (string, url.Values, http.Header, error) {
 	// Add authentication to the outbound request.
 	if speechToText.Service.Options.Authenticator == nil {
-		panic(fmt.Errorf("Authentication information was not properly configured."))
+		return "", nil, nil, fmt.Errorf("Authentication information was not properly configured.")
 	}
 
 	// Create a dummy request for authenticate
 	// Need to update design to let recognizeListener take in a request object
-	req, _ := http.NewRequest("POST", speechToText.Service.Options.URL, nil)
-	err := speechToText.Service.Options.Authenticator.Authenticate(req)
+	req, err := http.NewRequest("POST", speechToText.Service.Options.URL, nil)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	err = speechToText.Service.Options.Authenticator.Authenticate(req)
 	if err != nil {
-		panic(err)
+		return "", nil, nil, err
 	}
 	headers := req.Header
 
-	headers.Set("Content-Type", *recognizeWSOptions.ContentType)
+	if recognizeWSOptions.ContentType != nil {
+		headers.Set("Content-Type", *recognizeWSOptions.ContentType)
+	}
 
-	dialURL := strings.Replace(speechToText.Service.Options.URL, "https", "wss", 1)
+	dialURL := common.WebsocketURL(speechToText.Service.Options.URL)
 	param := url.Values{}
 
 	if recognizeWSOptions.Model != nil {
@@ -135,5 +183,5 @@ func (speechToText *SpeechToTextV1) RecognizeUsingWebsocket(recognizeWSOptions *
 		param.Set("base_model_version", *recognizeWSOptions.BaseModelVersion)
 	}
 
-	speechToText.NewRecognizeListener(callback, recognizeWSOptions, dialURL, param, headers)
+	return dialURL, param, headers, nil
 }
@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// SpeechToTextV1API : The operations of the SpeechToTextV1 service.
+// It is implemented by *SpeechToTextV1, and by *MockSpeechToTextV1 for unit tests.
+type SpeechToTextV1API interface {
+	ListModels(listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error)
+	ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error)
+	GetModel(getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error)
+	GetModelWithContext(ctx context.Context, getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error)
+	Recognize(recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error)
+	RecognizeWithContext(ctx context.Context, recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error)
+	RegisterCallback(registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error)
+	RegisterCallbackWithContext(ctx context.Context, registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error)
+	UnregisterCallback(unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error)
+	UnregisterCallbackWithContext(ctx context.Context, unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error)
+	CreateJob(createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	CheckJobs(checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error)
+	CheckJobsWithContext(ctx context.Context, checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error)
+	CheckJob(checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	CheckJobWithContext(ctx context.Context, checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	DeleteJob(deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error)
+	DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error)
+	CreateLanguageModel(createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error)
+	CreateLanguageModelWithContext(ctx context.Context, createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error)
+	ListLanguageModels(listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error)
+	ListLanguageModelsWithContext(ctx context.Context, listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error)
+	GetLanguageModel(getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error)
+	GetLanguageModelWithContext(ctx context.Context, getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error)
+	DeleteLanguageModel(deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error)
+	DeleteLanguageModelWithContext(ctx context.Context, deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error)
+	TrainLanguageModel(trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error)
+	TrainLanguageModelWithContext(ctx context.Context, trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error)
+	ResetLanguageModel(resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error)
+	ResetLanguageModelWithContext(ctx context.Context, resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error)
+	UpgradeLanguageModel(upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error)
+	UpgradeLanguageModelWithContext(ctx context.Context, upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error)
+	ListCorpora(listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error)
+	ListCorporaWithContext(ctx context.Context, listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error)
+	AddCorpus(addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error)
+	AddCorpusWithContext(ctx context.Context, addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error)
+	GetCorpus(getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error)
+	GetCorpusWithContext(ctx context.Context, getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error)
+	DeleteCorpus(deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error)
+	DeleteCorpusWithContext(ctx context.Context, deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error)
+	ListWords(listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error)
+	ListWordsWithContext(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error)
+	AddWords(addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error)
+	AddWordsWithContext(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error)
+	AddWord(addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error)
+	AddWordWithContext(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error)
+	GetWord(getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error)
+	GetWordWithContext(ctx context.Context, getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error)
+	DeleteWord(deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error)
+	DeleteWordWithContext(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error)
+	ListGrammars(listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error)
+	ListGrammarsWithContext(ctx context.Context, listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error)
+	AddGrammar(addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error)
+	AddGrammarWithContext(ctx context.Context, addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error)
+	GetGrammar(getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error)
+	GetGrammarWithContext(ctx context.Context, getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error)
+	DeleteGrammar(deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error)
+	DeleteGrammarWithContext(ctx context.Context, deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error)
+	CreateAcousticModel(createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error)
+	CreateAcousticModelWithContext(ctx context.Context, createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error)
+	ListAcousticModels(listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error)
+	ListAcousticModelsWithContext(ctx context.Context, listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error)
+	GetAcousticModel(getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error)
+	GetAcousticModelWithContext(ctx context.Context, getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error)
+	DeleteAcousticModel(deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error)
+	DeleteAcousticModelWithContext(ctx context.Context, deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error)
+	TrainAcousticModel(trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error)
+	TrainAcousticModelWithContext(ctx context.Context, trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error)
+	ResetAcousticModel(resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error)
+	ResetAcousticModelWithContext(ctx context.Context, resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error)
+	UpgradeAcousticModel(upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error)
+	UpgradeAcousticModelWithContext(ctx context.Context, upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error)
+	ListAudio(listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error)
+	ListAudioWithContext(ctx context.Context, listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error)
+	AddAudio(addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error)
+	AddAudioWithContext(ctx context.Context, addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error)
+	GetAudio(getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error)
+	GetAudioWithContext(ctx context.Context, getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error)
+	DeleteAudio(deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error)
+	DeleteAudioWithContext(ctx context.Context, deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error)
+	DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	NewAddAudioOptions(customizationID string, audioName string, audioResource io.ReadCloser) *AddAudioOptions
+	NewAddCorpusOptions(customizationID string, corpusName string, corpusFile io.ReadCloser) *AddCorpusOptions
+	NewAddGrammarOptions(customizationID string, grammarName string, grammarFile io.ReadCloser, contentType string) *AddGrammarOptions
+	NewAddWordOptions(customizationID string, wordName string) *AddWordOptions
+	NewAddWordsOptions(customizationID string, words []CustomWord) *AddWordsOptions
+	NewCheckJobOptions(ID string) *CheckJobOptions
+	NewCheckJobsOptions() *CheckJobsOptions
+	NewCreateAcousticModelOptions(name string, baseModelName string) *CreateAcousticModelOptions
+	NewCreateJobOptions(audio io.ReadCloser) *CreateJobOptions
+	NewCreateLanguageModelOptions(name string, baseModelName string) *CreateLanguageModelOptions
+	NewDeleteAcousticModelOptions(customizationID string) *DeleteAcousticModelOptions
+	NewDeleteAudioOptions(customizationID string, audioName string) *DeleteAudioOptions
+	NewDeleteCorpusOptions(customizationID string, corpusName string) *DeleteCorpusOptions
+	NewDeleteGrammarOptions(customizationID string, grammarName string) *DeleteGrammarOptions
+	NewDeleteJobOptions(ID string) *DeleteJobOptions
+	NewDeleteLanguageModelOptions(customizationID string) *DeleteLanguageModelOptions
+	NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions
+	NewDeleteWordOptions(customizationID string, wordName string) *DeleteWordOptions
+	NewGetAcousticModelOptions(customizationID string) *GetAcousticModelOptions
+	NewGetAudioOptions(customizationID string, audioName string) *GetAudioOptions
+	NewGetCorpusOptions(customizationID string, corpusName string) *GetCorpusOptions
+	NewGetGrammarOptions(customizationID string, grammarName string) *GetGrammarOptions
+	NewGetLanguageModelOptions(customizationID string) *GetLanguageModelOptions
+	NewGetModelOptions(modelID string) *GetModelOptions
+	NewGetWordOptions(customizationID string, wordName string) *GetWordOptions
+	NewListAcousticModelsOptions() *ListAcousticModelsOptions
+	NewListAudioOptions(customizationID string) *ListAudioOptions
+	NewListCorporaOptions(customizationID string) *ListCorporaOptions
+	NewListGrammarsOptions(customizationID string) *ListGrammarsOptions
+	NewListLanguageModelsOptions() *ListLanguageModelsOptions
+	NewListModelsOptions() *ListModelsOptions
+	NewListWordsOptions(customizationID string) *ListWordsOptions
+	NewRecognizeOptions(audio io.ReadCloser) *RecognizeOptions
+	NewRegisterCallbackOptions(callbackURL string) *RegisterCallbackOptions
+	NewResetAcousticModelOptions(customizationID string) *ResetAcousticModelOptions
+	NewResetLanguageModelOptions(customizationID string) *ResetLanguageModelOptions
+	NewTrainAcousticModelOptions(customizationID string) *TrainAcousticModelOptions
+	NewTrainLanguageModelOptions(customizationID string) *TrainLanguageModelOptions
+	NewUnregisterCallbackOptions(callbackURL string) *UnregisterCallbackOptions
+	NewUpgradeAcousticModelOptions(customizationID string) *UpgradeAcousticModelOptions
+	NewUpgradeLanguageModelOptions(customizationID string) *UpgradeLanguageModelOptions
+	NewBuildAcousticModelOptions(audioDirectory string) *BuildAcousticModelOptions
+	BuildAcousticModel(ctx context.Context, buildAcousticModelOptions *BuildAcousticModelOptions) (*AcousticModelBuild, error)
+	WaitForJob(ctx context.Context, jobID string, policy *JobPollingPolicy) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	NewBuildLanguageModelOptions() *BuildLanguageModelOptions
+	BuildLanguageModel(ctx context.Context, buildLanguageModelOptions *BuildLanguageModelOptions) (*LanguageModelBuild, error)
+	NewRecognizeMultichannelOptions(audio io.ReadCloser, contentType string) *RecognizeMultichannelOptions
+	RecognizeMultichannel(ctx context.Context, recognizeMultichannelOptions *RecognizeMultichannelOptions) (*MultichannelRecognitionResults, error)
+	RecognizeUsingWebsocketWithReconnect(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeEventCallback, policy *ReconnectPolicy) error
+	NewRecognizeSegmentedOptions(audio io.ReadCloser, contentType string) *RecognizeSegmentedOptions
+	RecognizeSegmented(ctx context.Context, recognizeSegmentedOptions *RecognizeSegmentedOptions) (*SpeechRecognitionResults, error)
+	OpenRecognizeSession(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) (*RecognizeSession, error)
+	OpenRecognizeSessionWithContext(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) (*RecognizeSession, error)
+	RecognizeUsingWebsocketStream(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions) (<-chan RecognitionEvent, error)
+	NewRecognizeUsingWebsocketOptions(audio io.ReadCloser, contentType string) *RecognizeUsingWebsocketOptions
+	RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) error
+	RecognizeUsingWebsocketWithContext(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) error
+}
+
+var _ SpeechToTextV1API = (*SpeechToTextV1)(nil)
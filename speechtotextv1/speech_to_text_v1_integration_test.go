@@ -421,6 +421,7 @@ func TestRecognizeUsingWebsocket(t *testing.T) {
 
 	recognizeOptions.SetModel("en-US_BroadbandModel").SetWordConfidence(true).SetSpeakerLabels(true).SetTimestamps(true)
 
-	service.RecognizeUsingWebsocket(recognizeOptions, callback)
+	err := service.RecognizeUsingWebsocket(recognizeOptions, callback)
+	assert.Nil(t, err)
 
 }
@@ -0,0 +1,1022 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockSpeechToTextV1 : A mock implementation of SpeechToTextV1API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *SpeechToTextV1.
+type MockSpeechToTextV1 struct {
+	common.MockRecorder
+
+	ListModelsFunc                           func(ctx context.Context, listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error)
+	GetModelFunc                             func(ctx context.Context, getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error)
+	RecognizeFunc                            func(ctx context.Context, recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error)
+	RegisterCallbackFunc                     func(ctx context.Context, registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error)
+	UnregisterCallbackFunc                   func(ctx context.Context, unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error)
+	CreateJobFunc                            func(ctx context.Context, createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	CheckJobsFunc                            func(ctx context.Context, checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error)
+	CheckJobFunc                             func(ctx context.Context, checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	DeleteJobFunc                            func(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error)
+	CreateLanguageModelFunc                  func(ctx context.Context, createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error)
+	ListLanguageModelsFunc                   func(ctx context.Context, listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error)
+	GetLanguageModelFunc                     func(ctx context.Context, getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error)
+	DeleteLanguageModelFunc                  func(ctx context.Context, deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error)
+	TrainLanguageModelFunc                   func(ctx context.Context, trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error)
+	ResetLanguageModelFunc                   func(ctx context.Context, resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error)
+	UpgradeLanguageModelFunc                 func(ctx context.Context, upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error)
+	ListCorporaFunc                          func(ctx context.Context, listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error)
+	AddCorpusFunc                            func(ctx context.Context, addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error)
+	GetCorpusFunc                            func(ctx context.Context, getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error)
+	DeleteCorpusFunc                         func(ctx context.Context, deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error)
+	ListWordsFunc                            func(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error)
+	AddWordsFunc                             func(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error)
+	AddWordFunc                              func(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error)
+	GetWordFunc                              func(ctx context.Context, getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error)
+	DeleteWordFunc                           func(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error)
+	ListGrammarsFunc                         func(ctx context.Context, listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error)
+	AddGrammarFunc                           func(ctx context.Context, addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error)
+	GetGrammarFunc                           func(ctx context.Context, getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error)
+	DeleteGrammarFunc                        func(ctx context.Context, deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error)
+	CreateAcousticModelFunc                  func(ctx context.Context, createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error)
+	ListAcousticModelsFunc                   func(ctx context.Context, listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error)
+	GetAcousticModelFunc                     func(ctx context.Context, getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error)
+	DeleteAcousticModelFunc                  func(ctx context.Context, deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error)
+	TrainAcousticModelFunc                   func(ctx context.Context, trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error)
+	ResetAcousticModelFunc                   func(ctx context.Context, resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error)
+	UpgradeAcousticModelFunc                 func(ctx context.Context, upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error)
+	ListAudioFunc                            func(ctx context.Context, listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error)
+	AddAudioFunc                             func(ctx context.Context, addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error)
+	GetAudioFunc                             func(ctx context.Context, getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error)
+	DeleteAudioFunc                          func(ctx context.Context, deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataFunc                       func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	BuildAcousticModelFunc                   func(ctx context.Context, buildAcousticModelOptions *BuildAcousticModelOptions) (*AcousticModelBuild, error)
+	WaitForJobFunc                           func(ctx context.Context, jobID string, policy *JobPollingPolicy) (result *RecognitionJob, response *core.DetailedResponse, err error)
+	BuildLanguageModelFunc                   func(ctx context.Context, buildLanguageModelOptions *BuildLanguageModelOptions) (*LanguageModelBuild, error)
+	RecognizeMultichannelFunc                func(ctx context.Context, recognizeMultichannelOptions *RecognizeMultichannelOptions) (*MultichannelRecognitionResults, error)
+	RecognizeUsingWebsocketWithReconnectFunc func(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeEventCallback, policy *ReconnectPolicy) error
+	RecognizeSegmentedFunc                   func(ctx context.Context, recognizeSegmentedOptions *RecognizeSegmentedOptions) (*SpeechRecognitionResults, error)
+	OpenRecognizeSessionFunc                 func(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) (*RecognizeSession, error)
+	RecognizeUsingWebsocketStreamFunc        func(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions) (<-chan RecognitionEvent, error)
+	RecognizeUsingWebsocketFunc              func(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) error
+}
+
+var _ SpeechToTextV1API = (*MockSpeechToTextV1)(nil)
+
+// ListModels records the call and invokes ListModelsFunc
+func (mock *MockSpeechToTextV1) ListModels(listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error) {
+	return mock.ListModelsWithContext(context.Background(), listModelsOptions)
+}
+
+// ListModelsWithContext records the call and invokes ListModelsFunc
+func (mock *MockSpeechToTextV1) ListModelsWithContext(ctx context.Context, listModelsOptions *ListModelsOptions) (result *SpeechModels, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListModels", listModelsOptions)
+	if mock.ListModelsFunc != nil {
+		return mock.ListModelsFunc(ctx, listModelsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ListModels")
+	return
+}
+
+// GetModel records the call and invokes GetModelFunc
+func (mock *MockSpeechToTextV1) GetModel(getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error) {
+	return mock.GetModelWithContext(context.Background(), getModelOptions)
+}
+
+// GetModelWithContext records the call and invokes GetModelFunc
+func (mock *MockSpeechToTextV1) GetModelWithContext(ctx context.Context, getModelOptions *GetModelOptions) (result *SpeechModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetModel", getModelOptions)
+	if mock.GetModelFunc != nil {
+		return mock.GetModelFunc(ctx, getModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "GetModel")
+	return
+}
+
+// Recognize records the call and invokes RecognizeFunc
+func (mock *MockSpeechToTextV1) Recognize(recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	return mock.RecognizeWithContext(context.Background(), recognizeOptions)
+}
+
+// RecognizeWithContext records the call and invokes RecognizeFunc
+func (mock *MockSpeechToTextV1) RecognizeWithContext(ctx context.Context, recognizeOptions *RecognizeOptions) (result *SpeechRecognitionResults, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Recognize", recognizeOptions)
+	if mock.RecognizeFunc != nil {
+		return mock.RecognizeFunc(ctx, recognizeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "Recognize")
+	return
+}
+
+// RegisterCallback records the call and invokes RegisterCallbackFunc
+func (mock *MockSpeechToTextV1) RegisterCallback(registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error) {
+	return mock.RegisterCallbackWithContext(context.Background(), registerCallbackOptions)
+}
+
+// RegisterCallbackWithContext records the call and invokes RegisterCallbackFunc
+func (mock *MockSpeechToTextV1) RegisterCallbackWithContext(ctx context.Context, registerCallbackOptions *RegisterCallbackOptions) (result *RegisterStatus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "RegisterCallback", registerCallbackOptions)
+	if mock.RegisterCallbackFunc != nil {
+		return mock.RegisterCallbackFunc(ctx, registerCallbackOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "RegisterCallback")
+	return
+}
+
+// UnregisterCallback records the call and invokes UnregisterCallbackFunc
+func (mock *MockSpeechToTextV1) UnregisterCallback(unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error) {
+	return mock.UnregisterCallbackWithContext(context.Background(), unregisterCallbackOptions)
+}
+
+// UnregisterCallbackWithContext records the call and invokes UnregisterCallbackFunc
+func (mock *MockSpeechToTextV1) UnregisterCallbackWithContext(ctx context.Context, unregisterCallbackOptions *UnregisterCallbackOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UnregisterCallback", unregisterCallbackOptions)
+	if mock.UnregisterCallbackFunc != nil {
+		return mock.UnregisterCallbackFunc(ctx, unregisterCallbackOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "UnregisterCallback")
+	return
+}
+
+// CreateJob records the call and invokes CreateJobFunc
+func (mock *MockSpeechToTextV1) CreateJob(createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	return mock.CreateJobWithContext(context.Background(), createJobOptions)
+}
+
+// CreateJobWithContext records the call and invokes CreateJobFunc
+func (mock *MockSpeechToTextV1) CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateJob", createJobOptions)
+	if mock.CreateJobFunc != nil {
+		return mock.CreateJobFunc(ctx, createJobOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "CreateJob")
+	return
+}
+
+// CheckJobs records the call and invokes CheckJobsFunc
+func (mock *MockSpeechToTextV1) CheckJobs(checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error) {
+	return mock.CheckJobsWithContext(context.Background(), checkJobsOptions)
+}
+
+// CheckJobsWithContext records the call and invokes CheckJobsFunc
+func (mock *MockSpeechToTextV1) CheckJobsWithContext(ctx context.Context, checkJobsOptions *CheckJobsOptions) (result *RecognitionJobs, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CheckJobs", checkJobsOptions)
+	if mock.CheckJobsFunc != nil {
+		return mock.CheckJobsFunc(ctx, checkJobsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "CheckJobs")
+	return
+}
+
+// CheckJob records the call and invokes CheckJobFunc
+func (mock *MockSpeechToTextV1) CheckJob(checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	return mock.CheckJobWithContext(context.Background(), checkJobOptions)
+}
+
+// CheckJobWithContext records the call and invokes CheckJobFunc
+func (mock *MockSpeechToTextV1) CheckJobWithContext(ctx context.Context, checkJobOptions *CheckJobOptions) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CheckJob", checkJobOptions)
+	if mock.CheckJobFunc != nil {
+		return mock.CheckJobFunc(ctx, checkJobOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "CheckJob")
+	return
+}
+
+// DeleteJob records the call and invokes DeleteJobFunc
+func (mock *MockSpeechToTextV1) DeleteJob(deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteJobWithContext(context.Background(), deleteJobOptions)
+}
+
+// DeleteJobWithContext records the call and invokes DeleteJobFunc
+func (mock *MockSpeechToTextV1) DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteJob", deleteJobOptions)
+	if mock.DeleteJobFunc != nil {
+		return mock.DeleteJobFunc(ctx, deleteJobOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteJob")
+	return
+}
+
+// CreateLanguageModel records the call and invokes CreateLanguageModelFunc
+func (mock *MockSpeechToTextV1) CreateLanguageModel(createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	return mock.CreateLanguageModelWithContext(context.Background(), createLanguageModelOptions)
+}
+
+// CreateLanguageModelWithContext records the call and invokes CreateLanguageModelFunc
+func (mock *MockSpeechToTextV1) CreateLanguageModelWithContext(ctx context.Context, createLanguageModelOptions *CreateLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateLanguageModel", createLanguageModelOptions)
+	if mock.CreateLanguageModelFunc != nil {
+		return mock.CreateLanguageModelFunc(ctx, createLanguageModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "CreateLanguageModel")
+	return
+}
+
+// ListLanguageModels records the call and invokes ListLanguageModelsFunc
+func (mock *MockSpeechToTextV1) ListLanguageModels(listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error) {
+	return mock.ListLanguageModelsWithContext(context.Background(), listLanguageModelsOptions)
+}
+
+// ListLanguageModelsWithContext records the call and invokes ListLanguageModelsFunc
+func (mock *MockSpeechToTextV1) ListLanguageModelsWithContext(ctx context.Context, listLanguageModelsOptions *ListLanguageModelsOptions) (result *LanguageModels, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListLanguageModels", listLanguageModelsOptions)
+	if mock.ListLanguageModelsFunc != nil {
+		return mock.ListLanguageModelsFunc(ctx, listLanguageModelsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ListLanguageModels")
+	return
+}
+
+// GetLanguageModel records the call and invokes GetLanguageModelFunc
+func (mock *MockSpeechToTextV1) GetLanguageModel(getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	return mock.GetLanguageModelWithContext(context.Background(), getLanguageModelOptions)
+}
+
+// GetLanguageModelWithContext records the call and invokes GetLanguageModelFunc
+func (mock *MockSpeechToTextV1) GetLanguageModelWithContext(ctx context.Context, getLanguageModelOptions *GetLanguageModelOptions) (result *LanguageModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetLanguageModel", getLanguageModelOptions)
+	if mock.GetLanguageModelFunc != nil {
+		return mock.GetLanguageModelFunc(ctx, getLanguageModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "GetLanguageModel")
+	return
+}
+
+// DeleteLanguageModel records the call and invokes DeleteLanguageModelFunc
+func (mock *MockSpeechToTextV1) DeleteLanguageModel(deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteLanguageModelWithContext(context.Background(), deleteLanguageModelOptions)
+}
+
+// DeleteLanguageModelWithContext records the call and invokes DeleteLanguageModelFunc
+func (mock *MockSpeechToTextV1) DeleteLanguageModelWithContext(ctx context.Context, deleteLanguageModelOptions *DeleteLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteLanguageModel", deleteLanguageModelOptions)
+	if mock.DeleteLanguageModelFunc != nil {
+		return mock.DeleteLanguageModelFunc(ctx, deleteLanguageModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteLanguageModel")
+	return
+}
+
+// TrainLanguageModel records the call and invokes TrainLanguageModelFunc
+func (mock *MockSpeechToTextV1) TrainLanguageModel(trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	return mock.TrainLanguageModelWithContext(context.Background(), trainLanguageModelOptions)
+}
+
+// TrainLanguageModelWithContext records the call and invokes TrainLanguageModelFunc
+func (mock *MockSpeechToTextV1) TrainLanguageModelWithContext(ctx context.Context, trainLanguageModelOptions *TrainLanguageModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "TrainLanguageModel", trainLanguageModelOptions)
+	if mock.TrainLanguageModelFunc != nil {
+		return mock.TrainLanguageModelFunc(ctx, trainLanguageModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "TrainLanguageModel")
+	return
+}
+
+// ResetLanguageModel records the call and invokes ResetLanguageModelFunc
+func (mock *MockSpeechToTextV1) ResetLanguageModel(resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.ResetLanguageModelWithContext(context.Background(), resetLanguageModelOptions)
+}
+
+// ResetLanguageModelWithContext records the call and invokes ResetLanguageModelFunc
+func (mock *MockSpeechToTextV1) ResetLanguageModelWithContext(ctx context.Context, resetLanguageModelOptions *ResetLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ResetLanguageModel", resetLanguageModelOptions)
+	if mock.ResetLanguageModelFunc != nil {
+		return mock.ResetLanguageModelFunc(ctx, resetLanguageModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ResetLanguageModel")
+	return
+}
+
+// UpgradeLanguageModel records the call and invokes UpgradeLanguageModelFunc
+func (mock *MockSpeechToTextV1) UpgradeLanguageModel(upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.UpgradeLanguageModelWithContext(context.Background(), upgradeLanguageModelOptions)
+}
+
+// UpgradeLanguageModelWithContext records the call and invokes UpgradeLanguageModelFunc
+func (mock *MockSpeechToTextV1) UpgradeLanguageModelWithContext(ctx context.Context, upgradeLanguageModelOptions *UpgradeLanguageModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpgradeLanguageModel", upgradeLanguageModelOptions)
+	if mock.UpgradeLanguageModelFunc != nil {
+		return mock.UpgradeLanguageModelFunc(ctx, upgradeLanguageModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "UpgradeLanguageModel")
+	return
+}
+
+// ListCorpora records the call and invokes ListCorporaFunc
+func (mock *MockSpeechToTextV1) ListCorpora(listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error) {
+	return mock.ListCorporaWithContext(context.Background(), listCorporaOptions)
+}
+
+// ListCorporaWithContext records the call and invokes ListCorporaFunc
+func (mock *MockSpeechToTextV1) ListCorporaWithContext(ctx context.Context, listCorporaOptions *ListCorporaOptions) (result *Corpora, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListCorpora", listCorporaOptions)
+	if mock.ListCorporaFunc != nil {
+		return mock.ListCorporaFunc(ctx, listCorporaOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ListCorpora")
+	return
+}
+
+// AddCorpus records the call and invokes AddCorpusFunc
+func (mock *MockSpeechToTextV1) AddCorpus(addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error) {
+	return mock.AddCorpusWithContext(context.Background(), addCorpusOptions)
+}
+
+// AddCorpusWithContext records the call and invokes AddCorpusFunc
+func (mock *MockSpeechToTextV1) AddCorpusWithContext(ctx context.Context, addCorpusOptions *AddCorpusOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddCorpus", addCorpusOptions)
+	if mock.AddCorpusFunc != nil {
+		return mock.AddCorpusFunc(ctx, addCorpusOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "AddCorpus")
+	return
+}
+
+// GetCorpus records the call and invokes GetCorpusFunc
+func (mock *MockSpeechToTextV1) GetCorpus(getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error) {
+	return mock.GetCorpusWithContext(context.Background(), getCorpusOptions)
+}
+
+// GetCorpusWithContext records the call and invokes GetCorpusFunc
+func (mock *MockSpeechToTextV1) GetCorpusWithContext(ctx context.Context, getCorpusOptions *GetCorpusOptions) (result *Corpus, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetCorpus", getCorpusOptions)
+	if mock.GetCorpusFunc != nil {
+		return mock.GetCorpusFunc(ctx, getCorpusOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "GetCorpus")
+	return
+}
+
+// DeleteCorpus records the call and invokes DeleteCorpusFunc
+func (mock *MockSpeechToTextV1) DeleteCorpus(deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteCorpusWithContext(context.Background(), deleteCorpusOptions)
+}
+
+// DeleteCorpusWithContext records the call and invokes DeleteCorpusFunc
+func (mock *MockSpeechToTextV1) DeleteCorpusWithContext(ctx context.Context, deleteCorpusOptions *DeleteCorpusOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteCorpus", deleteCorpusOptions)
+	if mock.DeleteCorpusFunc != nil {
+		return mock.DeleteCorpusFunc(ctx, deleteCorpusOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteCorpus")
+	return
+}
+
+// ListWords records the call and invokes ListWordsFunc
+func (mock *MockSpeechToTextV1) ListWords(listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
+	return mock.ListWordsWithContext(context.Background(), listWordsOptions)
+}
+
+// ListWordsWithContext records the call and invokes ListWordsFunc
+func (mock *MockSpeechToTextV1) ListWordsWithContext(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListWords", listWordsOptions)
+	if mock.ListWordsFunc != nil {
+		return mock.ListWordsFunc(ctx, listWordsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ListWords")
+	return
+}
+
+// AddWords records the call and invokes AddWordsFunc
+func (mock *MockSpeechToTextV1) AddWords(addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
+	return mock.AddWordsWithContext(context.Background(), addWordsOptions)
+}
+
+// AddWordsWithContext records the call and invokes AddWordsFunc
+func (mock *MockSpeechToTextV1) AddWordsWithContext(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddWords", addWordsOptions)
+	if mock.AddWordsFunc != nil {
+		return mock.AddWordsFunc(ctx, addWordsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "AddWords")
+	return
+}
+
+// AddWord records the call and invokes AddWordFunc
+func (mock *MockSpeechToTextV1) AddWord(addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
+	return mock.AddWordWithContext(context.Background(), addWordOptions)
+}
+
+// AddWordWithContext records the call and invokes AddWordFunc
+func (mock *MockSpeechToTextV1) AddWordWithContext(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddWord", addWordOptions)
+	if mock.AddWordFunc != nil {
+		return mock.AddWordFunc(ctx, addWordOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "AddWord")
+	return
+}
+
+// GetWord records the call and invokes GetWordFunc
+func (mock *MockSpeechToTextV1) GetWord(getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error) {
+	return mock.GetWordWithContext(context.Background(), getWordOptions)
+}
+
+// GetWordWithContext records the call and invokes GetWordFunc
+func (mock *MockSpeechToTextV1) GetWordWithContext(ctx context.Context, getWordOptions *GetWordOptions) (result *Word, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetWord", getWordOptions)
+	if mock.GetWordFunc != nil {
+		return mock.GetWordFunc(ctx, getWordOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "GetWord")
+	return
+}
+
+// DeleteWord records the call and invokes DeleteWordFunc
+func (mock *MockSpeechToTextV1) DeleteWord(deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteWordWithContext(context.Background(), deleteWordOptions)
+}
+
+// DeleteWordWithContext records the call and invokes DeleteWordFunc
+func (mock *MockSpeechToTextV1) DeleteWordWithContext(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteWord", deleteWordOptions)
+	if mock.DeleteWordFunc != nil {
+		return mock.DeleteWordFunc(ctx, deleteWordOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteWord")
+	return
+}
+
+// ListGrammars records the call and invokes ListGrammarsFunc
+func (mock *MockSpeechToTextV1) ListGrammars(listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error) {
+	return mock.ListGrammarsWithContext(context.Background(), listGrammarsOptions)
+}
+
+// ListGrammarsWithContext records the call and invokes ListGrammarsFunc
+func (mock *MockSpeechToTextV1) ListGrammarsWithContext(ctx context.Context, listGrammarsOptions *ListGrammarsOptions) (result *Grammars, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListGrammars", listGrammarsOptions)
+	if mock.ListGrammarsFunc != nil {
+		return mock.ListGrammarsFunc(ctx, listGrammarsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ListGrammars")
+	return
+}
+
+// AddGrammar records the call and invokes AddGrammarFunc
+func (mock *MockSpeechToTextV1) AddGrammar(addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error) {
+	return mock.AddGrammarWithContext(context.Background(), addGrammarOptions)
+}
+
+// AddGrammarWithContext records the call and invokes AddGrammarFunc
+func (mock *MockSpeechToTextV1) AddGrammarWithContext(ctx context.Context, addGrammarOptions *AddGrammarOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddGrammar", addGrammarOptions)
+	if mock.AddGrammarFunc != nil {
+		return mock.AddGrammarFunc(ctx, addGrammarOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "AddGrammar")
+	return
+}
+
+// GetGrammar records the call and invokes GetGrammarFunc
+func (mock *MockSpeechToTextV1) GetGrammar(getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error) {
+	return mock.GetGrammarWithContext(context.Background(), getGrammarOptions)
+}
+
+// GetGrammarWithContext records the call and invokes GetGrammarFunc
+func (mock *MockSpeechToTextV1) GetGrammarWithContext(ctx context.Context, getGrammarOptions *GetGrammarOptions) (result *Grammar, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetGrammar", getGrammarOptions)
+	if mock.GetGrammarFunc != nil {
+		return mock.GetGrammarFunc(ctx, getGrammarOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "GetGrammar")
+	return
+}
+
+// DeleteGrammar records the call and invokes DeleteGrammarFunc
+func (mock *MockSpeechToTextV1) DeleteGrammar(deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteGrammarWithContext(context.Background(), deleteGrammarOptions)
+}
+
+// DeleteGrammarWithContext records the call and invokes DeleteGrammarFunc
+func (mock *MockSpeechToTextV1) DeleteGrammarWithContext(ctx context.Context, deleteGrammarOptions *DeleteGrammarOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteGrammar", deleteGrammarOptions)
+	if mock.DeleteGrammarFunc != nil {
+		return mock.DeleteGrammarFunc(ctx, deleteGrammarOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteGrammar")
+	return
+}
+
+// CreateAcousticModel records the call and invokes CreateAcousticModelFunc
+func (mock *MockSpeechToTextV1) CreateAcousticModel(createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	return mock.CreateAcousticModelWithContext(context.Background(), createAcousticModelOptions)
+}
+
+// CreateAcousticModelWithContext records the call and invokes CreateAcousticModelFunc
+func (mock *MockSpeechToTextV1) CreateAcousticModelWithContext(ctx context.Context, createAcousticModelOptions *CreateAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateAcousticModel", createAcousticModelOptions)
+	if mock.CreateAcousticModelFunc != nil {
+		return mock.CreateAcousticModelFunc(ctx, createAcousticModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "CreateAcousticModel")
+	return
+}
+
+// ListAcousticModels records the call and invokes ListAcousticModelsFunc
+func (mock *MockSpeechToTextV1) ListAcousticModels(listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error) {
+	return mock.ListAcousticModelsWithContext(context.Background(), listAcousticModelsOptions)
+}
+
+// ListAcousticModelsWithContext records the call and invokes ListAcousticModelsFunc
+func (mock *MockSpeechToTextV1) ListAcousticModelsWithContext(ctx context.Context, listAcousticModelsOptions *ListAcousticModelsOptions) (result *AcousticModels, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListAcousticModels", listAcousticModelsOptions)
+	if mock.ListAcousticModelsFunc != nil {
+		return mock.ListAcousticModelsFunc(ctx, listAcousticModelsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ListAcousticModels")
+	return
+}
+
+// GetAcousticModel records the call and invokes GetAcousticModelFunc
+func (mock *MockSpeechToTextV1) GetAcousticModel(getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	return mock.GetAcousticModelWithContext(context.Background(), getAcousticModelOptions)
+}
+
+// GetAcousticModelWithContext records the call and invokes GetAcousticModelFunc
+func (mock *MockSpeechToTextV1) GetAcousticModelWithContext(ctx context.Context, getAcousticModelOptions *GetAcousticModelOptions) (result *AcousticModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetAcousticModel", getAcousticModelOptions)
+	if mock.GetAcousticModelFunc != nil {
+		return mock.GetAcousticModelFunc(ctx, getAcousticModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "GetAcousticModel")
+	return
+}
+
+// DeleteAcousticModel records the call and invokes DeleteAcousticModelFunc
+func (mock *MockSpeechToTextV1) DeleteAcousticModel(deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteAcousticModelWithContext(context.Background(), deleteAcousticModelOptions)
+}
+
+// DeleteAcousticModelWithContext records the call and invokes DeleteAcousticModelFunc
+func (mock *MockSpeechToTextV1) DeleteAcousticModelWithContext(ctx context.Context, deleteAcousticModelOptions *DeleteAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteAcousticModel", deleteAcousticModelOptions)
+	if mock.DeleteAcousticModelFunc != nil {
+		return mock.DeleteAcousticModelFunc(ctx, deleteAcousticModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteAcousticModel")
+	return
+}
+
+// TrainAcousticModel records the call and invokes TrainAcousticModelFunc
+func (mock *MockSpeechToTextV1) TrainAcousticModel(trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	return mock.TrainAcousticModelWithContext(context.Background(), trainAcousticModelOptions)
+}
+
+// TrainAcousticModelWithContext records the call and invokes TrainAcousticModelFunc
+func (mock *MockSpeechToTextV1) TrainAcousticModelWithContext(ctx context.Context, trainAcousticModelOptions *TrainAcousticModelOptions) (result *TrainingResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "TrainAcousticModel", trainAcousticModelOptions)
+	if mock.TrainAcousticModelFunc != nil {
+		return mock.TrainAcousticModelFunc(ctx, trainAcousticModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "TrainAcousticModel")
+	return
+}
+
+// ResetAcousticModel records the call and invokes ResetAcousticModelFunc
+func (mock *MockSpeechToTextV1) ResetAcousticModel(resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.ResetAcousticModelWithContext(context.Background(), resetAcousticModelOptions)
+}
+
+// ResetAcousticModelWithContext records the call and invokes ResetAcousticModelFunc
+func (mock *MockSpeechToTextV1) ResetAcousticModelWithContext(ctx context.Context, resetAcousticModelOptions *ResetAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ResetAcousticModel", resetAcousticModelOptions)
+	if mock.ResetAcousticModelFunc != nil {
+		return mock.ResetAcousticModelFunc(ctx, resetAcousticModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ResetAcousticModel")
+	return
+}
+
+// UpgradeAcousticModel records the call and invokes UpgradeAcousticModelFunc
+func (mock *MockSpeechToTextV1) UpgradeAcousticModel(upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.UpgradeAcousticModelWithContext(context.Background(), upgradeAcousticModelOptions)
+}
+
+// UpgradeAcousticModelWithContext records the call and invokes UpgradeAcousticModelFunc
+func (mock *MockSpeechToTextV1) UpgradeAcousticModelWithContext(ctx context.Context, upgradeAcousticModelOptions *UpgradeAcousticModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpgradeAcousticModel", upgradeAcousticModelOptions)
+	if mock.UpgradeAcousticModelFunc != nil {
+		return mock.UpgradeAcousticModelFunc(ctx, upgradeAcousticModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "UpgradeAcousticModel")
+	return
+}
+
+// ListAudio records the call and invokes ListAudioFunc
+func (mock *MockSpeechToTextV1) ListAudio(listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error) {
+	return mock.ListAudioWithContext(context.Background(), listAudioOptions)
+}
+
+// ListAudioWithContext records the call and invokes ListAudioFunc
+func (mock *MockSpeechToTextV1) ListAudioWithContext(ctx context.Context, listAudioOptions *ListAudioOptions) (result *AudioResources, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListAudio", listAudioOptions)
+	if mock.ListAudioFunc != nil {
+		return mock.ListAudioFunc(ctx, listAudioOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "ListAudio")
+	return
+}
+
+// AddAudio records the call and invokes AddAudioFunc
+func (mock *MockSpeechToTextV1) AddAudio(addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error) {
+	return mock.AddAudioWithContext(context.Background(), addAudioOptions)
+}
+
+// AddAudioWithContext records the call and invokes AddAudioFunc
+func (mock *MockSpeechToTextV1) AddAudioWithContext(ctx context.Context, addAudioOptions *AddAudioOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddAudio", addAudioOptions)
+	if mock.AddAudioFunc != nil {
+		return mock.AddAudioFunc(ctx, addAudioOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "AddAudio")
+	return
+}
+
+// GetAudio records the call and invokes GetAudioFunc
+func (mock *MockSpeechToTextV1) GetAudio(getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error) {
+	return mock.GetAudioWithContext(context.Background(), getAudioOptions)
+}
+
+// GetAudioWithContext records the call and invokes GetAudioFunc
+func (mock *MockSpeechToTextV1) GetAudioWithContext(ctx context.Context, getAudioOptions *GetAudioOptions) (result *AudioListing, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetAudio", getAudioOptions)
+	if mock.GetAudioFunc != nil {
+		return mock.GetAudioFunc(ctx, getAudioOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "GetAudio")
+	return
+}
+
+// DeleteAudio records the call and invokes DeleteAudioFunc
+func (mock *MockSpeechToTextV1) DeleteAudio(deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteAudioWithContext(context.Background(), deleteAudioOptions)
+}
+
+// DeleteAudioWithContext records the call and invokes DeleteAudioFunc
+func (mock *MockSpeechToTextV1) DeleteAudioWithContext(ctx context.Context, deleteAudioOptions *DeleteAudioOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteAudio", deleteAudioOptions)
+	if mock.DeleteAudioFunc != nil {
+		return mock.DeleteAudioFunc(ctx, deleteAudioOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteAudio")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockSpeechToTextV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockSpeechToTextV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "DeleteUserData")
+	return
+}
+
+// NewAddAudioOptions delegates to SpeechToTextV1.NewAddAudioOptions
+func (mock *MockSpeechToTextV1) NewAddAudioOptions(customizationID string, audioName string, audioResource io.ReadCloser) *AddAudioOptions {
+	return new(SpeechToTextV1).NewAddAudioOptions(customizationID, audioName, audioResource)
+}
+
+// NewAddCorpusOptions delegates to SpeechToTextV1.NewAddCorpusOptions
+func (mock *MockSpeechToTextV1) NewAddCorpusOptions(customizationID string, corpusName string, corpusFile io.ReadCloser) *AddCorpusOptions {
+	return new(SpeechToTextV1).NewAddCorpusOptions(customizationID, corpusName, corpusFile)
+}
+
+// NewAddGrammarOptions delegates to SpeechToTextV1.NewAddGrammarOptions
+func (mock *MockSpeechToTextV1) NewAddGrammarOptions(customizationID string, grammarName string, grammarFile io.ReadCloser, contentType string) *AddGrammarOptions {
+	return new(SpeechToTextV1).NewAddGrammarOptions(customizationID, grammarName, grammarFile, contentType)
+}
+
+// NewAddWordOptions delegates to SpeechToTextV1.NewAddWordOptions
+func (mock *MockSpeechToTextV1) NewAddWordOptions(customizationID string, wordName string) *AddWordOptions {
+	return new(SpeechToTextV1).NewAddWordOptions(customizationID, wordName)
+}
+
+// NewAddWordsOptions delegates to SpeechToTextV1.NewAddWordsOptions
+func (mock *MockSpeechToTextV1) NewAddWordsOptions(customizationID string, words []CustomWord) *AddWordsOptions {
+	return new(SpeechToTextV1).NewAddWordsOptions(customizationID, words)
+}
+
+// NewCheckJobOptions delegates to SpeechToTextV1.NewCheckJobOptions
+func (mock *MockSpeechToTextV1) NewCheckJobOptions(ID string) *CheckJobOptions {
+	return new(SpeechToTextV1).NewCheckJobOptions(ID)
+}
+
+// NewCheckJobsOptions delegates to SpeechToTextV1.NewCheckJobsOptions
+func (mock *MockSpeechToTextV1) NewCheckJobsOptions() *CheckJobsOptions {
+	return new(SpeechToTextV1).NewCheckJobsOptions()
+}
+
+// NewCreateAcousticModelOptions delegates to SpeechToTextV1.NewCreateAcousticModelOptions
+func (mock *MockSpeechToTextV1) NewCreateAcousticModelOptions(name string, baseModelName string) *CreateAcousticModelOptions {
+	return new(SpeechToTextV1).NewCreateAcousticModelOptions(name, baseModelName)
+}
+
+// NewCreateJobOptions delegates to SpeechToTextV1.NewCreateJobOptions
+func (mock *MockSpeechToTextV1) NewCreateJobOptions(audio io.ReadCloser) *CreateJobOptions {
+	return new(SpeechToTextV1).NewCreateJobOptions(audio)
+}
+
+// NewCreateLanguageModelOptions delegates to SpeechToTextV1.NewCreateLanguageModelOptions
+func (mock *MockSpeechToTextV1) NewCreateLanguageModelOptions(name string, baseModelName string) *CreateLanguageModelOptions {
+	return new(SpeechToTextV1).NewCreateLanguageModelOptions(name, baseModelName)
+}
+
+// NewDeleteAcousticModelOptions delegates to SpeechToTextV1.NewDeleteAcousticModelOptions
+func (mock *MockSpeechToTextV1) NewDeleteAcousticModelOptions(customizationID string) *DeleteAcousticModelOptions {
+	return new(SpeechToTextV1).NewDeleteAcousticModelOptions(customizationID)
+}
+
+// NewDeleteAudioOptions delegates to SpeechToTextV1.NewDeleteAudioOptions
+func (mock *MockSpeechToTextV1) NewDeleteAudioOptions(customizationID string, audioName string) *DeleteAudioOptions {
+	return new(SpeechToTextV1).NewDeleteAudioOptions(customizationID, audioName)
+}
+
+// NewDeleteCorpusOptions delegates to SpeechToTextV1.NewDeleteCorpusOptions
+func (mock *MockSpeechToTextV1) NewDeleteCorpusOptions(customizationID string, corpusName string) *DeleteCorpusOptions {
+	return new(SpeechToTextV1).NewDeleteCorpusOptions(customizationID, corpusName)
+}
+
+// NewDeleteGrammarOptions delegates to SpeechToTextV1.NewDeleteGrammarOptions
+func (mock *MockSpeechToTextV1) NewDeleteGrammarOptions(customizationID string, grammarName string) *DeleteGrammarOptions {
+	return new(SpeechToTextV1).NewDeleteGrammarOptions(customizationID, grammarName)
+}
+
+// NewDeleteJobOptions delegates to SpeechToTextV1.NewDeleteJobOptions
+func (mock *MockSpeechToTextV1) NewDeleteJobOptions(ID string) *DeleteJobOptions {
+	return new(SpeechToTextV1).NewDeleteJobOptions(ID)
+}
+
+// NewDeleteLanguageModelOptions delegates to SpeechToTextV1.NewDeleteLanguageModelOptions
+func (mock *MockSpeechToTextV1) NewDeleteLanguageModelOptions(customizationID string) *DeleteLanguageModelOptions {
+	return new(SpeechToTextV1).NewDeleteLanguageModelOptions(customizationID)
+}
+
+// NewDeleteUserDataOptions delegates to SpeechToTextV1.NewDeleteUserDataOptions
+func (mock *MockSpeechToTextV1) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(SpeechToTextV1).NewDeleteUserDataOptions(customerID)
+}
+
+// NewDeleteWordOptions delegates to SpeechToTextV1.NewDeleteWordOptions
+func (mock *MockSpeechToTextV1) NewDeleteWordOptions(customizationID string, wordName string) *DeleteWordOptions {
+	return new(SpeechToTextV1).NewDeleteWordOptions(customizationID, wordName)
+}
+
+// NewGetAcousticModelOptions delegates to SpeechToTextV1.NewGetAcousticModelOptions
+func (mock *MockSpeechToTextV1) NewGetAcousticModelOptions(customizationID string) *GetAcousticModelOptions {
+	return new(SpeechToTextV1).NewGetAcousticModelOptions(customizationID)
+}
+
+// NewGetAudioOptions delegates to SpeechToTextV1.NewGetAudioOptions
+func (mock *MockSpeechToTextV1) NewGetAudioOptions(customizationID string, audioName string) *GetAudioOptions {
+	return new(SpeechToTextV1).NewGetAudioOptions(customizationID, audioName)
+}
+
+// NewGetCorpusOptions delegates to SpeechToTextV1.NewGetCorpusOptions
+func (mock *MockSpeechToTextV1) NewGetCorpusOptions(customizationID string, corpusName string) *GetCorpusOptions {
+	return new(SpeechToTextV1).NewGetCorpusOptions(customizationID, corpusName)
+}
+
+// NewGetGrammarOptions delegates to SpeechToTextV1.NewGetGrammarOptions
+func (mock *MockSpeechToTextV1) NewGetGrammarOptions(customizationID string, grammarName string) *GetGrammarOptions {
+	return new(SpeechToTextV1).NewGetGrammarOptions(customizationID, grammarName)
+}
+
+// NewGetLanguageModelOptions delegates to SpeechToTextV1.NewGetLanguageModelOptions
+func (mock *MockSpeechToTextV1) NewGetLanguageModelOptions(customizationID string) *GetLanguageModelOptions {
+	return new(SpeechToTextV1).NewGetLanguageModelOptions(customizationID)
+}
+
+// NewGetModelOptions delegates to SpeechToTextV1.NewGetModelOptions
+func (mock *MockSpeechToTextV1) NewGetModelOptions(modelID string) *GetModelOptions {
+	return new(SpeechToTextV1).NewGetModelOptions(modelID)
+}
+
+// NewGetWordOptions delegates to SpeechToTextV1.NewGetWordOptions
+func (mock *MockSpeechToTextV1) NewGetWordOptions(customizationID string, wordName string) *GetWordOptions {
+	return new(SpeechToTextV1).NewGetWordOptions(customizationID, wordName)
+}
+
+// NewListAcousticModelsOptions delegates to SpeechToTextV1.NewListAcousticModelsOptions
+func (mock *MockSpeechToTextV1) NewListAcousticModelsOptions() *ListAcousticModelsOptions {
+	return new(SpeechToTextV1).NewListAcousticModelsOptions()
+}
+
+// NewListAudioOptions delegates to SpeechToTextV1.NewListAudioOptions
+func (mock *MockSpeechToTextV1) NewListAudioOptions(customizationID string) *ListAudioOptions {
+	return new(SpeechToTextV1).NewListAudioOptions(customizationID)
+}
+
+// NewListCorporaOptions delegates to SpeechToTextV1.NewListCorporaOptions
+func (mock *MockSpeechToTextV1) NewListCorporaOptions(customizationID string) *ListCorporaOptions {
+	return new(SpeechToTextV1).NewListCorporaOptions(customizationID)
+}
+
+// NewListGrammarsOptions delegates to SpeechToTextV1.NewListGrammarsOptions
+func (mock *MockSpeechToTextV1) NewListGrammarsOptions(customizationID string) *ListGrammarsOptions {
+	return new(SpeechToTextV1).NewListGrammarsOptions(customizationID)
+}
+
+// NewListLanguageModelsOptions delegates to SpeechToTextV1.NewListLanguageModelsOptions
+func (mock *MockSpeechToTextV1) NewListLanguageModelsOptions() *ListLanguageModelsOptions {
+	return new(SpeechToTextV1).NewListLanguageModelsOptions()
+}
+
+// NewListModelsOptions delegates to SpeechToTextV1.NewListModelsOptions
+func (mock *MockSpeechToTextV1) NewListModelsOptions() *ListModelsOptions {
+	return new(SpeechToTextV1).NewListModelsOptions()
+}
+
+// NewListWordsOptions delegates to SpeechToTextV1.NewListWordsOptions
+func (mock *MockSpeechToTextV1) NewListWordsOptions(customizationID string) *ListWordsOptions {
+	return new(SpeechToTextV1).NewListWordsOptions(customizationID)
+}
+
+// NewRecognizeOptions delegates to SpeechToTextV1.NewRecognizeOptions
+func (mock *MockSpeechToTextV1) NewRecognizeOptions(audio io.ReadCloser) *RecognizeOptions {
+	return new(SpeechToTextV1).NewRecognizeOptions(audio)
+}
+
+// NewRegisterCallbackOptions delegates to SpeechToTextV1.NewRegisterCallbackOptions
+func (mock *MockSpeechToTextV1) NewRegisterCallbackOptions(callbackURL string) *RegisterCallbackOptions {
+	return new(SpeechToTextV1).NewRegisterCallbackOptions(callbackURL)
+}
+
+// NewResetAcousticModelOptions delegates to SpeechToTextV1.NewResetAcousticModelOptions
+func (mock *MockSpeechToTextV1) NewResetAcousticModelOptions(customizationID string) *ResetAcousticModelOptions {
+	return new(SpeechToTextV1).NewResetAcousticModelOptions(customizationID)
+}
+
+// NewResetLanguageModelOptions delegates to SpeechToTextV1.NewResetLanguageModelOptions
+func (mock *MockSpeechToTextV1) NewResetLanguageModelOptions(customizationID string) *ResetLanguageModelOptions {
+	return new(SpeechToTextV1).NewResetLanguageModelOptions(customizationID)
+}
+
+// NewTrainAcousticModelOptions delegates to SpeechToTextV1.NewTrainAcousticModelOptions
+func (mock *MockSpeechToTextV1) NewTrainAcousticModelOptions(customizationID string) *TrainAcousticModelOptions {
+	return new(SpeechToTextV1).NewTrainAcousticModelOptions(customizationID)
+}
+
+// NewTrainLanguageModelOptions delegates to SpeechToTextV1.NewTrainLanguageModelOptions
+func (mock *MockSpeechToTextV1) NewTrainLanguageModelOptions(customizationID string) *TrainLanguageModelOptions {
+	return new(SpeechToTextV1).NewTrainLanguageModelOptions(customizationID)
+}
+
+// NewUnregisterCallbackOptions delegates to SpeechToTextV1.NewUnregisterCallbackOptions
+func (mock *MockSpeechToTextV1) NewUnregisterCallbackOptions(callbackURL string) *UnregisterCallbackOptions {
+	return new(SpeechToTextV1).NewUnregisterCallbackOptions(callbackURL)
+}
+
+// NewUpgradeAcousticModelOptions delegates to SpeechToTextV1.NewUpgradeAcousticModelOptions
+func (mock *MockSpeechToTextV1) NewUpgradeAcousticModelOptions(customizationID string) *UpgradeAcousticModelOptions {
+	return new(SpeechToTextV1).NewUpgradeAcousticModelOptions(customizationID)
+}
+
+// NewUpgradeLanguageModelOptions delegates to SpeechToTextV1.NewUpgradeLanguageModelOptions
+func (mock *MockSpeechToTextV1) NewUpgradeLanguageModelOptions(customizationID string) *UpgradeLanguageModelOptions {
+	return new(SpeechToTextV1).NewUpgradeLanguageModelOptions(customizationID)
+}
+
+// NewBuildAcousticModelOptions delegates to SpeechToTextV1.NewBuildAcousticModelOptions
+func (mock *MockSpeechToTextV1) NewBuildAcousticModelOptions(audioDirectory string) *BuildAcousticModelOptions {
+	return new(SpeechToTextV1).NewBuildAcousticModelOptions(audioDirectory)
+}
+
+// BuildAcousticModel records the call and invokes BuildAcousticModelFunc
+func (mock *MockSpeechToTextV1) BuildAcousticModel(ctx context.Context, buildAcousticModelOptions *BuildAcousticModelOptions) (*AcousticModelBuild, error) {
+	mock.Record(context.Background(), "BuildAcousticModel", ctx, buildAcousticModelOptions)
+	if mock.BuildAcousticModelFunc != nil {
+		return mock.BuildAcousticModelFunc(ctx, buildAcousticModelOptions)
+	}
+	return nil, common.ErrMockNotImplemented("MockSpeechToTextV1", "BuildAcousticModel")
+}
+
+// WaitForJob records the call and invokes WaitForJobFunc
+func (mock *MockSpeechToTextV1) WaitForJob(ctx context.Context, jobID string, policy *JobPollingPolicy) (result *RecognitionJob, response *core.DetailedResponse, err error) {
+	mock.Record(context.Background(), "WaitForJob", ctx, jobID, policy)
+	if mock.WaitForJobFunc != nil {
+		return mock.WaitForJobFunc(ctx, jobID, policy)
+	}
+	err = common.ErrMockNotImplemented("MockSpeechToTextV1", "WaitForJob")
+	return
+}
+
+// NewBuildLanguageModelOptions delegates to SpeechToTextV1.NewBuildLanguageModelOptions
+func (mock *MockSpeechToTextV1) NewBuildLanguageModelOptions() *BuildLanguageModelOptions {
+	return new(SpeechToTextV1).NewBuildLanguageModelOptions()
+}
+
+// BuildLanguageModel records the call and invokes BuildLanguageModelFunc
+func (mock *MockSpeechToTextV1) BuildLanguageModel(ctx context.Context, buildLanguageModelOptions *BuildLanguageModelOptions) (*LanguageModelBuild, error) {
+	mock.Record(context.Background(), "BuildLanguageModel", ctx, buildLanguageModelOptions)
+	if mock.BuildLanguageModelFunc != nil {
+		return mock.BuildLanguageModelFunc(ctx, buildLanguageModelOptions)
+	}
+	return nil, common.ErrMockNotImplemented("MockSpeechToTextV1", "BuildLanguageModel")
+}
+
+// NewRecognizeMultichannelOptions delegates to SpeechToTextV1.NewRecognizeMultichannelOptions
+func (mock *MockSpeechToTextV1) NewRecognizeMultichannelOptions(audio io.ReadCloser, contentType string) *RecognizeMultichannelOptions {
+	return new(SpeechToTextV1).NewRecognizeMultichannelOptions(audio, contentType)
+}
+
+// RecognizeMultichannel records the call and invokes RecognizeMultichannelFunc
+func (mock *MockSpeechToTextV1) RecognizeMultichannel(ctx context.Context, recognizeMultichannelOptions *RecognizeMultichannelOptions) (*MultichannelRecognitionResults, error) {
+	mock.Record(context.Background(), "RecognizeMultichannel", ctx, recognizeMultichannelOptions)
+	if mock.RecognizeMultichannelFunc != nil {
+		return mock.RecognizeMultichannelFunc(ctx, recognizeMultichannelOptions)
+	}
+	return nil, common.ErrMockNotImplemented("MockSpeechToTextV1", "RecognizeMultichannel")
+}
+
+// RecognizeUsingWebsocketWithReconnect records the call and invokes RecognizeUsingWebsocketWithReconnectFunc
+func (mock *MockSpeechToTextV1) RecognizeUsingWebsocketWithReconnect(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeEventCallback, policy *ReconnectPolicy) error {
+	mock.Record(context.Background(), "RecognizeUsingWebsocketWithReconnect", ctx, recognizeWSOptions, callback, policy)
+	if mock.RecognizeUsingWebsocketWithReconnectFunc != nil {
+		return mock.RecognizeUsingWebsocketWithReconnectFunc(ctx, recognizeWSOptions, callback, policy)
+	}
+	return common.ErrMockNotImplemented("MockSpeechToTextV1", "RecognizeUsingWebsocketWithReconnect")
+}
+
+// NewRecognizeSegmentedOptions delegates to SpeechToTextV1.NewRecognizeSegmentedOptions
+func (mock *MockSpeechToTextV1) NewRecognizeSegmentedOptions(audio io.ReadCloser, contentType string) *RecognizeSegmentedOptions {
+	return new(SpeechToTextV1).NewRecognizeSegmentedOptions(audio, contentType)
+}
+
+// RecognizeSegmented records the call and invokes RecognizeSegmentedFunc
+func (mock *MockSpeechToTextV1) RecognizeSegmented(ctx context.Context, recognizeSegmentedOptions *RecognizeSegmentedOptions) (*SpeechRecognitionResults, error) {
+	mock.Record(context.Background(), "RecognizeSegmented", ctx, recognizeSegmentedOptions)
+	if mock.RecognizeSegmentedFunc != nil {
+		return mock.RecognizeSegmentedFunc(ctx, recognizeSegmentedOptions)
+	}
+	return nil, common.ErrMockNotImplemented("MockSpeechToTextV1", "RecognizeSegmented")
+}
+
+// OpenRecognizeSession records the call and invokes OpenRecognizeSessionFunc
+func (mock *MockSpeechToTextV1) OpenRecognizeSession(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) (*RecognizeSession, error) {
+	return mock.OpenRecognizeSessionWithContext(context.Background(), recognizeWSOptions, callback)
+}
+
+// OpenRecognizeSessionWithContext records the call and invokes OpenRecognizeSessionFunc
+func (mock *MockSpeechToTextV1) OpenRecognizeSessionWithContext(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) (*RecognizeSession, error) {
+	mock.Record(ctx, "OpenRecognizeSession", recognizeWSOptions, callback)
+	if mock.OpenRecognizeSessionFunc != nil {
+		return mock.OpenRecognizeSessionFunc(ctx, recognizeWSOptions, callback)
+	}
+	return nil, common.ErrMockNotImplemented("MockSpeechToTextV1", "OpenRecognizeSession")
+}
+
+// RecognizeUsingWebsocketStream records the call and invokes RecognizeUsingWebsocketStreamFunc
+func (mock *MockSpeechToTextV1) RecognizeUsingWebsocketStream(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions) (<-chan RecognitionEvent, error) {
+	mock.Record(context.Background(), "RecognizeUsingWebsocketStream", ctx, recognizeWSOptions)
+	if mock.RecognizeUsingWebsocketStreamFunc != nil {
+		return mock.RecognizeUsingWebsocketStreamFunc(ctx, recognizeWSOptions)
+	}
+	return nil, common.ErrMockNotImplemented("MockSpeechToTextV1", "RecognizeUsingWebsocketStream")
+}
+
+// NewRecognizeUsingWebsocketOptions delegates to SpeechToTextV1.NewRecognizeUsingWebsocketOptions
+func (mock *MockSpeechToTextV1) NewRecognizeUsingWebsocketOptions(audio io.ReadCloser, contentType string) *RecognizeUsingWebsocketOptions {
+	return new(SpeechToTextV1).NewRecognizeUsingWebsocketOptions(audio, contentType)
+}
+
+// RecognizeUsingWebsocket records the call and invokes RecognizeUsingWebsocketFunc
+func (mock *MockSpeechToTextV1) RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) error {
+	return mock.RecognizeUsingWebsocketWithContext(context.Background(), recognizeWSOptions, callback)
+}
+
+// RecognizeUsingWebsocketWithContext records the call and invokes RecognizeUsingWebsocketFunc
+func (mock *MockSpeechToTextV1) RecognizeUsingWebsocketWithContext(ctx context.Context, recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper) error {
+	mock.Record(ctx, "RecognizeUsingWebsocket", recognizeWSOptions, callback)
+	if mock.RecognizeUsingWebsocketFunc != nil {
+		return mock.RecognizeUsingWebsocketFunc(ctx, recognizeWSOptions, callback)
+	}
+	return common.ErrMockNotImplemented("MockSpeechToTextV1", "RecognizeUsingWebsocket")
+}
@@ -0,0 +1,259 @@
+package speechtotextv1
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DEFAULT_MAX_LINE_LENGTH is the number of characters of a subtitle line, unless MaxLineLength is set
+const DEFAULT_MAX_LINE_LENGTH = 42
+
+// DEFAULT_MAX_LINES is the number of lines of a subtitle cue, unless MaxLines is set
+const DEFAULT_MAX_LINES = 2
+
+// DEFAULT_MAX_CUE_DURATION is how long a subtitle cue is displayed at most, unless MaxCueDuration is set
+const DEFAULT_MAX_CUE_DURATION = 7 * time.Second
+
+// HESITATION_MARKER is the word of the transcripts that stands for a hesitation such as "uhm"
+const HESITATION_MARKER = "%HESITATION"
+
+// SubtitleOptions : The options of the SRT and WebVTT exporters
+type SubtitleOptions struct {
+	// The maximum number of characters of a line. A longer word is put on a line of its own. The speaker label is not
+	// counted.
+	MaxLineLength *int
+
+	// The maximum number of lines of a cue.
+	MaxLines *int
+
+	// The maximum time between the start of the first word and the end of the last word of a cue.
+	MaxCueDuration *time.Duration
+
+	// If `true`, a cue only holds the words of one speaker, whose label is shown. The results must have been
+	// requested with speaker labels.
+	SpeakerLabels *bool
+
+	// If `true`, a cue ends with a result that the service ended at the end of an utterance, as reported by its
+	// `end_of_utterance` field. The default is `true`.
+	SplitAtEndOfUtterance *bool
+}
+
+// NewSubtitleOptions : Instantiate SubtitleOptions
+func NewSubtitleOptions() *SubtitleOptions {
+	return &SubtitleOptions{}
+}
+
+// SetMaxLineLength : Allow user to set MaxLineLength
+func (options *SubtitleOptions) SetMaxLineLength(maxLineLength int) *SubtitleOptions {
+	options.MaxLineLength = &maxLineLength
+	return options
+}
+
+// SetMaxLines : Allow user to set MaxLines
+func (options *SubtitleOptions) SetMaxLines(maxLines int) *SubtitleOptions {
+	options.MaxLines = &maxLines
+	return options
+}
+
+// SetMaxCueDuration : Allow user to set MaxCueDuration
+func (options *SubtitleOptions) SetMaxCueDuration(maxCueDuration time.Duration) *SubtitleOptions {
+	options.MaxCueDuration = &maxCueDuration
+	return options
+}
+
+// SetSpeakerLabels : Allow user to set SpeakerLabels
+func (options *SubtitleOptions) SetSpeakerLabels(speakerLabels bool) *SubtitleOptions {
+	options.SpeakerLabels = &speakerLabels
+	return options
+}
+
+// SetSplitAtEndOfUtterance : Allow user to set SplitAtEndOfUtterance
+func (options *SubtitleOptions) SetSplitAtEndOfUtterance(splitAtEndOfUtterance bool) *SubtitleOptions {
+	options.SplitAtEndOfUtterance = &splitAtEndOfUtterance
+	return options
+}
+
+// SubtitleCue : A piece of text displayed for a span of the audio
+type SubtitleCue struct {
+	// The start time of the first word, in seconds.
+	StartTime float64
+
+	// The end time of the last word, in seconds.
+	EndTime float64
+
+	// The speaker of the words, or UNKNOWN_SPEAKER when the cues are not split by speaker.
+	Speaker int64
+
+	// The words, wrapped into lines.
+	Lines []string
+}
+
+// subtitleLayout holds the options of the exporters, with their defaults applied
+type subtitleLayout struct {
+	maxLineLength         int
+	maxLines              int
+	maxCueDuration        float64
+	speakerLabels         bool
+	splitAtEndOfUtterance bool
+}
+
+func newSubtitleLayout(options *SubtitleOptions) (subtitleLayout, error) {
+	layout := subtitleLayout{
+		maxLineLength:         DEFAULT_MAX_LINE_LENGTH,
+		maxLines:              DEFAULT_MAX_LINES,
+		maxCueDuration:        DEFAULT_MAX_CUE_DURATION.Seconds(),
+		splitAtEndOfUtterance: true,
+	}
+	if options == nil {
+		return layout, nil
+	}
+	if options.MaxLineLength != nil {
+		if *options.MaxLineLength <= 0 {
+			return layout, fmt.Errorf("The maximum line length must be positive")
+		}
+		layout.maxLineLength = *options.MaxLineLength
+	}
+	if options.MaxLines != nil {
+		if *options.MaxLines <= 0 {
+			return layout, fmt.Errorf("The maximum number of lines must be positive")
+		}
+		layout.maxLines = *options.MaxLines
+	}
+	if options.MaxCueDuration != nil {
+		if *options.MaxCueDuration <= 0 {
+			return layout, fmt.Errorf("The maximum cue duration must be positive")
+		}
+		layout.maxCueDuration = options.MaxCueDuration.Seconds()
+	}
+	if options.SpeakerLabels != nil {
+		layout.speakerLabels = *options.SpeakerLabels
+	}
+	if options.SplitAtEndOfUtterance != nil {
+		layout.splitAtEndOfUtterance = *options.SplitAtEndOfUtterance
+	}
+	return layout, nil
+}
+
+// SubtitleCues : Splits the final results of a recognition into subtitle cues. The results must have been requested
+// with timestamps. For an asynchronous job, pass the results of the job returned by CheckJob.
+func SubtitleCues(results *SpeechRecognitionResults, options *SubtitleOptions) ([]SubtitleCue, error) {
+	layout, err := newSubtitleLayout(options)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := NewSpeakerTranscriptBuilder()
+	if err := builder.AddResults(results); err != nil {
+		return nil, err
+	}
+	for _, result := range results.Results {
+		if result.Final != nil && *result.Final && len(result.Alternatives) > 0 &&
+			result.Alternatives[0].Transcript != nil && strings.TrimSpace(*result.Alternatives[0].Transcript) != "" &&
+			len(result.Alternatives[0].Timestamps) == 0 {
+			return nil, fmt.Errorf("Subtitles require the results to be requested with timestamps")
+		}
+	}
+
+	var cues []SubtitleCue
+	var cue *SubtitleCue
+	endOfUtterance := false
+	for _, word := range builder.speakerWords() {
+		if word.Word == HESITATION_MARKER {
+			endOfUtterance = endOfUtterance || word.endOfUtterance
+			continue
+		}
+		if !layout.speakerLabels {
+			word.speaker = UNKNOWN_SPEAKER
+		}
+
+		lines := []string{word.Word}
+		if cue != nil {
+			lines = layout.wrap(cue.Lines, word.Word)
+		}
+		if cue == nil || cue.Speaker != word.speaker ||
+			(endOfUtterance && layout.splitAtEndOfUtterance) ||
+			word.EndTime-cue.StartTime > layout.maxCueDuration ||
+			len(lines) > layout.maxLines {
+			cues = append(cues, SubtitleCue{StartTime: word.StartTime, Speaker: word.speaker})
+			cue = &cues[len(cues)-1]
+			lines = []string{word.Word}
+		}
+		cue.Lines = lines
+		cue.EndTime = math.Max(cue.EndTime, word.EndTime)
+		endOfUtterance = word.endOfUtterance
+	}
+	return cues, nil
+}
+
+// wrap returns the lines of a cue once a word is added to them
+func (layout subtitleLayout) wrap(lines []string, word string) []string {
+	last := lines[len(lines)-1]
+	if utf8.RuneCountInString(last)+1+utf8.RuneCountInString(word) <= layout.maxLineLength {
+		wrapped := append([]string{}, lines[:len(lines)-1]...)
+		return append(wrapped, last+" "+word)
+	}
+	return append(append([]string{}, lines...), word)
+}
+
+// WriteSRT : Writes the final results of a recognition as SubRip (SRT) subtitles
+func WriteSRT(writer io.Writer, results *SpeechRecognitionResults, options *SubtitleOptions) error {
+	cues, err := SubtitleCues(results, options)
+	if err != nil {
+		return err
+	}
+
+	buffer := bufio.NewWriter(writer)
+	for i, cue := range cues {
+		fmt.Fprintf(buffer, "%d\n%s --> %s\n", i+1, formatSubtitleTime(cue.StartTime, ','), formatSubtitleTime(cue.EndTime, ','))
+		lines := cue.Lines
+		if cue.Speaker != UNKNOWN_SPEAKER {
+			lines = append([]string{fmt.Sprintf("[Speaker %d] %s", cue.Speaker, lines[0])}, lines[1:]...)
+		}
+		fmt.Fprintf(buffer, "%s\n\n", strings.Join(lines, "\n"))
+	}
+	return buffer.Flush()
+}
+
+// WriteWebVTT : Writes the final results of a recognition as WebVTT subtitles. Speaker labels are written as voice
+// spans.
+func WriteWebVTT(writer io.Writer, results *SpeechRecognitionResults, options *SubtitleOptions) error {
+	cues, err := SubtitleCues(results, options)
+	if err != nil {
+		return err
+	}
+
+	buffer := bufio.NewWriter(writer)
+	buffer.WriteString("WEBVTT\n\n")
+	for _, cue := range cues {
+		fmt.Fprintf(buffer, "%s --> %s\n", formatSubtitleTime(cue.StartTime, '.'), formatSubtitleTime(cue.EndTime, '.'))
+		lines := make([]string, len(cue.Lines))
+		for i, line := range cue.Lines {
+			lines[i] = escapeWebVTT(line)
+		}
+		if cue.Speaker != UNKNOWN_SPEAKER {
+			lines[0] = fmt.Sprintf("<v Speaker %d>%s", cue.Speaker, lines[0])
+		}
+		fmt.Fprintf(buffer, "%s\n\n", strings.Join(lines, "\n"))
+	}
+	return buffer.Flush()
+}
+
+// formatSubtitleTime formats a time in seconds as hours, minutes, seconds and milliseconds. SRT separates the
+// milliseconds with a comma, WebVTT with a period.
+func formatSubtitleTime(seconds float64, separator rune) string {
+	milliseconds := int64(math.Round(seconds * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d",
+		milliseconds/3600000, milliseconds/60000%60, milliseconds/1000%60, separator, milliseconds%1000)
+}
+
+var webVTTEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// escapeWebVTT escapes the characters that WebVTT reserves for markup
+func escapeWebVTT(text string) string {
+	return webVTTEscaper.Replace(text)
+}
@@ -0,0 +1,112 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+const subtitleResultsJSON = `{
+	"result_index": 0,
+	"results": [
+		{"final": true, "end_of_utterance": "silence", "alternatives": [{"transcript": "hello there ",
+			"timestamps": [["hello", 0.5, 0.9], ["there", 0.9, 1.3]]}]},
+		{"final": true, "end_of_utterance": "end_of_data", "alternatives": [{"transcript": "how are you %HESITATION today ",
+			"timestamps": [["how", 2.0, 2.2], ["are", 2.2, 2.4], ["you", 2.4, 2.6], ["%HESITATION", 2.6, 3.0], ["today", 3.0, 3.5]]}]}
+	],
+	"speaker_labels": [
+		{"from": 0.5, "to": 0.9, "speaker": 0, "confidence": 0.5, "final": true},
+		{"from": 0.9, "to": 1.3, "speaker": 0, "confidence": 0.5, "final": true},
+		{"from": 2.0, "to": 2.2, "speaker": 1, "confidence": 0.5, "final": true},
+		{"from": 2.2, "to": 2.4, "speaker": 1, "confidence": 0.5, "final": true},
+		{"from": 2.4, "to": 2.6, "speaker": 1, "confidence": 0.5, "final": true},
+		{"from": 3.0, "to": 3.5, "speaker": 1, "confidence": 0.5, "final": true}
+	]
+}`
+
+func subtitleResults(results string) *speechtotextv1.SpeechRecognitionResults {
+	var recognitionResults speechtotextv1.SpeechRecognitionResults
+	Expect(json.Unmarshal([]byte(results), &recognitionResults)).To(Succeed())
+	return &recognitionResults
+}
+
+var _ = Describe(`Subtitles`, func() {
+	It(`Writes SRT cues split at the end of utterances`, func() {
+		var srt bytes.Buffer
+		err := speechtotextv1.WriteSRT(&srt, subtitleResults(subtitleResultsJSON), nil)
+		Expect(err).To(BeNil())
+		Expect(srt.String()).To(Equal("1\n00:00:00,500 --> 00:00:01,300\nhello there\n\n" +
+			"2\n00:00:02,000 --> 00:00:03,500\nhow are you today\n\n"))
+	})
+	It(`Writes WebVTT cues with speaker labels`, func() {
+		var vtt bytes.Buffer
+		options := speechtotextv1.NewSubtitleOptions().SetSpeakerLabels(true).SetSplitAtEndOfUtterance(false)
+		err := speechtotextv1.WriteWebVTT(&vtt, subtitleResults(subtitleResultsJSON), options)
+		Expect(err).To(BeNil())
+		Expect(vtt.String()).To(Equal("WEBVTT\n\n" +
+			"00:00:00.500 --> 00:00:01.300\n<v Speaker 0>hello there\n\n" +
+			"00:00:02.000 --> 00:00:03.500\n<v Speaker 1>how are you today\n\n"))
+	})
+	It(`Wraps lines and limits the size and duration of cues`, func() {
+		options := speechtotextv1.NewSubtitleOptions().
+			SetMaxLineLength(9).
+			SetMaxLines(2)
+		cues, err := speechtotextv1.SubtitleCues(subtitleResults(subtitleResultsJSON), options)
+		Expect(err).To(BeNil())
+		Expect(cues).To(HaveLen(2))
+		Expect(cues[0].Lines).To(Equal([]string{"hello", "there"}))
+		Expect(cues[1].Lines).To(Equal([]string{"how are", "you today"}))
+		Expect(cues[1].Speaker).To(Equal(int64(speechtotextv1.UNKNOWN_SPEAKER)))
+
+		options.SetMaxLines(1)
+		cues, err = speechtotextv1.SubtitleCues(subtitleResults(subtitleResultsJSON), options)
+		Expect(err).To(BeNil())
+		Expect(cues).To(HaveLen(4))
+		Expect(cues[3].Lines).To(Equal([]string{"you today"}))
+
+		options.SetMaxLineLength(80).SetMaxCueDuration(time.Second)
+		cues, err = speechtotextv1.SubtitleCues(subtitleResults(subtitleResultsJSON), options)
+		Expect(err).To(BeNil())
+		Expect(cues).To(HaveLen(3))
+		Expect(cues[0].Lines).To(Equal([]string{"hello there"}))
+		Expect(cues[1].Lines).To(Equal([]string{"how are you"}))
+		Expect(cues[1].EndTime).To(Equal(2.6))
+		Expect(cues[2].Lines).To(Equal([]string{"today"}))
+	})
+	It(`Formats long times and escapes WebVTT markup`, func() {
+		results := subtitleResults(`{"results": [{"final": true, "alternatives": [{"transcript": "a<b ", "timestamps": [["a<b", 3723.4, 3724.0]]}]}]}`)
+		var srt, vtt bytes.Buffer
+		Expect(speechtotextv1.WriteSRT(&srt, results, nil)).To(Succeed())
+		Expect(srt.String()).To(Equal("1\n01:02:03,400 --> 01:02:04,000\na<b\n\n"))
+		Expect(speechtotextv1.WriteWebVTT(&vtt, results, nil)).To(Succeed())
+		Expect(vtt.String()).To(Equal("WEBVTT\n\n01:02:03.400 --> 01:02:04.000\na&lt;b\n\n"))
+	})
+	It(`Returns an error for results without timestamps or invalid options`, func() {
+		results := subtitleResults(`{"results": [{"final": true, "alternatives": [{"transcript": "hello "}]}]}`)
+		_, err := speechtotextv1.SubtitleCues(results, nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = speechtotextv1.SubtitleCues(subtitleResults(subtitleResultsJSON), speechtotextv1.NewSubtitleOptions().SetMaxLines(0))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -1,7 +1,10 @@
 package speechtotextv1
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,11 +13,19 @@ import (
 
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/gorilla/websocket"
+	common "github.com/watson-developer-cloud/go-sdk/common"
 )
 
+// errConnectionNotOpen is reported when the listener is given no websocket connection
+var errConnectionNotOpen = errors.New("The websocket connection is not open")
+
 type RecognizeListener struct {
 	IsClosed chan bool
 	Callback RecognizeCallbackWrapper
+
+	// The context of the recognition, and a channel closed once no more data is read from the connection
+	ctx  context.Context
+	done chan struct{}
 }
 
 /*
@@ -22,6 +33,10 @@ type RecognizeListener struct {
 */
 func (wsHandle RecognizeListener) OnOpen(recognizeOpt *RecognizeUsingWebsocketOptions, conn *websocket.Conn) {
 	wsHandle.Callback.OnOpen()
+	if conn == nil {
+		wsHandle.OnError(errConnectionNotOpen)
+		return
+	}
 	sendStartMessage(conn, recognizeOpt, &wsHandle)
 }
 
@@ -37,11 +52,25 @@ func (wsHandle RecognizeListener) OnClose() {
 	OnData: Callback when websocket connection receives data
 */
 func (wsHandle RecognizeListener) OnData(conn *websocket.Conn, recognizeOptions *RecognizeUsingWebsocketOptions) {
+	defer func() {
+		if wsHandle.done != nil {
+			close(wsHandle.done)
+		}
+		wsHandle.IsClosed <- true
+	}()
+	if conn == nil {
+		wsHandle.OnError(errConnectionNotOpen)
+		return
+	}
+
 	isListening := false
 	for {
 		var websocketResponse WebsocketRecognitionResults
 		_, result, err := conn.ReadMessage()
 		if err != nil {
+			if wsHandle.ctx != nil && wsHandle.ctx.Err() != nil {
+				err = wsHandle.ctx.Err()
+			}
 			wsHandle.OnError(err)
 			break
 		}
@@ -51,6 +80,11 @@ func (wsHandle RecognizeListener) OnData(conn *websocket.Conn, recognizeOptions
 			break
 		}
 
+		if websocketResponse.State != "" {
+			if stateCallback, ok := wsHandle.Callback.(recognizeStateCallback); ok {
+				stateCallback.onState(websocketResponse.State)
+			}
+		}
 		if websocketResponse.State == "listening" {
 			if !isListening {
 				isListening = true
@@ -65,7 +99,6 @@ func (wsHandle RecognizeListener) OnData(conn *websocket.Conn, recognizeOptions
 		wsHandle.Callback.OnData(&detailResp)
 	}
 	conn.Close()
-	wsHandle.IsClosed <- true
 }
 
 /*
@@ -75,6 +108,38 @@ func (wsHandle RecognizeListener) OnError(err error) {
 	wsHandle.Callback.OnError(err)
 }
 
+/*
+	wait: Waits for the given duration, unless the connection is no longer read first, in which case it returns false
+*/
+func (wsHandle RecognizeListener) wait(duration time.Duration) bool {
+	if duration <= 0 {
+		return !wsHandle.isDone()
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-wsHandle.done:
+		return false
+	}
+}
+
+/*
+	isDone: Reports whether the connection is no longer read, in which case sending more audio is pointless
+*/
+func (wsHandle RecognizeListener) isDone() bool {
+	if wsHandle.done == nil {
+		return false
+	}
+	select {
+	case <-wsHandle.done:
+		return true
+	default:
+		return false
+	}
+}
+
 /*
 	sendStartMessage : Sends start message to server
 */
@@ -101,34 +166,95 @@ func sendCloseMessage(conn *websocket.Conn) {
 	sendAudio : Sends audio data to the server
 */
 func sendAudio(conn *websocket.Conn, recognizeOptions *RecognizeUsingWebsocketOptions, recognizeListener *RecognizeListener) {
-	chunk := make([]byte, ONE_KB*2)
-	for {
-		bytesRead, err := (recognizeOptions.Audio).Read(chunk)
+	if conn == nil {
+		return
+	}
+
+	pacer := newAudioPacer(recognizeOptions)
+	bufferSize := pacer.chunkSize
+	if bufferSize < WAV_HEADER_SIZE {
+		bufferSize = WAV_HEADER_SIZE
+	}
+	audio := bufio.NewReaderSize(recognizeOptions.Audio, bufferSize)
+	if pacer.needsHeader() {
+		contentType := ""
+		if recognizeOptions.ContentType != nil {
+			contentType = *recognizeOptions.ContentType
+		}
+		header, _ := audio.Peek(WAV_HEADER_SIZE)
+		if err := pacer.setContentType(contentType, header); err != nil {
+			recognizeListener.OnError(err)
+			sendCloseMessage(conn)
+			return
+		}
+	}
+
+	chunk := make([]byte, pacer.chunkSize)
+	for !recognizeListener.isDone() {
+		bytesRead, err := io.ReadFull(audio, chunk)
+		if bytesRead > 0 {
+			if writeErr := conn.WriteMessage(websocket.BinaryMessage, chunk[:bytesRead]); writeErr != nil {
+				if !recognizeListener.isDone() {
+					recognizeListener.OnError(writeErr)
+				}
+				return
+			}
+		}
 		if err != nil {
-			if err == io.EOF {
-				break
-			} else {
+			if err != io.EOF && err != io.ErrUnexpectedEOF {
 				recognizeListener.OnError(err)
 			}
+			break
 		}
-		err = conn.WriteMessage(websocket.BinaryMessage, chunk[:bytesRead])
-		if err != nil {
-			recognizeListener.OnError(err)
+		if !recognizeListener.wait(pacer.delay(bytesRead)) {
+			return
 		}
-		time.Sleep(TEN_MILLISECONDS)
 	}
 	sendCloseMessage(conn)
 }
 
 /*
-	NewRecognizeListener : Instantiates a listener instance to control the sending/receiving of audio/text
+	NewRecognizeListener : Instantiates a listener instance to control the sending/receiving of audio/text. The call
+	returns once the recognition is over, or with an error if the connection could not be opened.
+*/
+func (speechToText *SpeechToTextV1) NewRecognizeListener(callback RecognizeCallbackWrapper, recognizeWSOptions *RecognizeUsingWebsocketOptions, dialURL string, param url.Values, headers http.Header) error {
+	return speechToText.newRecognizeListener(context.Background(), callback, recognizeWSOptions, dialURL, param, headers)
+}
+
+/*
+	newRecognizeListener : Runs a recognition over a new websocket connection, which is closed when ctx is done
 */
-func (speechToText *SpeechToTextV1) NewRecognizeListener(callback RecognizeCallbackWrapper, recognizeWSOptions *RecognizeUsingWebsocketOptions, dialURL string, param url.Values, headers http.Header) {
-	recognizeListener := RecognizeListener{Callback: callback, IsClosed: make(chan bool, 1)}
-	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s%s?%s", dialURL, RECOGNIZE_ENDPOINT, param.Encode()), headers)
+func (speechToText *SpeechToTextV1) newRecognizeListener(ctx context.Context, callback RecognizeCallbackWrapper, recognizeWSOptions *RecognizeUsingWebsocketOptions, dialURL string, param url.Values, headers http.Header) error {
+	conn, err := speechToText.dialRecognize(ctx, dialURL, param, headers)
 	if err != nil {
-		recognizeListener.OnError(err)
+		return err
 	}
+	runRecognizeListener(ctx, conn, callback, recognizeWSOptions)
+	return nil
+}
+
+/*
+	dialRecognize : Opens the websocket connection of a recognition
+*/
+func (speechToText *SpeechToTextV1) dialRecognize(ctx context.Context, dialURL string, param url.Values, headers http.Header) (*websocket.Conn, error) {
+	conn, _, err := common.DialWebsocket(ctx, speechToText.Service, fmt.Sprintf("%s%s?%s", dialURL, RECOGNIZE_ENDPOINT, param.Encode()), headers)
+	return conn, err
+}
+
+/*
+	runRecognizeListener : Sends the audio of the options over conn and delivers the results to the callback, until
+	the service sends the final results or ctx is done
+*/
+func runRecognizeListener(ctx context.Context, conn *websocket.Conn, callback RecognizeCallbackWrapper, recognizeWSOptions *RecognizeUsingWebsocketOptions) {
+	recognizeListener := RecognizeListener{Callback: callback, IsClosed: make(chan bool, 1), ctx: ctx, done: make(chan struct{})}
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-recognizeListener.done:
+		}
+	}()
+
 	recognizeListener.OnOpen(recognizeWSOptions, conn)
 	go recognizeListener.OnData(conn, recognizeWSOptions)
 	go sendAudio(conn, recognizeWSOptions, &recognizeListener)
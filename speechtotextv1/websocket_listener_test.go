@@ -0,0 +1,233 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/core"
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/common"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+// recordingCallback collects what a recognition delivers to its callback
+type recordingCallback struct {
+	lock    sync.Mutex
+	opened  bool
+	closed  bool
+	results []string
+	errors  []error
+}
+
+func (callback *recordingCallback) OnOpen() {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	callback.opened = true
+}
+
+func (callback *recordingCallback) OnClose() {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	callback.closed = true
+}
+
+func (callback *recordingCallback) OnData(resp *core.DetailedResponse) {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	callback.results = append(callback.results, string(resp.GetResult().([]byte)))
+}
+
+func (callback *recordingCallback) OnError(err error) {
+	callback.lock.Lock()
+	defer callback.lock.Unlock()
+	callback.errors = append(callback.errors, err)
+}
+
+// recognitionServer plays the service side of a websocket recognition. Once the audio of an utterance is stopped,
+// it answers with the messages returned by respond.
+func recognitionServer(respond func(audio []byte) []string) *httptest.Server {
+	return chunkedRecognitionServer(func(chunks [][]byte) []string {
+		return respond(bytes.Join(chunks, nil))
+	})
+}
+
+// chunkedRecognitionServer is a recognitionServer that passes the audio of an utterance as the chunks it received
+func chunkedRecognitionServer(respond func(chunks [][]byte) []string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		Expect(req.URL.Path).To(Equal("/v1/recognize"))
+		conn, err := upgrader.Upgrade(res, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var chunks [][]byte
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if messageType == websocket.BinaryMessage {
+				chunks = append(chunks, message)
+				continue
+			}
+
+			var action struct {
+				Action string `json:"action"`
+			}
+			Expect(json.Unmarshal(message, &action)).To(Succeed())
+			switch action.Action {
+			case "start":
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))
+			case "stop":
+				for _, response := range respond(chunks) {
+					_ = conn.WriteMessage(websocket.TextMessage, []byte(response))
+				}
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"state": "listening"}`))
+				chunks = nil
+			}
+		}
+	}))
+}
+
+func newWebsocketTestService(url string) *speechtotextv1.SpeechToTextV1 {
+	testService, err := speechtotextv1.NewSpeechToTextV1(&speechtotextv1.SpeechToTextV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Expect(err).To(BeNil())
+	return testService
+}
+
+func newWebsocketTestOptions(testService *speechtotextv1.SpeechToTextV1, audio string) *speechtotextv1.RecognizeUsingWebsocketOptions {
+	return testService.NewRecognizeUsingWebsocketOptions(ioutil.NopCloser(strings.NewReader(audio)), "audio/l16; rate=16000")
+}
+
+var _ = Describe(`RecognizeUsingWebsocket(recognizeWSOptions *RecognizeUsingWebsocketOptions, callback RecognizeCallbackWrapper)`, func() {
+	It(`Returns the results through the callback`, func() {
+		testServer := recognitionServer(func(audio []byte) []string {
+			Expect(string(audio)).To(Equal("some audio"))
+			return []string{`{"results": [{"final": true, "alternatives": [{"transcript": "some audio"}]}], "result_index": 0}`}
+		})
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		callback := new(recordingCallback)
+		err := testService.RecognizeUsingWebsocket(newWebsocketTestOptions(testService, "some audio"), callback)
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(BeEmpty())
+		Expect(callback.opened).To(BeTrue())
+		Expect(callback.closed).To(BeTrue())
+		Expect(callback.results).To(HaveLen(1))
+		Expect(callback.results[0]).To(ContainSubstring("some audio"))
+	})
+	It(`Returns setup errors instead of panicking`, func() {
+		testService := newWebsocketTestService("http://localhost")
+		callback := new(recordingCallback)
+
+		err := testService.RecognizeUsingWebsocket(nil, callback)
+		Expect(err).ToNot(BeNil())
+
+		err = testService.RecognizeUsingWebsocket(new(speechtotextv1.RecognizeUsingWebsocketOptions), callback)
+		Expect(err).ToNot(BeNil())
+
+		err = testService.RecognizeUsingWebsocket(newWebsocketTestOptions(testService, "some audio"), nil)
+		Expect(err).ToNot(BeNil())
+		Expect(callback.opened).To(BeFalse())
+	})
+	It(`Returns a ServiceError when the handshake is rejected`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-Type", "application/json")
+			res.Header().Set("X-Global-Transaction-Id", "abc")
+			res.WriteHeader(http.StatusUnauthorized)
+			_, _ = res.Write([]byte(`{"error": "Unauthorized", "code": 401}`))
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		callback := new(recordingCallback)
+		err := testService.RecognizeUsingWebsocket(newWebsocketTestOptions(testService, "some audio"), callback)
+		Expect(common.IsUnauthorized(err)).To(BeTrue())
+		serviceError, _ := common.GetServiceError(err)
+		Expect(serviceError.Description).To(Equal("Unauthorized"))
+		Expect(serviceError.TransactionID).To(Equal("abc"))
+		Expect(callback.opened).To(BeFalse())
+	})
+	It(`Reports a dropped connection through the callback`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err == nil {
+				conn.Close()
+			}
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		callback := new(recordingCallback)
+		err := testService.RecognizeUsingWebsocket(newWebsocketTestOptions(testService, "some audio"), callback)
+		Expect(err).To(BeNil())
+		Expect(callback.errors).ToNot(BeEmpty())
+		Expect(callback.closed).To(BeTrue())
+	})
+	It(`Stops when the context is cancelled`, func() {
+		upgrader := websocket.Upgrader{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(res, req, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}))
+		defer testServer.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		testService := newWebsocketTestService(testServer.URL)
+		callback := &cancellingCallback{cancel: cancel}
+		err := testService.RecognizeUsingWebsocketWithContext(ctx, newWebsocketTestOptions(testService, "some audio"), callback)
+		Expect(err).To(BeNil())
+		Expect(callback.errors).To(ContainElement(context.Canceled))
+		Expect(callback.closed).To(BeTrue())
+	})
+})
+
+// cancellingCallback cancels the recognition as soon as the connection is open
+type cancellingCallback struct {
+	recordingCallback
+	cancel context.CancelFunc
+}
+
+func (callback *cancellingCallback) OnOpen() {
+	callback.recordingCallback.OnOpen()
+	callback.cancel()
+}
@@ -0,0 +1,99 @@
+package speechtotextv1
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// WordTimestamp : The time alignment of a word of a transcript
+type WordTimestamp struct {
+	Word string
+
+	// The start time of the word, in seconds from the start of the audio.
+	StartTime float64
+
+	// The end time of the word, in seconds from the start of the audio.
+	EndTime float64
+}
+
+// WordConfidence : The confidence score of a word of a transcript
+type WordConfidence struct {
+	Word string
+
+	// A score in the range of 0.0 to 1.0.
+	Confidence float64
+}
+
+// WordTimestamps : Returns the Timestamps of the alternative as typed values. An error is returned if an element is
+// not a list of a word followed by its start and end times.
+func (alternative *SpeechRecognitionAlternative) WordTimestamps() ([]WordTimestamp, error) {
+	var timestamps []WordTimestamp
+	for i, element := range alternative.Timestamps {
+		values, ok := element.([]interface{})
+		if !ok || len(values) != 3 {
+			return nil, fmt.Errorf("timestamps[%d]: expected [word, start_time, end_time], got %v", i, element)
+		}
+		word, ok := values[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("timestamps[%d]: the word %v is not a string", i, values[0])
+		}
+		startTime, err := toFloat64(values[1])
+		if err != nil {
+			return nil, fmt.Errorf("timestamps[%d]: the start time %s", i, err.Error())
+		}
+		endTime, err := toFloat64(values[2])
+		if err != nil {
+			return nil, fmt.Errorf("timestamps[%d]: the end time %s", i, err.Error())
+		}
+		timestamps = append(timestamps, WordTimestamp{Word: word, StartTime: startTime, EndTime: endTime})
+	}
+	return timestamps, nil
+}
+
+// WordConfidences : Returns the WordConfidence of the alternative as typed values. An error is returned if an element
+// is not a list of a word followed by its confidence score.
+func (alternative *SpeechRecognitionAlternative) WordConfidences() ([]WordConfidence, error) {
+	var confidences []WordConfidence
+	for i, element := range alternative.WordConfidence {
+		values, ok := element.([]interface{})
+		if !ok || len(values) != 2 {
+			return nil, fmt.Errorf("word_confidence[%d]: expected [word, confidence], got %v", i, element)
+		}
+		word, ok := values[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("word_confidence[%d]: the word %v is not a string", i, values[0])
+		}
+		confidence, err := toFloat64(values[1])
+		if err != nil {
+			return nil, fmt.Errorf("word_confidence[%d]: the confidence %s", i, err.Error())
+		}
+		confidences = append(confidences, WordConfidence{Word: word, Confidence: confidence})
+	}
+	return confidences, nil
+}
+
+// setWordTimestamps replaces the Timestamps of the alternative, in the format sent by the service
+func (alternative *SpeechRecognitionAlternative) setWordTimestamps(timestamps []WordTimestamp) {
+	alternative.Timestamps = make([]interface{}, len(timestamps))
+	for i, timestamp := range timestamps {
+		alternative.Timestamps[i] = []interface{}{timestamp.Word, timestamp.StartTime, timestamp.EndTime}
+	}
+}
+
+// toFloat64 converts a number decoded from JSON, whether or not the decoder used json.Number
+func toFloat64(value interface{}) (float64, error) {
+	switch number := value.(type) {
+	case float64:
+		return number, nil
+	case float32:
+		return float64(number), nil
+	case int:
+		return float64(number), nil
+	case int64:
+		return float64(number), nil
+	case json.Number:
+		return number.Float64()
+	default:
+		return 0, fmt.Errorf("%v is not a number", value)
+	}
+}
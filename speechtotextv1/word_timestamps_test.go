@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package speechtotextv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/watson-developer-cloud/go-sdk/speechtotextv1"
+)
+
+const alternativeJSON = `{"transcript": "hello world", "confidence": 0.9, "timestamps": [["hello", 0.0, 0.5], ["world", 0.5, 1.25]], "word_confidence": [["hello", 0.95], ["world", 0.866]]}`
+
+var expectedWordTimestamps = []speechtotextv1.WordTimestamp{
+	{Word: "hello", StartTime: 0.0, EndTime: 0.5},
+	{Word: "world", StartTime: 0.5, EndTime: 1.25},
+}
+
+var expectedWordConfidences = []speechtotextv1.WordConfidence{
+	{Word: "hello", Confidence: 0.95},
+	{Word: "world", Confidence: 0.866},
+}
+
+func expectTypedWords(alternative speechtotextv1.SpeechRecognitionAlternative) {
+	timestamps, err := alternative.WordTimestamps()
+	Expect(err).To(BeNil())
+	Expect(timestamps).To(Equal(expectedWordTimestamps))
+
+	confidences, err := alternative.WordConfidences()
+	Expect(err).To(BeNil())
+	Expect(confidences).To(Equal(expectedWordConfidences))
+}
+
+var _ = Describe(`SpeechRecognitionAlternative typed words`, func() {
+	It(`Reads the words of a recognition`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"results": [{"final": true, "alternatives": [%s]}], "result_index": 0}`, alternativeJSON)
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		result, _, err := testService.Recognize(testService.NewRecognizeOptions(ioutil.NopCloser(strings.NewReader("audio"))))
+		Expect(err).To(BeNil())
+		expectTypedWords(result.Results[0].Alternatives[0])
+	})
+	It(`Reads the words of an asynchronous job`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "job", "status": "completed", "created": "2020-01-01T00:00:00Z", "results": [{"results": [{"final": true, "alternatives": [%s]}], "result_index": 0}]}`, alternativeJSON)
+		}))
+		defer testServer.Close()
+
+		testService := newWebsocketTestService(testServer.URL)
+		job, _, err := testService.CheckJob(testService.NewCheckJobOptions("job"))
+		Expect(err).To(BeNil())
+		expectTypedWords(job.Results[0].Results[0].Alternatives[0])
+	})
+	It(`Reads the words of a websocket event`, func() {
+		events, err := speechtotextv1.ParseRecognitionEvents([]byte(fmt.Sprintf(`{"results": [{"final": true, "alternatives": [%s]}], "result_index": 0}`, alternativeJSON)))
+		Expect(err).To(BeNil())
+		expectTypedWords(events[0].(*speechtotextv1.FinalResultEvent).Result.Alternatives[0])
+	})
+	It(`Reports malformed words`, func() {
+		var alternative speechtotextv1.SpeechRecognitionAlternative
+		Expect(json.Unmarshal([]byte(`{"transcript": "hello", "timestamps": [["hello", 0.0]], "word_confidence": [[0.5, "hello"]]}`), &alternative)).To(Succeed())
+
+		_, err := alternative.WordTimestamps()
+		Expect(err).To(MatchError(ContainSubstring("timestamps[0]")))
+		_, err = alternative.WordConfidences()
+		Expect(err).To(MatchError(ContainSubstring("word_confidence[0]")))
+
+		alternative.Timestamps = []interface{}{[]interface{}{"hello", json.Number("0.5"), "end"}}
+		_, err = alternative.WordTimestamps()
+		Expect(err).To(MatchError(ContainSubstring("end time")))
+
+		empty := speechtotextv1.SpeechRecognitionAlternative{}
+		timestamps, err := empty.WordTimestamps()
+		Expect(err).To(BeNil())
+		Expect(timestamps).To(BeEmpty())
+	})
+})
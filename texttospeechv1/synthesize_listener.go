@@ -25,6 +25,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/gorilla/websocket"
+	common "github.com/watson-developer-cloud/go-sdk/common"
 )
 
 const (
@@ -128,7 +129,7 @@ func (listener SynthesizeListener) OnData(conn *websocket.Conn) {
 
 func (textToSpeechV1 *TextToSpeechV1) NewSynthesizeListener(callback SynthesizeCallbackWrapper, req *http.Request) {
 	synthesizeListener := SynthesizeListener{Callback: callback, IsClosed: make(chan bool, 1)}
-	conn, _, err := websocket.DefaultDialer.Dial(req.URL.String(), req.Header)
+	conn, _, err := common.DialWebsocket(req.Context(), textToSpeechV1.Service, req.URL.String(), req.Header)
 	if err != nil {
 		synthesizeListener.OnError(err)
 	}
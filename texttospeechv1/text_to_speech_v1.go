@@ -18,8 +18,10 @@
 package texttospeechv1
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 
 	"github.com/IBM/go-sdk-core/core"
 	common "github.com/watson-developer-cloud/go-sdk/common"
@@ -55,9 +57,12 @@ const DefaultServiceName = "text_to_speech"
 
 // TextToSpeechV1Options : Service options
 type TextToSpeechV1Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewTextToSpeechV1 : constructs an instance of TextToSpeechV1 with passed in options.
@@ -95,6 +100,16 @@ func NewTextToSpeechV1(options *TextToSpeechV1Options) (service *TextToSpeechV1,
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &TextToSpeechV1{
 		Service: baseService,
 	}
@@ -109,7 +124,27 @@ func (textToSpeech *TextToSpeechV1) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (textToSpeech *TextToSpeechV1) DisableSSLVerification() {
-	textToSpeech.Service.DisableSSLVerification()
+	common.DisableSSLVerification(textToSpeech.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (textToSpeech *TextToSpeechV1) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(textToSpeech.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (textToSpeech *TextToSpeechV1) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(textToSpeech.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (textToSpeech *TextToSpeechV1) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(textToSpeech.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (textToSpeech *TextToSpeechV1) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(textToSpeech.Service, interceptor)
 }
 
 // ListVoices : List voices
@@ -120,6 +155,11 @@ func (textToSpeech *TextToSpeechV1) DisableSSLVerification() {
 // **See also:** [Listing all available
 // voices](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-voices#listVoices).
 func (textToSpeech *TextToSpeechV1) ListVoices(listVoicesOptions *ListVoicesOptions) (result *Voices, response *core.DetailedResponse, err error) {
+	return textToSpeech.ListVoicesWithContext(context.Background(), listVoicesOptions)
+}
+
+// ListVoicesWithContext is an alternate form of the ListVoices method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) ListVoicesWithContext(ctx context.Context, listVoicesOptions *ListVoicesOptions) (result *Voices, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listVoicesOptions, "listVoicesOptions")
 	if err != nil {
 		return
@@ -150,7 +190,10 @@ func (textToSpeech *TextToSpeechV1) ListVoices(listVoicesOptions *ListVoicesOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(Voices))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Voices)
@@ -170,6 +213,11 @@ func (textToSpeech *TextToSpeechV1) ListVoices(listVoicesOptions *ListVoicesOpti
 // **See also:** [Listing a specific
 // voice](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-voices#listVoice).
 func (textToSpeech *TextToSpeechV1) GetVoice(getVoiceOptions *GetVoiceOptions) (result *Voice, response *core.DetailedResponse, err error) {
+	return textToSpeech.GetVoiceWithContext(context.Background(), getVoiceOptions)
+}
+
+// GetVoiceWithContext is an alternate form of the GetVoice method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) GetVoiceWithContext(ctx context.Context, getVoiceOptions *GetVoiceOptions) (result *Voice, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getVoiceOptions, "getVoiceOptions cannot be nil")
 	if err != nil {
 		return
@@ -208,7 +256,10 @@ func (textToSpeech *TextToSpeechV1) GetVoice(getVoiceOptions *GetVoiceOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(Voice))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Voice)
@@ -274,6 +325,11 @@ func (textToSpeech *TextToSpeechV1) GetVoice(getVoiceOptions *GetVoiceOptions) (
 // strings. For example, a message such as `"Unknown arguments:"` or `"Unknown url query arguments:"` followed by a list
 // of the form `"{invalid_arg_1}, {invalid_arg_2}."` The request succeeds despite the warnings.
 func (textToSpeech *TextToSpeechV1) Synthesize(synthesizeOptions *SynthesizeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return textToSpeech.SynthesizeWithContext(context.Background(), synthesizeOptions)
+}
+
+// SynthesizeWithContext is an alternate form of the Synthesize method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) SynthesizeWithContext(ctx context.Context, synthesizeOptions *SynthesizeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(synthesizeOptions, "synthesizeOptions cannot be nil")
 	if err != nil {
 		return
@@ -328,7 +384,10 @@ func (textToSpeech *TextToSpeechV1) Synthesize(synthesizeOptions *SynthesizeOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(io.ReadCloser))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -350,6 +409,11 @@ func (textToSpeech *TextToSpeechV1) Synthesize(synthesizeOptions *SynthesizeOpti
 // **See also:** [Querying a word from a
 // language](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customWords#cuWordsQueryLanguage).
 func (textToSpeech *TextToSpeechV1) GetPronunciation(getPronunciationOptions *GetPronunciationOptions) (result *Pronunciation, response *core.DetailedResponse, err error) {
+	return textToSpeech.GetPronunciationWithContext(context.Background(), getPronunciationOptions)
+}
+
+// GetPronunciationWithContext is an alternate form of the GetPronunciation method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) GetPronunciationWithContext(ctx context.Context, getPronunciationOptions *GetPronunciationOptions) (result *Pronunciation, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getPronunciationOptions, "getPronunciationOptions cannot be nil")
 	if err != nil {
 		return
@@ -395,7 +459,10 @@ func (textToSpeech *TextToSpeechV1) GetPronunciation(getPronunciationOptions *Ge
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(Pronunciation))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Pronunciation)
@@ -417,6 +484,11 @@ func (textToSpeech *TextToSpeechV1) GetPronunciation(getPronunciationOptions *Ge
 // **See also:** [Creating a custom
 // model](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customModels#cuModelsCreate).
 func (textToSpeech *TextToSpeechV1) CreateVoiceModel(createVoiceModelOptions *CreateVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
+	return textToSpeech.CreateVoiceModelWithContext(context.Background(), createVoiceModelOptions)
+}
+
+// CreateVoiceModelWithContext is an alternate form of the CreateVoiceModel method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) CreateVoiceModelWithContext(ctx context.Context, createVoiceModelOptions *CreateVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createVoiceModelOptions, "createVoiceModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -467,7 +539,10 @@ func (textToSpeech *TextToSpeechV1) CreateVoiceModel(createVoiceModelOptions *Cr
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(VoiceModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*VoiceModel)
@@ -490,6 +565,11 @@ func (textToSpeech *TextToSpeechV1) CreateVoiceModel(createVoiceModelOptions *Cr
 // **See also:** [Querying all custom
 // models](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customModels#cuModelsQueryAll).
 func (textToSpeech *TextToSpeechV1) ListVoiceModels(listVoiceModelsOptions *ListVoiceModelsOptions) (result *VoiceModels, response *core.DetailedResponse, err error) {
+	return textToSpeech.ListVoiceModelsWithContext(context.Background(), listVoiceModelsOptions)
+}
+
+// ListVoiceModelsWithContext is an alternate form of the ListVoiceModels method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) ListVoiceModelsWithContext(ctx context.Context, listVoiceModelsOptions *ListVoiceModelsOptions) (result *VoiceModels, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listVoiceModelsOptions, "listVoiceModelsOptions")
 	if err != nil {
 		return
@@ -524,7 +604,10 @@ func (textToSpeech *TextToSpeechV1) ListVoiceModels(listVoiceModelsOptions *List
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(VoiceModels))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*VoiceModels)
@@ -562,6 +645,11 @@ func (textToSpeech *TextToSpeechV1) ListVoiceModels(listVoiceModelsOptions *List
 // * [Understanding
 // customization](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customIntro#customIntro).
 func (textToSpeech *TextToSpeechV1) UpdateVoiceModel(updateVoiceModelOptions *UpdateVoiceModelOptions) (response *core.DetailedResponse, err error) {
+	return textToSpeech.UpdateVoiceModelWithContext(context.Background(), updateVoiceModelOptions)
+}
+
+// UpdateVoiceModelWithContext is an alternate form of the UpdateVoiceModel method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) UpdateVoiceModelWithContext(ctx context.Context, updateVoiceModelOptions *UpdateVoiceModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateVoiceModelOptions, "updateVoiceModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -612,7 +700,10 @@ func (textToSpeech *TextToSpeechV1) UpdateVoiceModel(updateVoiceModelOptions *Up
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -627,6 +718,11 @@ func (textToSpeech *TextToSpeechV1) UpdateVoiceModel(updateVoiceModelOptions *Up
 // **See also:** [Querying a custom
 // model](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customModels#cuModelsQuery).
 func (textToSpeech *TextToSpeechV1) GetVoiceModel(getVoiceModelOptions *GetVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
+	return textToSpeech.GetVoiceModelWithContext(context.Background(), getVoiceModelOptions)
+}
+
+// GetVoiceModelWithContext is an alternate form of the GetVoiceModel method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) GetVoiceModelWithContext(ctx context.Context, getVoiceModelOptions *GetVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getVoiceModelOptions, "getVoiceModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -661,7 +757,10 @@ func (textToSpeech *TextToSpeechV1) GetVoiceModel(getVoiceModelOptions *GetVoice
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(VoiceModel))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*VoiceModel)
@@ -682,6 +781,11 @@ func (textToSpeech *TextToSpeechV1) GetVoiceModel(getVoiceModelOptions *GetVoice
 // **See also:** [Deleting a custom
 // model](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customModels#cuModelsDelete).
 func (textToSpeech *TextToSpeechV1) DeleteVoiceModel(deleteVoiceModelOptions *DeleteVoiceModelOptions) (response *core.DetailedResponse, err error) {
+	return textToSpeech.DeleteVoiceModelWithContext(context.Background(), deleteVoiceModelOptions)
+}
+
+// DeleteVoiceModelWithContext is an alternate form of the DeleteVoiceModel method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) DeleteVoiceModelWithContext(ctx context.Context, deleteVoiceModelOptions *DeleteVoiceModelOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteVoiceModelOptions, "deleteVoiceModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -714,7 +818,10 @@ func (textToSpeech *TextToSpeechV1) DeleteVoiceModel(deleteVoiceModelOptions *De
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -745,6 +852,11 @@ func (textToSpeech *TextToSpeechV1) DeleteVoiceModel(deleteVoiceModelOptions *De
 // * [Understanding
 // customization](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customIntro#customIntro).
 func (textToSpeech *TextToSpeechV1) AddWords(addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
+	return textToSpeech.AddWordsWithContext(context.Background(), addWordsOptions)
+}
+
+// AddWordsWithContext is an alternate form of the AddWords method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) AddWordsWithContext(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addWordsOptions, "addWordsOptions cannot be nil")
 	if err != nil {
 		return
@@ -789,7 +901,10 @@ func (textToSpeech *TextToSpeechV1) AddWords(addWordsOptions *AddWordsOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -804,6 +919,11 @@ func (textToSpeech *TextToSpeechV1) AddWords(addWordsOptions *AddWordsOptions) (
 // **See also:** [Querying all words from a custom
 // model](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customWords#cuWordsQueryModel).
 func (textToSpeech *TextToSpeechV1) ListWords(listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
+	return textToSpeech.ListWordsWithContext(context.Background(), listWordsOptions)
+}
+
+// ListWordsWithContext is an alternate form of the ListWords method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) ListWordsWithContext(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listWordsOptions, "listWordsOptions cannot be nil")
 	if err != nil {
 		return
@@ -838,7 +958,10 @@ func (textToSpeech *TextToSpeechV1) ListWords(listWordsOptions *ListWordsOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(Words))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Words)
@@ -875,6 +998,11 @@ func (textToSpeech *TextToSpeechV1) ListWords(listWordsOptions *ListWordsOptions
 // * [Understanding
 // customization](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customIntro#customIntro).
 func (textToSpeech *TextToSpeechV1) AddWord(addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
+	return textToSpeech.AddWordWithContext(context.Background(), addWordOptions)
+}
+
+// AddWordWithContext is an alternate form of the AddWord method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) AddWordWithContext(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addWordOptions, "addWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -921,7 +1049,10 @@ func (textToSpeech *TextToSpeechV1) AddWord(addWordOptions *AddWordOptions) (res
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -935,6 +1066,11 @@ func (textToSpeech *TextToSpeechV1) AddWord(addWordOptions *AddWordOptions) (res
 // **See also:** [Querying a single word from a custom
 // model](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customWords#cuWordQueryModel).
 func (textToSpeech *TextToSpeechV1) GetWord(getWordOptions *GetWordOptions) (result *Translation, response *core.DetailedResponse, err error) {
+	return textToSpeech.GetWordWithContext(context.Background(), getWordOptions)
+}
+
+// GetWordWithContext is an alternate form of the GetWord method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) GetWordWithContext(ctx context.Context, getWordOptions *GetWordOptions) (result *Translation, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getWordOptions, "getWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -969,7 +1105,10 @@ func (textToSpeech *TextToSpeechV1) GetWord(getWordOptions *GetWordOptions) (res
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, new(Translation))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Translation)
@@ -990,6 +1129,11 @@ func (textToSpeech *TextToSpeechV1) GetWord(getWordOptions *GetWordOptions) (res
 // **See also:** [Deleting a word from a custom
 // model](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-customWords#cuWordDelete).
 func (textToSpeech *TextToSpeechV1) DeleteWord(deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
+	return textToSpeech.DeleteWordWithContext(context.Background(), deleteWordOptions)
+}
+
+// DeleteWordWithContext is an alternate form of the DeleteWord method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) DeleteWordWithContext(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteWordOptions, "deleteWordOptions cannot be nil")
 	if err != nil {
 		return
@@ -1022,7 +1166,10 @@ func (textToSpeech *TextToSpeechV1) DeleteWord(deleteWordOptions *DeleteWordOpti
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -1041,6 +1188,11 @@ func (textToSpeech *TextToSpeechV1) DeleteWord(deleteWordOptions *DeleteWordOpti
 // **See also:** [Information
 // security](https://cloud.ibm.com/docs/text-to-speech?topic=text-to-speech-information-security#information-security).
 func (textToSpeech *TextToSpeechV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return textToSpeech.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (textToSpeech *TextToSpeechV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -1075,7 +1227,10 @@ func (textToSpeech *TextToSpeechV1) DeleteUserData(deleteUserDataOptions *Delete
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = textToSpeech.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
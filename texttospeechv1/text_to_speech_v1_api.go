@@ -0,0 +1,81 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package texttospeechv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// TextToSpeechV1API : The operations of the TextToSpeechV1 service.
+// It is implemented by *TextToSpeechV1, and by *MockTextToSpeechV1 for unit tests.
+type TextToSpeechV1API interface {
+	ListVoices(listVoicesOptions *ListVoicesOptions) (result *Voices, response *core.DetailedResponse, err error)
+	ListVoicesWithContext(ctx context.Context, listVoicesOptions *ListVoicesOptions) (result *Voices, response *core.DetailedResponse, err error)
+	GetVoice(getVoiceOptions *GetVoiceOptions) (result *Voice, response *core.DetailedResponse, err error)
+	GetVoiceWithContext(ctx context.Context, getVoiceOptions *GetVoiceOptions) (result *Voice, response *core.DetailedResponse, err error)
+	Synthesize(synthesizeOptions *SynthesizeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	SynthesizeWithContext(ctx context.Context, synthesizeOptions *SynthesizeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetPronunciation(getPronunciationOptions *GetPronunciationOptions) (result *Pronunciation, response *core.DetailedResponse, err error)
+	GetPronunciationWithContext(ctx context.Context, getPronunciationOptions *GetPronunciationOptions) (result *Pronunciation, response *core.DetailedResponse, err error)
+	CreateVoiceModel(createVoiceModelOptions *CreateVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error)
+	CreateVoiceModelWithContext(ctx context.Context, createVoiceModelOptions *CreateVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error)
+	ListVoiceModels(listVoiceModelsOptions *ListVoiceModelsOptions) (result *VoiceModels, response *core.DetailedResponse, err error)
+	ListVoiceModelsWithContext(ctx context.Context, listVoiceModelsOptions *ListVoiceModelsOptions) (result *VoiceModels, response *core.DetailedResponse, err error)
+	UpdateVoiceModel(updateVoiceModelOptions *UpdateVoiceModelOptions) (response *core.DetailedResponse, err error)
+	UpdateVoiceModelWithContext(ctx context.Context, updateVoiceModelOptions *UpdateVoiceModelOptions) (response *core.DetailedResponse, err error)
+	GetVoiceModel(getVoiceModelOptions *GetVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error)
+	GetVoiceModelWithContext(ctx context.Context, getVoiceModelOptions *GetVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error)
+	DeleteVoiceModel(deleteVoiceModelOptions *DeleteVoiceModelOptions) (response *core.DetailedResponse, err error)
+	DeleteVoiceModelWithContext(ctx context.Context, deleteVoiceModelOptions *DeleteVoiceModelOptions) (response *core.DetailedResponse, err error)
+	AddWords(addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error)
+	AddWordsWithContext(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error)
+	ListWords(listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error)
+	ListWordsWithContext(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error)
+	AddWord(addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error)
+	AddWordWithContext(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error)
+	GetWord(getWordOptions *GetWordOptions) (result *Translation, response *core.DetailedResponse, err error)
+	GetWordWithContext(ctx context.Context, getWordOptions *GetWordOptions) (result *Translation, response *core.DetailedResponse, err error)
+	DeleteWord(deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error)
+	DeleteWordWithContext(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error)
+	DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	NewAddWordOptions(customizationID string, word string, translation string) *AddWordOptions
+	NewAddWordsOptions(customizationID string, words []Word) *AddWordsOptions
+	NewCreateVoiceModelOptions(name string) *CreateVoiceModelOptions
+	NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions
+	NewDeleteVoiceModelOptions(customizationID string) *DeleteVoiceModelOptions
+	NewDeleteWordOptions(customizationID string, word string) *DeleteWordOptions
+	NewGetPronunciationOptions(text string) *GetPronunciationOptions
+	NewGetVoiceModelOptions(customizationID string) *GetVoiceModelOptions
+	NewGetVoiceOptions(voice string) *GetVoiceOptions
+	NewGetWordOptions(customizationID string, word string) *GetWordOptions
+	NewListVoiceModelsOptions() *ListVoiceModelsOptions
+	NewListVoicesOptions() *ListVoicesOptions
+	NewListWordsOptions(customizationID string) *ListWordsOptions
+	NewSynthesizeOptions(text string) *SynthesizeOptions
+	NewTranslation(translation string) (model *Translation, err error)
+	NewUpdateVoiceModelOptions(customizationID string) *UpdateVoiceModelOptions
+	NewWord(word string, translation string) (model *Word, err error)
+	NewWords(words []Word) (model *Words, err error)
+	NewSynthesizeUsingWebsocketOptions(text string, callback SynthesizeCallbackWrapper) *SynthesizeUsingWebsocketOptions
+	SynthesizeUsingWebsocket(synthesizeOptions *SynthesizeUsingWebsocketOptions) error
+}
+
+var _ TextToSpeechV1API = (*TextToSpeechV1)(nil)
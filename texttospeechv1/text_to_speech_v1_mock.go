@@ -0,0 +1,380 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package texttospeechv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockTextToSpeechV1 : A mock implementation of TextToSpeechV1API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *TextToSpeechV1.
+type MockTextToSpeechV1 struct {
+	common.MockRecorder
+
+	ListVoicesFunc               func(ctx context.Context, listVoicesOptions *ListVoicesOptions) (result *Voices, response *core.DetailedResponse, err error)
+	GetVoiceFunc                 func(ctx context.Context, getVoiceOptions *GetVoiceOptions) (result *Voice, response *core.DetailedResponse, err error)
+	SynthesizeFunc               func(ctx context.Context, synthesizeOptions *SynthesizeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetPronunciationFunc         func(ctx context.Context, getPronunciationOptions *GetPronunciationOptions) (result *Pronunciation, response *core.DetailedResponse, err error)
+	CreateVoiceModelFunc         func(ctx context.Context, createVoiceModelOptions *CreateVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error)
+	ListVoiceModelsFunc          func(ctx context.Context, listVoiceModelsOptions *ListVoiceModelsOptions) (result *VoiceModels, response *core.DetailedResponse, err error)
+	UpdateVoiceModelFunc         func(ctx context.Context, updateVoiceModelOptions *UpdateVoiceModelOptions) (response *core.DetailedResponse, err error)
+	GetVoiceModelFunc            func(ctx context.Context, getVoiceModelOptions *GetVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error)
+	DeleteVoiceModelFunc         func(ctx context.Context, deleteVoiceModelOptions *DeleteVoiceModelOptions) (response *core.DetailedResponse, err error)
+	AddWordsFunc                 func(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error)
+	ListWordsFunc                func(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error)
+	AddWordFunc                  func(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error)
+	GetWordFunc                  func(ctx context.Context, getWordOptions *GetWordOptions) (result *Translation, response *core.DetailedResponse, err error)
+	DeleteWordFunc               func(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataFunc           func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	SynthesizeUsingWebsocketFunc func(synthesizeOptions *SynthesizeUsingWebsocketOptions) error
+}
+
+var _ TextToSpeechV1API = (*MockTextToSpeechV1)(nil)
+
+// ListVoices records the call and invokes ListVoicesFunc
+func (mock *MockTextToSpeechV1) ListVoices(listVoicesOptions *ListVoicesOptions) (result *Voices, response *core.DetailedResponse, err error) {
+	return mock.ListVoicesWithContext(context.Background(), listVoicesOptions)
+}
+
+// ListVoicesWithContext records the call and invokes ListVoicesFunc
+func (mock *MockTextToSpeechV1) ListVoicesWithContext(ctx context.Context, listVoicesOptions *ListVoicesOptions) (result *Voices, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListVoices", listVoicesOptions)
+	if mock.ListVoicesFunc != nil {
+		return mock.ListVoicesFunc(ctx, listVoicesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "ListVoices")
+	return
+}
+
+// GetVoice records the call and invokes GetVoiceFunc
+func (mock *MockTextToSpeechV1) GetVoice(getVoiceOptions *GetVoiceOptions) (result *Voice, response *core.DetailedResponse, err error) {
+	return mock.GetVoiceWithContext(context.Background(), getVoiceOptions)
+}
+
+// GetVoiceWithContext records the call and invokes GetVoiceFunc
+func (mock *MockTextToSpeechV1) GetVoiceWithContext(ctx context.Context, getVoiceOptions *GetVoiceOptions) (result *Voice, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetVoice", getVoiceOptions)
+	if mock.GetVoiceFunc != nil {
+		return mock.GetVoiceFunc(ctx, getVoiceOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "GetVoice")
+	return
+}
+
+// Synthesize records the call and invokes SynthesizeFunc
+func (mock *MockTextToSpeechV1) Synthesize(synthesizeOptions *SynthesizeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return mock.SynthesizeWithContext(context.Background(), synthesizeOptions)
+}
+
+// SynthesizeWithContext records the call and invokes SynthesizeFunc
+func (mock *MockTextToSpeechV1) SynthesizeWithContext(ctx context.Context, synthesizeOptions *SynthesizeOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Synthesize", synthesizeOptions)
+	if mock.SynthesizeFunc != nil {
+		return mock.SynthesizeFunc(ctx, synthesizeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "Synthesize")
+	return
+}
+
+// GetPronunciation records the call and invokes GetPronunciationFunc
+func (mock *MockTextToSpeechV1) GetPronunciation(getPronunciationOptions *GetPronunciationOptions) (result *Pronunciation, response *core.DetailedResponse, err error) {
+	return mock.GetPronunciationWithContext(context.Background(), getPronunciationOptions)
+}
+
+// GetPronunciationWithContext records the call and invokes GetPronunciationFunc
+func (mock *MockTextToSpeechV1) GetPronunciationWithContext(ctx context.Context, getPronunciationOptions *GetPronunciationOptions) (result *Pronunciation, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetPronunciation", getPronunciationOptions)
+	if mock.GetPronunciationFunc != nil {
+		return mock.GetPronunciationFunc(ctx, getPronunciationOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "GetPronunciation")
+	return
+}
+
+// CreateVoiceModel records the call and invokes CreateVoiceModelFunc
+func (mock *MockTextToSpeechV1) CreateVoiceModel(createVoiceModelOptions *CreateVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
+	return mock.CreateVoiceModelWithContext(context.Background(), createVoiceModelOptions)
+}
+
+// CreateVoiceModelWithContext records the call and invokes CreateVoiceModelFunc
+func (mock *MockTextToSpeechV1) CreateVoiceModelWithContext(ctx context.Context, createVoiceModelOptions *CreateVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateVoiceModel", createVoiceModelOptions)
+	if mock.CreateVoiceModelFunc != nil {
+		return mock.CreateVoiceModelFunc(ctx, createVoiceModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "CreateVoiceModel")
+	return
+}
+
+// ListVoiceModels records the call and invokes ListVoiceModelsFunc
+func (mock *MockTextToSpeechV1) ListVoiceModels(listVoiceModelsOptions *ListVoiceModelsOptions) (result *VoiceModels, response *core.DetailedResponse, err error) {
+	return mock.ListVoiceModelsWithContext(context.Background(), listVoiceModelsOptions)
+}
+
+// ListVoiceModelsWithContext records the call and invokes ListVoiceModelsFunc
+func (mock *MockTextToSpeechV1) ListVoiceModelsWithContext(ctx context.Context, listVoiceModelsOptions *ListVoiceModelsOptions) (result *VoiceModels, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListVoiceModels", listVoiceModelsOptions)
+	if mock.ListVoiceModelsFunc != nil {
+		return mock.ListVoiceModelsFunc(ctx, listVoiceModelsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "ListVoiceModels")
+	return
+}
+
+// UpdateVoiceModel records the call and invokes UpdateVoiceModelFunc
+func (mock *MockTextToSpeechV1) UpdateVoiceModel(updateVoiceModelOptions *UpdateVoiceModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.UpdateVoiceModelWithContext(context.Background(), updateVoiceModelOptions)
+}
+
+// UpdateVoiceModelWithContext records the call and invokes UpdateVoiceModelFunc
+func (mock *MockTextToSpeechV1) UpdateVoiceModelWithContext(ctx context.Context, updateVoiceModelOptions *UpdateVoiceModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateVoiceModel", updateVoiceModelOptions)
+	if mock.UpdateVoiceModelFunc != nil {
+		return mock.UpdateVoiceModelFunc(ctx, updateVoiceModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "UpdateVoiceModel")
+	return
+}
+
+// GetVoiceModel records the call and invokes GetVoiceModelFunc
+func (mock *MockTextToSpeechV1) GetVoiceModel(getVoiceModelOptions *GetVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
+	return mock.GetVoiceModelWithContext(context.Background(), getVoiceModelOptions)
+}
+
+// GetVoiceModelWithContext records the call and invokes GetVoiceModelFunc
+func (mock *MockTextToSpeechV1) GetVoiceModelWithContext(ctx context.Context, getVoiceModelOptions *GetVoiceModelOptions) (result *VoiceModel, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetVoiceModel", getVoiceModelOptions)
+	if mock.GetVoiceModelFunc != nil {
+		return mock.GetVoiceModelFunc(ctx, getVoiceModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "GetVoiceModel")
+	return
+}
+
+// DeleteVoiceModel records the call and invokes DeleteVoiceModelFunc
+func (mock *MockTextToSpeechV1) DeleteVoiceModel(deleteVoiceModelOptions *DeleteVoiceModelOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteVoiceModelWithContext(context.Background(), deleteVoiceModelOptions)
+}
+
+// DeleteVoiceModelWithContext records the call and invokes DeleteVoiceModelFunc
+func (mock *MockTextToSpeechV1) DeleteVoiceModelWithContext(ctx context.Context, deleteVoiceModelOptions *DeleteVoiceModelOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteVoiceModel", deleteVoiceModelOptions)
+	if mock.DeleteVoiceModelFunc != nil {
+		return mock.DeleteVoiceModelFunc(ctx, deleteVoiceModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "DeleteVoiceModel")
+	return
+}
+
+// AddWords records the call and invokes AddWordsFunc
+func (mock *MockTextToSpeechV1) AddWords(addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
+	return mock.AddWordsWithContext(context.Background(), addWordsOptions)
+}
+
+// AddWordsWithContext records the call and invokes AddWordsFunc
+func (mock *MockTextToSpeechV1) AddWordsWithContext(ctx context.Context, addWordsOptions *AddWordsOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddWords", addWordsOptions)
+	if mock.AddWordsFunc != nil {
+		return mock.AddWordsFunc(ctx, addWordsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "AddWords")
+	return
+}
+
+// ListWords records the call and invokes ListWordsFunc
+func (mock *MockTextToSpeechV1) ListWords(listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
+	return mock.ListWordsWithContext(context.Background(), listWordsOptions)
+}
+
+// ListWordsWithContext records the call and invokes ListWordsFunc
+func (mock *MockTextToSpeechV1) ListWordsWithContext(ctx context.Context, listWordsOptions *ListWordsOptions) (result *Words, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListWords", listWordsOptions)
+	if mock.ListWordsFunc != nil {
+		return mock.ListWordsFunc(ctx, listWordsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "ListWords")
+	return
+}
+
+// AddWord records the call and invokes AddWordFunc
+func (mock *MockTextToSpeechV1) AddWord(addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
+	return mock.AddWordWithContext(context.Background(), addWordOptions)
+}
+
+// AddWordWithContext records the call and invokes AddWordFunc
+func (mock *MockTextToSpeechV1) AddWordWithContext(ctx context.Context, addWordOptions *AddWordOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddWord", addWordOptions)
+	if mock.AddWordFunc != nil {
+		return mock.AddWordFunc(ctx, addWordOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "AddWord")
+	return
+}
+
+// GetWord records the call and invokes GetWordFunc
+func (mock *MockTextToSpeechV1) GetWord(getWordOptions *GetWordOptions) (result *Translation, response *core.DetailedResponse, err error) {
+	return mock.GetWordWithContext(context.Background(), getWordOptions)
+}
+
+// GetWordWithContext records the call and invokes GetWordFunc
+func (mock *MockTextToSpeechV1) GetWordWithContext(ctx context.Context, getWordOptions *GetWordOptions) (result *Translation, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetWord", getWordOptions)
+	if mock.GetWordFunc != nil {
+		return mock.GetWordFunc(ctx, getWordOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "GetWord")
+	return
+}
+
+// DeleteWord records the call and invokes DeleteWordFunc
+func (mock *MockTextToSpeechV1) DeleteWord(deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteWordWithContext(context.Background(), deleteWordOptions)
+}
+
+// DeleteWordWithContext records the call and invokes DeleteWordFunc
+func (mock *MockTextToSpeechV1) DeleteWordWithContext(ctx context.Context, deleteWordOptions *DeleteWordOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteWord", deleteWordOptions)
+	if mock.DeleteWordFunc != nil {
+		return mock.DeleteWordFunc(ctx, deleteWordOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "DeleteWord")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockTextToSpeechV1) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockTextToSpeechV1) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockTextToSpeechV1", "DeleteUserData")
+	return
+}
+
+// NewAddWordOptions delegates to TextToSpeechV1.NewAddWordOptions
+func (mock *MockTextToSpeechV1) NewAddWordOptions(customizationID string, word string, translation string) *AddWordOptions {
+	return new(TextToSpeechV1).NewAddWordOptions(customizationID, word, translation)
+}
+
+// NewAddWordsOptions delegates to TextToSpeechV1.NewAddWordsOptions
+func (mock *MockTextToSpeechV1) NewAddWordsOptions(customizationID string, words []Word) *AddWordsOptions {
+	return new(TextToSpeechV1).NewAddWordsOptions(customizationID, words)
+}
+
+// NewCreateVoiceModelOptions delegates to TextToSpeechV1.NewCreateVoiceModelOptions
+func (mock *MockTextToSpeechV1) NewCreateVoiceModelOptions(name string) *CreateVoiceModelOptions {
+	return new(TextToSpeechV1).NewCreateVoiceModelOptions(name)
+}
+
+// NewDeleteUserDataOptions delegates to TextToSpeechV1.NewDeleteUserDataOptions
+func (mock *MockTextToSpeechV1) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(TextToSpeechV1).NewDeleteUserDataOptions(customerID)
+}
+
+// NewDeleteVoiceModelOptions delegates to TextToSpeechV1.NewDeleteVoiceModelOptions
+func (mock *MockTextToSpeechV1) NewDeleteVoiceModelOptions(customizationID string) *DeleteVoiceModelOptions {
+	return new(TextToSpeechV1).NewDeleteVoiceModelOptions(customizationID)
+}
+
+// NewDeleteWordOptions delegates to TextToSpeechV1.NewDeleteWordOptions
+func (mock *MockTextToSpeechV1) NewDeleteWordOptions(customizationID string, word string) *DeleteWordOptions {
+	return new(TextToSpeechV1).NewDeleteWordOptions(customizationID, word)
+}
+
+// NewGetPronunciationOptions delegates to TextToSpeechV1.NewGetPronunciationOptions
+func (mock *MockTextToSpeechV1) NewGetPronunciationOptions(text string) *GetPronunciationOptions {
+	return new(TextToSpeechV1).NewGetPronunciationOptions(text)
+}
+
+// NewGetVoiceModelOptions delegates to TextToSpeechV1.NewGetVoiceModelOptions
+func (mock *MockTextToSpeechV1) NewGetVoiceModelOptions(customizationID string) *GetVoiceModelOptions {
+	return new(TextToSpeechV1).NewGetVoiceModelOptions(customizationID)
+}
+
+// NewGetVoiceOptions delegates to TextToSpeechV1.NewGetVoiceOptions
+func (mock *MockTextToSpeechV1) NewGetVoiceOptions(voice string) *GetVoiceOptions {
+	return new(TextToSpeechV1).NewGetVoiceOptions(voice)
+}
+
+// NewGetWordOptions delegates to TextToSpeechV1.NewGetWordOptions
+func (mock *MockTextToSpeechV1) NewGetWordOptions(customizationID string, word string) *GetWordOptions {
+	return new(TextToSpeechV1).NewGetWordOptions(customizationID, word)
+}
+
+// NewListVoiceModelsOptions delegates to TextToSpeechV1.NewListVoiceModelsOptions
+func (mock *MockTextToSpeechV1) NewListVoiceModelsOptions() *ListVoiceModelsOptions {
+	return new(TextToSpeechV1).NewListVoiceModelsOptions()
+}
+
+// NewListVoicesOptions delegates to TextToSpeechV1.NewListVoicesOptions
+func (mock *MockTextToSpeechV1) NewListVoicesOptions() *ListVoicesOptions {
+	return new(TextToSpeechV1).NewListVoicesOptions()
+}
+
+// NewListWordsOptions delegates to TextToSpeechV1.NewListWordsOptions
+func (mock *MockTextToSpeechV1) NewListWordsOptions(customizationID string) *ListWordsOptions {
+	return new(TextToSpeechV1).NewListWordsOptions(customizationID)
+}
+
+// NewSynthesizeOptions delegates to TextToSpeechV1.NewSynthesizeOptions
+func (mock *MockTextToSpeechV1) NewSynthesizeOptions(text string) *SynthesizeOptions {
+	return new(TextToSpeechV1).NewSynthesizeOptions(text)
+}
+
+// NewTranslation delegates to TextToSpeechV1.NewTranslation
+func (mock *MockTextToSpeechV1) NewTranslation(translation string) (model *Translation, err error) {
+	return new(TextToSpeechV1).NewTranslation(translation)
+}
+
+// NewUpdateVoiceModelOptions delegates to TextToSpeechV1.NewUpdateVoiceModelOptions
+func (mock *MockTextToSpeechV1) NewUpdateVoiceModelOptions(customizationID string) *UpdateVoiceModelOptions {
+	return new(TextToSpeechV1).NewUpdateVoiceModelOptions(customizationID)
+}
+
+// NewWord delegates to TextToSpeechV1.NewWord
+func (mock *MockTextToSpeechV1) NewWord(word string, translation string) (model *Word, err error) {
+	return new(TextToSpeechV1).NewWord(word, translation)
+}
+
+// NewWords delegates to TextToSpeechV1.NewWords
+func (mock *MockTextToSpeechV1) NewWords(words []Word) (model *Words, err error) {
+	return new(TextToSpeechV1).NewWords(words)
+}
+
+// NewSynthesizeUsingWebsocketOptions delegates to TextToSpeechV1.NewSynthesizeUsingWebsocketOptions
+func (mock *MockTextToSpeechV1) NewSynthesizeUsingWebsocketOptions(text string, callback SynthesizeCallbackWrapper) *SynthesizeUsingWebsocketOptions {
+	return new(TextToSpeechV1).NewSynthesizeUsingWebsocketOptions(text, callback)
+}
+
+// SynthesizeUsingWebsocket records the call and invokes SynthesizeUsingWebsocketFunc
+func (mock *MockTextToSpeechV1) SynthesizeUsingWebsocket(synthesizeOptions *SynthesizeUsingWebsocketOptions) error {
+	mock.Record(context.Background(), "SynthesizeUsingWebsocket", synthesizeOptions)
+	if mock.SynthesizeUsingWebsocketFunc != nil {
+		return mock.SynthesizeUsingWebsocketFunc(synthesizeOptions)
+	}
+	return common.ErrMockNotImplemented("MockTextToSpeechV1", "SynthesizeUsingWebsocket")
+}
@@ -18,9 +18,11 @@
 package toneanalyzerv3
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/core"
 	common "github.com/watson-developer-cloud/go-sdk/common"
+	"net/http"
 	"strings"
 )
 
@@ -48,10 +50,13 @@ const DefaultServiceName = "tone_analyzer"
 
 // ToneAnalyzerV3Options : Service options
 type ToneAnalyzerV3Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewToneAnalyzerV3 : constructs an instance of ToneAnalyzerV3 with passed in options.
@@ -89,6 +94,16 @@ func NewToneAnalyzerV3(options *ToneAnalyzerV3Options) (service *ToneAnalyzerV3,
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &ToneAnalyzerV3{
 		Service: baseService,
 		Version: options.Version,
@@ -104,7 +119,27 @@ func (toneAnalyzer *ToneAnalyzerV3) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (toneAnalyzer *ToneAnalyzerV3) DisableSSLVerification() {
-	toneAnalyzer.Service.DisableSSLVerification()
+	common.DisableSSLVerification(toneAnalyzer.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (toneAnalyzer *ToneAnalyzerV3) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(toneAnalyzer.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (toneAnalyzer *ToneAnalyzerV3) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(toneAnalyzer.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (toneAnalyzer *ToneAnalyzerV3) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(toneAnalyzer.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (toneAnalyzer *ToneAnalyzerV3) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(toneAnalyzer.Service, interceptor)
 }
 
 // Tone : Analyze general tone
@@ -125,6 +160,11 @@ func (toneAnalyzer *ToneAnalyzerV3) DisableSSLVerification() {
 // **See also:** [Using the general-purpose
 // endpoint](https://cloud.ibm.com/docs/tone-analyzer?topic=tone-analyzer-utgpe#utgpe).
 func (toneAnalyzer *ToneAnalyzerV3) Tone(toneOptions *ToneOptions) (result *ToneAnalysis, response *core.DetailedResponse, err error) {
+	return toneAnalyzer.ToneWithContext(context.Background(), toneOptions)
+}
+
+// ToneWithContext is an alternate form of the Tone method which supports a Context parameter
+func (toneAnalyzer *ToneAnalyzerV3) ToneWithContext(ctx context.Context, toneOptions *ToneOptions) (result *ToneAnalysis, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(toneOptions, "toneOptions cannot be nil")
 	if err != nil {
 		return
@@ -181,7 +221,10 @@ func (toneAnalyzer *ToneAnalyzerV3) Tone(toneOptions *ToneOptions) (result *Tone
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = toneAnalyzer.Service.Request(request, new(ToneAnalysis))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ToneAnalysis)
@@ -206,6 +249,11 @@ func (toneAnalyzer *ToneAnalyzerV3) Tone(toneOptions *ToneOptions) (result *Tone
 // **See also:** [Using the customer-engagement
 // endpoint](https://cloud.ibm.com/docs/tone-analyzer?topic=tone-analyzer-utco#utco).
 func (toneAnalyzer *ToneAnalyzerV3) ToneChat(toneChatOptions *ToneChatOptions) (result *UtteranceAnalyses, response *core.DetailedResponse, err error) {
+	return toneAnalyzer.ToneChatWithContext(context.Background(), toneChatOptions)
+}
+
+// ToneChatWithContext is an alternate form of the ToneChat method which supports a Context parameter
+func (toneAnalyzer *ToneAnalyzerV3) ToneChatWithContext(ctx context.Context, toneChatOptions *ToneChatOptions) (result *UtteranceAnalyses, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(toneChatOptions, "toneChatOptions cannot be nil")
 	if err != nil {
 		return
@@ -257,7 +305,10 @@ func (toneAnalyzer *ToneAnalyzerV3) ToneChat(toneChatOptions *ToneChatOptions) (
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = toneAnalyzer.Service.Request(request, new(UtteranceAnalyses))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*UtteranceAnalyses)
@@ -0,0 +1,38 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toneanalyzerv3
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// ToneAnalyzerV3API : The operations of the ToneAnalyzerV3 service.
+// It is implemented by *ToneAnalyzerV3, and by *MockToneAnalyzerV3 for unit tests.
+type ToneAnalyzerV3API interface {
+	Tone(toneOptions *ToneOptions) (result *ToneAnalysis, response *core.DetailedResponse, err error)
+	ToneWithContext(ctx context.Context, toneOptions *ToneOptions) (result *ToneAnalysis, response *core.DetailedResponse, err error)
+	ToneChat(toneChatOptions *ToneChatOptions) (result *UtteranceAnalyses, response *core.DetailedResponse, err error)
+	ToneChatWithContext(ctx context.Context, toneChatOptions *ToneChatOptions) (result *UtteranceAnalyses, response *core.DetailedResponse, err error)
+	NewToneChatOptions(utterances []Utterance) *ToneChatOptions
+	NewToneInput(text string) (model *ToneInput, err error)
+	NewToneOptions() *ToneOptions
+	NewUtterance(text string) (model *Utterance, err error)
+}
+
+var _ ToneAnalyzerV3API = (*ToneAnalyzerV3)(nil)
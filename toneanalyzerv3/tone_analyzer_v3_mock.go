@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toneanalyzerv3
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockToneAnalyzerV3 : A mock implementation of ToneAnalyzerV3API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *ToneAnalyzerV3.
+type MockToneAnalyzerV3 struct {
+	common.MockRecorder
+
+	ToneFunc     func(ctx context.Context, toneOptions *ToneOptions) (result *ToneAnalysis, response *core.DetailedResponse, err error)
+	ToneChatFunc func(ctx context.Context, toneChatOptions *ToneChatOptions) (result *UtteranceAnalyses, response *core.DetailedResponse, err error)
+}
+
+var _ ToneAnalyzerV3API = (*MockToneAnalyzerV3)(nil)
+
+// Tone records the call and invokes ToneFunc
+func (mock *MockToneAnalyzerV3) Tone(toneOptions *ToneOptions) (result *ToneAnalysis, response *core.DetailedResponse, err error) {
+	return mock.ToneWithContext(context.Background(), toneOptions)
+}
+
+// ToneWithContext records the call and invokes ToneFunc
+func (mock *MockToneAnalyzerV3) ToneWithContext(ctx context.Context, toneOptions *ToneOptions) (result *ToneAnalysis, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Tone", toneOptions)
+	if mock.ToneFunc != nil {
+		return mock.ToneFunc(ctx, toneOptions)
+	}
+	err = common.ErrMockNotImplemented("MockToneAnalyzerV3", "Tone")
+	return
+}
+
+// ToneChat records the call and invokes ToneChatFunc
+func (mock *MockToneAnalyzerV3) ToneChat(toneChatOptions *ToneChatOptions) (result *UtteranceAnalyses, response *core.DetailedResponse, err error) {
+	return mock.ToneChatWithContext(context.Background(), toneChatOptions)
+}
+
+// ToneChatWithContext records the call and invokes ToneChatFunc
+func (mock *MockToneAnalyzerV3) ToneChatWithContext(ctx context.Context, toneChatOptions *ToneChatOptions) (result *UtteranceAnalyses, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ToneChat", toneChatOptions)
+	if mock.ToneChatFunc != nil {
+		return mock.ToneChatFunc(ctx, toneChatOptions)
+	}
+	err = common.ErrMockNotImplemented("MockToneAnalyzerV3", "ToneChat")
+	return
+}
+
+// NewToneChatOptions delegates to ToneAnalyzerV3.NewToneChatOptions
+func (mock *MockToneAnalyzerV3) NewToneChatOptions(utterances []Utterance) *ToneChatOptions {
+	return new(ToneAnalyzerV3).NewToneChatOptions(utterances)
+}
+
+// NewToneInput delegates to ToneAnalyzerV3.NewToneInput
+func (mock *MockToneAnalyzerV3) NewToneInput(text string) (model *ToneInput, err error) {
+	return new(ToneAnalyzerV3).NewToneInput(text)
+}
+
+// NewToneOptions delegates to ToneAnalyzerV3.NewToneOptions
+func (mock *MockToneAnalyzerV3) NewToneOptions() *ToneOptions {
+	return new(ToneAnalyzerV3).NewToneOptions()
+}
+
+// NewUtterance delegates to ToneAnalyzerV3.NewUtterance
+func (mock *MockToneAnalyzerV3) NewUtterance(text string) (model *Utterance, err error) {
+	return new(ToneAnalyzerV3).NewUtterance(text)
+}
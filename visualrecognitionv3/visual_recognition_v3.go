@@ -18,8 +18,10 @@
 package visualrecognitionv3
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/IBM/go-sdk-core/core"
@@ -46,10 +48,13 @@ const DefaultServiceName = "watson_vision_combined"
 
 // VisualRecognitionV3Options : Service options
 type VisualRecognitionV3Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewVisualRecognitionV3 : constructs an instance of VisualRecognitionV3 with passed in options.
@@ -87,6 +92,16 @@ func NewVisualRecognitionV3(options *VisualRecognitionV3Options) (service *Visua
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &VisualRecognitionV3{
 		Service: baseService,
 		Version: options.Version,
@@ -102,12 +117,37 @@ func (visualRecognition *VisualRecognitionV3) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (visualRecognition *VisualRecognitionV3) DisableSSLVerification() {
-	visualRecognition.Service.DisableSSLVerification()
+	common.DisableSSLVerification(visualRecognition.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (visualRecognition *VisualRecognitionV3) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(visualRecognition.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (visualRecognition *VisualRecognitionV3) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(visualRecognition.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (visualRecognition *VisualRecognitionV3) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(visualRecognition.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (visualRecognition *VisualRecognitionV3) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(visualRecognition.Service, interceptor)
 }
 
 // Classify : Classify images
 // Classify images with built-in or custom classifiers.
 func (visualRecognition *VisualRecognitionV3) Classify(classifyOptions *ClassifyOptions) (result *ClassifiedImages, response *core.DetailedResponse, err error) {
+	return visualRecognition.ClassifyWithContext(context.Background(), classifyOptions)
+}
+
+// ClassifyWithContext is an alternate form of the Classify method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) ClassifyWithContext(ctx context.Context, classifyOptions *ClassifyOptions) (result *ClassifiedImages, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(classifyOptions, "classifyOptions cannot be nil")
 	if err != nil {
 		return
@@ -167,7 +207,10 @@ func (visualRecognition *VisualRecognitionV3) Classify(classifyOptions *Classify
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(ClassifiedImages))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ClassifiedImages)
@@ -193,6 +236,11 @@ func (visualRecognition *VisualRecognitionV3) Classify(classifyOptions *Classify
 // - Encode all names in UTF-8 if they contain non-ASCII characters (.zip and image file names, and classifier and class
 // names). The service assumes UTF-8 encoding if it encounters non-ASCII characters.
 func (visualRecognition *VisualRecognitionV3) CreateClassifier(createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return visualRecognition.CreateClassifierWithContext(context.Background(), createClassifierOptions)
+}
+
+// CreateClassifierWithContext is an alternate form of the CreateClassifier method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) CreateClassifierWithContext(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createClassifierOptions, "createClassifierOptions cannot be nil")
 	if err != nil {
 		return
@@ -238,7 +286,10 @@ func (visualRecognition *VisualRecognitionV3) CreateClassifier(createClassifierO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Classifier))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -252,6 +303,11 @@ func (visualRecognition *VisualRecognitionV3) CreateClassifier(createClassifierO
 
 // ListClassifiers : Retrieve a list of classifiers
 func (visualRecognition *VisualRecognitionV3) ListClassifiers(listClassifiersOptions *ListClassifiersOptions) (result *Classifiers, response *core.DetailedResponse, err error) {
+	return visualRecognition.ListClassifiersWithContext(context.Background(), listClassifiersOptions)
+}
+
+// ListClassifiersWithContext is an alternate form of the ListClassifiers method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) ListClassifiersWithContext(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *Classifiers, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listClassifiersOptions, "listClassifiersOptions")
 	if err != nil {
 		return
@@ -287,7 +343,10 @@ func (visualRecognition *VisualRecognitionV3) ListClassifiers(listClassifiersOpt
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Classifiers))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifiers)
@@ -302,6 +361,11 @@ func (visualRecognition *VisualRecognitionV3) ListClassifiers(listClassifiersOpt
 // GetClassifier : Retrieve classifier details
 // Retrieve information about a custom classifier.
 func (visualRecognition *VisualRecognitionV3) GetClassifier(getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetClassifierWithContext(context.Background(), getClassifierOptions)
+}
+
+// GetClassifierWithContext is an alternate form of the GetClassifier method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) GetClassifierWithContext(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getClassifierOptions, "getClassifierOptions cannot be nil")
 	if err != nil {
 		return
@@ -337,7 +401,10 @@ func (visualRecognition *VisualRecognitionV3) GetClassifier(getClassifierOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Classifier))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -367,6 +434,11 @@ func (visualRecognition *VisualRecognitionV3) GetClassifier(getClassifierOptions
 // parallel, the last request overwrites the previous requests. The `retrained` property shows the last time the
 // classifier retraining finished.
 func (visualRecognition *VisualRecognitionV3) UpdateClassifier(updateClassifierOptions *UpdateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return visualRecognition.UpdateClassifierWithContext(context.Background(), updateClassifierOptions)
+}
+
+// UpdateClassifierWithContext is an alternate form of the UpdateClassifier method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) UpdateClassifierWithContext(ctx context.Context, updateClassifierOptions *UpdateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateClassifierOptions, "updateClassifierOptions cannot be nil")
 	if err != nil {
 		return
@@ -415,7 +487,10 @@ func (visualRecognition *VisualRecognitionV3) UpdateClassifier(updateClassifierO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Classifier))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Classifier)
@@ -429,6 +504,11 @@ func (visualRecognition *VisualRecognitionV3) UpdateClassifier(updateClassifierO
 
 // DeleteClassifier : Delete a classifier
 func (visualRecognition *VisualRecognitionV3) DeleteClassifier(deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
+	return visualRecognition.DeleteClassifierWithContext(context.Background(), deleteClassifierOptions)
+}
+
+// DeleteClassifierWithContext is an alternate form of the DeleteClassifier method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) DeleteClassifierWithContext(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteClassifierOptions, "deleteClassifierOptions cannot be nil")
 	if err != nil {
 		return
@@ -464,7 +544,10 @@ func (visualRecognition *VisualRecognitionV3) DeleteClassifier(deleteClassifierO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -473,6 +556,11 @@ func (visualRecognition *VisualRecognitionV3) DeleteClassifier(deleteClassifierO
 // Download a Core ML model file (.mlmodel) of a custom classifier that returns <tt>"core_ml_enabled": true</tt> in the
 // classifier details.
 func (visualRecognition *VisualRecognitionV3) GetCoreMlModel(getCoreMlModelOptions *GetCoreMlModelOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetCoreMlModelWithContext(context.Background(), getCoreMlModelOptions)
+}
+
+// GetCoreMlModelWithContext is an alternate form of the GetCoreMlModel method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) GetCoreMlModelWithContext(ctx context.Context, getCoreMlModelOptions *GetCoreMlModelOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCoreMlModelOptions, "getCoreMlModelOptions cannot be nil")
 	if err != nil {
 		return
@@ -508,7 +596,10 @@ func (visualRecognition *VisualRecognitionV3) GetCoreMlModel(getCoreMlModelOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(io.ReadCloser))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -528,6 +619,11 @@ func (visualRecognition *VisualRecognitionV3) GetCoreMlModel(getCoreMlModelOptio
 // more information about personal data and customer IDs, see [Information
 // security](https://cloud.ibm.com/docs/visual-recognition?topic=visual-recognition-information-security).
 func (visualRecognition *VisualRecognitionV3) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return visualRecognition.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV3) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -565,7 +661,10 @@ func (visualRecognition *VisualRecognitionV3) DeleteUserData(deleteUserDataOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
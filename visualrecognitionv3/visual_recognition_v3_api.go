@@ -0,0 +1,55 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package visualrecognitionv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// VisualRecognitionV3API : The operations of the VisualRecognitionV3 service.
+// It is implemented by *VisualRecognitionV3, and by *MockVisualRecognitionV3 for unit tests.
+type VisualRecognitionV3API interface {
+	Classify(classifyOptions *ClassifyOptions) (result *ClassifiedImages, response *core.DetailedResponse, err error)
+	ClassifyWithContext(ctx context.Context, classifyOptions *ClassifyOptions) (result *ClassifiedImages, response *core.DetailedResponse, err error)
+	CreateClassifier(createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	CreateClassifierWithContext(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	ListClassifiers(listClassifiersOptions *ListClassifiersOptions) (result *Classifiers, response *core.DetailedResponse, err error)
+	ListClassifiersWithContext(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *Classifiers, response *core.DetailedResponse, err error)
+	GetClassifier(getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	GetClassifierWithContext(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	UpdateClassifier(updateClassifierOptions *UpdateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	UpdateClassifierWithContext(ctx context.Context, updateClassifierOptions *UpdateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	DeleteClassifier(deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error)
+	DeleteClassifierWithContext(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error)
+	GetCoreMlModel(getCoreMlModelOptions *GetCoreMlModelOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetCoreMlModelWithContext(ctx context.Context, getCoreMlModelOptions *GetCoreMlModelOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	NewClassifyOptions() *ClassifyOptions
+	NewCreateClassifierOptions(name string) *CreateClassifierOptions
+	NewDeleteClassifierOptions(classifierID string) *DeleteClassifierOptions
+	NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions
+	NewGetClassifierOptions(classifierID string) *GetClassifierOptions
+	NewGetCoreMlModelOptions(classifierID string) *GetCoreMlModelOptions
+	NewListClassifiersOptions() *ListClassifiersOptions
+	NewUpdateClassifierOptions(classifierID string) *UpdateClassifierOptions
+}
+
+var _ VisualRecognitionV3API = (*VisualRecognitionV3)(nil)
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package visualrecognitionv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockVisualRecognitionV3 : A mock implementation of VisualRecognitionV3API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *VisualRecognitionV3.
+type MockVisualRecognitionV3 struct {
+	common.MockRecorder
+
+	ClassifyFunc         func(ctx context.Context, classifyOptions *ClassifyOptions) (result *ClassifiedImages, response *core.DetailedResponse, err error)
+	CreateClassifierFunc func(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	ListClassifiersFunc  func(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *Classifiers, response *core.DetailedResponse, err error)
+	GetClassifierFunc    func(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	UpdateClassifierFunc func(ctx context.Context, updateClassifierOptions *UpdateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error)
+	DeleteClassifierFunc func(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error)
+	GetCoreMlModelFunc   func(ctx context.Context, getCoreMlModelOptions *GetCoreMlModelOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	DeleteUserDataFunc   func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ VisualRecognitionV3API = (*MockVisualRecognitionV3)(nil)
+
+// Classify records the call and invokes ClassifyFunc
+func (mock *MockVisualRecognitionV3) Classify(classifyOptions *ClassifyOptions) (result *ClassifiedImages, response *core.DetailedResponse, err error) {
+	return mock.ClassifyWithContext(context.Background(), classifyOptions)
+}
+
+// ClassifyWithContext records the call and invokes ClassifyFunc
+func (mock *MockVisualRecognitionV3) ClassifyWithContext(ctx context.Context, classifyOptions *ClassifyOptions) (result *ClassifiedImages, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Classify", classifyOptions)
+	if mock.ClassifyFunc != nil {
+		return mock.ClassifyFunc(ctx, classifyOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "Classify")
+	return
+}
+
+// CreateClassifier records the call and invokes CreateClassifierFunc
+func (mock *MockVisualRecognitionV3) CreateClassifier(createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return mock.CreateClassifierWithContext(context.Background(), createClassifierOptions)
+}
+
+// CreateClassifierWithContext records the call and invokes CreateClassifierFunc
+func (mock *MockVisualRecognitionV3) CreateClassifierWithContext(ctx context.Context, createClassifierOptions *CreateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateClassifier", createClassifierOptions)
+	if mock.CreateClassifierFunc != nil {
+		return mock.CreateClassifierFunc(ctx, createClassifierOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "CreateClassifier")
+	return
+}
+
+// ListClassifiers records the call and invokes ListClassifiersFunc
+func (mock *MockVisualRecognitionV3) ListClassifiers(listClassifiersOptions *ListClassifiersOptions) (result *Classifiers, response *core.DetailedResponse, err error) {
+	return mock.ListClassifiersWithContext(context.Background(), listClassifiersOptions)
+}
+
+// ListClassifiersWithContext records the call and invokes ListClassifiersFunc
+func (mock *MockVisualRecognitionV3) ListClassifiersWithContext(ctx context.Context, listClassifiersOptions *ListClassifiersOptions) (result *Classifiers, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListClassifiers", listClassifiersOptions)
+	if mock.ListClassifiersFunc != nil {
+		return mock.ListClassifiersFunc(ctx, listClassifiersOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "ListClassifiers")
+	return
+}
+
+// GetClassifier records the call and invokes GetClassifierFunc
+func (mock *MockVisualRecognitionV3) GetClassifier(getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return mock.GetClassifierWithContext(context.Background(), getClassifierOptions)
+}
+
+// GetClassifierWithContext records the call and invokes GetClassifierFunc
+func (mock *MockVisualRecognitionV3) GetClassifierWithContext(ctx context.Context, getClassifierOptions *GetClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetClassifier", getClassifierOptions)
+	if mock.GetClassifierFunc != nil {
+		return mock.GetClassifierFunc(ctx, getClassifierOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "GetClassifier")
+	return
+}
+
+// UpdateClassifier records the call and invokes UpdateClassifierFunc
+func (mock *MockVisualRecognitionV3) UpdateClassifier(updateClassifierOptions *UpdateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	return mock.UpdateClassifierWithContext(context.Background(), updateClassifierOptions)
+}
+
+// UpdateClassifierWithContext records the call and invokes UpdateClassifierFunc
+func (mock *MockVisualRecognitionV3) UpdateClassifierWithContext(ctx context.Context, updateClassifierOptions *UpdateClassifierOptions) (result *Classifier, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateClassifier", updateClassifierOptions)
+	if mock.UpdateClassifierFunc != nil {
+		return mock.UpdateClassifierFunc(ctx, updateClassifierOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "UpdateClassifier")
+	return
+}
+
+// DeleteClassifier records the call and invokes DeleteClassifierFunc
+func (mock *MockVisualRecognitionV3) DeleteClassifier(deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteClassifierWithContext(context.Background(), deleteClassifierOptions)
+}
+
+// DeleteClassifierWithContext records the call and invokes DeleteClassifierFunc
+func (mock *MockVisualRecognitionV3) DeleteClassifierWithContext(ctx context.Context, deleteClassifierOptions *DeleteClassifierOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteClassifier", deleteClassifierOptions)
+	if mock.DeleteClassifierFunc != nil {
+		return mock.DeleteClassifierFunc(ctx, deleteClassifierOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "DeleteClassifier")
+	return
+}
+
+// GetCoreMlModel records the call and invokes GetCoreMlModelFunc
+func (mock *MockVisualRecognitionV3) GetCoreMlModel(getCoreMlModelOptions *GetCoreMlModelOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return mock.GetCoreMlModelWithContext(context.Background(), getCoreMlModelOptions)
+}
+
+// GetCoreMlModelWithContext records the call and invokes GetCoreMlModelFunc
+func (mock *MockVisualRecognitionV3) GetCoreMlModelWithContext(ctx context.Context, getCoreMlModelOptions *GetCoreMlModelOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetCoreMlModel", getCoreMlModelOptions)
+	if mock.GetCoreMlModelFunc != nil {
+		return mock.GetCoreMlModelFunc(ctx, getCoreMlModelOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "GetCoreMlModel")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockVisualRecognitionV3) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockVisualRecognitionV3) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV3", "DeleteUserData")
+	return
+}
+
+// NewClassifyOptions delegates to VisualRecognitionV3.NewClassifyOptions
+func (mock *MockVisualRecognitionV3) NewClassifyOptions() *ClassifyOptions {
+	return new(VisualRecognitionV3).NewClassifyOptions()
+}
+
+// NewCreateClassifierOptions delegates to VisualRecognitionV3.NewCreateClassifierOptions
+func (mock *MockVisualRecognitionV3) NewCreateClassifierOptions(name string) *CreateClassifierOptions {
+	return new(VisualRecognitionV3).NewCreateClassifierOptions(name)
+}
+
+// NewDeleteClassifierOptions delegates to VisualRecognitionV3.NewDeleteClassifierOptions
+func (mock *MockVisualRecognitionV3) NewDeleteClassifierOptions(classifierID string) *DeleteClassifierOptions {
+	return new(VisualRecognitionV3).NewDeleteClassifierOptions(classifierID)
+}
+
+// NewDeleteUserDataOptions delegates to VisualRecognitionV3.NewDeleteUserDataOptions
+func (mock *MockVisualRecognitionV3) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(VisualRecognitionV3).NewDeleteUserDataOptions(customerID)
+}
+
+// NewGetClassifierOptions delegates to VisualRecognitionV3.NewGetClassifierOptions
+func (mock *MockVisualRecognitionV3) NewGetClassifierOptions(classifierID string) *GetClassifierOptions {
+	return new(VisualRecognitionV3).NewGetClassifierOptions(classifierID)
+}
+
+// NewGetCoreMlModelOptions delegates to VisualRecognitionV3.NewGetCoreMlModelOptions
+func (mock *MockVisualRecognitionV3) NewGetCoreMlModelOptions(classifierID string) *GetCoreMlModelOptions {
+	return new(VisualRecognitionV3).NewGetCoreMlModelOptions(classifierID)
+}
+
+// NewListClassifiersOptions delegates to VisualRecognitionV3.NewListClassifiersOptions
+func (mock *MockVisualRecognitionV3) NewListClassifiersOptions() *ListClassifiersOptions {
+	return new(VisualRecognitionV3).NewListClassifiersOptions()
+}
+
+// NewUpdateClassifierOptions delegates to VisualRecognitionV3.NewUpdateClassifierOptions
+func (mock *MockVisualRecognitionV3) NewUpdateClassifierOptions(classifierID string) *UpdateClassifierOptions {
+	return new(VisualRecognitionV3).NewUpdateClassifierOptions(classifierID)
+}
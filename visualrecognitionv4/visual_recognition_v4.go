@@ -18,8 +18,10 @@
 package visualrecognitionv4
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/IBM/go-sdk-core/core"
@@ -45,10 +47,13 @@ const DefaultServiceName = "watson_vision_combined"
 
 // VisualRecognitionV4Options : Service options
 type VisualRecognitionV4Options struct {
-	ServiceName   string
-	URL           string
-	Authenticator core.Authenticator
-	Version       string
+	ServiceName     string
+	URL             string
+	Authenticator   core.Authenticator
+	Version         string
+	RetryPolicy     *common.RetryPolicy
+	HTTPClient      *http.Client
+	TransportConfig *common.TransportConfig
 }
 
 // NewVisualRecognitionV4 : constructs an instance of VisualRecognitionV4 with passed in options.
@@ -86,6 +91,16 @@ func NewVisualRecognitionV4(options *VisualRecognitionV4Options) (service *Visua
 		}
 	}
 
+	if options.HTTPClient != nil {
+		common.SetHTTPClient(baseService, options.HTTPClient)
+	}
+	if options.TransportConfig != nil {
+		common.ConfigureTransport(baseService, options.TransportConfig)
+	}
+	if options.RetryPolicy != nil {
+		common.EnableRetries(baseService, options.RetryPolicy)
+	}
+
 	service = &VisualRecognitionV4{
 		Service: baseService,
 		Version: options.Version,
@@ -101,7 +116,27 @@ func (visualRecognition *VisualRecognitionV4) SetServiceURL(url string) error {
 
 // DisableSSLVerification bypasses verification of the server's SSL certificate
 func (visualRecognition *VisualRecognitionV4) DisableSSLVerification() {
-	visualRecognition.Service.DisableSSLVerification()
+	common.DisableSSLVerification(visualRecognition.Service)
+}
+
+// SetHTTPClient sends the requests of the service through the given client
+func (visualRecognition *VisualRecognitionV4) SetHTTPClient(client *http.Client) {
+	common.SetHTTPClient(visualRecognition.Service, client)
+}
+
+// ConfigureTransport applies the proxy, TLS and connection pool settings to the requests of the service
+func (visualRecognition *VisualRecognitionV4) ConfigureTransport(config *common.TransportConfig) {
+	common.ConfigureTransport(visualRecognition.Service, config)
+}
+
+// EnableRetries retries failed requests according to the given policy
+func (visualRecognition *VisualRecognitionV4) EnableRetries(policy *common.RetryPolicy) {
+	common.EnableRetries(visualRecognition.Service, policy)
+}
+
+// AddInterceptor registers an interceptor invoked around every request sent by the service
+func (visualRecognition *VisualRecognitionV4) AddInterceptor(interceptor common.Interceptor) {
+	common.AddInterceptor(visualRecognition.Service, interceptor)
 }
 
 // Analyze : Analyze images
@@ -111,6 +146,11 @@ func (visualRecognition *VisualRecognitionV4) DisableSSLVerification() {
 // Encode the image and .zip file names in UTF-8 if they contain non-ASCII characters. The service assumes UTF-8
 // encoding if it encounters non-ASCII characters.
 func (visualRecognition *VisualRecognitionV4) Analyze(analyzeOptions *AnalyzeOptions) (result *AnalyzeResponse, response *core.DetailedResponse, err error) {
+	return visualRecognition.AnalyzeWithContext(context.Background(), analyzeOptions)
+}
+
+// AnalyzeWithContext is an alternate form of the Analyze method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) AnalyzeWithContext(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalyzeResponse, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(analyzeOptions, "analyzeOptions cannot be nil")
 	if err != nil {
 		return
@@ -166,7 +206,10 @@ func (visualRecognition *VisualRecognitionV4) Analyze(analyzeOptions *AnalyzeOpt
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(AnalyzeResponse))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*AnalyzeResponse)
@@ -186,6 +229,11 @@ func (visualRecognition *VisualRecognitionV4) Analyze(analyzeOptions *AnalyzeOpt
 // Encode the name and description in UTF-8 if they contain non-ASCII characters. The service assumes UTF-8 encoding if
 // it encounters non-ASCII characters.
 func (visualRecognition *VisualRecognitionV4) CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return visualRecognition.CreateCollectionWithContext(context.Background(), createCollectionOptions)
+}
+
+// CreateCollectionWithContext is an alternate form of the CreateCollection method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(createCollectionOptions, "createCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -234,7 +282,10 @@ func (visualRecognition *VisualRecognitionV4) CreateCollection(createCollectionO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -249,6 +300,11 @@ func (visualRecognition *VisualRecognitionV4) CreateCollection(createCollectionO
 // ListCollections : List collections
 // Retrieves a list of collections for the service instance.
 func (visualRecognition *VisualRecognitionV4) ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *CollectionsList, response *core.DetailedResponse, err error) {
+	return visualRecognition.ListCollectionsWithContext(context.Background(), listCollectionsOptions)
+}
+
+// ListCollectionsWithContext is an alternate form of the ListCollections method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *CollectionsList, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(listCollectionsOptions, "listCollectionsOptions")
 	if err != nil {
 		return
@@ -280,7 +336,10 @@ func (visualRecognition *VisualRecognitionV4) ListCollections(listCollectionsOpt
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(CollectionsList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*CollectionsList)
@@ -295,6 +354,11 @@ func (visualRecognition *VisualRecognitionV4) ListCollections(listCollectionsOpt
 // GetCollection : Get collection details
 // Get details of one collection.
 func (visualRecognition *VisualRecognitionV4) GetCollection(getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetCollectionWithContext(context.Background(), getCollectionOptions)
+}
+
+// GetCollectionWithContext is an alternate form of the GetCollection method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getCollectionOptions, "getCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -330,7 +394,10 @@ func (visualRecognition *VisualRecognitionV4) GetCollection(getCollectionOptions
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -348,6 +415,11 @@ func (visualRecognition *VisualRecognitionV4) GetCollection(getCollectionOptions
 // Encode the name and description in UTF-8 if they contain non-ASCII characters. The service assumes UTF-8 encoding if
 // it encounters non-ASCII characters.
 func (visualRecognition *VisualRecognitionV4) UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return visualRecognition.UpdateCollectionWithContext(context.Background(), updateCollectionOptions)
+}
+
+// UpdateCollectionWithContext is an alternate form of the UpdateCollection method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateCollectionOptions, "updateCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -396,7 +468,10 @@ func (visualRecognition *VisualRecognitionV4) UpdateCollection(updateCollectionO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -411,6 +486,11 @@ func (visualRecognition *VisualRecognitionV4) UpdateCollection(updateCollectionO
 // DeleteCollection : Delete a collection
 // Delete a collection from the service instance.
 func (visualRecognition *VisualRecognitionV4) DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
+	return visualRecognition.DeleteCollectionWithContext(context.Background(), deleteCollectionOptions)
+}
+
+// DeleteCollectionWithContext is an alternate form of the DeleteCollection method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteCollectionOptions, "deleteCollectionOptions cannot be nil")
 	if err != nil {
 		return
@@ -446,7 +526,10 @@ func (visualRecognition *VisualRecognitionV4) DeleteCollection(deleteCollectionO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -459,6 +542,11 @@ func (visualRecognition *VisualRecognitionV4) DeleteCollection(deleteCollectionO
 // Currently, the model format is specific to Android apps. For more information about how to deploy the model to your
 // app, see the [Watson Visual Recognition on Android](https://github.com/matt-ny/rscnn) project in GitHub.
 func (visualRecognition *VisualRecognitionV4) GetModelFile(getModelFileOptions *GetModelFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetModelFileWithContext(context.Background(), getModelFileOptions)
+}
+
+// GetModelFileWithContext is an alternate form of the GetModelFile method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) GetModelFileWithContext(ctx context.Context, getModelFileOptions *GetModelFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getModelFileOptions, "getModelFileOptions cannot be nil")
 	if err != nil {
 		return
@@ -497,7 +585,10 @@ func (visualRecognition *VisualRecognitionV4) GetModelFile(getModelFileOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(io.ReadCloser))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -515,6 +606,11 @@ func (visualRecognition *VisualRecognitionV4) GetModelFile(getModelFileOptions *
 // Encode the image and .zip file names in UTF-8 if they contain non-ASCII characters. The service assumes UTF-8
 // encoding if it encounters non-ASCII characters.
 func (visualRecognition *VisualRecognitionV4) AddImages(addImagesOptions *AddImagesOptions) (result *ImageDetailsList, response *core.DetailedResponse, err error) {
+	return visualRecognition.AddImagesWithContext(context.Background(), addImagesOptions)
+}
+
+// AddImagesWithContext is an alternate form of the AddImages method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) AddImagesWithContext(ctx context.Context, addImagesOptions *AddImagesOptions) (result *ImageDetailsList, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addImagesOptions, "addImagesOptions cannot be nil")
 	if err != nil {
 		return
@@ -568,7 +664,10 @@ func (visualRecognition *VisualRecognitionV4) AddImages(addImagesOptions *AddIma
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(ImageDetailsList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ImageDetailsList)
@@ -583,6 +682,11 @@ func (visualRecognition *VisualRecognitionV4) AddImages(addImagesOptions *AddIma
 // ListImages : List images
 // Retrieves a list of images in a collection.
 func (visualRecognition *VisualRecognitionV4) ListImages(listImagesOptions *ListImagesOptions) (result *ImageSummaryList, response *core.DetailedResponse, err error) {
+	return visualRecognition.ListImagesWithContext(context.Background(), listImagesOptions)
+}
+
+// ListImagesWithContext is an alternate form of the ListImages method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) ListImagesWithContext(ctx context.Context, listImagesOptions *ListImagesOptions) (result *ImageSummaryList, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listImagesOptions, "listImagesOptions cannot be nil")
 	if err != nil {
 		return
@@ -618,7 +722,10 @@ func (visualRecognition *VisualRecognitionV4) ListImages(listImagesOptions *List
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(ImageSummaryList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ImageSummaryList)
@@ -633,6 +740,11 @@ func (visualRecognition *VisualRecognitionV4) ListImages(listImagesOptions *List
 // GetImageDetails : Get image details
 // Get the details of an image in a collection.
 func (visualRecognition *VisualRecognitionV4) GetImageDetails(getImageDetailsOptions *GetImageDetailsOptions) (result *ImageDetails, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetImageDetailsWithContext(context.Background(), getImageDetailsOptions)
+}
+
+// GetImageDetailsWithContext is an alternate form of the GetImageDetails method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) GetImageDetailsWithContext(ctx context.Context, getImageDetailsOptions *GetImageDetailsOptions) (result *ImageDetails, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getImageDetailsOptions, "getImageDetailsOptions cannot be nil")
 	if err != nil {
 		return
@@ -668,7 +780,10 @@ func (visualRecognition *VisualRecognitionV4) GetImageDetails(getImageDetailsOpt
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(ImageDetails))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ImageDetails)
@@ -683,6 +798,11 @@ func (visualRecognition *VisualRecognitionV4) GetImageDetails(getImageDetailsOpt
 // DeleteImage : Delete an image
 // Delete one image from a collection.
 func (visualRecognition *VisualRecognitionV4) DeleteImage(deleteImageOptions *DeleteImageOptions) (response *core.DetailedResponse, err error) {
+	return visualRecognition.DeleteImageWithContext(context.Background(), deleteImageOptions)
+}
+
+// DeleteImageWithContext is an alternate form of the DeleteImage method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) DeleteImageWithContext(ctx context.Context, deleteImageOptions *DeleteImageOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteImageOptions, "deleteImageOptions cannot be nil")
 	if err != nil {
 		return
@@ -718,7 +838,10 @@ func (visualRecognition *VisualRecognitionV4) DeleteImage(deleteImageOptions *De
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -726,6 +849,11 @@ func (visualRecognition *VisualRecognitionV4) DeleteImage(deleteImageOptions *De
 // GetJpegImage : Get a JPEG file of an image
 // Download a JPEG representation of an image.
 func (visualRecognition *VisualRecognitionV4) GetJpegImage(getJpegImageOptions *GetJpegImageOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetJpegImageWithContext(context.Background(), getJpegImageOptions)
+}
+
+// GetJpegImageWithContext is an alternate form of the GetJpegImage method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) GetJpegImageWithContext(ctx context.Context, getJpegImageOptions *GetJpegImageOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getJpegImageOptions, "getJpegImageOptions cannot be nil")
 	if err != nil {
 		return
@@ -765,7 +893,10 @@ func (visualRecognition *VisualRecognitionV4) GetJpegImage(getJpegImageOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(io.ReadCloser))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(io.ReadCloser)
@@ -780,6 +911,11 @@ func (visualRecognition *VisualRecognitionV4) GetJpegImage(getJpegImageOptions *
 // ListObjectMetadata : List object metadata
 // Retrieves a list of object names in a collection.
 func (visualRecognition *VisualRecognitionV4) ListObjectMetadata(listObjectMetadataOptions *ListObjectMetadataOptions) (result *ObjectMetadataList, response *core.DetailedResponse, err error) {
+	return visualRecognition.ListObjectMetadataWithContext(context.Background(), listObjectMetadataOptions)
+}
+
+// ListObjectMetadataWithContext is an alternate form of the ListObjectMetadata method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) ListObjectMetadataWithContext(ctx context.Context, listObjectMetadataOptions *ListObjectMetadataOptions) (result *ObjectMetadataList, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(listObjectMetadataOptions, "listObjectMetadataOptions cannot be nil")
 	if err != nil {
 		return
@@ -815,7 +951,10 @@ func (visualRecognition *VisualRecognitionV4) ListObjectMetadata(listObjectMetad
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(ObjectMetadataList))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ObjectMetadataList)
@@ -830,6 +969,11 @@ func (visualRecognition *VisualRecognitionV4) ListObjectMetadata(listObjectMetad
 // UpdateObjectMetadata : Update an object name
 // Update the name of an object. A successful request updates the training data for all images that use the object.
 func (visualRecognition *VisualRecognitionV4) UpdateObjectMetadata(updateObjectMetadataOptions *UpdateObjectMetadataOptions) (result *UpdateObjectMetadata, response *core.DetailedResponse, err error) {
+	return visualRecognition.UpdateObjectMetadataWithContext(context.Background(), updateObjectMetadataOptions)
+}
+
+// UpdateObjectMetadataWithContext is an alternate form of the UpdateObjectMetadata method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) UpdateObjectMetadataWithContext(ctx context.Context, updateObjectMetadataOptions *UpdateObjectMetadataOptions) (result *UpdateObjectMetadata, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(updateObjectMetadataOptions, "updateObjectMetadataOptions cannot be nil")
 	if err != nil {
 		return
@@ -875,7 +1019,10 @@ func (visualRecognition *VisualRecognitionV4) UpdateObjectMetadata(updateObjectM
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(UpdateObjectMetadata))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*UpdateObjectMetadata)
@@ -890,6 +1037,11 @@ func (visualRecognition *VisualRecognitionV4) UpdateObjectMetadata(updateObjectM
 // GetObjectMetadata : Get object metadata
 // Get the number of bounding boxes for a single object in a collection.
 func (visualRecognition *VisualRecognitionV4) GetObjectMetadata(getObjectMetadataOptions *GetObjectMetadataOptions) (result *ObjectMetadata, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetObjectMetadataWithContext(context.Background(), getObjectMetadataOptions)
+}
+
+// GetObjectMetadataWithContext is an alternate form of the GetObjectMetadata method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) GetObjectMetadataWithContext(ctx context.Context, getObjectMetadataOptions *GetObjectMetadataOptions) (result *ObjectMetadata, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(getObjectMetadataOptions, "getObjectMetadataOptions cannot be nil")
 	if err != nil {
 		return
@@ -925,7 +1077,10 @@ func (visualRecognition *VisualRecognitionV4) GetObjectMetadata(getObjectMetadat
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(ObjectMetadata))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*ObjectMetadata)
@@ -941,6 +1096,11 @@ func (visualRecognition *VisualRecognitionV4) GetObjectMetadata(getObjectMetadat
 // Delete one object from a collection. A successful request deletes the training data from all images that use the
 // object.
 func (visualRecognition *VisualRecognitionV4) DeleteObject(deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error) {
+	return visualRecognition.DeleteObjectWithContext(context.Background(), deleteObjectOptions)
+}
+
+// DeleteObjectWithContext is an alternate form of the DeleteObject method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) DeleteObjectWithContext(ctx context.Context, deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteObjectOptions, "deleteObjectOptions cannot be nil")
 	if err != nil {
 		return
@@ -976,7 +1136,10 @@ func (visualRecognition *VisualRecognitionV4) DeleteObject(deleteObjectOptions *
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -986,6 +1149,11 @@ func (visualRecognition *VisualRecognitionV4) DeleteObject(deleteObjectOptions *
 // **training_status.objects.data_changed** is `true`). If training is in progress, the request queues the next training
 // job.
 func (visualRecognition *VisualRecognitionV4) Train(trainOptions *TrainOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return visualRecognition.TrainWithContext(context.Background(), trainOptions)
+}
+
+// TrainWithContext is an alternate form of the Train method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) TrainWithContext(ctx context.Context, trainOptions *TrainOptions) (result *Collection, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(trainOptions, "trainOptions cannot be nil")
 	if err != nil {
 		return
@@ -1021,7 +1189,10 @@ func (visualRecognition *VisualRecognitionV4) Train(trainOptions *TrainOptions)
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(Collection))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*Collection)
@@ -1043,6 +1214,11 @@ func (visualRecognition *VisualRecognitionV4) Train(trainOptions *TrainOptions)
 //
 // - To delete the training data, provide an empty value for the training data.
 func (visualRecognition *VisualRecognitionV4) AddImageTrainingData(addImageTrainingDataOptions *AddImageTrainingDataOptions) (result *TrainingDataObjects, response *core.DetailedResponse, err error) {
+	return visualRecognition.AddImageTrainingDataWithContext(context.Background(), addImageTrainingDataOptions)
+}
+
+// AddImageTrainingDataWithContext is an alternate form of the AddImageTrainingData method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) AddImageTrainingDataWithContext(ctx context.Context, addImageTrainingDataOptions *AddImageTrainingDataOptions) (result *TrainingDataObjects, response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(addImageTrainingDataOptions, "addImageTrainingDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -1088,7 +1264,10 @@ func (visualRecognition *VisualRecognitionV4) AddImageTrainingData(addImageTrain
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(TrainingDataObjects))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingDataObjects)
@@ -1104,6 +1283,11 @@ func (visualRecognition *VisualRecognitionV4) AddImageTrainingData(addImageTrain
 // Information about the completed training events. You can use this information to determine how close you are to the
 // training limits for the month.
 func (visualRecognition *VisualRecognitionV4) GetTrainingUsage(getTrainingUsageOptions *GetTrainingUsageOptions) (result *TrainingEvents, response *core.DetailedResponse, err error) {
+	return visualRecognition.GetTrainingUsageWithContext(context.Background(), getTrainingUsageOptions)
+}
+
+// GetTrainingUsageWithContext is an alternate form of the GetTrainingUsage method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) GetTrainingUsageWithContext(ctx context.Context, getTrainingUsageOptions *GetTrainingUsageOptions) (result *TrainingEvents, response *core.DetailedResponse, err error) {
 	err = core.ValidateStruct(getTrainingUsageOptions, "getTrainingUsageOptions")
 	if err != nil {
 		return
@@ -1142,7 +1326,10 @@ func (visualRecognition *VisualRecognitionV4) GetTrainingUsage(getTrainingUsageO
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, new(TrainingEvents))
+	err = common.WrapServiceError(response, err)
 	if err == nil {
 		var ok bool
 		result, ok = response.Result.(*TrainingEvents)
@@ -1162,6 +1349,11 @@ func (visualRecognition *VisualRecognitionV4) GetTrainingUsage(getTrainingUsageO
 // more information about personal data and customer IDs, see [Information
 // security](https://cloud.ibm.com/docs/visual-recognition?topic=visual-recognition-information-security).
 func (visualRecognition *VisualRecognitionV4) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return visualRecognition.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext is an alternate form of the DeleteUserData method which supports a Context parameter
+func (visualRecognition *VisualRecognitionV4) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
 	err = core.ValidateNotNil(deleteUserDataOptions, "deleteUserDataOptions cannot be nil")
 	if err != nil {
 		return
@@ -1199,7 +1391,10 @@ func (visualRecognition *VisualRecognitionV4) DeleteUserData(deleteUserDataOptio
 		return
 	}
 
+	request = request.WithContext(ctx)
+
 	response, err = visualRecognition.Service.Request(request, nil)
+	err = common.WrapServiceError(response, err)
 
 	return
 }
@@ -0,0 +1,96 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package visualrecognitionv4
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+)
+
+// VisualRecognitionV4API : The operations of the VisualRecognitionV4 service.
+// It is implemented by *VisualRecognitionV4, and by *MockVisualRecognitionV4 for unit tests.
+type VisualRecognitionV4API interface {
+	Analyze(analyzeOptions *AnalyzeOptions) (result *AnalyzeResponse, response *core.DetailedResponse, err error)
+	AnalyzeWithContext(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalyzeResponse, response *core.DetailedResponse, err error)
+	CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *CollectionsList, response *core.DetailedResponse, err error)
+	ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *CollectionsList, response *core.DetailedResponse, err error)
+	GetCollection(getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error)
+	DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error)
+	GetModelFile(getModelFileOptions *GetModelFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetModelFileWithContext(ctx context.Context, getModelFileOptions *GetModelFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	AddImages(addImagesOptions *AddImagesOptions) (result *ImageDetailsList, response *core.DetailedResponse, err error)
+	AddImagesWithContext(ctx context.Context, addImagesOptions *AddImagesOptions) (result *ImageDetailsList, response *core.DetailedResponse, err error)
+	ListImages(listImagesOptions *ListImagesOptions) (result *ImageSummaryList, response *core.DetailedResponse, err error)
+	ListImagesWithContext(ctx context.Context, listImagesOptions *ListImagesOptions) (result *ImageSummaryList, response *core.DetailedResponse, err error)
+	GetImageDetails(getImageDetailsOptions *GetImageDetailsOptions) (result *ImageDetails, response *core.DetailedResponse, err error)
+	GetImageDetailsWithContext(ctx context.Context, getImageDetailsOptions *GetImageDetailsOptions) (result *ImageDetails, response *core.DetailedResponse, err error)
+	DeleteImage(deleteImageOptions *DeleteImageOptions) (response *core.DetailedResponse, err error)
+	DeleteImageWithContext(ctx context.Context, deleteImageOptions *DeleteImageOptions) (response *core.DetailedResponse, err error)
+	GetJpegImage(getJpegImageOptions *GetJpegImageOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetJpegImageWithContext(ctx context.Context, getJpegImageOptions *GetJpegImageOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	ListObjectMetadata(listObjectMetadataOptions *ListObjectMetadataOptions) (result *ObjectMetadataList, response *core.DetailedResponse, err error)
+	ListObjectMetadataWithContext(ctx context.Context, listObjectMetadataOptions *ListObjectMetadataOptions) (result *ObjectMetadataList, response *core.DetailedResponse, err error)
+	UpdateObjectMetadata(updateObjectMetadataOptions *UpdateObjectMetadataOptions) (result *UpdateObjectMetadata, response *core.DetailedResponse, err error)
+	UpdateObjectMetadataWithContext(ctx context.Context, updateObjectMetadataOptions *UpdateObjectMetadataOptions) (result *UpdateObjectMetadata, response *core.DetailedResponse, err error)
+	GetObjectMetadata(getObjectMetadataOptions *GetObjectMetadataOptions) (result *ObjectMetadata, response *core.DetailedResponse, err error)
+	GetObjectMetadataWithContext(ctx context.Context, getObjectMetadataOptions *GetObjectMetadataOptions) (result *ObjectMetadata, response *core.DetailedResponse, err error)
+	DeleteObject(deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+	DeleteObjectWithContext(ctx context.Context, deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+	Train(trainOptions *TrainOptions) (result *Collection, response *core.DetailedResponse, err error)
+	TrainWithContext(ctx context.Context, trainOptions *TrainOptions) (result *Collection, response *core.DetailedResponse, err error)
+	AddImageTrainingData(addImageTrainingDataOptions *AddImageTrainingDataOptions) (result *TrainingDataObjects, response *core.DetailedResponse, err error)
+	AddImageTrainingDataWithContext(ctx context.Context, addImageTrainingDataOptions *AddImageTrainingDataOptions) (result *TrainingDataObjects, response *core.DetailedResponse, err error)
+	GetTrainingUsage(getTrainingUsageOptions *GetTrainingUsageOptions) (result *TrainingEvents, response *core.DetailedResponse, err error)
+	GetTrainingUsageWithContext(ctx context.Context, getTrainingUsageOptions *GetTrainingUsageOptions) (result *TrainingEvents, response *core.DetailedResponse, err error)
+	DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+	NewAddImageTrainingDataOptions(collectionID string, imageID string) *AddImageTrainingDataOptions
+	NewAddImagesOptions(collectionID string) *AddImagesOptions
+	NewAnalyzeOptions(collectionIds []string, features []string) *AnalyzeOptions
+	NewCreateCollectionOptions() *CreateCollectionOptions
+	NewDeleteCollectionOptions(collectionID string) *DeleteCollectionOptions
+	NewDeleteImageOptions(collectionID string, imageID string) *DeleteImageOptions
+	NewDeleteObjectOptions(collectionID string, object string) *DeleteObjectOptions
+	NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions
+	NewFileWithMetadata(data io.ReadCloser) (model *FileWithMetadata, err error)
+	NewGetCollectionOptions(collectionID string) *GetCollectionOptions
+	NewGetImageDetailsOptions(collectionID string, imageID string) *GetImageDetailsOptions
+	NewGetJpegImageOptions(collectionID string, imageID string) *GetJpegImageOptions
+	NewGetModelFileOptions(collectionID string, feature string, modelFormat string) *GetModelFileOptions
+	NewGetObjectMetadataOptions(collectionID string, object string) *GetObjectMetadataOptions
+	NewGetTrainingUsageOptions() *GetTrainingUsageOptions
+	NewListCollectionsOptions() *ListCollectionsOptions
+	NewListImagesOptions(collectionID string) *ListImagesOptions
+	NewListObjectMetadataOptions(collectionID string) *ListObjectMetadataOptions
+	NewLocation(top int64, left int64, width int64, height int64) (model *Location, err error)
+	NewObjectTrainingStatus(ready bool, inProgress bool, dataChanged bool, latestFailed bool, rscnnReady bool, description string) (model *ObjectTrainingStatus, err error)
+	NewTrainOptions(collectionID string) *TrainOptions
+	NewTrainingStatus(objects *ObjectTrainingStatus) (model *TrainingStatus, err error)
+	NewUpdateCollectionOptions(collectionID string) *UpdateCollectionOptions
+	NewUpdateObjectMetadata(object string, count int64) (model *UpdateObjectMetadata, err error)
+	NewUpdateObjectMetadataOptions(collectionID string, object string, newObject string) *UpdateObjectMetadataOptions
+}
+
+var _ VisualRecognitionV4API = (*VisualRecognitionV4)(nil)
@@ -0,0 +1,480 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package visualrecognitionv4
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/core"
+	common "github.com/watson-developer-cloud/go-sdk/common"
+)
+
+// MockVisualRecognitionV4 : A mock implementation of VisualRecognitionV4API for unit tests.
+// Each operation records the call, then returns the result of the matching Func field, or an error if the field is
+// nil. The options and model constructors behave like the ones of *VisualRecognitionV4.
+type MockVisualRecognitionV4 struct {
+	common.MockRecorder
+
+	AnalyzeFunc              func(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalyzeResponse, response *core.DetailedResponse, err error)
+	CreateCollectionFunc     func(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	ListCollectionsFunc      func(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *CollectionsList, response *core.DetailedResponse, err error)
+	GetCollectionFunc        func(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	UpdateCollectionFunc     func(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error)
+	DeleteCollectionFunc     func(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error)
+	GetModelFileFunc         func(ctx context.Context, getModelFileOptions *GetModelFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	AddImagesFunc            func(ctx context.Context, addImagesOptions *AddImagesOptions) (result *ImageDetailsList, response *core.DetailedResponse, err error)
+	ListImagesFunc           func(ctx context.Context, listImagesOptions *ListImagesOptions) (result *ImageSummaryList, response *core.DetailedResponse, err error)
+	GetImageDetailsFunc      func(ctx context.Context, getImageDetailsOptions *GetImageDetailsOptions) (result *ImageDetails, response *core.DetailedResponse, err error)
+	DeleteImageFunc          func(ctx context.Context, deleteImageOptions *DeleteImageOptions) (response *core.DetailedResponse, err error)
+	GetJpegImageFunc         func(ctx context.Context, getJpegImageOptions *GetJpegImageOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	ListObjectMetadataFunc   func(ctx context.Context, listObjectMetadataOptions *ListObjectMetadataOptions) (result *ObjectMetadataList, response *core.DetailedResponse, err error)
+	UpdateObjectMetadataFunc func(ctx context.Context, updateObjectMetadataOptions *UpdateObjectMetadataOptions) (result *UpdateObjectMetadata, response *core.DetailedResponse, err error)
+	GetObjectMetadataFunc    func(ctx context.Context, getObjectMetadataOptions *GetObjectMetadataOptions) (result *ObjectMetadata, response *core.DetailedResponse, err error)
+	DeleteObjectFunc         func(ctx context.Context, deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+	TrainFunc                func(ctx context.Context, trainOptions *TrainOptions) (result *Collection, response *core.DetailedResponse, err error)
+	AddImageTrainingDataFunc func(ctx context.Context, addImageTrainingDataOptions *AddImageTrainingDataOptions) (result *TrainingDataObjects, response *core.DetailedResponse, err error)
+	GetTrainingUsageFunc     func(ctx context.Context, getTrainingUsageOptions *GetTrainingUsageOptions) (result *TrainingEvents, response *core.DetailedResponse, err error)
+	DeleteUserDataFunc       func(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ VisualRecognitionV4API = (*MockVisualRecognitionV4)(nil)
+
+// Analyze records the call and invokes AnalyzeFunc
+func (mock *MockVisualRecognitionV4) Analyze(analyzeOptions *AnalyzeOptions) (result *AnalyzeResponse, response *core.DetailedResponse, err error) {
+	return mock.AnalyzeWithContext(context.Background(), analyzeOptions)
+}
+
+// AnalyzeWithContext records the call and invokes AnalyzeFunc
+func (mock *MockVisualRecognitionV4) AnalyzeWithContext(ctx context.Context, analyzeOptions *AnalyzeOptions) (result *AnalyzeResponse, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Analyze", analyzeOptions)
+	if mock.AnalyzeFunc != nil {
+		return mock.AnalyzeFunc(ctx, analyzeOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "Analyze")
+	return
+}
+
+// CreateCollection records the call and invokes CreateCollectionFunc
+func (mock *MockVisualRecognitionV4) CreateCollection(createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return mock.CreateCollectionWithContext(context.Background(), createCollectionOptions)
+}
+
+// CreateCollectionWithContext records the call and invokes CreateCollectionFunc
+func (mock *MockVisualRecognitionV4) CreateCollectionWithContext(ctx context.Context, createCollectionOptions *CreateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "CreateCollection", createCollectionOptions)
+	if mock.CreateCollectionFunc != nil {
+		return mock.CreateCollectionFunc(ctx, createCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "CreateCollection")
+	return
+}
+
+// ListCollections records the call and invokes ListCollectionsFunc
+func (mock *MockVisualRecognitionV4) ListCollections(listCollectionsOptions *ListCollectionsOptions) (result *CollectionsList, response *core.DetailedResponse, err error) {
+	return mock.ListCollectionsWithContext(context.Background(), listCollectionsOptions)
+}
+
+// ListCollectionsWithContext records the call and invokes ListCollectionsFunc
+func (mock *MockVisualRecognitionV4) ListCollectionsWithContext(ctx context.Context, listCollectionsOptions *ListCollectionsOptions) (result *CollectionsList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListCollections", listCollectionsOptions)
+	if mock.ListCollectionsFunc != nil {
+		return mock.ListCollectionsFunc(ctx, listCollectionsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "ListCollections")
+	return
+}
+
+// GetCollection records the call and invokes GetCollectionFunc
+func (mock *MockVisualRecognitionV4) GetCollection(getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return mock.GetCollectionWithContext(context.Background(), getCollectionOptions)
+}
+
+// GetCollectionWithContext records the call and invokes GetCollectionFunc
+func (mock *MockVisualRecognitionV4) GetCollectionWithContext(ctx context.Context, getCollectionOptions *GetCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetCollection", getCollectionOptions)
+	if mock.GetCollectionFunc != nil {
+		return mock.GetCollectionFunc(ctx, getCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "GetCollection")
+	return
+}
+
+// UpdateCollection records the call and invokes UpdateCollectionFunc
+func (mock *MockVisualRecognitionV4) UpdateCollection(updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return mock.UpdateCollectionWithContext(context.Background(), updateCollectionOptions)
+}
+
+// UpdateCollectionWithContext records the call and invokes UpdateCollectionFunc
+func (mock *MockVisualRecognitionV4) UpdateCollectionWithContext(ctx context.Context, updateCollectionOptions *UpdateCollectionOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateCollection", updateCollectionOptions)
+	if mock.UpdateCollectionFunc != nil {
+		return mock.UpdateCollectionFunc(ctx, updateCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "UpdateCollection")
+	return
+}
+
+// DeleteCollection records the call and invokes DeleteCollectionFunc
+func (mock *MockVisualRecognitionV4) DeleteCollection(deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteCollectionWithContext(context.Background(), deleteCollectionOptions)
+}
+
+// DeleteCollectionWithContext records the call and invokes DeleteCollectionFunc
+func (mock *MockVisualRecognitionV4) DeleteCollectionWithContext(ctx context.Context, deleteCollectionOptions *DeleteCollectionOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteCollection", deleteCollectionOptions)
+	if mock.DeleteCollectionFunc != nil {
+		return mock.DeleteCollectionFunc(ctx, deleteCollectionOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "DeleteCollection")
+	return
+}
+
+// GetModelFile records the call and invokes GetModelFileFunc
+func (mock *MockVisualRecognitionV4) GetModelFile(getModelFileOptions *GetModelFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return mock.GetModelFileWithContext(context.Background(), getModelFileOptions)
+}
+
+// GetModelFileWithContext records the call and invokes GetModelFileFunc
+func (mock *MockVisualRecognitionV4) GetModelFileWithContext(ctx context.Context, getModelFileOptions *GetModelFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetModelFile", getModelFileOptions)
+	if mock.GetModelFileFunc != nil {
+		return mock.GetModelFileFunc(ctx, getModelFileOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "GetModelFile")
+	return
+}
+
+// AddImages records the call and invokes AddImagesFunc
+func (mock *MockVisualRecognitionV4) AddImages(addImagesOptions *AddImagesOptions) (result *ImageDetailsList, response *core.DetailedResponse, err error) {
+	return mock.AddImagesWithContext(context.Background(), addImagesOptions)
+}
+
+// AddImagesWithContext records the call and invokes AddImagesFunc
+func (mock *MockVisualRecognitionV4) AddImagesWithContext(ctx context.Context, addImagesOptions *AddImagesOptions) (result *ImageDetailsList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddImages", addImagesOptions)
+	if mock.AddImagesFunc != nil {
+		return mock.AddImagesFunc(ctx, addImagesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "AddImages")
+	return
+}
+
+// ListImages records the call and invokes ListImagesFunc
+func (mock *MockVisualRecognitionV4) ListImages(listImagesOptions *ListImagesOptions) (result *ImageSummaryList, response *core.DetailedResponse, err error) {
+	return mock.ListImagesWithContext(context.Background(), listImagesOptions)
+}
+
+// ListImagesWithContext records the call and invokes ListImagesFunc
+func (mock *MockVisualRecognitionV4) ListImagesWithContext(ctx context.Context, listImagesOptions *ListImagesOptions) (result *ImageSummaryList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListImages", listImagesOptions)
+	if mock.ListImagesFunc != nil {
+		return mock.ListImagesFunc(ctx, listImagesOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "ListImages")
+	return
+}
+
+// GetImageDetails records the call and invokes GetImageDetailsFunc
+func (mock *MockVisualRecognitionV4) GetImageDetails(getImageDetailsOptions *GetImageDetailsOptions) (result *ImageDetails, response *core.DetailedResponse, err error) {
+	return mock.GetImageDetailsWithContext(context.Background(), getImageDetailsOptions)
+}
+
+// GetImageDetailsWithContext records the call and invokes GetImageDetailsFunc
+func (mock *MockVisualRecognitionV4) GetImageDetailsWithContext(ctx context.Context, getImageDetailsOptions *GetImageDetailsOptions) (result *ImageDetails, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetImageDetails", getImageDetailsOptions)
+	if mock.GetImageDetailsFunc != nil {
+		return mock.GetImageDetailsFunc(ctx, getImageDetailsOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "GetImageDetails")
+	return
+}
+
+// DeleteImage records the call and invokes DeleteImageFunc
+func (mock *MockVisualRecognitionV4) DeleteImage(deleteImageOptions *DeleteImageOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteImageWithContext(context.Background(), deleteImageOptions)
+}
+
+// DeleteImageWithContext records the call and invokes DeleteImageFunc
+func (mock *MockVisualRecognitionV4) DeleteImageWithContext(ctx context.Context, deleteImageOptions *DeleteImageOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteImage", deleteImageOptions)
+	if mock.DeleteImageFunc != nil {
+		return mock.DeleteImageFunc(ctx, deleteImageOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "DeleteImage")
+	return
+}
+
+// GetJpegImage records the call and invokes GetJpegImageFunc
+func (mock *MockVisualRecognitionV4) GetJpegImage(getJpegImageOptions *GetJpegImageOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	return mock.GetJpegImageWithContext(context.Background(), getJpegImageOptions)
+}
+
+// GetJpegImageWithContext records the call and invokes GetJpegImageFunc
+func (mock *MockVisualRecognitionV4) GetJpegImageWithContext(ctx context.Context, getJpegImageOptions *GetJpegImageOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetJpegImage", getJpegImageOptions)
+	if mock.GetJpegImageFunc != nil {
+		return mock.GetJpegImageFunc(ctx, getJpegImageOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "GetJpegImage")
+	return
+}
+
+// ListObjectMetadata records the call and invokes ListObjectMetadataFunc
+func (mock *MockVisualRecognitionV4) ListObjectMetadata(listObjectMetadataOptions *ListObjectMetadataOptions) (result *ObjectMetadataList, response *core.DetailedResponse, err error) {
+	return mock.ListObjectMetadataWithContext(context.Background(), listObjectMetadataOptions)
+}
+
+// ListObjectMetadataWithContext records the call and invokes ListObjectMetadataFunc
+func (mock *MockVisualRecognitionV4) ListObjectMetadataWithContext(ctx context.Context, listObjectMetadataOptions *ListObjectMetadataOptions) (result *ObjectMetadataList, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "ListObjectMetadata", listObjectMetadataOptions)
+	if mock.ListObjectMetadataFunc != nil {
+		return mock.ListObjectMetadataFunc(ctx, listObjectMetadataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "ListObjectMetadata")
+	return
+}
+
+// UpdateObjectMetadata records the call and invokes UpdateObjectMetadataFunc
+func (mock *MockVisualRecognitionV4) UpdateObjectMetadata(updateObjectMetadataOptions *UpdateObjectMetadataOptions) (result *UpdateObjectMetadata, response *core.DetailedResponse, err error) {
+	return mock.UpdateObjectMetadataWithContext(context.Background(), updateObjectMetadataOptions)
+}
+
+// UpdateObjectMetadataWithContext records the call and invokes UpdateObjectMetadataFunc
+func (mock *MockVisualRecognitionV4) UpdateObjectMetadataWithContext(ctx context.Context, updateObjectMetadataOptions *UpdateObjectMetadataOptions) (result *UpdateObjectMetadata, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "UpdateObjectMetadata", updateObjectMetadataOptions)
+	if mock.UpdateObjectMetadataFunc != nil {
+		return mock.UpdateObjectMetadataFunc(ctx, updateObjectMetadataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "UpdateObjectMetadata")
+	return
+}
+
+// GetObjectMetadata records the call and invokes GetObjectMetadataFunc
+func (mock *MockVisualRecognitionV4) GetObjectMetadata(getObjectMetadataOptions *GetObjectMetadataOptions) (result *ObjectMetadata, response *core.DetailedResponse, err error) {
+	return mock.GetObjectMetadataWithContext(context.Background(), getObjectMetadataOptions)
+}
+
+// GetObjectMetadataWithContext records the call and invokes GetObjectMetadataFunc
+func (mock *MockVisualRecognitionV4) GetObjectMetadataWithContext(ctx context.Context, getObjectMetadataOptions *GetObjectMetadataOptions) (result *ObjectMetadata, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetObjectMetadata", getObjectMetadataOptions)
+	if mock.GetObjectMetadataFunc != nil {
+		return mock.GetObjectMetadataFunc(ctx, getObjectMetadataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "GetObjectMetadata")
+	return
+}
+
+// DeleteObject records the call and invokes DeleteObjectFunc
+func (mock *MockVisualRecognitionV4) DeleteObject(deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteObjectWithContext(context.Background(), deleteObjectOptions)
+}
+
+// DeleteObjectWithContext records the call and invokes DeleteObjectFunc
+func (mock *MockVisualRecognitionV4) DeleteObjectWithContext(ctx context.Context, deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteObject", deleteObjectOptions)
+	if mock.DeleteObjectFunc != nil {
+		return mock.DeleteObjectFunc(ctx, deleteObjectOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "DeleteObject")
+	return
+}
+
+// Train records the call and invokes TrainFunc
+func (mock *MockVisualRecognitionV4) Train(trainOptions *TrainOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	return mock.TrainWithContext(context.Background(), trainOptions)
+}
+
+// TrainWithContext records the call and invokes TrainFunc
+func (mock *MockVisualRecognitionV4) TrainWithContext(ctx context.Context, trainOptions *TrainOptions) (result *Collection, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "Train", trainOptions)
+	if mock.TrainFunc != nil {
+		return mock.TrainFunc(ctx, trainOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "Train")
+	return
+}
+
+// AddImageTrainingData records the call and invokes AddImageTrainingDataFunc
+func (mock *MockVisualRecognitionV4) AddImageTrainingData(addImageTrainingDataOptions *AddImageTrainingDataOptions) (result *TrainingDataObjects, response *core.DetailedResponse, err error) {
+	return mock.AddImageTrainingDataWithContext(context.Background(), addImageTrainingDataOptions)
+}
+
+// AddImageTrainingDataWithContext records the call and invokes AddImageTrainingDataFunc
+func (mock *MockVisualRecognitionV4) AddImageTrainingDataWithContext(ctx context.Context, addImageTrainingDataOptions *AddImageTrainingDataOptions) (result *TrainingDataObjects, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "AddImageTrainingData", addImageTrainingDataOptions)
+	if mock.AddImageTrainingDataFunc != nil {
+		return mock.AddImageTrainingDataFunc(ctx, addImageTrainingDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "AddImageTrainingData")
+	return
+}
+
+// GetTrainingUsage records the call and invokes GetTrainingUsageFunc
+func (mock *MockVisualRecognitionV4) GetTrainingUsage(getTrainingUsageOptions *GetTrainingUsageOptions) (result *TrainingEvents, response *core.DetailedResponse, err error) {
+	return mock.GetTrainingUsageWithContext(context.Background(), getTrainingUsageOptions)
+}
+
+// GetTrainingUsageWithContext records the call and invokes GetTrainingUsageFunc
+func (mock *MockVisualRecognitionV4) GetTrainingUsageWithContext(ctx context.Context, getTrainingUsageOptions *GetTrainingUsageOptions) (result *TrainingEvents, response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "GetTrainingUsage", getTrainingUsageOptions)
+	if mock.GetTrainingUsageFunc != nil {
+		return mock.GetTrainingUsageFunc(ctx, getTrainingUsageOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "GetTrainingUsage")
+	return
+}
+
+// DeleteUserData records the call and invokes DeleteUserDataFunc
+func (mock *MockVisualRecognitionV4) DeleteUserData(deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteUserDataWithContext(context.Background(), deleteUserDataOptions)
+}
+
+// DeleteUserDataWithContext records the call and invokes DeleteUserDataFunc
+func (mock *MockVisualRecognitionV4) DeleteUserDataWithContext(ctx context.Context, deleteUserDataOptions *DeleteUserDataOptions) (response *core.DetailedResponse, err error) {
+	mock.Record(ctx, "DeleteUserData", deleteUserDataOptions)
+	if mock.DeleteUserDataFunc != nil {
+		return mock.DeleteUserDataFunc(ctx, deleteUserDataOptions)
+	}
+	err = common.ErrMockNotImplemented("MockVisualRecognitionV4", "DeleteUserData")
+	return
+}
+
+// NewAddImageTrainingDataOptions delegates to VisualRecognitionV4.NewAddImageTrainingDataOptions
+func (mock *MockVisualRecognitionV4) NewAddImageTrainingDataOptions(collectionID string, imageID string) *AddImageTrainingDataOptions {
+	return new(VisualRecognitionV4).NewAddImageTrainingDataOptions(collectionID, imageID)
+}
+
+// NewAddImagesOptions delegates to VisualRecognitionV4.NewAddImagesOptions
+func (mock *MockVisualRecognitionV4) NewAddImagesOptions(collectionID string) *AddImagesOptions {
+	return new(VisualRecognitionV4).NewAddImagesOptions(collectionID)
+}
+
+// NewAnalyzeOptions delegates to VisualRecognitionV4.NewAnalyzeOptions
+func (mock *MockVisualRecognitionV4) NewAnalyzeOptions(collectionIds []string, features []string) *AnalyzeOptions {
+	return new(VisualRecognitionV4).NewAnalyzeOptions(collectionIds, features)
+}
+
+// NewCreateCollectionOptions delegates to VisualRecognitionV4.NewCreateCollectionOptions
+func (mock *MockVisualRecognitionV4) NewCreateCollectionOptions() *CreateCollectionOptions {
+	return new(VisualRecognitionV4).NewCreateCollectionOptions()
+}
+
+// NewDeleteCollectionOptions delegates to VisualRecognitionV4.NewDeleteCollectionOptions
+func (mock *MockVisualRecognitionV4) NewDeleteCollectionOptions(collectionID string) *DeleteCollectionOptions {
+	return new(VisualRecognitionV4).NewDeleteCollectionOptions(collectionID)
+}
+
+// NewDeleteImageOptions delegates to VisualRecognitionV4.NewDeleteImageOptions
+func (mock *MockVisualRecognitionV4) NewDeleteImageOptions(collectionID string, imageID string) *DeleteImageOptions {
+	return new(VisualRecognitionV4).NewDeleteImageOptions(collectionID, imageID)
+}
+
+// NewDeleteObjectOptions delegates to VisualRecognitionV4.NewDeleteObjectOptions
+func (mock *MockVisualRecognitionV4) NewDeleteObjectOptions(collectionID string, object string) *DeleteObjectOptions {
+	return new(VisualRecognitionV4).NewDeleteObjectOptions(collectionID, object)
+}
+
+// NewDeleteUserDataOptions delegates to VisualRecognitionV4.NewDeleteUserDataOptions
+func (mock *MockVisualRecognitionV4) NewDeleteUserDataOptions(customerID string) *DeleteUserDataOptions {
+	return new(VisualRecognitionV4).NewDeleteUserDataOptions(customerID)
+}
+
+// NewFileWithMetadata delegates to VisualRecognitionV4.NewFileWithMetadata
+func (mock *MockVisualRecognitionV4) NewFileWithMetadata(data io.ReadCloser) (model *FileWithMetadata, err error) {
+	return new(VisualRecognitionV4).NewFileWithMetadata(data)
+}
+
+// NewGetCollectionOptions delegates to VisualRecognitionV4.NewGetCollectionOptions
+func (mock *MockVisualRecognitionV4) NewGetCollectionOptions(collectionID string) *GetCollectionOptions {
+	return new(VisualRecognitionV4).NewGetCollectionOptions(collectionID)
+}
+
+// NewGetImageDetailsOptions delegates to VisualRecognitionV4.NewGetImageDetailsOptions
+func (mock *MockVisualRecognitionV4) NewGetImageDetailsOptions(collectionID string, imageID string) *GetImageDetailsOptions {
+	return new(VisualRecognitionV4).NewGetImageDetailsOptions(collectionID, imageID)
+}
+
+// NewGetJpegImageOptions delegates to VisualRecognitionV4.NewGetJpegImageOptions
+func (mock *MockVisualRecognitionV4) NewGetJpegImageOptions(collectionID string, imageID string) *GetJpegImageOptions {
+	return new(VisualRecognitionV4).NewGetJpegImageOptions(collectionID, imageID)
+}
+
+// NewGetModelFileOptions delegates to VisualRecognitionV4.NewGetModelFileOptions
+func (mock *MockVisualRecognitionV4) NewGetModelFileOptions(collectionID string, feature string, modelFormat string) *GetModelFileOptions {
+	return new(VisualRecognitionV4).NewGetModelFileOptions(collectionID, feature, modelFormat)
+}
+
+// NewGetObjectMetadataOptions delegates to VisualRecognitionV4.NewGetObjectMetadataOptions
+func (mock *MockVisualRecognitionV4) NewGetObjectMetadataOptions(collectionID string, object string) *GetObjectMetadataOptions {
+	return new(VisualRecognitionV4).NewGetObjectMetadataOptions(collectionID, object)
+}
+
+// NewGetTrainingUsageOptions delegates to VisualRecognitionV4.NewGetTrainingUsageOptions
+func (mock *MockVisualRecognitionV4) NewGetTrainingUsageOptions() *GetTrainingUsageOptions {
+	return new(VisualRecognitionV4).NewGetTrainingUsageOptions()
+}
+
+// NewListCollectionsOptions delegates to VisualRecognitionV4.NewListCollectionsOptions
+func (mock *MockVisualRecognitionV4) NewListCollectionsOptions() *ListCollectionsOptions {
+	return new(VisualRecognitionV4).NewListCollectionsOptions()
+}
+
+// NewListImagesOptions delegates to VisualRecognitionV4.NewListImagesOptions
+func (mock *MockVisualRecognitionV4) NewListImagesOptions(collectionID string) *ListImagesOptions {
+	return new(VisualRecognitionV4).NewListImagesOptions(collectionID)
+}
+
+// NewListObjectMetadataOptions delegates to VisualRecognitionV4.NewListObjectMetadataOptions
+func (mock *MockVisualRecognitionV4) NewListObjectMetadataOptions(collectionID string) *ListObjectMetadataOptions {
+	return new(VisualRecognitionV4).NewListObjectMetadataOptions(collectionID)
+}
+
+// NewLocation delegates to VisualRecognitionV4.NewLocation
+func (mock *MockVisualRecognitionV4) NewLocation(top int64, left int64, width int64, height int64) (model *Location, err error) {
+	return new(VisualRecognitionV4).NewLocation(top, left, width, height)
+}
+
+// NewObjectTrainingStatus delegates to VisualRecognitionV4.NewObjectTrainingStatus
+func (mock *MockVisualRecognitionV4) NewObjectTrainingStatus(ready bool, inProgress bool, dataChanged bool, latestFailed bool, rscnnReady bool, description string) (model *ObjectTrainingStatus, err error) {
+	return new(VisualRecognitionV4).NewObjectTrainingStatus(ready, inProgress, dataChanged, latestFailed, rscnnReady, description)
+}
+
+// NewTrainOptions delegates to VisualRecognitionV4.NewTrainOptions
+func (mock *MockVisualRecognitionV4) NewTrainOptions(collectionID string) *TrainOptions {
+	return new(VisualRecognitionV4).NewTrainOptions(collectionID)
+}
+
+// NewTrainingStatus delegates to VisualRecognitionV4.NewTrainingStatus
+func (mock *MockVisualRecognitionV4) NewTrainingStatus(objects *ObjectTrainingStatus) (model *TrainingStatus, err error) {
+	return new(VisualRecognitionV4).NewTrainingStatus(objects)
+}
+
+// NewUpdateCollectionOptions delegates to VisualRecognitionV4.NewUpdateCollectionOptions
+func (mock *MockVisualRecognitionV4) NewUpdateCollectionOptions(collectionID string) *UpdateCollectionOptions {
+	return new(VisualRecognitionV4).NewUpdateCollectionOptions(collectionID)
+}
+
+// NewUpdateObjectMetadata delegates to VisualRecognitionV4.NewUpdateObjectMetadata
+func (mock *MockVisualRecognitionV4) NewUpdateObjectMetadata(object string, count int64) (model *UpdateObjectMetadata, err error) {
+	return new(VisualRecognitionV4).NewUpdateObjectMetadata(object, count)
+}
+
+// NewUpdateObjectMetadataOptions delegates to VisualRecognitionV4.NewUpdateObjectMetadataOptions
+func (mock *MockVisualRecognitionV4) NewUpdateObjectMetadataOptions(collectionID string, object string, newObject string) *UpdateObjectMetadataOptions {
+	return new(VisualRecognitionV4).NewUpdateObjectMetadataOptions(collectionID, object, newObject)
+}